@@ -0,0 +1,337 @@
+// Package api is the public interface to the FG/FGG type checker, interpreter
+// and translations (monomorphisation and obliteration).
+//
+// N.B. unlike the fgg command, nothing here prints any output or exits -- errors
+// are returned.
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rhu1/fgg/internal/base"
+	"github.com/rhu1/fgg/internal/fg"
+	"github.com/rhu1/fgg/internal/fgg"
+	"github.com/rhu1/fgg/internal/fgr"
+	"github.com/rhu1/fgg/internal/frontend"
+	"github.com/rhu1/fgg/internal/parser"
+)
+
+/* Languages */
+
+type Lang int
+
+const (
+	FG  Lang = iota
+	FGG      // Can be monomorphised (to FG) or obliterated (to FGR)
+	FGR      // Target of obliteration, cannot be parsed
+)
+
+func (l Lang) String() string {
+	switch l {
+	case FG:
+		return "FG"
+	case FGG:
+		return "FGG"
+	case FGR:
+		return "FGR"
+	default:
+		return fmt.Sprintf("Lang(%d)", int(l))
+	}
+}
+
+/* Programs */
+
+// An immutable FG, FGG or FGR program -- checking, evaluating and translating
+// return new Programs
+type Program struct {
+	lang Lang
+	prog base.Program
+	typ  base.Type // Type of main, nil if not checked
+}
+
+func (p *Program) Lang() Lang { return p.lang }
+
+func (p *Program) String() string { return p.prog.String() }
+
+// The main expression, e.g., the result of Eval
+func (p *Program) Main() string { return p.prog.GetMain().String() }
+
+// Cf. fmt.Printf("%#v", ...) -- as output by the -printf flag
+func (p *Program) MainGoString() string {
+	return p.prog.GetMain().ToGoString(p.prog.GetDecls())
+}
+
+// The type of main, or "" if the program has not been checked or evaluated
+func (p *Program) Type() string {
+	if p.typ == nil {
+		return ""
+	}
+	return p.typ.String()
+}
+
+// Whether main is a value
+func (p *Program) IsValue() bool { return p.prog.GetMain().IsValue() }
+
+// Whether main is a panic result, e.g., panic(v) or a run-time error (cf. Main)
+func (p *Program) IsPanic() bool { return p.prog.GetMain().IsPanic() }
+
+/* Errors */
+
+// Located syntax and type errors
+type Diagnostic = base.Diagnostic
+type DiagnosticKind = base.DiagnosticKind
+type Span = base.Span
+
+// The kind of a Diagnostic, e.g., DIAG_SYNTAX for a lexer/parser error
+const (
+	DIAG_OTHER          = base.DIAG_OTHER
+	DIAG_DUPLICATE_DECL = base.DIAG_DUPLICATE_DECL
+	DIAG_UNKNOWN_TYPE   = base.DIAG_UNKNOWN_TYPE
+	DIAG_UNKNOWN_VAR    = base.DIAG_UNKNOWN_VAR
+	DIAG_UNKNOWN_FIELD  = base.DIAG_UNKNOWN_FIELD
+	DIAG_UNKNOWN_METHOD = base.DIAG_UNKNOWN_METHOD
+	DIAG_UNKNOWN_FUNC   = base.DIAG_UNKNOWN_FUNC
+	DIAG_NOT_ASSIGNABLE = base.DIAG_NOT_ASSIGNABLE
+	DIAG_ARITY          = base.DIAG_ARITY
+	DIAG_NOT_STRUCT     = base.DIAG_NOT_STRUCT
+	DIAG_NOT_INTERFACE  = base.DIAG_NOT_INTERFACE
+	DIAG_BAD_ASSERT     = base.DIAG_BAD_ASSERT
+	DIAG_BAD_CONVERSION = base.DIAG_BAD_CONVERSION
+	DIAG_BAD_OPERATION  = base.DIAG_BAD_OPERATION
+	DIAG_BAD_RECEIVER   = base.DIAG_BAD_RECEIVER
+	DIAG_BAD_BOUND      = base.DIAG_BAD_BOUND
+	DIAG_CYCLIC_DECL    = base.DIAG_CYCLIC_DECL
+	DIAG_AMBIGUOUS      = base.DIAG_AMBIGUOUS
+	DIAG_INFER          = base.DIAG_INFER
+	DIAG_BAD_TYPE_SET   = base.DIAG_BAD_TYPE_SET
+	DIAG_NO_ZERO_VALUE  = base.DIAG_NO_ZERO_VALUE
+	DIAG_SYNTAX         = base.DIAG_SYNTAX
+	DIAG_UNSUPPORTED    = base.DIAG_UNSUPPORTED
+)
+
+// All the errors found by a parse or check, each a Diagnostic
+type Errors []error
+
+func (es Errors) Error() string {
+	msgs := make([]string, len(es))
+	for i, e := range es {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// A panic raised by the checker or interpreter that is not a Diagnostic
+// (N.B. not a panic result of the evaluated program, cf. Program.IsPanic)
+type PanicError struct {
+	Value interface{}
+}
+
+func (e PanicError) Error() string { return fmt.Sprint("panic: ", e.Value) }
+
+// Converts a checker/interpreter panic into *err
+func catch(err *error) {
+	if r := recover(); r != nil {
+		switch r1 := r.(type) {
+		case base.Diagnostic:
+			*err = Errors{r1}
+		default:
+			*err = PanicError{r}
+		}
+	}
+}
+
+/* Parsing */
+
+type ParseOptions struct {
+	Strict bool // Stop at the first syntax error (o/w error recovery is attempted)
+}
+
+// Returns Errors if there are syntax errors
+func ParseFG(src string, opts ParseOptions) (res *Program, err error) {
+	defer catch(&err)
+	var a parser.FGAdaptor
+	p, errs := a.Parse(opts.Strict, src)
+	if len(errs) > 0 {
+		return nil, Errors(errs)
+	}
+	return &Program{FG, p, nil}, nil
+}
+
+// Method type params are renamed (cf. frontend.RenameParams), as by the fgg command
+func ParseFGG(src string, opts ParseOptions) (res *Program, err error) {
+	defer catch(&err)
+	var a parser.FGGAdaptor
+	p, errs := a.Parse(opts.Strict, src)
+	if len(errs) > 0 {
+		return nil, Errors(errs)
+	}
+	return &Program{FGG, frontend.RenameParams(p.(fgg.FGGProgram)), nil}, nil
+}
+
+// Returns the canonical, indented form of src, as output by fgg fmt.  Comments
+// are kept, and the result reparses to the same program as src.
+func Format(src string, lang Lang, opts ParseOptions) (string, error) {
+	var a base.Adaptor
+	switch lang {
+	case FG:
+		a = &parser.FGAdaptor{}
+	case FGG:
+		a = &parser.FGGAdaptor{} // N.B. method type params are not renamed
+	default:
+		return "", errors.New("cannot format " + lang.String() + " source")
+	}
+	p, errs := a.Parse(opts.Strict, src)
+	if len(errs) > 0 {
+		return "", Errors(errs)
+	}
+	switch p1 := p.(type) {
+	case fg.FGProgram:
+		return p1.Format(a.GetComments()), nil
+	default:
+		return p1.(fgg.FGGProgram).Format(a.GetComments()), nil
+	}
+}
+
+/* Checking */
+
+type CheckOptions struct {
+	AllowStupid bool // Allow "stupid" type assertions (as arise during evaluation)
+	Infer       bool // Infer omitted type args (FGG only)
+}
+
+// Returns the checked program (main annotated with, e.g., implicit conversions).
+// Returns Errors with every (per decl) type error found, if any.
+func Check(p *Program, opts CheckOptions) (res *Program, err error) {
+	defer catch(&err)
+	mode := base.CHECK
+	if opts.Infer {
+		if p.lang != FGG {
+			return nil, errors.New("type inference is only supported for FGG, not " +
+				p.lang.String())
+		}
+		mode = base.INFER
+	}
+	t, checked, ds := p.prog.Check(opts.AllowStupid, mode)
+	if len(ds) > 0 {
+		errs := make(Errors, len(ds))
+		for i, d := range ds {
+			errs[i] = d
+		}
+		return nil, errs
+	}
+	return &Program{p.lang, checked, t}, nil
+}
+
+/* Evaluation */
+
+type EvalOptions struct {
+	Steps      int  // Max number of steps, <= 0 means evaluate to a value
+	CheckSteps bool // Check type preservation after every step (as fgg -eval)
+
+	// If not nil, called after every step with the rule applied
+	Trace func(step int, rule string, p *Program)
+}
+
+// Evaluates p (which is first checked) until a value or a panic (cf.
+// Program.IsPanic), or for opts.Steps steps. Returns the evaluated program; on
+// cancellation of ctx, the program so far is also returned with ctx.Err(). A
+// panic of the interpreter itself is returned as a PanicError.
+// N.B. p is checked without inference: for an FGG program with omitted type
+// args, pass the result of Check with CheckOptions.Infer, in which they are
+// filled in -- the same goes for Monomorphise and Obliterate.
+func Eval(ctx context.Context, p *Program, opts EvalOptions) (res *Program, err error) {
+	cur, err := Check(p, CheckOptions{AllowStupid: true})
+	if err != nil {
+		return nil, err
+	}
+	defer catch(&err)
+	allowStupid := true
+	ds := cur.prog.GetDecls()
+	t_init := cur.typ
+	for i := 1; !cur.IsValue() && !cur.IsPanic() && (opts.Steps <= 0 || i <= opts.Steps); i++ {
+		if err := ctx.Err(); err != nil {
+			return cur, err
+		}
+		prog, rule := cur.prog.Eval()
+		t := t_init
+		if opts.CheckSteps {
+			t, prog = prog.Ok(allowStupid, base.CHECK)
+			if !frontend.AssignableTo(ds, t, t_init) {
+				return nil, errors.New("type not preserved by evaluation: " +
+					t.String() + " is not assignable to " + t_init.String())
+			}
+		}
+		cur = &Program{cur.lang, prog, t}
+		if opts.Trace != nil {
+			opts.Trace(i, rule, cur)
+		}
+	}
+	return cur, nil
+}
+
+/* Translations */
+
+type MonomOptions struct {
+	Check bool // Also check the resulting FG program
+}
+
+// Translates an FGG program to FG.
+// Returns an error if the program cannot be monomorphised (nomono).
+func Monomorphise(p *Program, opts MonomOptions) (res *Program, err error) {
+	p_fgg, err := asFGG(p)
+	if err != nil {
+		return nil, err
+	}
+	defer catch(&err)
+	if ok, msg := fgg.IsMonomOK(p_fgg); !ok {
+		return nil, errors.New("cannot monomorphise (nomono detected): " + msg)
+	}
+	var p_mono base.Program = fgg.Monomorph(p_fgg)
+	var t base.Type
+	if opts.Check {
+		t, p_mono = p_mono.Ok(false, base.CHECK)
+	}
+	return &Program{FG, p_mono, t}, nil
+}
+
+type OblitOptions struct {
+	Check bool // Also check the resulting FGR program
+}
+
+// Translates an FGG program to FGR
+func Obliterate(p *Program, opts OblitOptions) (res *Program, err error) {
+	p_fgg, err := asFGG(p)
+	if err != nil {
+		return nil, err
+	}
+	defer catch(&err)
+	var p_fgr base.Program = fgr.Obliterate(p_fgg)
+	var t base.Type
+	if opts.Check {
+		t, p_fgr = p_fgr.Ok(false, base.CHECK)
+	}
+	return &Program{FGR, p_fgr, t}, nil
+}
+
+// Translates an FG program to the equivalent (non-generic) FGG program
+func FromFG(p *Program) (*Program, error) {
+	if p.lang != FG {
+		return nil, errors.New("expected an FG program, not " + p.lang.String())
+	}
+	p_fgg, err := fgg.FromFG(p.prog.(fg.FGProgram))
+	if err != nil {
+		return nil, err
+	}
+	return &Program{FGG, p_fgg, nil}, nil
+}
+
+func asFGG(p *Program) (fgg.FGGProgram, error) {
+	if p.lang != FGG {
+		return fgg.FGGProgram{}, errors.New("expected an FGG program, not " +
+			p.lang.String())
+	}
+	return p.prog.(fgg.FGGProgram), nil
+}
@@ -0,0 +1,186 @@
+package api_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rhu1/fgg/api"
+)
+
+const fgSrc = `package main;
+type A struct {};
+type B struct { f A };
+func (x0 B) m() A { return x0.f };
+func main() { _ = B{A{}}.m() }`
+
+const fggSrc = `package main;
+type Any(type ) interface {};
+type A(type ) struct {};
+type Box(type a Any()) struct { f a };
+func (x0 Box(type a Any())) get(type )() a { return x0.f };
+func main() { _ = Box(A()){A(){}}.get()() }`
+
+func TestEvalFG(t *testing.T) {
+	p, err := api.ParseFG(fgSrc, api.ParseOptions{Strict: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	steps := 0
+	trace := func(int, string, *api.Program) { steps++ }
+	res, err := api.Eval(context.Background(), p,
+		api.EvalOptions{CheckSteps: true, Trace: trace})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.IsValue() || res.Type() != "A" || steps != 2 {
+		t.Errorf("Unexpected result: " + res.Main() + " : " + res.Type())
+	}
+}
+
+func TestEvalCancelled(t *testing.T) {
+	p, err := api.ParseFG(fgSrc, api.ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res, err := api.Eval(ctx, p, api.EvalOptions{})
+	if err != context.Canceled || res == nil || res.IsValue() {
+		t.Errorf("Expected cancelled evaluation, got: %v", err)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := api.ParseFG("package main; type A struct {", api.ParseOptions{})
+	errs, ok := err.(api.Errors)
+	if !ok || len(errs) == 0 {
+		t.Fatalf("Expected syntax errors, got: %v", err)
+	}
+	if d, ok := errs[0].(api.Diagnostic); !ok || d.Kind != api.DIAG_SYNTAX {
+		t.Errorf("Expected syntax diagnostic, got: %v", errs[0])
+	}
+}
+
+// An adaptor panic, e.g., an int constant that overflows, is returned as an error
+func TestParsePanic(t *testing.T) {
+	src := `package main;
+type A(type ) struct { f int64 };
+func main() { _ = A(){99999999999999999999} }`
+	if _, err := api.ParseFGG(src, api.ParseOptions{}); err == nil {
+		t.Errorf("Expected error: int constant overflows")
+	}
+}
+
+func TestCheckErrors(t *testing.T) {
+	src := `package main;
+type A struct {};
+func (x0 A) m1() A { return x1 };
+func (x0 A) m2() A { return B{} };
+func main() { _ = A{} }`
+	p, err := api.ParseFG(src, api.ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = api.Check(p, api.CheckOptions{})
+	errs, ok := err.(api.Errors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Expected two type errors, got: %v", err)
+	}
+	kinds := []api.DiagnosticKind{api.DIAG_UNKNOWN_VAR, api.DIAG_UNKNOWN_TYPE}
+	for i, e := range errs {
+		if d, ok := e.(api.Diagnostic); !ok || d.Kind != kinds[i] {
+			t.Errorf("Expected " + kinds[i].String() + " diagnostic, got: " + e.Error())
+		}
+	}
+}
+
+// Checking annotates the decls, e.g., the untyped constant in m, but only those
+// of the checked program
+func TestCheckImmutable(t *testing.T) {
+	src := `package main;
+type A struct {};
+func (x0 A) m() int32 { return 1 };
+func main() { _ = A{}.m() }`
+	p, err := api.ParseFG(src, api.ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := p.String()
+	if _, err := api.Check(p, api.CheckOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if p.String() != before {
+		t.Errorf("Check modified the program:\n" + before + "\nbecame:\n" + p.String())
+	}
+}
+
+// Inference is only done by Check, whose result has the type args filled in
+func TestEvalInfer(t *testing.T) {
+	src := `package main;
+type Any(type ) interface {};
+type A(type ) struct {};
+func (x0 A(type )) id(type b Any())(y b) b { return y };
+func main() { _ = A(){}.id()(A(){}) }`
+	p, err := api.ParseFGG(src, api.ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.Eval(context.Background(), p, api.EvalOptions{}); err == nil {
+		t.Errorf("Expected error: omitted type arg")
+	}
+	checked, err := api.Check(p, api.CheckOptions{Infer: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := api.Eval(context.Background(), checked, api.EvalOptions{CheckSteps: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.IsValue() || res.Type() != "A()" {
+		t.Errorf("Unexpected result: " + res.Main() + " : " + res.Type())
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	p, err := api.ParseFGG(fggSrc, api.ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mono, err := api.Monomorphise(p, api.MonomOptions{Check: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mono.Lang() != api.FG {
+		t.Errorf("Expected FG, got: " + mono.Lang().String())
+	}
+	oblit, err := api.Obliterate(p, api.OblitOptions{Check: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oblit.Lang() != api.FGR {
+		t.Errorf("Expected FGR, got: " + oblit.Lang().String())
+	}
+	if _, err := api.Monomorphise(mono, api.MonomOptions{}); err == nil {
+		t.Errorf("Expected error: cannot monomorphise an FG program")
+	}
+}
+
+// Obliteration of an unsupported feature, e.g., a slice, is returned as an error
+func TestObliterateUnsupported(t *testing.T) {
+	src := `package main;
+func main() { _ = []int32{1} }`
+	p, err := api.ParseFGG(src, api.ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = api.Obliterate(p, api.OblitOptions{})
+	errs, ok := err.(api.Errors)
+	if !ok || len(errs) != 1 {
+		t.Fatalf("Expected an unsupported error, got: %v", err)
+	}
+	if d, ok := errs[0].(api.Diagnostic); !ok || d.Kind != api.DIAG_UNSUPPORTED ||
+		!strings.Contains(d.Message, "Slices") {
+		t.Errorf("Expected unsupported diagnostic, got: " + errs[0].Error())
+	}
+}
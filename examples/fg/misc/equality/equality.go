@@ -0,0 +1,30 @@
+//$ go run github.com/rhu1/fgg run -eval=-1 -v examples/fg/misc/equality/equality.go
+// Cf. examples/fg/oopsla20/fig2/equality.go -- using the built-in == on
+// (comparable) structs instead of the Church-encoded Bool and Int
+//$ go run github.com/rhu1/fgg/examples/fg/misc/equality
+
+package main;
+
+import "fmt";
+
+type Eq interface {
+	Equal(that Eq) bool
+};
+type Int struct {
+	val int32
+};
+func (this Int) Equal(that Eq) bool {
+	return this == that.(Int)
+};
+type Pair struct {
+	left Eq;
+	right Eq
+};
+func (this Pair) Equal(that Eq) bool {
+	return this.left.Equal(that.(Pair).left) && this.right.Equal(that.(Pair).right)
+};
+
+func main() {
+	fmt.Printf("%#v", Pair{Int{1}, Int{2}}.Equal(Pair{Int{1}, Int{2}}) &&
+		Pair{Int{1}, Int{2}} != Pair{Int{2}, Int{1}})
+}
@@ -22,13 +22,15 @@ type Program interface {
 	GetDecls() []Decl
 	GetMain() Expr
 	Ok(allowStupid bool, mode TypingMode) (Type, Program) // Set false for source check
+	Check(allowStupid bool, mode TypingMode) (Type, Program, []Diagnostic) // Cf. Ok, but collects all (per decl) errors
 	Eval() (Program, string)  // Eval one step; string is the name of the (innermost) applied rule
 }
 
 type Expr interface {
 	AstNode
 	IsValue() bool
-	CanEval(ds []Decl) bool      // More like, canReduce -- basically, bad assert returns false, cf. IsValue()
+	IsPanic() bool               // An "explicit" panic(v), e.g., from a run-time error -- neither a value nor stuck
+	CanEval(ds []Decl) bool      // More like, canReduce -- N.B. E[panic(v)] reduces to panic(v), cf. IsPanic()
 	ToGoString(ds []Decl) string // Basically, type T printed as main.T  // TODO (cf. %#v, Go-syntax value representation)
 }
 
@@ -46,7 +48,8 @@ type Type interface {
 /* ANTLR (parsing) */
 
 type Adaptor interface {
-	Parse(strictParse bool, input string) Program
+	Parse(strictParse bool, input string) (Program, []error) // Errors are located base.Diagnostics
+	GetComments() []Comment                                  // Of the last successful Parse, in source order
 }
 
 /* Typing modes */ // TODO decide where (pkg/file) does it make more sense to put this
@@ -0,0 +1,178 @@
+package base
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+/* Diagnostics -- structured type errors (cf. bare string panics) */
+
+type DiagnosticKind int
+
+const (
+	DIAG_OTHER          DiagnosticKind = iota // E.g., an (unstructured) string panic
+	DIAG_DUPLICATE_DECL                       // Type, method, field, param, sig
+	DIAG_UNKNOWN_TYPE                         // Type name not declared
+	DIAG_UNKNOWN_VAR                          // Variable not in env
+	DIAG_UNKNOWN_FIELD                        // Field not found in struct type
+	DIAG_UNKNOWN_METHOD                       // Method not found in method set
+	DIAG_UNKNOWN_FUNC                         // Function name not declared
+	DIAG_NOT_ASSIGNABLE                       // Arg/field/return type mismatch
+	DIAG_ARITY                                // Wrong number of args/type args
+	DIAG_NOT_STRUCT                           // Struct literal/select on non-struct type
+	DIAG_NOT_INTERFACE                        // Embedded/asserted-from type is not an interface
+	DIAG_BAD_ASSERT                           // Impossible type assertion
+	DIAG_BAD_CONVERSION                       // Invalid type conversion
+	DIAG_BAD_OPERATION                        // Operator not defined for operand types
+	DIAG_BAD_RECEIVER                         // Invalid method receiver
+	DIAG_BAD_BOUND                            // Type arg does not satisfy its bound
+	DIAG_CYCLIC_DECL                          // Cyclic type decl
+	DIAG_AMBIGUOUS                            // Ambiguous selector (promoted field/method at equal depth)
+	DIAG_INFER                                // Type argument inference failed (unification)
+	DIAG_BAD_TYPE_SET                         // Invalid union term, empty type set, or constraint used as a type
+	DIAG_NO_ZERO_VALUE                        // E.g., comma-ok assertion to an interface type (no nil)
+	DIAG_SYNTAX                               // Lexer/parser error
+	DIAG_UNSUPPORTED                          // Not supported by a translation, e.g., obliteration
+)
+
+var diagKindNames = map[DiagnosticKind]string{
+	DIAG_OTHER:          "other",
+	DIAG_DUPLICATE_DECL: "duplicate-decl",
+	DIAG_UNKNOWN_TYPE:   "unknown-type",
+	DIAG_UNKNOWN_VAR:    "unknown-var",
+	DIAG_UNKNOWN_FIELD:  "unknown-field",
+	DIAG_UNKNOWN_METHOD: "unknown-method",
+	DIAG_UNKNOWN_FUNC:   "unknown-func",
+	DIAG_NOT_ASSIGNABLE: "not-assignable",
+	DIAG_ARITY:          "arity",
+	DIAG_NOT_STRUCT:     "not-struct",
+	DIAG_NOT_INTERFACE:  "not-interface",
+	DIAG_BAD_ASSERT:     "bad-assert",
+	DIAG_BAD_CONVERSION: "bad-conversion",
+	DIAG_BAD_OPERATION:  "bad-operation",
+	DIAG_BAD_RECEIVER:   "bad-receiver",
+	DIAG_BAD_BOUND:      "bad-bound",
+	DIAG_CYCLIC_DECL:    "cyclic-decl",
+	DIAG_AMBIGUOUS:      "ambiguous-selector",
+	DIAG_INFER:          "cannot-infer",
+	DIAG_BAD_TYPE_SET:   "bad-type-set",
+	DIAG_NO_ZERO_VALUE:  "no-zero-value",
+	DIAG_SYNTAX:         "syntax",
+	DIAG_UNSUPPORTED:    "unsupported",
+}
+
+func (k DiagnosticKind) String() string {
+	if s, ok := diagKindNames[k]; ok {
+		return s
+	}
+	return "kind(" + strconv.Itoa(int(k)) + ")"
+}
+
+/* Source spans */
+
+// Lines are 1-based, columns are 0-based (as ANTLR) -- zero value means unknown
+type Span struct {
+	StartLine int
+	StartCol  int
+	EndLine   int
+	EndCol    int
+}
+
+func (s Span) IsKnown() bool { return s.StartLine > 0 }
+
+func (s Span) String() string {
+	if !s.IsKnown() {
+		return "?"
+	}
+	return strconv.Itoa(s.StartLine) + ":" + strconv.Itoa(s.StartCol) + "-" +
+		strconv.Itoa(s.EndLine) + ":" + strconv.Itoa(s.EndCol)
+}
+
+// Implemented by AST nodes that record their source position
+type Spanned interface {
+	GetSpan() Span
+}
+
+/* Diagnostic */
+
+type Diagnostic struct {
+	Kind    DiagnosticKind
+	Message string
+	Node    AstNode // The offending node, may be nil
+	Span    Span
+}
+
+var _ error = Diagnostic{}
+
+// Span is taken from node, if available
+func NewDiagnostic(kind DiagnosticKind, node AstNode, msg string) Diagnostic {
+	return Diagnostic{kind, msg, node, spanOf(node)}
+}
+
+func spanOf(node AstNode) Span {
+	if n, ok := node.(Spanned); ok {
+		return n.GetSpan()
+	}
+	return Span{}
+}
+
+// Cf. previous string panics: "msg\n\tnode"
+func (d Diagnostic) Error() string {
+	var b strings.Builder
+	if d.Span.IsKnown() {
+		b.WriteString(d.Span.String())
+		b.WriteString(": ")
+	}
+	b.WriteString(d.Message)
+	if d.Node != nil {
+		b.WriteString("\n\t")
+		b.WriteString(d.Node.String())
+	}
+	return b.String()
+}
+
+func (d Diagnostic) String() string {
+	return "[" + d.Kind.String() + "] " + d.Error()
+}
+
+/* Collecting diagnostics */
+
+type Diagnostics []Diagnostic
+
+// Runs f, converting a checker panic into a Diagnostic (located at node).
+// A string panic already includes the offending node, so only its span is kept.
+// A Diagnostic without a known span (e.g., a nameless type) is also located at node.
+// Go run-time errors are re-panicked, they are not type errors.
+// Returns true if f completed without error.
+func (ds *Diagnostics) Catch(node AstNode, f func()) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+			switch r1 := r.(type) {
+			case Diagnostic:
+				if !r1.Span.IsKnown() {
+					r1.Span = spanOf(node)
+				}
+				*ds = append(*ds, r1)
+			case string:
+				*ds = append(*ds, Diagnostic{DIAG_OTHER, r1, nil, spanOf(node)})
+			case runtime.Error:
+				panic(r1)
+			case error:
+				*ds = append(*ds, Diagnostic{DIAG_OTHER, r1.Error(), nil, spanOf(node)})
+			default:
+				panic(r)
+			}
+		}
+	}()
+	f()
+	return true
+}
+
+// Panics with the first diagnostic, if any -- cf. Program.Ok
+func (ds Diagnostics) PanicIfAny() {
+	if len(ds) > 0 {
+		panic(ds[0])
+	}
+}
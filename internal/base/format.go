@@ -0,0 +1,161 @@
+package base
+
+import "strings"
+
+/* Comments */
+
+// A source comment, including the "//" or "/*...*/" -- not part of the AST,
+// but kept by the adaptors (cf. Adaptor.GetComments) for formatting
+type Comment struct {
+	Text string
+	Span Span
+}
+
+func (c Comment) isLine() bool { return strings.HasPrefix(c.Text, "//") }
+
+/* Printer */
+
+// A line-based printer for the formatters (cf. fg/fgg Format).
+// Each line is an "item" located at a source span (if known): the comments
+// before an item are printed on the lines before it, and the comments inside
+// an item or on its last line are appended to it.  Blank lines between items
+// (or comments) are kept, at most one.
+type Printer struct {
+	b        strings.Builder
+	indent   int
+	comments []Comment // Not yet printed, in source order
+	line     int       // Source line of the last printed item or comment, 0 if unknown
+}
+
+func NewPrinter(comments []Comment) *Printer {
+	return &Printer{comments: comments}
+}
+
+// Prints a one-line item, e.g., a field decl
+func (p *Printer) Item(span Span, text string) {
+	p.leading(span)
+	p.trailing(span, text)
+}
+
+// Prints the first line of a block located at span, e.g., "type A struct {",
+// then indents the following items
+func (p *Printer) Open(span Span, text string) {
+	p.leading(span)
+	p.writeLine(text)
+	p.line = span.StartLine
+	p.indent++
+}
+
+// Prints the comments remaining inside the block located at span, then
+// unindents and prints the last line of the block, e.g., "};"
+func (p *Printer) Close(span Span, text string) {
+	if span.IsKnown() {
+		p.flush(func(c Comment) bool { return before(c.Span, span.EndLine, span.EndCol) })
+	}
+	p.indent--
+	end := span
+	end.StartLine, end.StartCol = span.EndLine, span.EndCol
+	p.trailing(end, text)
+}
+
+// Prints a line between two blocks, e.g., "} else {", at the indentation of
+// the enclosing block -- N.B. its source line is not known, so no blank line
+// is kept before the next item
+func (p *Printer) Middle(text string) {
+	p.indent--
+	p.writeLine(text)
+	p.indent++
+	p.line = 0
+}
+
+// Prints the comments before line (if known) on their own lines
+func (p *Printer) FlushBefore(line int) {
+	if line > 0 {
+		p.flush(func(c Comment) bool { return c.Span.StartLine < line })
+	}
+}
+
+// Prints a blank line, unless at the start or after a blank line
+func (p *Printer) Blank() {
+	out := p.b.String()
+	if out != "" && !strings.HasSuffix(out, "\n\n") {
+		p.b.WriteString("\n")
+	}
+	p.line = 0
+}
+
+// Any comments not yet printed (e.g., at the end of the source) are printed last
+func (p *Printer) String() string {
+	p.flush(func(Comment) bool { return true })
+	return p.b.String()
+}
+
+// Prints the comments before span on their own lines
+func (p *Printer) leading(span Span) {
+	if !span.IsKnown() {
+		return
+	}
+	p.flush(func(c Comment) bool { return before(c.Span, span.StartLine, span.StartCol) })
+	p.gap(span.StartLine)
+}
+
+// Prints text followed by the comments inside span or on its last line --
+// appended to the same line if only the last is a "//" comment, o/w each on its own line
+func (p *Printer) trailing(span Span, text string) {
+	if !span.IsKnown() {
+		p.writeLine(text)
+		p.line = 0
+		return
+	}
+	var cs []Comment
+	for len(p.comments) > 0 && p.comments[0].Span.StartLine <= span.EndLine {
+		cs = append(cs, p.comments[0])
+		p.comments = p.comments[1:]
+	}
+	p.line = span.EndLine
+	inline := true
+	for i, c := range cs {
+		if strings.Contains(c.Text, "\n") || (c.isLine() && i < len(cs)-1) {
+			inline = false
+		}
+	}
+	if !inline {
+		p.writeLine(text)
+		for _, c := range cs {
+			p.writeLine(c.Text)
+		}
+		return
+	}
+	for _, c := range cs {
+		text += " " + c.Text
+	}
+	p.writeLine(text)
+}
+
+// Prints the leading comments satisfying pred, each on its own line
+func (p *Printer) flush(pred func(Comment) bool) {
+	for len(p.comments) > 0 && pred(p.comments[0]) {
+		c := p.comments[0]
+		p.comments = p.comments[1:]
+		p.gap(c.Span.StartLine)
+		p.writeLine(c.Text)
+		p.line = c.Span.EndLine
+	}
+}
+
+// Keeps (at most) one blank line before something at line
+func (p *Printer) gap(line int) {
+	if p.line > 0 && line > p.line+1 {
+		p.Blank()
+	}
+}
+
+func (p *Printer) writeLine(text string) {
+	p.b.WriteString(strings.Repeat("\t", p.indent))
+	p.b.WriteString(text)
+	p.b.WriteString("\n")
+}
+
+func before(s Span, line int, col int) bool {
+	return s.StartLine < line || (s.StartLine == line && s.StartCol < col)
+}
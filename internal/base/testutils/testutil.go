@@ -2,6 +2,7 @@ package testutils
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -12,8 +13,17 @@ const PARSER_PANIC_PREFIX = "[Parser] "
 
 /* Test harness functions */
 
+// Syntax errors are raised as a PARSER_PANIC_PREFIX panic, cf. expectPanic
+func parse(a base.Adaptor, src string) base.Program {
+	ast, errs := a.Parse(true, src)
+	if len(errs) > 0 {
+		panic(PARSER_PANIC_PREFIX + errs[0].Error())
+	}
+	return ast
+}
+
 func parseAndType(a base.Adaptor, src string, mode base.TypingMode) base.Program {
-	ast := a.Parse(true, src)
+	ast := parse(a, src)
 	allowStupid := false
 	_, ast = ast.Ok(allowStupid, mode)
 	return ast
@@ -62,6 +72,38 @@ func ParseAndInferBad(t *testing.T, msg string, a base.Adaptor, src string) base
 	return parseAndType(a, src, base.INFER)
 }
 
+// Checks that the collected diagnostics have exactly the expected kinds (in order)
+func ParseAndCheckBad(t *testing.T, a base.Adaptor, src string,
+	kinds ...base.DiagnosticKind) []base.Diagnostic {
+	return parseAndCheckBad(t, a, src, base.CHECK, kinds)
+}
+
+// As ParseAndCheckBad, but inferring omitted type actuals
+func ParseAndInferCheckBad(t *testing.T, a base.Adaptor, src string,
+	kinds ...base.DiagnosticKind) []base.Diagnostic {
+	return parseAndCheckBad(t, a, src, base.INFER, kinds)
+}
+
+func parseAndCheckBad(t *testing.T, a base.Adaptor, src string,
+	mode base.TypingMode, kinds []base.DiagnosticKind) []base.Diagnostic {
+	defer expectNoPanic(t, src)
+	ast := parse(a, src)
+	allowStupid := false
+	_, _, errs := ast.Check(allowStupid, mode)
+	if len(errs) != len(kinds) {
+		t.Errorf("Expected " + fmt.Sprint(len(kinds)) + " diagnostics, got " +
+			fmt.Sprint(len(errs)) + ": " + fmt.Sprint(errs) + "\n" + src)
+		return errs
+	}
+	for i, d := range errs {
+		if d.Kind != kinds[i] {
+			t.Errorf("Expected diagnostic kind " + kinds[i].String() + ", got: " +
+				d.String() + "\n" + src)
+		}
+	}
+	return errs
+}
+
 // Pre: parseAndOkGood
 func EvalAndOkGood(t *testing.T, p base.Program, steps int) base.Program {
 	defer expectNoPanic(t, p.String())
@@ -150,3 +192,124 @@ func EvalAndInferBad(t *testing.T, p base.Program, msg string, steps int) base.P
 	}
 	return p
 }
+
+// Evaluates p (checking each step) until a value, in at most max steps
+// Pre: parseAndOkGood
+func EvalToValueGood(t *testing.T, p base.Program, max int) base.Program {
+	defer expectNoPanic(t, p.String())
+	allowStupid := true
+	for i := 0; i < max && !p.GetMain().IsValue() && !p.GetMain().IsPanic(); i++ {
+		p, _ = p.Eval()
+		p.Ok(allowStupid, base.CHECK)
+	}
+	if !p.GetMain().IsValue() {
+		t.Errorf("Not a value after " + fmt.Sprint(max) + " steps: " +
+			p.GetMain().String())
+	}
+	return p
+}
+
+// Checks that evaluation (in at most max steps) panics with a message
+// containing msg, e.g., a run-time error -- either a panic result (cf.
+// base.Expr.IsPanic), or a panic of the interpreter itself
+// Pre: parseAndOkGood
+func EvalToValueBad(t *testing.T, p base.Program, msg string, max int) base.Program {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic, but none occurred: " + msg + "\n" +
+				p.String())
+		} else if rec := fmt.Sprintf("%v", r); !strings.Contains(rec, msg) {
+			t.Errorf("Expected panic: " + msg + ", got: " + rec + "\n" +
+				p.String())
+		}
+	}()
+	allowStupid := true
+	for i := 0; i < max && !p.GetMain().IsValue() && !p.GetMain().IsPanic(); i++ {
+		p, _ = p.Eval()
+		p.Ok(allowStupid, base.CHECK)
+	}
+	if p.GetMain().IsPanic() {
+		panic(p.GetMain().String())
+	}
+	return p
+}
+
+/* Formatting */
+
+// Cf. fg.FGProgram.Format, fgg.FGGProgram.Format
+type formattable interface {
+	Format(comments []base.Comment) string
+}
+
+// Checks that the formatted src reparses to the same program (modulo spans),
+// and that formatting the result changes nothing.  Returns the formatted src.
+func FormatAndReparseGood(t *testing.T, a base.Adaptor, src string) string {
+	defer expectNoPanic(t, src)
+	p := parse(a, src)
+	out := p.(formattable).Format(a.GetComments())
+	p1 := parse(a, out)
+	if !EqualModSpans(p, p1) {
+		t.Errorf("Formatted program does not reparse to the original:\n" + out +
+			"\n" + src)
+	}
+	if out1 := p1.(formattable).Format(a.GetComments()); out1 != out {
+		t.Errorf("Formatting is not idempotent:\n" + out + "\n" + out1)
+	}
+	return out
+}
+
+var spanType = reflect.TypeOf(base.Span{})
+
+// Structural equality of ASTs, ignoring source spans -- nil and empty slices
+// are considered equal
+func EqualModSpans(x, y interface{}) bool {
+	return equalModSpans(reflect.ValueOf(x), reflect.ValueOf(y))
+}
+
+func equalModSpans(x, y reflect.Value) bool {
+	if !x.IsValid() || !y.IsValid() {
+		return x.IsValid() == y.IsValid()
+	}
+	if x.Type() != y.Type() {
+		return false
+	}
+	switch x.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if x.IsNil() || y.IsNil() {
+			return x.IsNil() == y.IsNil()
+		}
+		return equalModSpans(x.Elem(), y.Elem())
+	case reflect.Struct:
+		if x.Type() == spanType {
+			return true
+		}
+		for i := 0; i < x.NumField(); i++ {
+			if !equalModSpans(x.Field(i), y.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice, reflect.Array:
+		if x.Len() != y.Len() {
+			return false
+		}
+		for i := 0; i < x.Len(); i++ {
+			if !equalModSpans(x.Index(i), y.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Bool:
+		return x.Bool() == y.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return x.Int() == y.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return x.Uint() == y.Uint()
+	case reflect.Float32, reflect.Float64:
+		return x.Float() == y.Float()
+	case reflect.String:
+		return x.String() == y.String()
+	default:
+		panic("Unsupported AST value: " + x.Type().String())
+	}
+}
@@ -55,8 +55,136 @@ type FGExpr interface {
 	// string is the type name of the "actually evaluated" expr (within the eval context)
 	// CHECKME: resulting Exprs are not "parsed" from source, OK?
 	Eval(ds []Decl) (FGExpr, string)
+}
 
-	//IsPanic() bool  // TODO "explicit" FG panic -- cf. underlying runtime panic
+/* Source spans */
+
+// Returns a copy of n located at span -- for the parser adaptor.
+func SetSpan(n FGNode, span base.Span) FGNode {
+	switch n1 := n.(type) {
+	case FGProgram:
+		n1.span = span
+		return n1
+	case TypeDecl:
+		n1.span = span
+		return n1
+	case MethDecl:
+		n1.span = span
+		return n1
+	case FuncDecl:
+		n1.span = span
+		return n1
+	case ParamDecl:
+		n1.span = span
+		return n1
+	case Sig:
+		n1.span = span
+		return n1
+	case FieldDecl:
+		n1.span = span
+		return n1
+	case TNamed:
+		n1.span = span
+		return n1
+	case TPrimitive:
+		n1.span = span
+		return n1
+	case STypeLit:
+		n1.span = span
+		return n1
+	case ITypeLit:
+		n1.span = span
+		return n1
+	case TFunc:
+		n1.span = span
+		return n1
+	case TSlice:
+		n1.span = span
+		return n1
+	case TMap:
+		n1.span = span
+		return n1
+	case Variable:
+		n1.span = span
+		return n1
+	case StructLit:
+		n1.span = span
+		return n1
+	case Select:
+		n1.span = span
+		return n1
+	case Call:
+		n1.span = span
+		return n1
+	case FuncCall:
+		n1.span = span
+		return n1
+	case FuncLit:
+		n1.span = span
+		return n1
+	case Apply:
+		n1.span = span
+		return n1
+	case SliceLit:
+		n1.span = span
+		return n1
+	case Index:
+		n1.span = span
+		return n1
+	case Len:
+		n1.span = span
+		return n1
+	case Append:
+		n1.span = span
+		return n1
+	case MapLit:
+		n1.span = span
+		return n1
+	case MapAssign:
+		n1.span = span
+		return n1
+	case CommaOk:
+		n1.span = span
+		return n1
+	case Assert:
+		n1.span = span
+		return n1
+	case Convert:
+		n1.span = span
+		return n1
+	case Cond:
+		n1.span = span
+		return n1
+	case Let:
+		n1.span = span
+		return n1
+	case TypeSwitch:
+		n1.span = span
+		return n1
+	case Panic:
+		n1.span = span
+		return n1
+	case Sprintf:
+		n1.span = span
+		return n1
+	case UnaryOperation:
+		n1.span = span
+		return n1
+	case BinaryOperation:
+		n1.span = span
+		return n1
+	case Comparison:
+		n1.span = span
+		return n1
+	case PrimitiveLiteral:
+		n1.span = span
+		return n1
+	case TypedPrimitiveValue:
+		n1.span = span
+		return n1
+	default:
+		return n
+	}
 }
 
 /* Helpers */
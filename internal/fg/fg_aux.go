@@ -1,6 +1,10 @@
 package fg
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/rhu1/fgg/internal/base"
+)
 
 var _ = fmt.Errorf
 
@@ -29,6 +33,71 @@ func fields(ds []Decl, t_S Type) []FieldDecl {
 	return s.fDecls
 }
 
+// A field or method found by selections: path is the sequence of embedded
+// fields to follow from the original type, and t is the type of the field
+// or, for a method, the type that declares it
+type selection struct {
+	path   []Name
+	t      Type
+	isMeth bool
+}
+
+// Go's selector rule: the field or method x of t at the shallowest depth
+// through embedded fields, cf. fields (depth 0) and methods.  Returns the
+// number of candidates at that depth -- 0 if none, >1 if ambiguous.
+func selections(ds []Decl, t Type, x Name) (selection, int) {
+	level := []selection{{[]Name{}, t, false}}
+	seen := make(map[string]bool) // Keyed by String, cf. span
+	for len(level) > 0 {
+		var res selection
+		n := 0
+		var next []selection
+		for _, v := range level {
+			if _, ok := declaredMethods(ds, v.t)[x]; ok {
+				res = selection{v.path, v.t, true}
+				n++
+			}
+			s, ok := v.t.Underlying(ds).(STypeLit)
+			if !ok {
+				continue
+			}
+			for _, fd := range s.fDecls {
+				path := append(append([]Name{}, v.path...), fd.name)
+				if fd.name == x {
+					res = selection{path, fd.t, false}
+					n++
+				}
+				if fd.embedded && !seen[fd.t.String()] {
+					next = append(next, selection{path, fd.t, false})
+				}
+			}
+		}
+		if n > 0 {
+			return res, n
+		}
+		for _, v := range next { // N.B. equal-depth duplicates are not pruned, cf. ambiguity
+			seen[v.t.String()] = true
+		}
+		level = next
+	}
+	return selection{}, 0
+}
+
+// The methods of t itself, i.e., excluding those promoted from embedded fields
+func declaredMethods(ds []Decl, t Type) MethodSet {
+	if t_N, ok := t.(TNamed); ok && !isInterfaceType(ds, t_N) {
+		res := make(MethodSet)
+		for _, v := range ds {
+			md, ok := v.(MethDecl)
+			if ok && md.recv.t.Equals(t_N) {
+				res[md.name] = md.ToSig()
+			}
+		}
+		return res
+	}
+	return methods(ds, t)
+}
+
 // Go has no overloading, meth names are a unique key
 func methods(ds []Decl, t Type) MethodSet {
 	switch t_cast := t.(type) {
@@ -44,26 +113,79 @@ func methods(ds []Decl, t Type) MethodSet {
 	case TNamed:
 		// The method set of an interface type is its interface.
 		// The method set of any other TNamed T consists of all methods
-		// declared with receiver type T
+		// declared with receiver type T, and those promoted from its
+		// embedded fields (if unambiguous, cf. selections)
 		if t_I, ok := t_cast.Underlying(ds).(ITypeLit); ok {
 			return methods(ds, t_I)
 		} else {
-			res := make(MethodSet)
-			for _, v := range ds {
-				md, ok := v.(MethDecl)
-				if ok && md.recv.t == t_cast {
-					res[md.name] = md.ToSig()
+			res := declaredMethods(ds, t_cast)
+			for _, m := range promotableMethNames(ds, t_cast) {
+				if _, ok := res[m]; ok {
+					continue
+				}
+				if sel, n := selections(ds, t_cast, m); n == 1 && sel.isMeth {
+					res[m] = declaredMethods(ds, sel.t)[m]
 				}
 			}
 			return res
 		}
-	case TPrimitive, UndefTPrimitive, STypeLit:
+	case TPrimitive, UndefTPrimitive, STypeLit, TFunc, TSlice, TMap:
 		return MethodSet{} // primitives don't implement any methods
 	default:
 		panic("Unknown type: " + t.String()) // Perhaps redundant if all TDecl OK checked first
 	}
 }
 
+// The names of all methods declared by the types embedded, at any depth, in t
+func promotableMethNames(ds []Decl, t TNamed) []Name {
+	var res []Name
+	seen := map[string]bool{t.String(): true}
+	todo := []Type{t}
+	for len(todo) > 0 {
+		s, ok := todo[0].Underlying(ds).(STypeLit)
+		todo = todo[1:]
+		if !ok {
+			continue
+		}
+		for _, fd := range s.fDecls {
+			if fd.embedded && !seen[fd.t.String()] {
+				seen[fd.t.String()] = true
+				todo = append(todo, fd.t)
+				for m := range declaredMethods(ds, fd.t) {
+					res = append(res, m)
+				}
+			}
+		}
+	}
+	return res
+}
+
+// The (elaborated) selection of path from e, cf. selections
+func selectPath(e FGExpr, path []Name) FGExpr {
+	for _, f := range path {
+		e = Select{e, f, base.Span{}}
+	}
+	return e
+}
+
+// Pre: v is a value whose concrete type has (possibly promoted) method m
+// Returns the receiver value (and its concrete type) that declares m
+func methodRecv(ds []Decl, v FGExpr, m Name) (FGExpr, TNamed) {
+	for {
+		t := concreteType(v).(TNamed)
+		if _, ok := declaredMethods(ds, t)[m]; ok {
+			return v, t
+		}
+		sel, n := selections(ds, t, m)
+		if n != 1 || !sel.isMeth {
+			panic("Method not found: " + t.String() + "." + m)
+		}
+		for _, f := range sel.path {
+			v, _ = Select{v, f, base.Span{}}.Eval(ds)
+		}
+	}
+}
+
 // Pre: t_S is a concrete type
 func body(ds []Decl, t_S TNamed, m Name) (Name, []Name, FGExpr) {
 	md := getMethDecl(ds, t_S, m) // panics if not found
@@ -84,10 +206,79 @@ func concreteType(e FGExpr) Type {
 		return e1.typ
 	case PrimitiveLiteral:
 		return UndefTPrimitive{e1.tag}
+	case FuncLit:
+		return e1.GetType()
+	case SliceLit:
+		return e1.typ
+	case MapLit:
+		return e1.typ
 	}
 	panic("concreteType: expression is not a value: " + e.String())
 }
 
+// The zero value of t, if any -- FG has no nil, so interface and function
+// types have none
+func zeroValue(ds []Decl, t Type) (FGExpr, bool) {
+	switch t1 := t.Underlying(ds).(type) {
+	case TPrimitive:
+		return TypedPrimitiveValue{zeroLiteral(t1.tag), t, base.Span{}}, true
+	case UndefTPrimitive:
+		return zeroLiteral(t1.tag), true
+	case STypeLit:
+		es := make([]FGExpr, len(t1.fDecls))
+		for i, v := range t1.fDecls {
+			e, ok := zeroValue(ds, v.t)
+			if !ok {
+				return nil, false
+			}
+			es[i] = e
+		}
+		return StructLit{t, es, base.Span{}}, true
+	case TSlice:
+		return SliceLit{t, []FGExpr{}, base.Span{}}, true
+	case TMap:
+		return MapLit{t, []MapEntry{}, base.Span{}}, true
+	}
+	return nil, false
+}
+
+// Why t has no zero value (cf. zeroValue), e.g., "I is an interface type"
+func noZeroValueCause(ds []Decl, t Type) string {
+	switch t1 := t.Underlying(ds).(type) {
+	case ITypeLit:
+		return t.String() + " is an interface type"
+	case TFunc:
+		return t.String() + " is a function type"
+	case STypeLit:
+		for _, v := range t1.fDecls {
+			if _, ok := zeroValue(ds, v.t); !ok {
+				return "field " + v.name + " of " + t.String() + ": " +
+					noZeroValueCause(ds, v.t)
+			}
+		}
+	}
+	return t.String() + " has no zero value"
+}
+
+func zeroLiteral(tag Tag) PrimitiveLiteral {
+	var payload interface{}
+	switch tag {
+	case BOOL:
+		payload = false
+	case INT32:
+		payload = int32(0)
+	case INT64:
+		payload = int64(0)
+	case FLOAT32:
+		payload = float32(0)
+	case FLOAT64:
+		payload = float64(0)
+	case STRING:
+		payload = ""
+	}
+	return PrimitiveLiteral{payload, tag, base.Span{}}
+}
+
 /* Additional */
 
 func getTDecl(ds []Decl, t Name) TypeDecl {
@@ -97,15 +288,44 @@ func getTDecl(ds []Decl, t Name) TypeDecl {
 			return td
 		}
 	}
-	panic("Type not found: " + t)
+	panic(base.NewDiagnostic(base.DIAG_UNKNOWN_TYPE, nil, "Type not found: "+t))
+}
+
+func isTypeName(ds []Decl, t Name) bool {
+	for _, v := range ds {
+		if td, ok := v.(TypeDecl); ok && td.GetName() == t {
+			return true
+		}
+	}
+	return false
+}
+
+func isFuncName(ds []Decl, f Name) bool {
+	for _, v := range ds {
+		if fd, ok := v.(FuncDecl); ok && fd.GetName() == f {
+			return true
+		}
+	}
+	return false
+}
+
+func getFuncDecl(ds []Decl, f Name) FuncDecl {
+	for _, d := range ds {
+		fd, ok := d.(FuncDecl)
+		if ok && fd.name == f {
+			return fd
+		}
+	}
+	panic(base.NewDiagnostic(base.DIAG_UNKNOWN_FUNC, nil, "Function not found: "+f))
 }
 
 func getMethDecl(ds []Decl, recv Type, m Name) MethDecl {
 	for _, d := range ds {
 		md, ok := d.(MethDecl)
-		if ok && md.recv.t == recv && md.name == m {
+		if ok && md.recv.t.Equals(recv) && md.name == m {
 			return md
 		}
 	}
-	panic("Method not found: " + recv.String() + "." + m)
+	panic(base.NewDiagnostic(base.DIAG_UNKNOWN_METHOD, nil,
+		"Method not found: "+recv.String()+"."+m))
 }
@@ -18,20 +18,24 @@ var _ = fmt.Errorf
 /* "Exported" constructors (e.g., for fgg_monom)*/
 
 func NewFGProgram(ds []Decl, e FGExpr, printf bool) FGProgram {
-	return FGProgram{ds, e, printf}
+	return FGProgram{ds, e, printf, base.Span{}}
 }
 
 func NewTypeDecl(name Name, srcType Type) TypeDecl {
-	return TypeDecl{name, srcType}
+	return TypeDecl{name, srcType, base.Span{}}
 }
 
 // TODO: NewMethDecl
 func NewMDecl(recv ParamDecl, m Name, pds []ParamDecl, t Type, e FGExpr) MethDecl {
-	return MethDecl{recv, m, pds, t, e}
+	return MethDecl{recv, m, pds, t, e, base.Span{}}
 }
-func NewFieldDecl(f Name, t Type) FieldDecl      { return FieldDecl{f, t} }
-func NewParamDecl(x Name, t Type) ParamDecl      { return ParamDecl{x, t} } // For fgg_monom.MakeWMap
-func NewSig(m Name, pds []ParamDecl, t Type) Sig { return Sig{m, pds, t} }  // For fgg_monom.MakeWMap
+func NewFuncDecl(f Name, pds []ParamDecl, t Type, e FGExpr) FuncDecl {
+	return FuncDecl{f, pds, t, e, base.Span{}}
+}
+func NewFieldDecl(f Name, t Type) FieldDecl         { return FieldDecl{f, t, false, base.Span{}} }
+func NewEmbeddedFieldDecl(f Name, t Type) FieldDecl { return FieldDecl{f, t, true, base.Span{}} } // f: cf. FieldDecl.String
+func NewParamDecl(x Name, t Type) ParamDecl         { return ParamDecl{x, t, base.Span{}} }       // For fgg_monom.MakeWMap
+func NewSig(m Name, pds []ParamDecl, t Type) Sig    { return Sig{m, pds, t, base.Span{}} }        // For fgg_monom.MakeWMap
 
 /* Program */
 
@@ -40,8 +44,11 @@ type FGProgram struct {
 	e_main FGExpr
 	printf bool // false = "original" `_ = e_main` syntax; true = import-fmt/printf syntax
 	// N.B. coincidentally "behaves" like an actual printf because interpreter prints out final eval result
+	span base.Span // From "package" to EOF -- for placing comments, cf. Format
 }
 
+func (p FGProgram) GetSpan() base.Span { return p.span }
+
 var _ base.Program = FGProgram{}
 var _ FGNode = FGProgram{}
 
@@ -51,46 +58,98 @@ func (p FGProgram) GetMain() base.Expr { return p.e_main }
 func (p FGProgram) IsPrintf() bool     { return p.printf } // HACK
 
 // From base.Program
-func (p FGProgram) Ok(allowStupid bool, _mode base.TypingMode) (base.Type, base.Program) {
+// Panics with the first Diagnostic found, if any -- cf. Check
+func (p FGProgram) Ok(allowStupid bool, mode base.TypingMode) (base.Type, base.Program) {
+	typ, p1, errs := p.Check(allowStupid, mode)
+	base.Diagnostics(errs).PanicIfAny()
+	return typ, p1
+}
+
+// From base.Program
+// Each decl (and main) is checked independently, so all of them are reported.
+// The returned Type is nil if main is not well typed.
+func (p FGProgram) Check(allowStupid bool, _mode base.TypingMode) (base.Type, base.Program, []base.Diagnostic) {
+	var errs base.Diagnostics
+	// Checked decls (e.g., method bodies, cf. okRet) replace the originals in a
+	// copy -- the checked program is returned, p itself is left unchanged
+	p.decls = append([]Decl{}, p.decls...)
 	tds := make(map[string]TypeDecl) // Type name
 	mds := make(map[string]MethDecl) // Hack, string = string(md.recv.t) + "." + md.name
+	fds := make(map[string]FuncDecl) // Function name
 	for i, v := range p.decls {
-		switch d := v.(type) {
-		case TypeDecl:
-			d.Ok(p.decls) // Currently empty -- TODO: check, e.g., unique field names -- cf., above [Warning]
-			// N.B. checks also omitted from submission version
-			t := d.GetName()
-			if _, ok := tds[t]; ok {
-				panic("Multiple declarations of type name: " + t + "\n\t" +
-					d.String())
+		errs.Catch(v, func() {
+			switch d := v.(type) {
+			case TypeDecl:
+				t := d.GetName()
+				if _, ok := tds[t]; ok {
+					panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, d,
+						"Multiple declarations of type name: "+t))
+				}
+				tds[t] = d
+				d.Ok(p.decls) // Currently empty -- TODO: check, e.g., unique field names -- cf., above [Warning]
+				// N.B. checks also omitted from submission version
+			case FuncDecl:
+				f := d.GetName()
+				if _, ok := fds[f]; ok {
+					panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, d,
+						"Multiple declarations of function name: "+f))
+				}
+				if isTypeName(p.decls, f) {
+					panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, d,
+						"Function name already declared as a type: "+f))
+				}
+				fds[f] = d
+				p.decls[i] = d.okRet(p.decls)
+			case MethDecl:
+				hash := d.recv.t.String() + "." + d.name
+				if _, ok := mds[hash]; ok {
+					panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, d,
+						"Multiple declarations for receiver "+d.recv.t.String()+
+							" of the method name: "+d.name))
+				}
+				mds[hash] = d
+				//d.Ok(p.decls)
+				md := d.okRet(p.decls)
+				mds[hash] = md
+				p.decls[i] = md
+			default:
+				panic("Unknown decl: " + reflect.TypeOf(v).String() + "\n\t" +
+					v.String())
 			}
-			tds[t] = d
-		case MethDecl:
-			//d.Ok(p.decls)
-			md := d.okRet(p.decls)
-			hash := d.recv.t.String() + "." + d.name
-			if _, ok := mds[hash]; ok {
-				panic("Multiple declarations for receiver " + d.recv.t.String() +
-					" of the method name: " + d.name + "\n\t" + d.String())
-			}
-			mds[hash] = md
-			p.decls[i] = md
-		default:
-			panic("Unknown decl: " + reflect.TypeOf(v).String() + "\n\t" +
-				v.String())
-		}
+		})
 	}
 	var gamma Gamma // Empty env for main
-	typ, ast := p.e_main.Typing(p.decls, gamma, allowStupid)
-	return typ, FGProgram{p.decls, ast, p.printf}
+	var typ Type
+	ast := p.e_main
+	errs.Catch(p.e_main, func() {
+		typ, ast = p.e_main.Typing(p.decls, gamma, allowStupid)
+	})
+	return typ, FGProgram{p.decls, ast, p.printf, p.span}, errs
 }
 
 // CHECKME: resulting FGProgram is not parsed from source, OK? -- cf. Expr.Eval
 // But doesn't affect FGPprogam.Ok() (i.e., Expr.Typing)
 // From base.Program
-func (p FGProgram) Eval() (base.Program, string) {
+// E[panic(v)] --> panic(v): a raised panic unwinds the eval context (cf.
+// Panic.Eval), and is caught here -- at the type of main, cf. Panic.typ
+func (p FGProgram) Eval() (res base.Program, rule string) {
+	if p.e_main.IsPanic() {
+		panic("Cannot reduce: " + p.e_main.String())
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			p1, ok := r.(Panic)
+			if !ok {
+				panic(r)
+			}
+			var gamma Gamma // Empty env for main
+			t, _ := p.e_main.Typing(p.decls, gamma, true)
+			res = FGProgram{p.decls, Panic{p1.e_arg, t, p1.span}, p.printf, p.span}
+			rule = "Panic"
+		}
+	}()
 	e, rule := p.e_main.Eval(p.decls)
-	return FGProgram{p.decls, e.(FGExpr), p.printf}, rule
+	return FGProgram{p.decls, e.(FGExpr), p.printf, p.span}, rule
 }
 
 func (p FGProgram) String() string {
@@ -124,8 +183,11 @@ type MethDecl struct {
 	pDecls []ParamDecl
 	t_ret  Type // Return
 	e_body FGExpr
+	span   base.Span // Source position, not part of node identity
 }
 
+func (md MethDecl) GetSpan() base.Span { return md.span }
+
 var _ Decl = MethDecl{}
 
 func (md MethDecl) GetReceiver() ParamDecl     { return md.recv }
@@ -144,15 +206,15 @@ func (md MethDecl) okRet(ds []Decl) MethDecl {
 	md.recv.t.Ok(ds)
 
 	if isInterfaceType(ds, md.recv.t) {
-		panic("Invalid receiver type: " + md.recv.t.String() +
-			"\n\t" + md.String())
+		panic(base.NewDiagnostic(base.DIAG_BAD_RECEIVER, md,
+			"Invalid receiver type: "+md.recv.t.String()))
 	}
 	// distinct, params ok
 	env := Gamma{md.recv.name: md.recv.t}
 	for _, v := range md.pDecls {
 		if _, ok := env[v.name]; ok {
-			panic("Multiple receiver/parameters with name " + v.name + "\n\t" +
-				md.String())
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, md,
+				"Multiple receiver/parameters with name "+v.name))
 		}
 		v.t.Ok(ds)
 		env[v.name] = v.t
@@ -162,16 +224,17 @@ func (md MethDecl) okRet(ds []Decl) MethDecl {
 	t, e_body := md.e_body.Typing(ds, env, allowStupid)
 	ok, coercion := t.AssignableTo(ds, md.t_ret)
 	if !ok {
-		panic("Method body must be assignable to declared return type: found=" +
-			t.String() + ", expected=" + md.t_ret.String() + "\n\t" + md.String())
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, md,
+			"Method body must be assignable to declared return type: found="+
+				t.String()+", expected="+md.t_ret.String()))
 	}
 
-	md.e_body = coercion(e_body)
+	md.e_body = coerceBody(e_body, coercion)
 	return md
 }
 
 func (md MethDecl) ToSig() Sig {
-	return Sig{md.name, md.pDecls, md.t_ret}
+	return Sig{md.name, md.pDecls, md.t_ret, md.span}
 }
 
 func (md MethDecl) String() string {
@@ -184,8 +247,8 @@ func (md MethDecl) String() string {
 	writeParamDecls(&b, md.pDecls)
 	b.WriteString(") ")
 	b.WriteString(md.t_ret.String())
-	b.WriteString(" { return ")
-	b.WriteString(md.e_body.String())
+	b.WriteString(" { ")
+	writeBody(&b, md.e_body)
 	b.WriteString(" }")
 	return b.String()
 }
@@ -194,8 +257,11 @@ func (md MethDecl) String() string {
 type ParamDecl struct {
 	name Name // CHECKME: Variable? (also Env key)
 	t    Type
+	span base.Span // Source position, not part of node identity
 }
 
+func (pd ParamDecl) GetSpan() base.Span { return pd.span }
+
 var _ FGNode = ParamDecl{}
 
 func (pd ParamDecl) GetName() Name { return pd.name } // From Decl
@@ -209,14 +275,79 @@ func (pd ParamDecl) String() string {
 	return b.String()
 }
 
+/* FuncDecl */
+
+// A top-level function -- cf. MethDecl, without the receiver
+type FuncDecl struct {
+	name   Name
+	pDecls []ParamDecl
+	t_ret  Type
+	e_body FGExpr
+	span   base.Span // Source position, not part of node identity
+}
+
+func (fd FuncDecl) GetSpan() base.Span { return fd.span }
+
+var _ Decl = FuncDecl{}
+
+func (fd FuncDecl) GetName() Name              { return fd.name } // From Decl
+func (fd FuncDecl) GetParamDecls() []ParamDecl { return fd.pDecls }
+func (fd FuncDecl) GetReturn() Type            { return fd.t_ret }
+func (fd FuncDecl) GetBody() FGExpr            { return fd.e_body }
+
+func (fd FuncDecl) Ok(ds []Decl) {
+	_ = fd.okRet(ds)
+}
+
+// Cf. MethDecl.okRet
+func (fd FuncDecl) okRet(ds []Decl) FuncDecl {
+	env := Gamma{}
+	for _, v := range fd.pDecls {
+		if _, ok := env[v.name]; ok {
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, fd,
+				"Multiple parameters with name "+v.name))
+		}
+		v.t.Ok(ds)
+		env[v.name] = v.t
+	}
+	fd.t_ret.Ok(ds)
+	allowStupid := false
+	t, e_body := fd.e_body.Typing(ds, env, allowStupid)
+	ok, coercion := t.AssignableTo(ds, fd.t_ret)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, fd,
+			"Function body must be assignable to declared return type: found="+
+				t.String()+", expected="+fd.t_ret.String()))
+	}
+	fd.e_body = coerceBody(e_body, coercion)
+	return fd
+}
+
+func (fd FuncDecl) String() string {
+	var b strings.Builder
+	b.WriteString("func ")
+	b.WriteString(fd.name)
+	b.WriteString("(")
+	writeParamDecls(&b, fd.pDecls)
+	b.WriteString(") ")
+	b.WriteString(fd.t_ret.String())
+	b.WriteString(" { ")
+	writeBody(&b, fd.e_body)
+	b.WriteString(" }")
+	return b.String()
+}
+
 /* Sig */
 
 type Sig struct {
 	meth   Name
 	pDecls []ParamDecl
 	t_ret  Type
+	span   base.Span // Source position, not part of node identity
 }
 
+func (g Sig) GetSpan() base.Span { return g.span }
+
 var _ Spec = Sig{}
 
 func (g Sig) GetMethod() Name            { return g.meth }
@@ -224,12 +355,13 @@ func (g Sig) GetParamDecls() []ParamDecl { return g.pDecls }
 func (g Sig) GetReturn() Type            { return g.t_ret }
 
 func (g0 Sig) Ok(ds []Decl) {
-	seen := make(map[Type]ParamDecl)
+	seen := make(map[Name]ParamDecl)
 	for _, v := range g0.pDecls {
-		if _, ok := seen[v.t]; ok {
-			panic("Multiple parameters with same name: " + v.name +
-				"\n\t" + g0.String())
+		if _, ok := seen[v.name]; ok {
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, g0,
+				"Multiple parameters with same name: "+v.name))
 		}
+		seen[v.name] = v
 		v.t.Ok(ds)
 	}
 	g0.t_ret.Ok(ds)
@@ -266,8 +398,11 @@ func (g Sig) String() string {
 type TypeDecl struct {
 	name    Name
 	srcType Type
+	span    base.Span // Source position, not part of node identity
 }
 
+func (t TypeDecl) GetSpan() base.Span { return t.span }
+
 var _ Decl = TypeDecl{}
 
 func (t TypeDecl) GetName() Name       { return t.name }
@@ -297,13 +432,14 @@ func checkCyclicTypeDecl(ds []Decl, decl TypeDecl, target Type) {
 		return
 	case TNamed:
 		if target.GetName() == decl.GetName() {
-			panic("Invalid cyclic declaration: " + decl.String())
+			panic(base.NewDiagnostic(base.DIAG_CYCLIC_DECL, decl,
+				"Invalid cyclic declaration: "+decl.GetName()))
 		}
 		targetDecl := getTDecl(ds, target.GetName())
 		checkCyclicTypeDecl(ds, decl, targetDecl.GetSourceType())
 
 	case STypeLit:
-		for _, f := range target.GetFieldDecls() {
+		for _, f := range target.GetFieldDecls() { // N.B. includes embedded fields, always TNamed
 			if u, ok := f.t.(TNamed); ok {
 				//if isStructType(ds, u) // CHECKME: without this check, the next call may be needlessly checking for cycles in u_I's -- cf. commented checkCyclicTypeDecl
 				checkCyclicTypeDecl(ds, decl, u)
@@ -21,7 +21,7 @@ func TestDistinctDecl(t *testing.T) {
 	expectDistinct := []bool{false, true, false, true, false, true, false}
 
 	var a parser.FGAdaptor
-	ast := a.Parse(true, prog)
+	ast, _ := a.Parse(true, prog)
 	if want, got := len(expectDistinct), len(ast.GetDecls()); want != got {
 		t.Fatalf("expected %d decls but got %d", want, got)
 	}
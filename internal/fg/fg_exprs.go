@@ -8,28 +8,50 @@ package fg
 import (
 	"fmt"
 	"strings"
+
+	"github.com/rhu1/fgg/internal/base"
 )
 
 /* "Exported" constructors for fgg (monomorph) */
 
-func NewVariable(id Name) Variable                    { return Variable{id} }
-func NewStructLit(t Type, es []FGExpr) StructLit      { return StructLit{t, es} }
-func NewSelect(e FGExpr, f Name) Select               { return Select{e, f} }
-func NewCall(e FGExpr, m Name, es []FGExpr) Call      { return Call{e, m, es} }
-func NewAssert(e FGExpr, t Type) Assert               { return Assert{e, t} }
-func NewConvert(t Type, e FGExpr) Convert             { return Convert{t, e} }
-func NewSprintf(format string, args []FGExpr) Sprintf { return Sprintf{format, args} }
+func NewVariable(id Name) Variable                    { return Variable{id, base.Span{}} }
+func NewStructLit(t Type, es []FGExpr) StructLit      { return StructLit{t, es, base.Span{}} }
+func NewSelect(e FGExpr, f Name) Select               { return Select{e, f, base.Span{}} }
+func NewCall(e FGExpr, m Name, es []FGExpr) Call      { return Call{e, m, es, base.Span{}} }
+func NewAssert(e FGExpr, t Type) Assert               { return Assert{e, t, base.Span{}} }
+func NewConvert(t Type, e FGExpr) Convert             { return Convert{t, e, base.Span{}} }
+func NewSprintf(format string, args []FGExpr) Sprintf { return Sprintf{format, args, base.Span{}} }
+func NewFuncCall(f Name, es []FGExpr) FuncCall        { return FuncCall{f, es, base.Span{}} }
+func NewApply(e FGExpr, es []FGExpr) Apply            { return Apply{e, es, base.Span{}} }
+
+// t may be nil, cf. FuncLit
+func NewFuncLit(pds []ParamDecl, t_ret Type, e FGExpr, t Type) FuncLit {
+	return FuncLit{pds, t_ret, e, t, base.Span{}}
+}
+
+// t may be nil, cf. Cond
+func NewCond(cond FGExpr, e_then FGExpr, e_else FGExpr, t Type) Cond {
+	return Cond{cond, e_then, e_else, t, base.Span{}}
+}
+
+// t may be nil, cf. Let
+func NewLet(x Name, t Type, e_def FGExpr, e_body FGExpr) Let {
+	return Let{x, t, e_def, e_body, base.Span{}}
+}
 
 /* Variable */
 
 type Variable struct {
 	name Name
+	span base.Span // Source position, not part of node identity
 }
 
+func (x Variable) GetSpan() base.Span { return x.span }
+
 var _ FGExpr = Variable{}
 
 func (x Variable) Subs(subs map[Variable]FGExpr) FGExpr {
-	res, ok := subs[x]
+	res, ok := subs[NewVariable(x.name)] // N.B. subs keys carry no span
 	if !ok {
 		panic("Unknown var: " + x.String())
 	}
@@ -43,7 +65,8 @@ func (x Variable) Eval(ds []Decl) (FGExpr, string) {
 func (x Variable) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
 	res, ok := gamma[x.name]
 	if !ok {
-		panic("Var not in env: " + x.String())
+		panic(base.NewDiagnostic(base.DIAG_UNKNOWN_VAR, x,
+			"Var not in env: "+x.String()))
 	}
 	return res, x
 }
@@ -52,6 +75,10 @@ func (x Variable) IsValue() bool {
 	return false
 }
 
+func (x Variable) IsPanic() bool {
+	return false
+}
+
 func (x Variable) CanEval(ds []Decl) bool {
 	return false
 }
@@ -69,8 +96,11 @@ func (x Variable) ToGoString(ds []Decl) string {
 type StructLit struct {
 	t_S   Type
 	elems []FGExpr
+	span  base.Span // Source position, not part of node identity
 }
 
+func (s StructLit) GetSpan() base.Span { return s.span }
+
 var _ FGExpr = StructLit{}
 
 func (s StructLit) GetType() Type      { return s.t_S }
@@ -81,7 +111,7 @@ func (s StructLit) Subs(subs map[Variable]FGExpr) FGExpr {
 	for i := 0; i < len(s.elems); i++ {
 		es[i] = s.elems[i].Subs(subs)
 	}
-	return StructLit{s.t_S, es}
+	return StructLit{s.t_S, es, s.span}
 }
 
 func (s StructLit) Eval(ds []Decl) (FGExpr, string) {
@@ -97,7 +127,7 @@ func (s StructLit) Eval(ds []Decl) (FGExpr, string) {
 		es[i] = v
 	}
 	if done {
-		return StructLit{s.t_S, es}, rule
+		return StructLit{s.t_S, es, s.span}, rule
 	} else {
 		panic("Cannot reduce: " + s.String())
 	}
@@ -105,8 +135,19 @@ func (s StructLit) Eval(ds []Decl) (FGExpr, string) {
 
 func (s StructLit) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
 	s.t_S.Ok(ds)
+	if _, ok := s.t_S.Underlying(ds).(TSlice); ok { // E.g., "[]int32{1, 2}", or a defined slice type
+		return SliceLit{s.t_S, s.elems, s.span}.Typing(ds, gamma, allowStupid)
+	}
+	if _, ok := s.t_S.Underlying(ds).(TMap); ok { // "t{}", cf. MapLit
+		if len(s.elems) > 0 {
+			panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, s,
+				"Missing key in map literal: "+s.String()))
+		}
+		return MapLit{s.t_S, []MapEntry{}, s.span}.Typing(ds, gamma, allowStupid)
+	}
 	if !isStructType(ds, s.t_S) {
-		panic("Struct literal: " + s.t_S.String() + " is not a struct type")
+		panic(base.NewDiagnostic(base.DIAG_NOT_STRUCT, s,
+			"Struct literal: "+s.t_S.String()+" is not a struct type"))
 	}
 	fs := fields(ds, s.t_S)
 	if len(s.elems) != len(fs) {
@@ -115,9 +156,8 @@ func (s StructLit) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExp
 		writeExprs(&b, s.elems)
 		b.WriteString("], fields=[")
 		writeFieldDecls(&b, fs)
-		b.WriteString("]\n\t")
-		b.WriteString(s.String())
-		panic(b.String())
+		b.WriteString("]")
+		panic(base.NewDiagnostic(base.DIAG_ARITY, s, b.String()))
 	}
 	elems := make([]FGExpr, len(s.elems))
 	for i, v := range s.elems {
@@ -125,12 +165,13 @@ func (s StructLit) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExp
 		u := fs[i].t
 		ok, coercion := t.AssignableTo(ds, u)
 		if !ok {
-			panic("Arg expr must be assignable to field type: arg=" + t.String() +
-				", field=" + u.String() + "\n\t" + s.String())
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, s,
+				"Arg expr must be assignable to field type: arg="+t.String()+
+					", field="+u.String()))
 		}
 		elems[i] = coercion(newSubtree)
 	}
-	return s.t_S, StructLit{s.t_S, elems}
+	return s.t_S, StructLit{s.t_S, elems, s.span}
 }
 
 // From base.Expr
@@ -143,6 +184,10 @@ func (s StructLit) IsValue() bool {
 	return true
 }
 
+func (s StructLit) IsPanic() bool {
+	return false
+}
+
 func (s StructLit) CanEval(ds []Decl) bool {
 	for _, v := range s.elems {
 		if v.CanEval(ds) {
@@ -191,21 +236,24 @@ func (s StructLit) ToGoString(ds []Decl) string {
 type Select struct {
 	e_S   FGExpr
 	field Name
+	span  base.Span // Source position, not part of node identity
 }
 
+func (s Select) GetSpan() base.Span { return s.span }
+
 var _ FGExpr = Select{}
 
 func (s Select) GetExpr() FGExpr { return s.e_S }
 func (s Select) GetField() Name  { return s.field }
 
 func (s Select) Subs(subs map[Variable]FGExpr) FGExpr {
-	return Select{s.e_S.Subs(subs), s.field}
+	return Select{s.e_S.Subs(subs), s.field, s.span}
 }
 
 func (s Select) Eval(ds []Decl) (FGExpr, string) {
 	if !s.e_S.IsValue() {
 		e, rule := s.e_S.Eval(ds)
-		return Select{e.(FGExpr), s.field}, rule
+		return Select{e.(FGExpr), s.field, s.span}, rule
 	}
 	v := s.e_S.(StructLit)
 	fds := fields(ds, v.t_S)
@@ -220,17 +268,20 @@ func (s Select) Eval(ds []Decl) (FGExpr, string) {
 func (s Select) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
 	t, e_S := s.e_S.Typing(ds, gamma, allowStupid)
 	if !isStructType(ds, t) {
-		panic("Illegal select on expr of non-struct type: " + t.String() +
-			"\n\t" + s.String())
+		panic(base.NewDiagnostic(base.DIAG_NOT_STRUCT, s,
+			"Illegal select on expr of non-struct type: "+t.String()))
 	}
-	fds := fields(ds, t)
-	for _, v := range fds {
-		if v.name == s.field {
-			return v.t, Select{e_S, s.field}
-		}
+	sel, n := selections(ds, t, s.field)
+	if n > 1 {
+		panic(base.NewDiagnostic(base.DIAG_AMBIGUOUS, s,
+			"Ambiguous selector "+s.field+" in type: "+t.String()))
+	} else if n == 0 || sel.isMeth {
+		panic(base.NewDiagnostic(base.DIAG_UNKNOWN_FIELD, s,
+			"Field "+s.field+" not found in type: "+t.String()))
 	}
-	panic("Field " + s.field + " not found in type: " + t.String() +
-		"\n\t" + s.String())
+	// A promoted field is elaborated to the explicit path of embedded fields
+	path := sel.path[:len(sel.path)-1]
+	return sel.t, Select{selectPath(e_S, path), s.field, s.span}
 }
 
 // From base.Expr
@@ -238,6 +289,10 @@ func (s Select) IsValue() bool {
 	return false
 }
 
+func (s Select) IsPanic() bool {
+	return false
+}
+
 func (s Select) CanEval(ds []Decl) bool {
 	if s.e_S.CanEval(ds) {
 		return true
@@ -266,8 +321,11 @@ type Call struct {
 	e_recv FGExpr
 	meth   Name
 	args   []FGExpr
+	span   base.Span // Source position, not part of node identity
 }
 
+func (c Call) GetSpan() base.Span { return c.span }
+
 var _ FGExpr = Call{}
 
 func (c Call) GetReceiver() FGExpr { return c.e_recv }
@@ -280,13 +338,13 @@ func (c Call) Subs(subs map[Variable]FGExpr) FGExpr {
 	for i := 0; i < len(c.args); i++ {
 		args[i] = c.args[i].Subs(subs)
 	}
-	return Call{e, c.meth, args}
+	return Call{e, c.meth, args, c.span}
 }
 
 func (c Call) Eval(ds []Decl) (FGExpr, string) {
 	if !c.e_recv.IsValue() {
 		e, rule := c.e_recv.Eval(ds)
-		return Call{e.(FGExpr), c.meth, c.args}, rule
+		return Call{e.(FGExpr), c.meth, c.args, c.span}, rule
 	}
 	args := make([]FGExpr, len(c.args))
 	done := false
@@ -300,16 +358,16 @@ func (c Call) Eval(ds []Decl) (FGExpr, string) {
 		args[i] = e
 	}
 	if done {
-		return Call{c.e_recv, c.meth, args}, rule
+		return Call{c.e_recv, c.meth, args, c.span}, rule
 	}
 	// c.e and c.args all values
-	t := concreteType(c.e_recv).(TNamed)
-	x0, xs, e := body(ds, t, c.meth) // panics if method not found
+	recv, t := methodRecv(ds, c.e_recv, c.meth) // Dynamic dispatch may reach a promoted method
+	x0, xs, e := body(ds, t, c.meth)            // panics if method not found
 
 	subs := make(map[Variable]FGExpr)
-	subs[Variable{x0}] = c.e_recv
+	subs[NewVariable(x0)] = recv
 	for i := 0; i < len(xs); i++ {
-		subs[Variable{xs[i]}] = c.args[i]
+		subs[NewVariable(xs[i])] = c.args[i]
 	}
 	return e.Subs(subs), "Call" // N.B. single combined substitution map slightly different to R-Call
 }
@@ -318,19 +376,27 @@ func (c Call) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
 	t0, e_recv := c.e_recv.Typing(ds, gamma, allowStupid)
 	var g Sig
 	if tmp, ok := methods(ds, t0)[c.meth]; !ok { // !!! submission version had "methods(m)"
-		panic("Method not found: " + c.meth + " in " + t0.String() + "\n\t" + c.String())
+		if _, n := selections(ds, t0, c.meth); n > 1 {
+			panic(base.NewDiagnostic(base.DIAG_AMBIGUOUS, c,
+				"Ambiguous selector "+c.meth+" in type: "+t0.String()))
+		}
+		panic(base.NewDiagnostic(base.DIAG_UNKNOWN_METHOD, c,
+			"Method not found: "+c.meth+" in "+t0.String()))
 	} else {
 		g = tmp
 	}
+	if !isInterfaceType(ds, t0) { // A promoted method is called on the explicit embedded field
+		sel, _ := selections(ds, t0, c.meth)
+		e_recv = selectPath(e_recv, sel.path)
+	}
 	if len(c.args) != len(g.pDecls) {
 		var b strings.Builder
 		b.WriteString("Arity mismatch: args=[")
 		writeExprs(&b, c.args)
 		b.WriteString("], params=[")
 		writeParamDecls(&b, g.pDecls)
-		b.WriteString("]\n\t")
-		b.WriteString(c.String())
-		panic(b.String())
+		b.WriteString("]")
+		panic(base.NewDiagnostic(base.DIAG_ARITY, c, b.String()))
 	}
 	args := make([]FGExpr, len(c.args))
 	for i, a := range c.args {
@@ -338,12 +404,13 @@ func (c Call) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
 		u := g.pDecls[i].t
 		ok, coercion := t.AssignableTo(ds, u)
 		if !ok {
-			panic("Arg expr must be assignable to param type: arg=" + t.String() +
-				", param=" + g.pDecls[i].t.String() + "\n\t" + c.String())
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+				"Arg expr must be assignable to param type: arg="+t.String()+
+					", param="+g.pDecls[i].t.String()))
 		}
 		args[i] = coercion(newSubtree)
 	}
-	return g.t_ret, Call{e_recv, c.meth, args}
+	return g.t_ret, Call{e_recv, c.meth, args, c.span}
 }
 
 // From base.Expr
@@ -351,6 +418,10 @@ func (c Call) IsValue() bool {
 	return false
 }
 
+func (c Call) IsPanic() bool {
+	return false
+}
+
 func (c Call) CanEval(ds []Decl) bool {
 	if c.e_recv.CanEval(ds) {
 		return true
@@ -364,7 +435,7 @@ func (c Call) CanEval(ds []Decl) bool {
 			return false
 		}
 	}
-	t_S := concreteType(c.e_recv).(TNamed)
+	_, t_S := methodRecv(ds, c.e_recv, c.meth)
 	md := getMethDecl(ds, t_S, c.meth)
 	return len(md.pDecls) == len(c.args) // Needed?
 }
@@ -391,47 +462,433 @@ func (c Call) ToGoString(ds []Decl) string {
 	return b.String()
 }
 
+/* Function calls */
+
+type FuncCall struct {
+	fun  Name
+	args []FGExpr
+	span base.Span // Source position, not part of node identity
+}
+
+func (c FuncCall) GetSpan() base.Span { return c.span }
+
+var _ FGExpr = FuncCall{}
+
+func (c FuncCall) GetFunc() Name     { return c.fun }
+func (c FuncCall) GetArgs() []FGExpr { return c.args }
+
+func (c FuncCall) Subs(subs map[Variable]FGExpr) FGExpr {
+	args := make([]FGExpr, len(c.args))
+	for i := 0; i < len(c.args); i++ {
+		args[i] = c.args[i].Subs(subs)
+	}
+	return FuncCall{c.fun, args, c.span}
+}
+
+// Cf. Call.Eval
+func (c FuncCall) Eval(ds []Decl) (FGExpr, string) {
+	args := make([]FGExpr, len(c.args))
+	done := false
+	var rule string
+	for i := 0; i < len(c.args); i++ {
+		e := c.args[i]
+		if !done && !e.IsValue() {
+			e, rule = e.Eval(ds)
+			done = true
+		}
+		args[i] = e
+	}
+	if done {
+		return FuncCall{c.fun, args, c.span}, rule
+	}
+	// c.args all values
+	fd := getFuncDecl(ds, c.fun)
+	subs := make(map[Variable]FGExpr)
+	for i := 0; i < len(fd.pDecls); i++ {
+		subs[NewVariable(fd.pDecls[i].name)] = c.args[i]
+	}
+	return fd.e_body.Subs(subs), "FuncCall"
+}
+
+func (c FuncCall) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	if _, ok := gamma[c.fun]; ok { // A local of function type, e.g., "f()" or "f(x, y)"
+		return Apply{Variable{c.fun, c.span}, c.args, c.span}.Typing(ds, gamma, allowStupid)
+	}
+	if !isFuncName(ds, c.fun) {
+		panic(base.NewDiagnostic(base.DIAG_UNKNOWN_FUNC, c,
+			"Function not found: "+c.fun))
+	}
+	fd := getFuncDecl(ds, c.fun)
+	if len(c.args) != len(fd.pDecls) {
+		var b strings.Builder
+		b.WriteString("Arity mismatch: args=[")
+		writeExprs(&b, c.args)
+		b.WriteString("], params=[")
+		writeParamDecls(&b, fd.pDecls)
+		b.WriteString("]")
+		panic(base.NewDiagnostic(base.DIAG_ARITY, c, b.String()))
+	}
+	args := make([]FGExpr, len(c.args))
+	for i, a := range c.args {
+		t, newSubtree := a.Typing(ds, gamma, allowStupid)
+		ok, coercion := t.AssignableTo(ds, fd.pDecls[i].t)
+		if !ok {
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+				"Arg expr must be assignable to param type: arg="+t.String()+
+					", param="+fd.pDecls[i].t.String()))
+		}
+		args[i] = coercion(newSubtree)
+	}
+	return fd.t_ret, FuncCall{c.fun, args, c.span}
+}
+
+// From base.Expr
+func (c FuncCall) IsValue() bool {
+	return false
+}
+
+func (c FuncCall) IsPanic() bool {
+	return false
+}
+
+func (c FuncCall) CanEval(ds []Decl) bool {
+	for _, v := range c.args {
+		if v.CanEval(ds) {
+			return true
+		} else if !v.IsValue() {
+			return false
+		}
+	}
+	return isFuncName(ds, c.fun) && len(getFuncDecl(ds, c.fun).pDecls) == len(c.args)
+}
+
+func (c FuncCall) String() string {
+	var b strings.Builder
+	b.WriteString(c.fun)
+	b.WriteString("(")
+	writeExprs(&b, c.args)
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c FuncCall) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString(c.fun)
+	b.WriteString("(")
+	writeToGoExprs(ds, &b, c.args)
+	b.WriteString(")")
+	return b.String()
+}
+
+/* Function values */
+
+// func(x1 t1, ..., xn tn) t_ret { e_body } -- a closure, i.e., the body may
+// refer to variables in scope, which are captured by substitution (as for
+// the params of a method body).  typ is nil unless the value has been
+// converted to a defined function type, e.g., F(func(x int32) int32 { ... }).
+type FuncLit struct {
+	pDecls []ParamDecl
+	t_ret  Type
+	e_body FGExpr
+	typ    Type
+	span   base.Span // Source position, not part of node identity
+}
+
+func (f FuncLit) GetSpan() base.Span { return f.span }
+
+var _ FGExpr = FuncLit{}
+
+func (f FuncLit) GetParamDecls() []ParamDecl { return f.pDecls }
+func (f FuncLit) GetReturn() Type            { return f.t_ret }
+func (f FuncLit) GetBody() FGExpr            { return f.e_body }
+
+// The (possibly defined) type of this function value
+func (f FuncLit) GetType() Type {
+	if f.typ != nil {
+		return f.typ
+	}
+	return f.sigType()
+}
+
+func (f FuncLit) sigType() TFunc {
+	ts := make([]Type, len(f.pDecls))
+	for i, v := range f.pDecls {
+		ts[i] = v.t
+	}
+	return TFunc{ts, f.t_ret, f.span}
+}
+
+// The params are bound in e_body, so are not substituted there (cf. Let)
+func (f FuncLit) Subs(subs map[Variable]FGExpr) FGExpr {
+	subs1 := make(map[Variable]FGExpr)
+	for k, v := range subs {
+		subs1[k] = v
+	}
+	for _, v := range f.pDecls {
+		subs1[NewVariable(v.name)] = NewVariable(v.name)
+	}
+	return FuncLit{f.pDecls, f.t_ret, f.e_body.Subs(subs1), f.typ, f.span}
+}
+
+func (f FuncLit) Eval(ds []Decl) (FGExpr, string) {
+	panic("Cannot reduce: " + f.String())
+}
+
+// N.B. unlike Let, the params may shadow variables already in gamma
+func (f FuncLit) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	gamma1 := make(Gamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	seen := make(map[Name]ParamDecl)
+	for _, v := range f.pDecls {
+		if _, ok := seen[v.name]; ok {
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, f,
+				"Multiple parameters with name "+v.name))
+		}
+		seen[v.name] = v
+		v.t.Ok(ds)
+		gamma1[v.name] = v.t
+	}
+	f.t_ret.Ok(ds)
+	t, e_body := f.e_body.Typing(ds, gamma1, allowStupid)
+	ok, coercion := t.AssignableTo(ds, f.t_ret)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, f,
+			"Function body must be assignable to declared return type: found="+
+				t.String()+", expected="+f.t_ret.String()))
+	}
+	return f.GetType(), FuncLit{f.pDecls, f.t_ret, coerceBody(e_body, coercion), f.typ, f.span}
+}
+
+// From base.Expr
+func (f FuncLit) IsValue() bool {
+	return true
+}
+
+func (f FuncLit) IsPanic() bool {
+	return false
+}
+
+func (f FuncLit) CanEval(ds []Decl) bool {
+	return false
+}
+
+func (f FuncLit) String() string {
+	var b strings.Builder
+	b.WriteString("func(")
+	writeParamDecls(&b, f.pDecls)
+	b.WriteString(") ")
+	b.WriteString(f.t_ret.String())
+	b.WriteString(" { ")
+	writeBody(&b, f.e_body)
+	b.WriteString(" }")
+	if f.typ != nil {
+		return f.typ.String() + "(" + b.String() + ")"
+	}
+	return b.String()
+}
+
+func (f FuncLit) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString("func(")
+	writeParamDecls(&b, f.pDecls)
+	b.WriteString(") ")
+	b.WriteString(f.t_ret.String())
+	b.WriteString(" { ")
+	writeToGoBody(ds, &b, f.e_body)
+	b.WriteString(" }")
+	if f.typ != nil {
+		return "main." + f.typ.String() + "(" + b.String() + ")"
+	}
+	return b.String()
+}
+
+/* Apply */
+
+// e_fun(e1, ..., en) -- a call on a function value, cf. FuncCall
+type Apply struct {
+	e_fun FGExpr
+	args  []FGExpr
+	span  base.Span // Source position, not part of node identity
+}
+
+func (a Apply) GetSpan() base.Span { return a.span }
+
+var _ FGExpr = Apply{}
+
+func (a Apply) GetFunc() FGExpr   { return a.e_fun }
+func (a Apply) GetArgs() []FGExpr { return a.args }
+
+func (a Apply) Subs(subs map[Variable]FGExpr) FGExpr {
+	args := make([]FGExpr, len(a.args))
+	for i := 0; i < len(a.args); i++ {
+		args[i] = a.args[i].Subs(subs)
+	}
+	return Apply{a.e_fun.Subs(subs), args, a.span}
+}
+
+// Cf. Call.Eval
+func (a Apply) Eval(ds []Decl) (FGExpr, string) {
+	if !a.e_fun.IsValue() {
+		e, rule := a.e_fun.Eval(ds)
+		return Apply{e, a.args, a.span}, rule
+	}
+	args := make([]FGExpr, len(a.args))
+	done := false
+	var rule string
+	for i := 0; i < len(a.args); i++ {
+		e := a.args[i]
+		if !done && !e.IsValue() {
+			e, rule = e.Eval(ds)
+			done = true
+		}
+		args[i] = e
+	}
+	if done {
+		return Apply{a.e_fun, args, a.span}, rule
+	}
+	// a.e_fun and a.args all values
+	f := a.e_fun.(FuncLit)
+	subs := make(map[Variable]FGExpr)
+	for i := 0; i < len(f.pDecls); i++ {
+		subs[NewVariable(f.pDecls[i].name)] = a.args[i]
+	}
+	return f.e_body.Subs(subs), "Apply"
+}
+
+func (a Apply) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	t, e_fun := a.e_fun.Typing(ds, gamma, allowStupid)
+	t_F, ok := t.Underlying(ds).(TFunc)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, a,
+			"Cannot call non-function: "+a.e_fun.String()+" of type "+t.String()))
+	}
+	if len(a.args) != len(t_F.params) {
+		var b strings.Builder
+		b.WriteString("Arity mismatch: args=[")
+		writeExprs(&b, a.args)
+		b.WriteString("], params=")
+		b.WriteString(t_F.String())
+		panic(base.NewDiagnostic(base.DIAG_ARITY, a, b.String()))
+	}
+	args := make([]FGExpr, len(a.args))
+	for i, e := range a.args {
+		t, newSubtree := e.Typing(ds, gamma, allowStupid)
+		ok, coercion := t.AssignableTo(ds, t_F.params[i])
+		if !ok {
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, a,
+				"Arg expr must be assignable to param type: arg="+t.String()+
+					", param="+t_F.params[i].String()))
+		}
+		args[i] = coercion(newSubtree)
+	}
+	return t_F.t_ret, Apply{e_fun, args, a.span}
+}
+
+// From base.Expr
+func (a Apply) IsValue() bool {
+	return false
+}
+
+func (a Apply) IsPanic() bool {
+	return false
+}
+
+func (a Apply) CanEval(ds []Decl) bool {
+	if a.e_fun.CanEval(ds) {
+		return true
+	} else if !a.e_fun.IsValue() {
+		return false
+	}
+	for _, v := range a.args {
+		if v.CanEval(ds) {
+			return true
+		} else if !v.IsValue() {
+			return false
+		}
+	}
+	f, ok := a.e_fun.(FuncLit)
+	return ok && len(f.pDecls) == len(a.args)
+}
+
+func (a Apply) String() string {
+	var b strings.Builder
+	writeFunc(&b, a.e_fun, a.e_fun.String())
+	b.WriteString("(")
+	writeExprs(&b, a.args)
+	b.WriteString(")")
+	return b.String()
+}
+
+func (a Apply) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	writeFunc(&b, a.e_fun, a.e_fun.ToGoString(ds))
+	b.WriteString("(")
+	writeToGoExprs(ds, &b, a.args)
+	b.WriteString(")")
+	return b.String()
+}
+
+// Parenthesises e_fun unless it is a variable, call, etc. -- e.g., "(x.f)(e)"
+// and "(func(x t) t { ... })(e)", cf. the Call and FuncLit grammar rules
+func writeFunc(b *strings.Builder, e_fun FGExpr, s string) {
+	switch e_fun.(type) {
+	case Variable, FuncCall, Apply, Call:
+		b.WriteString(s)
+	default:
+		b.WriteString("(")
+		b.WriteString(s)
+		b.WriteString(")")
+	}
+}
+
 /* Assert */
 
 type Assert struct {
 	e_I    FGExpr
 	t_cast Type
+	span   base.Span // Source position, not part of node identity
 }
 
+func (a Assert) GetSpan() base.Span { return a.span }
+
 var _ FGExpr = Assert{}
 
 func (a Assert) GetExpr() FGExpr { return a.e_I }
 func (a Assert) GetType() Type   { return a.t_cast }
 
 func (a Assert) Subs(subs map[Variable]FGExpr) FGExpr {
-	return Assert{a.e_I.Subs(subs), a.t_cast}
+	return Assert{a.e_I.Subs(subs), a.t_cast, a.span}
 }
 
 func (a Assert) Eval(ds []Decl) (FGExpr, string) {
 	if !a.e_I.IsValue() {
 		e, rule := a.e_I.Eval(ds)
-		return Assert{e.(FGExpr), a.t_cast}, rule
+		return Assert{e.(FGExpr), a.t_cast, a.span}, rule
 	}
 	//if !isStructType(ds, t_S) { todo why this check??
 	//	panic("Non struct type found in struct lit: " + t_S.String())
 	//}
-	ok, _ := concreteType(a.e_I).AssignableTo(ds, a.t_cast)
-	if ok {
+	t := concreteType(a.e_I)
+	if ok, _ := t.AssignableTo(ds, a.t_cast); ok {
 		return a.e_I, "Assert"
 	}
-	panic("Cannot reduce: " + a.String())
+	return runtimePanic(ds, a, "interface conversion: "+t.String()+" is not "+
+		a.t_cast.String()), "AssertPanic"
 }
 
 func (a Assert) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
 	a.t_cast.Ok(ds)
 	u_I, e_I := a.e_I.Typing(ds, gamma, allowStupid)
-	newAst := Assert{e_I, a.t_cast}
+	newAst := Assert{e_I, a.t_cast, a.span}
 	if !isInterfaceType(ds, u_I) {
 		if allowStupid {
 			return a.t_cast, newAst
 		} else {
-			panic("Expr must be an interface type (in a non-stupid context): found " +
-				u_I.String() + " for\n\t" + a.String())
+			panic(base.NewDiagnostic(base.DIAG_NOT_INTERFACE, a,
+				"Expr must be an interface type (in a non-stupid context): found "+
+					u_I.String()))
 		}
 	}
 	// u_I is an interface type
@@ -441,8 +898,9 @@ func (a Assert) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr)
 		if Impls(ds, a.t_cast, getInterface(ds, u_I)) {
 			return a.t_cast, newAst
 		}
-		panic("Struct type assertion must implement expr type: asserted=" +
-			a.t_cast.String() + ", expr=" + u_I.String())
+		panic(base.NewDiagnostic(base.DIAG_BAD_ASSERT, a,
+			"Struct type assertion must implement expr type: asserted="+
+				a.t_cast.String()+", expr="+u_I.String()))
 	}
 }
 
@@ -451,14 +909,13 @@ func (a Assert) IsValue() bool {
 	return false
 }
 
+func (a Assert) IsPanic() bool {
+	return false
+}
+
+// N.B. true for a failed assertion, cf. the run-time panic in Eval
 func (a Assert) CanEval(ds []Decl) bool {
-	if a.e_I.CanEval(ds) {
-		return true
-	} else if !a.e_I.IsValue() {
-		return false
-	}
-	ok, _ := concreteType(a.e_I).AssignableTo(ds, a.t_cast)
-	return ok
+	return a.e_I.IsValue() || a.e_I.CanEval(ds)
 }
 
 func (a Assert) String() string {
@@ -485,24 +942,28 @@ func (a Assert) ToGoString(ds []Decl) string {
 // The main goal is to allow conversions such as:
 //   - int32(1), MyInt(1), MyInt(int32(1))
 //   - S(struct{}{}), struct{}(S{})
+//   - float32(int32(1)), int32(x) for x of type float64 (truncated)
+//
 // Essentially, it only supports conversions between types with similar
-// underlying types (Cf. validConversion).
-// In particular, conversions such as float32(int32(1)) are not supported.
+// underlying types, and between numeric types (Cf. validConversion).
 type Convert struct {
 	typ  Type
 	expr FGExpr
+	span base.Span // Source position, not part of node identity
 }
 
+func (c Convert) GetSpan() base.Span { return c.span }
+
 var _ FGExpr = Convert{}
 
 func (c Convert) Subs(subs map[Variable]FGExpr) FGExpr {
-	return Convert{c.typ, c.expr.Subs(subs)}
+	return Convert{c.typ, c.expr.Subs(subs), c.span}
 }
 
 func (c Convert) Eval(ds []Decl) (FGExpr, string) {
 	if !c.expr.IsValue() {
 		e, rule := c.expr.Eval(ds)
-		return Convert{c.typ, e}, rule
+		return Convert{c.typ, e, c.span}, rule
 	}
 
 	var converted FGExpr
@@ -513,12 +974,23 @@ func (c Convert) Eval(ds []Decl) (FGExpr, string) {
 		if _, ok := c.typ.(UndefTPrimitive); ok {
 			converted = convdLit
 		} else {
-			converted = TypedPrimitiveValue{convdLit, c.typ}
+			converted = TypedPrimitiveValue{convdLit, c.typ, c.span}
 		}
 	case TypedPrimitiveValue:
-		converted = TypedPrimitiveValue{e.lit, c.typ}
+		ptype := c.typ.Underlying(ds).(PrimType)
+		converted = TypedPrimitiveValue{rawConversion(e.lit, ptype.Tag()), c.typ, c.span}
 	case StructLit:
-		converted = StructLit{c.typ, e.elems}
+		converted = StructLit{c.typ, e.elems, c.span}
+	case FuncLit:
+		var t Type // nil if c.typ is not a defined type, cf. FuncLit
+		if _, ok := c.typ.(TFunc); !ok {
+			t = c.typ
+		}
+		converted = FuncLit{e.pDecls, e.t_ret, e.e_body, t, c.span}
+	case SliceLit:
+		converted = SliceLit{c.typ, e.elems, c.span}
+	case MapLit:
+		converted = MapLit{c.typ, e.entries, c.span}
 	default:
 		panic("Unsupported conversion: " + c.String())
 	}
@@ -535,14 +1007,26 @@ func rawConversion(lit PrimitiveLiteral, tag Tag) PrimitiveLiteral {
 		convdPayload = lit.payload // payload can only be bool/string
 
 	case INT32:
-		pload := lit.payload.(int32) // lit.payload can only be int32
-		convdPayload = int32(pload)  //  redundant
+		switch pload := lit.payload.(type) {
+		case int32:
+			convdPayload = int32(pload) // redundant
+		case int64:
+			convdPayload = int32(pload)
+		case float32:
+			convdPayload = int32(pload) // Truncated, as in Go
+		case float64:
+			convdPayload = int32(pload)
+		}
 	case INT64:
 		switch pload := lit.payload.(type) {
 		case int32:
 			convdPayload = int64(pload)
 		case int64:
 			convdPayload = int64(pload) // redundant
+		case float32:
+			convdPayload = int64(pload)
+		case float64:
+			convdPayload = int64(pload)
 		}
 	case FLOAT32:
 		switch pload := lit.payload.(type) {
@@ -552,6 +1036,8 @@ func rawConversion(lit PrimitiveLiteral, tag Tag) PrimitiveLiteral {
 			convdPayload = float32(pload)
 		case float32:
 			convdPayload = float32(pload) // redundant
+		case float64:
+			convdPayload = float32(pload)
 		}
 	case FLOAT64:
 		switch pload := lit.payload.(type) {
@@ -565,34 +1051,50 @@ func rawConversion(lit PrimitiveLiteral, tag Tag) PrimitiveLiteral {
 			convdPayload = float64(pload) // redundant
 		}
 	}
-	return PrimitiveLiteral{convdPayload, tag}
+	return PrimitiveLiteral{convdPayload, tag, base.Span{}}
 }
 
 func (c Convert) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	if t, ok := c.typ.(TNamed); ok { // A local of function type, e.g., "f(x)"
+		if _, ok := gamma[t.name]; ok {
+			f := Variable{t.name, c.span}
+			return Apply{f, []FGExpr{c.expr}, c.span}.Typing(ds, gamma, allowStupid)
+		}
+	}
 	c.typ.Ok(ds)
 	t_expr, expr := c.expr.Typing(ds, gamma, allowStupid)
 
 	if validConversion(ds, t_expr, c.typ) {
-		return c.typ, Convert{c.typ, expr}
+		return c.typ, Convert{c.typ, expr, c.span}
 	}
-	panic("Invalid type conversion: " + c.String())
+	panic(base.NewDiagnostic(base.DIAG_BAD_CONVERSION, c,
+		"Invalid type conversion from "+t_expr.String()+" to "+c.typ.String()))
 }
 
 func validConversion(ds []Decl, t1, t2 Type) bool {
 	if t1.Underlying(ds).Equals(t2.Underlying(ds)) {
 		return true
 	}
-	if u1, ok := t1.(UndefTPrimitive); ok {
+	if u1, ok := t1.(UndefTPrimitive); ok { // Constants must be representable, e.g., not int32(1.5)
 		return u1.RepresentableBy(ds, t2)
 	}
-	return false
+	return evalPrimtPredicate(ds, isNumeric, t1) && evalPrimtPredicate(ds, isNumeric, t2)
 }
 
 func (c Convert) IsValue() bool {
 	return false
 }
 
+func (c Convert) IsPanic() bool {
+	return false
+}
+
 func (c Convert) CanEval(ds []Decl) bool {
+	if c.expr.CanEval(ds) {
+		return true
+	} else if !c.expr.IsValue() {
+		return false
+	}
 	t_expr := concreteType(c.expr)
 	return validConversion(ds, t_expr, c.typ)
 }
@@ -616,13 +1118,306 @@ func (c Convert) ToGoString(ds []Decl) string {
 	return b.String()
 }
 
+/* Conditionals */
+
+// A method body (or branch of one): if cond { return e_then } else { return e_else }.
+// typ is the declared return type of the method (cf. SetBodyType), to which
+// each branch must be assignable -- if nil, the type is the join of the branches.
+type Cond struct {
+	cond   FGExpr
+	e_then FGExpr
+	e_else FGExpr
+	typ    Type
+	span   base.Span // Source position, not part of node identity
+}
+
+func (c Cond) GetSpan() base.Span { return c.span }
+
+var _ FGExpr = Cond{}
+
+func (c Cond) GetCond() FGExpr { return c.cond }
+func (c Cond) GetThen() FGExpr { return c.e_then }
+func (c Cond) GetElse() FGExpr { return c.e_else }
+func (c Cond) GetType() Type   { return c.typ }
+
+func (c Cond) Subs(subs map[Variable]FGExpr) FGExpr {
+	return Cond{c.cond.Subs(subs), c.e_then.Subs(subs), c.e_else.Subs(subs), c.typ, c.span}
+}
+
+// N.B. only the condition is evaluated, the branches are not
+func (c Cond) Eval(ds []Decl) (FGExpr, string) {
+	if !c.cond.IsValue() {
+		e, rule := c.cond.Eval(ds)
+		return Cond{e, c.e_then, c.e_else, c.typ, c.span}, rule
+	}
+	if c.cond.(PrimtValue).Val().(bool) {
+		return c.e_then, "IfTrue"
+	}
+	return c.e_else, "IfFalse"
+}
+
+func (c Cond) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	t_cond, cond := c.cond.Typing(ds, gamma, allowStupid)
+	if !evalPrimtPredicate(ds, isBool, t_cond) {
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+			"Non-boolean condition: "+t_cond.String()))
+	}
+	t_then, e_then := c.e_then.Typing(ds, gamma, allowStupid)
+	t_else, e_else := c.e_else.Typing(ds, gamma, allowStupid)
+
+	if c.typ != nil {
+		e_then = c.coerceBranch(ds, t_then, e_then)
+		e_else = c.coerceBranch(ds, t_else, e_else)
+		return c.typ, Cond{cond, e_then, e_else, c.typ, c.span}
+	}
+	// o/w the join of the branch types
+	if ok, coercion := t_then.AssignableTo(ds, t_else); ok {
+		return t_else, Cond{cond, coerceBody(e_then, coercion), e_else, c.typ, c.span}
+	}
+	if ok, coercion := t_else.AssignableTo(ds, t_then); ok {
+		return t_then, Cond{cond, e_then, coerceBody(e_else, coercion), c.typ, c.span}
+	}
+	panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+		"Mismatched branch types: "+t_then.String()+" and "+t_else.String()))
+}
+
+func (c Cond) coerceBranch(ds []Decl, t Type, e FGExpr) FGExpr {
+	ok, coercion := t.AssignableTo(ds, c.typ)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+			"Branch must be assignable to declared return type: found="+
+				t.String()+", expected="+c.typ.String()))
+	}
+	return coerceBody(e, coercion)
+}
+
+func (c Cond) IsValue() bool {
+	return false
+}
+
+func (c Cond) IsPanic() bool {
+	return false
+}
+
+func (c Cond) CanEval(ds []Decl) bool {
+	if c.cond.CanEval(ds) {
+		return true
+	}
+	return c.cond.IsValue()
+}
+
+func (c Cond) String() string {
+	var b strings.Builder
+	b.WriteString("if ")
+	b.WriteString(c.cond.String())
+	b.WriteString(" { ")
+	writeBody(&b, c.e_then)
+	b.WriteString(" } else { ")
+	writeBody(&b, c.e_else)
+	b.WriteString(" }")
+	return b.String()
+}
+
+func (c Cond) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString("if ")
+	b.WriteString(c.cond.ToGoString(ds))
+	b.WriteString(" { ")
+	writeToGoBody(ds, &b, c.e_then)
+	b.WriteString(" } else { ")
+	writeToGoBody(ds, &b, c.e_else)
+	b.WriteString(" }")
+	return b.String()
+}
+
+/* Local bindings */
+
+// A method body (or branch of one): x := e_def; e_body, or var x t = e_def; e_body.
+// If t is nil, x has the type of e_def -- N.B. an untyped constant stays
+// untyped, cf. Go's const.  Evaluation is call-by-value: e_def is evaluated
+// before it is substituted into e_body.
+type Let struct {
+	x      Name
+	t      Type
+	e_def  FGExpr
+	e_body FGExpr
+	span   base.Span // Source position, not part of node identity
+}
+
+func (l Let) GetSpan() base.Span { return l.span }
+
+var _ FGExpr = Let{}
+
+func (l Let) GetVar() Name    { return l.x }
+func (l Let) GetType() Type   { return l.t }
+func (l Let) GetDef() FGExpr  { return l.e_def }
+func (l Let) GetBody() FGExpr { return l.e_body }
+
+// x is bound in e_body, so is not substituted there
+func (l Let) Subs(subs map[Variable]FGExpr) FGExpr {
+	subs1 := make(map[Variable]FGExpr)
+	for k, v := range subs {
+		subs1[k] = v
+	}
+	subs1[NewVariable(l.x)] = NewVariable(l.x)
+	return Let{l.x, l.t, l.e_def.Subs(subs), l.e_body.Subs(subs1), l.span}
+}
+
+func (l Let) Eval(ds []Decl) (FGExpr, string) {
+	if !l.e_def.IsValue() {
+		e, rule := l.e_def.Eval(ds)
+		return Let{l.x, l.t, e, l.e_body, l.span}, rule
+	}
+	subs := map[Variable]FGExpr{NewVariable(l.x): l.e_def}
+	return l.e_body.Subs(subs), "Let"
+}
+
+// N.B. x may not shadow a variable already in gamma (cf. Go's "no new variables")
+// -- a simplification: this includes a variable captured by a FuncLit body,
+// which Go allows to be redeclared there (cf. the FuncLit params, which may)
+func (l Let) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	if _, ok := gamma[l.x]; ok {
+		panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, l,
+			"Variable already declared: "+l.x))
+	}
+	t_def, e_def := l.e_def.Typing(ds, gamma, allowStupid)
+	t_x := t_def
+	if l.t != nil {
+		l.t.Ok(ds)
+		ok, coercion := t_def.AssignableTo(ds, l.t)
+		if !ok {
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, l,
+				"Definition of "+l.x+" must be assignable to its declared type: found="+
+					t_def.String()+", expected="+l.t.String()))
+		}
+		t_x, e_def = l.t, coercion(e_def)
+	}
+	gamma1 := make(Gamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	gamma1[l.x] = t_x
+	t_body, e_body := l.e_body.Typing(ds, gamma1, allowStupid)
+	return t_body, Let{l.x, l.t, e_def, e_body, l.span}
+}
+
+func (l Let) IsValue() bool {
+	return false
+}
+
+func (l Let) IsPanic() bool {
+	return false
+}
+
+func (l Let) CanEval(ds []Decl) bool {
+	if l.e_def.CanEval(ds) {
+		return true
+	}
+	return l.e_def.IsValue()
+}
+
+func (l Let) String() string {
+	var b strings.Builder
+	l.writeBinding(&b, l.e_def.String())
+	writeBody(&b, l.e_body)
+	return b.String()
+}
+
+func (l Let) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	l.writeBinding(&b, l.e_def.ToGoString(ds))
+	writeToGoBody(ds, &b, l.e_body)
+	return b.String()
+}
+
+// "x := e_def; ", or "var x t = e_def; "
+func (l Let) writeBinding(b *strings.Builder, e_def string) {
+	if l.t == nil {
+		b.WriteString(l.x + " := ")
+	} else {
+		b.WriteString("var " + l.x + " " + l.t.String() + " = ")
+	}
+	b.WriteString(e_def)
+	b.WriteString("; ")
+}
+
+/* Method bodies */
+
+// Sets the type of the conditionals (and type switches, and panics) in e, the body of a method with return type t
+func SetBodyType(e FGExpr, t Type) FGExpr {
+	switch e1 := e.(type) {
+	case Panic:
+		return Panic{e1.e_arg, t, e1.span}
+	case Cond:
+		return Cond{e1.cond, SetBodyType(e1.e_then, t), SetBodyType(e1.e_else, t), t, e1.span}
+	case Let:
+		return Let{e1.x, e1.t, e1.e_def, SetBodyType(e1.e_body, t), e1.span}
+	case MapAssign:
+		return MapAssign{e1.x, e1.e_M, e1.e_key, e1.e_val, SetBodyType(e1.e_body, t), e1.span}
+	case CommaOk:
+		return CommaOk{e1.x, e1.x_ok, e1.e_def, SetBodyType(e1.e_body, t), e1.span}
+	case TypeSwitch:
+		cases := make([]TypeCase, len(e1.cases))
+		for i, c := range e1.cases {
+			cases[i] = TypeCase{c.t, SetBodyType(c.e_body, t)}
+		}
+		var e_def FGExpr
+		if e1.e_def != nil {
+			e_def = SetBodyType(e1.e_def, t)
+		}
+		return TypeSwitch{e1.x, e1.e_I, cases, e_def, t, e1.span}
+	default:
+		return e
+	}
+}
+
+// Applies coercion to the result of e, a method body (or branch of one) --
+// i.e., under any bindings, so that e is still printed as a body
+func coerceBody(e FGExpr, coercion Coercion) FGExpr {
+	switch e1 := e.(type) {
+	case Let:
+		return Let{e1.x, e1.t, e1.e_def, coerceBody(e1.e_body, coercion), e1.span}
+	case MapAssign:
+		return MapAssign{e1.x, e1.e_M, e1.e_key, e1.e_val, coerceBody(e1.e_body, coercion), e1.span}
+	case CommaOk:
+		return CommaOk{e1.x, e1.x_ok, e1.e_def, coerceBody(e1.e_body, coercion), e1.span}
+	case Panic: // Already has the declared return type, cf. SetBodyType
+		return e
+	}
+	return coercion(e)
+}
+
+// "return e", a conditional, a type switch, a binding or a panic -- cf. MethDecl.String
+func writeBody(b *strings.Builder, e FGExpr) {
+	switch e.(type) {
+	case Cond, Let, MapAssign, CommaOk, TypeSwitch, Panic:
+		b.WriteString(e.String())
+	default:
+		b.WriteString("return ")
+		b.WriteString(e.String())
+	}
+}
+
+func writeToGoBody(ds []Decl, b *strings.Builder, e FGExpr) {
+	switch e.(type) {
+	case Cond, Let, MapAssign, CommaOk, TypeSwitch, Panic:
+		b.WriteString(e.ToGoString(ds))
+	default:
+		b.WriteString("return ")
+		b.WriteString(e.ToGoString(ds))
+	}
+}
+
 /* fmt.Sprintf */
 
 type Sprintf struct {
 	format string // Includes surrounding quotes
 	args   []FGExpr
+	span   base.Span // Source position, not part of node identity
 }
 
+func (s Sprintf) GetSpan() base.Span { return s.span }
+
 var _ FGExpr = Sprintf{}
 
 func (s Sprintf) GetFormat() string { return s.format }
@@ -633,7 +1428,7 @@ func (s Sprintf) Subs(subs map[Variable]FGExpr) FGExpr {
 	for i := 0; i < len(args); i++ {
 		args[i] = s.args[i].Subs(subs)
 	}
-	return Sprintf{s.format, args}
+	return Sprintf{s.format, args, s.span}
 }
 
 func (s Sprintf) Eval(ds []Decl) (FGExpr, string) {
@@ -649,7 +1444,7 @@ func (s Sprintf) Eval(ds []Decl) (FGExpr, string) {
 		args[i] = v
 	}
 	if done {
-		return Sprintf{s.format, args}, rule
+		return Sprintf{s.format, args, s.span}, rule
 	} else {
 		cast := make([]interface{}, len(args))
 		for i := range args {
@@ -669,7 +1464,7 @@ func (s Sprintf) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr)
 	for i := 0; i < len(s.args); i++ {
 		_, args[i] = s.args[i].Typing(ds, gamma, allowStupid)
 	}
-	return NewTPrimitive(STRING) /* todo def or Undef? */, Sprintf{s.format, args}
+	return NewTPrimitive(STRING) /* todo def or Undef? */, Sprintf{s.format, args, s.span}
 }
 
 // From base.Expr
@@ -677,6 +1472,10 @@ func (s Sprintf) IsValue() bool {
 	return false
 }
 
+func (s Sprintf) IsPanic() bool {
+	return false
+}
+
 func (s Sprintf) CanEval(ds []Decl) bool {
 	return true
 }
@@ -0,0 +1,568 @@
+/*
+ * This file contains defs for map values and the map operations: literals,
+ * (functional) update and comma-ok lookup -- the latter also covers comma-ok
+ * type assertions.  Cf. TMap, in fg_types.go, and Index and Len, in
+ * fg_slices.go.
+ */
+
+package fg
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/rhu1/fgg/internal/base"
+)
+
+/* "Exported" constructors for fgg (monomorph) */
+
+func NewMapLit(t Type, es []MapEntry) MapLit  { return MapLit{t, es, base.Span{}} }
+func NewMapEntry(k FGExpr, v FGExpr) MapEntry { return MapEntry{k, v} }
+
+// N.B. e_M is initially x, cf. MapAssign
+func NewMapAssign(x Name, e_key FGExpr, e_val FGExpr, e_body FGExpr) MapAssign {
+	return MapAssign{x, NewVariable(x), e_key, e_val, e_body, base.Span{}}
+}
+
+func NewCommaOk(x Name, x_ok Name, e_def FGExpr, e_body FGExpr) CommaOk {
+	return CommaOk{x, x_ok, e_def, e_body, base.Span{}}
+}
+
+/* MapLit */
+
+// "key: val"
+type MapEntry struct {
+	key FGExpr
+	val FGExpr
+}
+
+func (e MapEntry) GetKey() FGExpr { return e.key }
+func (e MapEntry) GetVal() FGExpr { return e.val }
+
+func (e MapEntry) String() string {
+	return e.key.String() + ": " + e.val.String()
+}
+
+// t{k1: v1, ..., kn: vn}, where t is a map type or a defined type whose
+// underlying type is a map type -- "t{}" is parsed as a StructLit, cf.
+// StructLit.Typing.  A map value has no duplicate keys, and its entries are
+// ordered by key (cf. compareKeys), so that evaluation, and the printing of
+// results, is deterministic.
+type MapLit struct {
+	typ     Type
+	entries []MapEntry
+	span    base.Span // Source position, not part of node identity
+}
+
+func (m MapLit) GetSpan() base.Span { return m.span }
+
+var _ FGExpr = MapLit{}
+
+func (m MapLit) GetType() Type          { return m.typ }
+func (m MapLit) GetEntries() []MapEntry { return m.entries }
+
+func (m MapLit) Subs(subs map[Variable]FGExpr) FGExpr {
+	es := make([]MapEntry, len(m.entries))
+	for i, v := range m.entries {
+		es[i] = MapEntry{v.key.Subs(subs), v.val.Subs(subs)}
+	}
+	return MapLit{m.typ, es, m.span}
+}
+
+// Evaluates the entries left to right, then normalises the map value
+func (m MapLit) Eval(ds []Decl) (FGExpr, string) {
+	es := make([]MapEntry, len(m.entries))
+	done := false
+	var rule string
+	for i, v := range m.entries {
+		if !done && !v.key.IsValue() {
+			v.key, rule = v.key.Eval(ds)
+			done = true
+		}
+		if !done && !v.val.IsValue() {
+			v.val, rule = v.val.Eval(ds)
+			done = true
+		}
+		es[i] = v
+	}
+	if done {
+		return MapLit{m.typ, es, m.span}, rule
+	}
+	for _, v := range m.entries {
+		if msg := unhashable(v.key); msg != "" { // Cf. Go run-time panics
+			return runtimePanic(ds, m, msg), "MapLitPanic"
+		}
+	}
+	if isCanonical(m.entries) {
+		panic("Cannot reduce: " + m.String())
+	}
+	return MapLit{m.typ, canonicalEntries(m.entries), m.span}, "MapLit"
+}
+
+func (m MapLit) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	m.typ.Ok(ds)
+	t_M, ok := m.typ.Underlying(ds).(TMap)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, m,
+			"Map literal: "+m.typ.String()+" is not a map type"))
+	}
+	es := make([]MapEntry, len(m.entries))
+	for i, v := range m.entries {
+		if lit, ok := v.key.(PrimitiveLiteral); ok { // Cf. Go's "duplicate key" error
+			for _, v1 := range m.entries[:i] {
+				if lit1, ok := v1.key.(PrimitiveLiteral); ok && lit1.payload == lit.payload {
+					panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, m,
+						"Duplicate key in map literal: "+lit.String()))
+				}
+			}
+		}
+		es[i] = MapEntry{
+			typeMapOperand(ds, gamma, allowStupid, m, v.key, t_M.key, "key", "key"),
+			typeMapOperand(ds, gamma, allowStupid, m, v.val, t_M.elem, "element", "elem")}
+	}
+	return m.typ, MapLit{m.typ, es, m.span}
+}
+
+// Types e, a key or element (the noun) of the map operation n, against t
+func typeMapOperand(ds []Decl, gamma Gamma, allowStupid bool, n FGExpr, e FGExpr,
+	t Type, noun string, abbrev string) FGExpr {
+	t_e, newSubtree := e.Typing(ds, gamma, allowStupid)
+	ok, coercion := t_e.AssignableTo(ds, t)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, n,
+			"Map "+noun+" must be assignable to "+noun+" type: "+abbrev+"="+
+				t_e.String()+", expected="+t.String()))
+	}
+	return coercion(newSubtree)
+}
+
+// From base.Expr
+func (m MapLit) IsValue() bool {
+	for _, v := range m.entries {
+		if !v.key.IsValue() || !v.val.IsValue() {
+			return false
+		}
+	}
+	return isCanonical(m.entries)
+}
+
+func (m MapLit) IsPanic() bool {
+	return false
+}
+
+func (m MapLit) CanEval(ds []Decl) bool {
+	for _, v := range m.entries {
+		for _, e := range []FGExpr{v.key, v.val} {
+			if e.CanEval(ds) {
+				return true
+			} else if !e.IsValue() {
+				return false
+			}
+		}
+	}
+	return !isCanonical(m.entries)
+}
+
+func (m MapLit) String() string {
+	var b strings.Builder
+	b.WriteString(m.typ.String())
+	b.WriteString("{")
+	for i, v := range m.entries {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(v.String())
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// Cf. the "%#v" output of Go, e.g., "map[int32]int32{1:2, 3:4}"
+func (m MapLit) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString(toGoTypeString(m.typ))
+	b.WriteString("{")
+	for i, v := range m.entries {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(v.key.ToGoString(ds))
+		b.WriteString(":")
+		b.WriteString(v.val.ToGoString(ds))
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+/* MapAssign */
+
+// x[e_key] = e_val; e_body -- a functional update: x is rebound in e_body to
+// (the value of) e_M updated with e_key: e_val.  e_M is x itself, until x is
+// substituted by a call or binding.  N.B. unlike Go, the map value is not
+// mutated, so any other variable bound to it does not observe the update.
+type MapAssign struct {
+	x      Name
+	e_M    FGExpr
+	e_key  FGExpr
+	e_val  FGExpr
+	e_body FGExpr
+	span   base.Span // Source position, not part of node identity
+}
+
+func (m MapAssign) GetSpan() base.Span { return m.span }
+
+var _ FGExpr = MapAssign{}
+
+func (m MapAssign) GetVar() Name    { return m.x }
+func (m MapAssign) GetMap() FGExpr  { return m.e_M }
+func (m MapAssign) GetKey() FGExpr  { return m.e_key }
+func (m MapAssign) GetVal() FGExpr  { return m.e_val }
+func (m MapAssign) GetBody() FGExpr { return m.e_body }
+
+// x is (re)bound in e_body, so is not substituted there
+func (m MapAssign) Subs(subs map[Variable]FGExpr) FGExpr {
+	subs1 := make(map[Variable]FGExpr)
+	for k, v := range subs {
+		subs1[k] = v
+	}
+	subs1[NewVariable(m.x)] = NewVariable(m.x)
+	return MapAssign{m.x, m.e_M.Subs(subs), m.e_key.Subs(subs), m.e_val.Subs(subs),
+		m.e_body.Subs(subs1), m.span}
+}
+
+// Cf. Go's order of evaluation: the map and key operands, then the value
+func (m MapAssign) Eval(ds []Decl) (FGExpr, string) {
+	if !m.e_M.IsValue() {
+		e, rule := m.e_M.Eval(ds)
+		return MapAssign{m.x, e, m.e_key, m.e_val, m.e_body, m.span}, rule
+	}
+	if !m.e_key.IsValue() {
+		e, rule := m.e_key.Eval(ds)
+		return MapAssign{m.x, m.e_M, e, m.e_val, m.e_body, m.span}, rule
+	}
+	if !m.e_val.IsValue() {
+		e, rule := m.e_val.Eval(ds)
+		return MapAssign{m.x, m.e_M, m.e_key, e, m.e_body, m.span}, rule
+	}
+	if msg := unhashable(m.e_key); msg != "" { // Cf. Go run-time panics
+		return runtimePanic(ds, m, msg), "MapAssignPanic"
+	}
+	m1 := m.e_M.(MapLit)
+	es := append(append([]MapEntry{}, m1.entries...), MapEntry{m.e_key, m.e_val})
+	subs := map[Variable]FGExpr{NewVariable(m.x): MapLit{m1.typ, canonicalEntries(es), m1.span}}
+	return m.e_body.Subs(subs), "MapAssign"
+}
+
+func (m MapAssign) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	t, e_M := m.e_M.Typing(ds, gamma, allowStupid)
+	t_M, ok := t.Underlying(ds).(TMap)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, m,
+			"Map assignment to non-map: "+m.x+" of type "+t.String()))
+	}
+	e_key := typeMapOperand(ds, gamma, allowStupid, m, m.e_key, t_M.key, "key", "key")
+	e_val := typeMapOperand(ds, gamma, allowStupid, m, m.e_val, t_M.elem, "element", "elem")
+	gamma1 := make(Gamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	gamma1[m.x] = t
+	t_body, e_body := m.e_body.Typing(ds, gamma1, allowStupid)
+	return t_body, MapAssign{m.x, e_M, e_key, e_val, e_body, m.span}
+}
+
+func (m MapAssign) IsValue() bool {
+	return false
+}
+
+func (m MapAssign) IsPanic() bool {
+	return false
+}
+
+func (m MapAssign) CanEval(ds []Decl) bool {
+	for _, e := range []FGExpr{m.e_M, m.e_key, m.e_val} {
+		if e.CanEval(ds) {
+			return true
+		} else if !e.IsValue() {
+			return false
+		}
+	}
+	return true
+}
+
+func (m MapAssign) String() string {
+	var b strings.Builder
+	m.writeAssign(&b, m.e_M.String(), m.e_key.String(), m.e_val.String())
+	writeBody(&b, m.e_body)
+	return b.String()
+}
+
+func (m MapAssign) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	m.writeAssign(&b, m.e_M.ToGoString(ds), m.e_key.ToGoString(ds), m.e_val.ToGoString(ds))
+	writeToGoBody(ds, &b, m.e_body)
+	return b.String()
+}
+
+// "x[e_key] = e_val; ", or "x := e_M; x[e_key] = e_val; " once x is substituted
+func (m MapAssign) writeAssign(b *strings.Builder, e_M string, e_key string, e_val string) {
+	if x, ok := m.e_M.(Variable); !ok || x.name != m.x {
+		b.WriteString(m.x + " := " + e_M + "; ")
+	}
+	b.WriteString(m.x + "[" + e_key + "] = " + e_val + "; ")
+}
+
+/* CommaOk */
+
+// x, x_ok := e_def; e_body -- where e_def is a map index e_M[e_key]: x is
+// bound to the element for e_key, or else the zero value (cf. mapIndex), and
+// x_ok to whether e_key is present.  Or e_def is a type assertion e_I.(t):
+// x is bound to the value of e_I, if its type is assignable to t, or else
+// the zero value of t (cf. assertOk) -- so, unlike Assert, it never gets stuck.
+type CommaOk struct {
+	x      Name
+	x_ok   Name
+	e_def  FGExpr
+	e_body FGExpr
+	span   base.Span // Source position, not part of node identity
+}
+
+func (c CommaOk) GetSpan() base.Span { return c.span }
+
+var _ FGExpr = CommaOk{}
+
+func (c CommaOk) GetVar() Name    { return c.x }
+func (c CommaOk) GetOkVar() Name  { return c.x_ok }
+func (c CommaOk) GetDef() FGExpr  { return c.e_def }
+func (c CommaOk) GetBody() FGExpr { return c.e_body }
+
+// x and x_ok are bound in e_body, so are not substituted there
+func (c CommaOk) Subs(subs map[Variable]FGExpr) FGExpr {
+	subs1 := make(map[Variable]FGExpr)
+	for k, v := range subs {
+		subs1[k] = v
+	}
+	subs1[NewVariable(c.x)] = NewVariable(c.x)
+	subs1[NewVariable(c.x_ok)] = NewVariable(c.x_ok)
+	return CommaOk{c.x, c.x_ok, c.e_def.Subs(subs), c.e_body.Subs(subs1), c.span}
+}
+
+// Evaluates the operands of e_def, but not e_def itself, cf. Index.Eval
+func (c CommaOk) Eval(ds []Decl) (FGExpr, string) {
+	if a, ok := c.e_def.(Assert); ok {
+		if !a.e_I.IsValue() {
+			e, rule := a.e_I.Eval(ds)
+			return CommaOk{c.x, c.x_ok, Assert{e, a.t_cast, a.span}, c.e_body, c.span}, rule
+		}
+		v, ok := assertOk(ds, a)
+		return c.bind(v, ok), "CommaOkAssert"
+	}
+	x := c.e_def.(Index)
+	if !x.e_S.IsValue() {
+		e, rule := x.e_S.Eval(ds)
+		return CommaOk{c.x, c.x_ok, Index{e, x.e_idx, x.span}, c.e_body, c.span}, rule
+	}
+	if !x.e_idx.IsValue() {
+		e, rule := x.e_idx.Eval(ds)
+		return CommaOk{c.x, c.x_ok, Index{x.e_S, e, x.span}, c.e_body, c.span}, rule
+	}
+	v, ok := mapIndex(ds, x.e_S.(MapLit), x.e_idx, c)
+	if v.IsPanic() {
+		return v, "CommaOkPanic"
+	}
+	return c.bind(v, ok), "CommaOk"
+}
+
+// Substitutes v for x and ok for x_ok in e_body
+func (c CommaOk) bind(v FGExpr, ok bool) FGExpr {
+	lit := PrimitiveLiteral{ok, BOOL, c.span}
+	subs := map[Variable]FGExpr{
+		NewVariable(c.x):    v,
+		NewVariable(c.x_ok): TypedPrimitiveValue{lit, NewTPrimitive(BOOL), c.span}}
+	return c.e_body.Subs(subs)
+}
+
+// N.B. x_ok has type bool, i.e., the default type of an untyped bool
+func (c CommaOk) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	for _, y := range []Name{c.x, c.x_ok} {
+		if _, ok := gamma[y]; ok {
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, c,
+				"Variable already declared: "+y))
+		}
+	}
+	if c.x == c.x_ok {
+		panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, c,
+			"Variable repeated in binding: "+c.x))
+	}
+	var t_x Type
+	var e_def FGExpr
+	switch x := c.e_def.(type) {
+	case Assert: // Cf. T-ASSERT_I/S
+		t_x, e_def = x.Typing(ds, gamma, allowStupid)
+		if _, ok := zeroValue(ds, x.t_cast); !ok {
+			panic(base.NewDiagnostic(base.DIAG_NO_ZERO_VALUE, c,
+				"Comma-ok assertion requires a type with a zero value (FG has no nil): "+
+					noZeroValueCause(ds, x.t_cast)))
+		}
+	case Index:
+		t_S, _ := x.e_S.Typing(ds, gamma, allowStupid)
+		if _, ok := t_S.Underlying(ds).(TMap); !ok {
+			panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, c,
+				"Comma-ok binding requires a map index or a type assertion: "+
+					c.e_def.String()))
+		}
+		t_x, e_def = x.Typing(ds, gamma, allowStupid)
+	default:
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, c,
+			"Comma-ok binding requires a map index or a type assertion: "+
+				c.e_def.String()))
+	}
+	gamma1 := make(Gamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	gamma1[c.x] = t_x
+	gamma1[c.x_ok] = NewTPrimitive(BOOL)
+	t_body, e_body := c.e_body.Typing(ds, gamma1, allowStupid)
+	return t_body, CommaOk{c.x, c.x_ok, e_def, e_body, c.span}
+}
+
+func (c CommaOk) IsValue() bool {
+	return false
+}
+
+func (c CommaOk) IsPanic() bool {
+	return false
+}
+
+// Cf. Index.CanEval -- N.B. a comma-ok assertion, unlike Assert, can always
+// be reduced once e_I is a value
+func (c CommaOk) CanEval(ds []Decl) bool {
+	if a, ok := c.e_def.(Assert); ok {
+		return a.e_I.CanEval(ds) || a.e_I.IsValue()
+	}
+	return c.e_def.CanEval(ds)
+}
+
+func (c CommaOk) String() string {
+	var b strings.Builder
+	b.WriteString(c.x + ", " + c.x_ok + " := " + c.e_def.String() + "; ")
+	writeBody(&b, c.e_body)
+	return b.String()
+}
+
+func (c CommaOk) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString(c.x + ", " + c.x_ok + " := " + c.e_def.ToGoString(ds) + "; ")
+	writeToGoBody(ds, &b, c.e_body)
+	return b.String()
+}
+
+/* Helpers */
+
+// The value of a.e_I, if its type is assignable to a.t_cast (cf.
+// Assert.Eval), or else the zero value of a.t_cast.  Returns whether the
+// former.
+// Pre: a.e_I is a value
+func assertOk(ds []Decl, a Assert) (FGExpr, bool) {
+	if ok, _ := concreteType(a.e_I).AssignableTo(ds, a.t_cast); ok {
+		return a.e_I, true
+	}
+	if v, ok := zeroValue(ds, a.t_cast); ok {
+		return v, false
+	}
+	panic("failed assertion, and no zero value of type " + a.t_cast.String() + ": " +
+		a.String())
+}
+
+// The element for key k in m, or else the zero value of the element type --
+// N.B. FG has no nil, so a missing key is a run-time error of e, the redex, if
+// there is none, e.g., for an interface element type.  Returns whether k is
+// present.
+// Pre: k is a value
+func mapIndex(ds []Decl, m MapLit, k FGExpr, e FGExpr) (FGExpr, bool) {
+	if msg := unhashable(k); msg != "" { // Cf. Go run-time panics
+		return runtimePanic(ds, e, msg), false
+	}
+	for _, v := range m.entries {
+		if eq, _ := valueEquals(v.key, k); eq { // N.B. keys are hashable, cf. unhashable
+			return v.val, true
+		}
+	}
+	t := m.typ.Underlying(ds).(TMap).elem
+	if v, ok := zeroValue(ds, t); ok {
+		return v, false
+	}
+	return runtimePanic(ds, e, "missing map key, and no zero value of type "+t.String()), false
+}
+
+// Removes duplicate keys, the last entry for a key taking precedence, and
+// orders the entries by key
+// Pre: all keys and elements are values, and all keys are hashable
+func canonicalEntries(es []MapEntry) []MapEntry {
+	res := []MapEntry{}
+	for _, v := range es {
+		found := false
+		for i, v1 := range res {
+			if eq, _ := valueEquals(v1.key, v.key); eq {
+				res[i] = v
+				found = true
+				break
+			}
+		}
+		if !found {
+			res = append(res, v)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return compareKeys(res[i].key, res[j].key) < 0
+	})
+	return res
+}
+
+// Whether es are the entries of a map value: hashable keys, with no
+// duplicates, in order (cf. canonicalEntries)
+func isCanonical(es []MapEntry) bool {
+	for i, v := range es {
+		if unhashable(v.key) != "" || i > 0 && compareKeys(es[i-1].key, v.key) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// A total order on map keys, i.e., values of comparable types: by dynamic
+// type, then by payload or fields -- cf. the sorted printing of maps by fmt
+func compareKeys(k1, k2 FGExpr) int {
+	if c := strings.Compare(concreteType(k1).String(), concreteType(k2).String()); c != 0 {
+		return c // E.g., keys of different dynamic types, for an interface key type
+	}
+	switch k1 := k1.(type) {
+	case StructLit:
+		k2 := k2.(StructLit)
+		for i := 0; i < len(k1.elems); i++ {
+			if c := compareKeys(k1.elems[i], k2.elems[i]); c != 0 {
+				return c
+			}
+		}
+		return 0
+	case PrimtValue:
+		return comparePayloads(k1.Val(), k2.(PrimtValue).Val())
+	}
+	return strings.Compare(k1.String(), k2.String()) // Not comparable, cf. valueEquals
+}
+
+// Pre: p1 and p2 have the same (Go) type
+func comparePayloads(p1, p2 interface{}) int {
+	switch {
+	case p1 == p2:
+		return 0
+	case p1 == false: // false < true
+		return -1
+	case p1 == true:
+		return 1
+	case rawBinop(p1, p2, LT).(bool):
+		return -1
+	}
+	return 1
+}
@@ -12,44 +12,85 @@ const (
 	// arithmetic
 	ADD = Operator("+")
 	SUB = Operator("-")
+	MUL = Operator("*")
+	QUO = Operator("/")
+	REM = Operator("%")
+	// bitwise
+	AND = Operator("&")
+	OR  = Operator("|")
+	XOR = Operator("^")
+	SHL = Operator("<<")
+	SHR = Operator(">>")
 	// logical
+	NOT  = Operator("!")
 	LAND = Operator("&&")
 	LOR  = Operator("||")
 	// relational
-	GT = Operator(">")
-	LT = Operator("<")
+	EQL = Operator("==")
+	NEQ = Operator("!=")
+	GT  = Operator(">")
+	LT  = Operator("<")
+	GEQ = Operator(">=")
+	LEQ = Operator("<=")
 )
 
 var OpToRule = map[Operator]string{
 	ADD:  "Add",
 	SUB:  "Sub",
+	MUL:  "Mul",
+	QUO:  "Quo",
+	REM:  "Rem",
+	AND:  "And",
+	OR:   "Or",
+	XOR:  "Xor",
+	SHL:  "Shl",
+	SHR:  "Shr",
 	LAND: "LAnd",
 	LOR:  "LOr",
+	EQL:  "Eql",
+	NEQ:  "Neq",
 	GT:   "Gt",
 	LT:   "Lt",
+	GEQ:  "Geq",
+	LEQ:  "Leq",
+}
+
+// N.B. "-" is also a binary operator, cf. OpToRule
+var UnaryOpToRule = map[Operator]string{
+	NOT: "Not",
+	SUB: "Neg",
 }
 
 /* "Exported" constructors */
 
 func NewBinaryOp(left, right FGExpr, op Operator) FGExpr {
-	baseBop := BaseBinaryOperation{left, right, op}
+	baseBop := BaseBinaryOperation{left, right, op, base.Span{}}
 	switch op {
-	case GT, LT:
+	case EQL, NEQ, GT, LT, GEQ, LEQ:
 		return Comparison{baseBop}
 	default:
 		return BinaryOperation{baseBop}
 	}
 }
 
+func NewUnaryOp(e FGExpr, op Operator) FGExpr {
+	return UnaryOperation{e, op, base.Span{}}
+}
+
 // "Base class" for binary operations. Provides the methods common to
 // arithmetic, logical and relational operations.
 type BaseBinaryOperation struct {
 	left, right FGExpr
 	op          Operator
+	span        base.Span // Source position, not part of node identity
 }
 
+func (b BaseBinaryOperation) GetSpan() base.Span { return b.span }
+
 func (b BaseBinaryOperation) IsValue() bool { return false }
 
+func (b BaseBinaryOperation) IsPanic() bool { return false }
+
 func (b BaseBinaryOperation) CanEval(ds []base.Decl) bool {
 	leftOk := b.left.IsValue() || b.left.CanEval(ds)
 	rightOk := b.right.IsValue() || b.right.CanEval(ds)
@@ -104,15 +145,18 @@ func (b BinaryOperation) Eval(ds []Decl) (FGExpr, string) {
 
 	left := b.left.(PrimtValue)
 	right := b.right.(PrimtValue)
+	if msg := runtimeError(right.Val(), b.op); msg != "" { // Cf. Go run-time panics
+		return runtimePanic(ds, b, msg), OpToRule[b.op] + "Panic"
+	}
 	rawRes := rawBinop(left.Val(), right.Val(), b.op)
 
 	switch left := left.(type) {
 	case PrimitiveLiteral:
-		return PrimitiveLiteral{rawRes, left.tag}, OpToRule[b.op]
+		return PrimitiveLiteral{rawRes, left.tag, b.span}, OpToRule[b.op]
 
 	case TypedPrimitiveValue:
-		primLit := PrimitiveLiteral{rawRes, left.lit.tag}
-		return TypedPrimitiveValue{primLit, left.typ}, OpToRule[b.op]
+		primLit := PrimitiveLiteral{rawRes, left.lit.tag, b.span}
+		return TypedPrimitiveValue{primLit, left.typ, b.span}, OpToRule[b.op]
 	}
 	panic("Unsupported binary operation: " +
 		b.left.String() + " " + string(b.op) + " " + b.right.String())
@@ -122,21 +166,24 @@ func (b BinaryOperation) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type,
 	ltype, ltree := b.left.Typing(ds, gamma, allowStupid)
 	rtype, rtree := b.right.Typing(ds, gamma, allowStupid)
 
-	var pred PrimtPredicate
-	switch b.op {
-	case ADD:
-		pred = Or(isNumeric, isString)
-	case SUB:
-		pred = isNumeric
-	case LAND, LOR:
-		pred = isBool
-	}
+	pred := operandPredicate(b.op)
 	if ok := evalPrimtPredicate(ds, pred, ltype); !ok {
-		panic("operator " + string(b.op) + " not defined for type: " + ltype.String())
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, b,
+			"operator "+string(b.op)+" not defined for type: "+ltype.String()))
 	}
 	// also check if op defined for rtype?
 	if ok := evalPrimtPredicate(ds, pred, rtype); !ok {
-		panic("operator " + string(b.op) + " not defined for type: " + rtype.String())
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, b,
+			"operator "+string(b.op)+" not defined for type: "+rtype.String()))
+	}
+	// As in Go, a constant divisor must not be zero, cf. runtimeError
+	if lit, ok := b.right.(PrimitiveLiteral); ok && (b.op == QUO || b.op == REM) &&
+		isIntPayload(lit.payload) && toInt64(lit.payload) == 0 {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, b,
+			"invalid operation: division by zero"))
+	}
+	if isShift(b.op) { // The result has the type of the left operand, the right need only be an integer
+		return ltype, NewBinaryOp(ltree, rtree, b.op)
 	}
 
 	// verify that ltype and rtype are compatible;
@@ -147,7 +194,8 @@ func (b BinaryOperation) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type,
 	if ok, coercion := rtype.AssignableTo(ds, ltype); ok {
 		return ltype, NewBinaryOp(ltree, coercion(rtree), b.op)
 	}
-	panic("mismatched types " + ltype.String() + " and " + rtype.String())
+	panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, b,
+		"mismatched types "+ltype.String()+" and "+rtype.String()))
 }
 
 // Different from "pure" BinaryOperation -- output is always boolean.
@@ -169,21 +217,37 @@ func (c Comparison) Eval(ds []Decl) (FGExpr, string) {
 		return NewBinaryOp(c.left, e, c.op), rule
 	}
 
-	left := c.left.(PrimtValue)
-	right := c.right.(PrimtValue)
-	res := rawBinop(left.Val(), right.Val(), c.op).(bool)
-	return PrimitiveLiteral{res, BOOL}, OpToRule[c.op] // according to the spec, the result of a comparison is an "untyped" boolean
+	var res bool
+	switch c.op {
+	case EQL, NEQ:
+		eq, msg := valueEquals(c.left, c.right)
+		if msg != "" { // Cf. Go run-time panics
+			return runtimePanic(ds, c, msg), OpToRule[c.op] + "Panic"
+		}
+		res = eq == (c.op == EQL)
+	default:
+		left := c.left.(PrimtValue)
+		right := c.right.(PrimtValue)
+		res = rawBinop(left.Val(), right.Val(), c.op).(bool)
+	}
+	return PrimitiveLiteral{res, BOOL, c.span}, OpToRule[c.op] // according to the spec, the result of a comparison is an "untyped" boolean
 }
 
 func (c Comparison) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
 	ltype, ltree := c.left.Typing(ds, gamma, allowStupid)
 	rtype, rtree := c.right.Typing(ds, gamma, allowStupid)
 
-	if ok := evalPrimtPredicate(ds, isComparable, ltype); !ok {
-		panic("operator " + string(c.op) + " not defined for type: " + ltype.String())
+	// During evaluation, operands of interface type are replaced by values of
+	// their dynamic types, which may be uncomparable or different -- cf.
+	// valueEquals, which gives false or the Go run-time panic
+	stupid := allowStupid && (c.op == EQL || c.op == NEQ)
+	if ok := comparisonDefined(ds, c.op, ltype); !ok && !stupid {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, c,
+			"operator "+string(c.op)+" not defined for type: "+ltype.String()))
 	}
-	if ok := evalPrimtPredicate(ds, isComparable, rtype); !ok {
-		panic("operator " + string(c.op) + " not defined for type: " + ltype.String())
+	if ok := comparisonDefined(ds, c.op, rtype); !ok && !stupid {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, c,
+			"operator "+string(c.op)+" not defined for type: "+rtype.String()))
 	}
 
 	var newTree FGExpr
@@ -192,16 +256,196 @@ func (c Comparison) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGEx
 		newTree = NewBinaryOp(coercion(ltree), rtree, c.op)
 	} else if ok, coercion := rtype.AssignableTo(ds, ltype); ok {
 		newTree = NewBinaryOp(ltree, coercion(rtree), c.op)
+	} else if stupid {
+		newTree = NewBinaryOp(ltree, rtree, c.op)
 	} else {
-		panic("mismatched types " + ltype.String() + " and " + rtype.String())
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, c,
+			"mismatched types "+ltype.String()+" and "+rtype.String()))
 	}
 
 	return NewUndefTPrimitive(BOOL), newTree // according to the spec, the result of a comparison is an "untyped" boolean
 }
 
+/**********************************************************************************/
+
+// Logical not and numeric negation -- the output has the type of the input
+type UnaryOperation struct {
+	e    FGExpr
+	op   Operator
+	span base.Span // Source position, not part of node identity
+}
+
+var _ FGExpr = UnaryOperation{}
+
+func (u UnaryOperation) GetSpan() base.Span { return u.span }
+
+func (u UnaryOperation) Subs(subs map[Variable]FGExpr) FGExpr {
+	return NewUnaryOp(u.e.Subs(subs), u.op)
+}
+
+func (u UnaryOperation) Eval(ds []Decl) (FGExpr, string) {
+	if !u.e.IsValue() {
+		e, rule := u.e.Eval(ds)
+		return NewUnaryOp(e, u.op), rule
+	}
+
+	rawRes := rawUnop(u.e.(PrimtValue).Val(), u.op)
+
+	switch e := u.e.(type) {
+	case PrimitiveLiteral:
+		return PrimitiveLiteral{rawRes, e.tag, u.span}, UnaryOpToRule[u.op]
+
+	case TypedPrimitiveValue:
+		primLit := PrimitiveLiteral{rawRes, e.lit.tag, u.span}
+		return TypedPrimitiveValue{primLit, e.typ, u.span}, UnaryOpToRule[u.op]
+	}
+	panic("Unsupported unary operation: " + u.String())
+}
+
+func (u UnaryOperation) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	t, tree := u.e.Typing(ds, gamma, allowStupid)
+	if ok := evalPrimtPredicate(ds, unaryOperandPredicate(u.op), t); !ok {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, u,
+			"operator "+string(u.op)+" not defined for type: "+t.String()))
+	}
+	return t, NewUnaryOp(tree, u.op)
+}
+
+func (u UnaryOperation) IsValue() bool { return false }
+
+func (u UnaryOperation) IsPanic() bool { return false }
+
+func (u UnaryOperation) CanEval(ds []base.Decl) bool {
+	return u.e.IsValue() || u.e.CanEval(ds)
+}
+
+func (u UnaryOperation) String() string {
+	return string(u.op) + u.e.String()
+}
+
+func (u UnaryOperation) ToGoString(ds []base.Decl) string {
+	return string(u.op) + u.e.ToGoString(ds)
+}
+
 /* Helpers */
 
+// The operand types for which op is defined -- cf. the Go spec
+func operandPredicate(op Operator) PrimtPredicate {
+	switch op {
+	case ADD:
+		return Or(isNumeric, isString)
+	case SUB, MUL, QUO:
+		return isNumeric
+	case REM, AND, OR, XOR, SHL, SHR:
+		return isInt
+	default: // LAND, LOR
+		return isBool
+	}
+}
+
+// The operand types for which the unary op is defined
+func unaryOperandPredicate(op Operator) PrimtPredicate {
+	if op == SUB {
+		return isNumeric
+	}
+	return isBool // NOT
+}
+
+func isShift(op Operator) bool { return op == SHL || op == SHR }
+
+// == and != are defined for comparable types, the other comparisons for ordered types
+func comparisonDefined(ds []Decl, op Operator, t Type) bool {
+	if op == EQL || op == NEQ {
+		return isComparableType(ds, t)
+	}
+	return evalPrimtPredicate(ds, isOrdered, t)
+}
+
+// Go == on values: the same (dynamic) type, and equal payloads or fields.
+// Also returns the message of the Go run-time panic, if any, o/w "" -- i.e.,
+// values of the same uncomparable type, e.g., held by interface values.
+// Pre: isComparableType, for the types of v1 and v2
+func valueEquals(v1, v2 FGExpr) (bool, string) {
+	switch v1 := v1.(type) {
+	case StructLit:
+		v2, ok := v2.(StructLit)
+		if !ok || !v1.t_S.Equals(v2.t_S) {
+			return false, ""
+		}
+		for i := 0; i < len(v1.elems); i++ { // Cf. Go, fields in order until unequal
+			if eq, msg := valueEquals(v1.elems[i], v2.elems[i]); !eq {
+				return false, msg
+			}
+		}
+		return true, ""
+	case TypedPrimitiveValue:
+		v2, ok := v2.(TypedPrimitiveValue)
+		return ok && v1.typ.Equals(v2.typ) && v1.lit.payload == v2.lit.payload, ""
+	case PrimitiveLiteral: // Untyped constants, cf. Comparison.Typing
+		v2, ok := v2.(PrimitiveLiteral)
+		return ok && v1.payload == v2.payload, ""
+	case FuncLit, SliceLit, MapLit:
+		t := concreteType(v1)
+		if !t.Equals(concreteType(v2)) {
+			return false, ""
+		}
+		return false, "comparing uncomparable type " + t.String()
+	}
+	panic("Not a value: " + v1.String())
+}
+
+// Returns the message of the Go run-time panic raised by using v as a map key,
+// if any, o/w "" -- i.e., v is, or has a field, of an uncomparable type, e.g.,
+// held by an interface value.
+func unhashable(v FGExpr) string {
+	switch v1 := v.(type) {
+	case StructLit:
+		for _, e := range v1.elems {
+			if msg := unhashable(e); msg != "" {
+				return msg
+			}
+		}
+	case FuncLit, SliceLit, MapLit:
+		return "hash of unhashable type " + concreteType(v).String()
+	}
+	return ""
+}
+
+// Returns the message of the Go run-time panic raised by op with the given
+// right operand, if any, o/w ""
+func runtimeError(right interface{}, op Operator) string {
+	switch {
+	case (op == QUO || op == REM) && isIntPayload(right) && toInt64(right) == 0:
+		return "integer divide by zero"
+	case isShift(op) && toInt64(right) < 0:
+		return "negative shift amount"
+	}
+	return ""
+}
+
+func isIntPayload(x interface{}) bool {
+	switch x.(type) {
+	case int32, int64:
+		return true
+	}
+	return false
+}
+
+// Pre: isIntPayload(x)
+func toInt64(x interface{}) int64 {
+	switch x := x.(type) {
+	case int32:
+		return int64(x)
+	case int64:
+		return x
+	}
+	panic("Not an integer payload: " + reflect.TypeOf(x).String())
+}
+
 func rawBinop(left, right interface{}, op Operator) interface{} {
+	if isShift(op) { // The right operand may be any integer type
+		return rawShift(left, uint64(toInt64(right)), op)
+	}
 	switch lval := left.(type) {
 	case bool:
 		rval := right.(bool)
@@ -218,10 +462,26 @@ func rawBinop(left, right interface{}, op Operator) interface{} {
 			return lval + rval
 		case SUB:
 			return lval - rval
+		case MUL:
+			return lval * rval
+		case QUO:
+			return lval / rval
+		case REM:
+			return lval % rval
+		case AND:
+			return lval & rval
+		case OR:
+			return lval | rval
+		case XOR:
+			return lval ^ rval
 		case GT:
 			return lval > rval
 		case LT:
 			return lval < rval
+		case GEQ:
+			return lval >= rval
+		case LEQ:
+			return lval <= rval
 		}
 	case int64:
 		rval := right.(int64)
@@ -230,10 +490,26 @@ func rawBinop(left, right interface{}, op Operator) interface{} {
 			return lval + rval
 		case SUB:
 			return lval - rval
+		case MUL:
+			return lval * rval
+		case QUO:
+			return lval / rval
+		case REM:
+			return lval % rval
+		case AND:
+			return lval & rval
+		case OR:
+			return lval | rval
+		case XOR:
+			return lval ^ rval
 		case GT:
 			return lval > rval
 		case LT:
 			return lval < rval
+		case GEQ:
+			return lval >= rval
+		case LEQ:
+			return lval <= rval
 		}
 	case float32:
 		rval := right.(float32)
@@ -242,10 +518,18 @@ func rawBinop(left, right interface{}, op Operator) interface{} {
 			return lval + rval
 		case SUB:
 			return lval - rval
+		case MUL:
+			return lval * rval
+		case QUO:
+			return lval / rval
 		case GT:
 			return lval > rval
 		case LT:
 			return lval < rval
+		case GEQ:
+			return lval >= rval
+		case LEQ:
+			return lval <= rval
 		}
 	case float64:
 		rval := right.(float64)
@@ -254,10 +538,18 @@ func rawBinop(left, right interface{}, op Operator) interface{} {
 			return lval + rval
 		case SUB:
 			return lval - rval
+		case MUL:
+			return lval * rval
+		case QUO:
+			return lval / rval
 		case GT:
 			return lval > rval
 		case LT:
 			return lval < rval
+		case GEQ:
+			return lval >= rval
+		case LEQ:
+			return lval <= rval
 		}
 	case string:
 		rval := right.(string)
@@ -268,8 +560,57 @@ func rawBinop(left, right interface{}, op Operator) interface{} {
 			return lval > rval
 		case LT:
 			return lval < rval
+		case GEQ:
+			return lval >= rval
+		case LEQ:
+			return lval <= rval
 		}
 	}
 	panic("Unsupported raw binOp: " + string(op) +
 		" for type: " + reflect.TypeOf(left).String())
 }
+
+func rawUnop(x interface{}, op Operator) interface{} {
+	switch val := x.(type) {
+	case bool:
+		if op == NOT {
+			return !val
+		}
+	case int32:
+		if op == SUB {
+			return -val
+		}
+	case int64:
+		if op == SUB {
+			return -val
+		}
+	case float32:
+		if op == SUB {
+			return -val
+		}
+	case float64:
+		if op == SUB {
+			return -val
+		}
+	}
+	panic("Unsupported raw unOp: " + string(op) +
+		" for type: " + reflect.TypeOf(x).String())
+}
+
+// Pre: count >= 0, cf. runtimeError
+func rawShift(left interface{}, count uint64, op Operator) interface{} {
+	switch lval := left.(type) {
+	case int32:
+		if op == SHL {
+			return lval << count
+		}
+		return lval >> count
+	case int64:
+		if op == SHL {
+			return lval << count
+		}
+		return lval >> count
+	}
+	panic("Unsupported raw shift: " + string(op) +
+		" for type: " + reflect.TypeOf(left).String())
+}
@@ -1,6 +1,7 @@
 package fg
 
 import (
+	"fmt"
 	"github.com/rhu1/fgg/internal/base"
 	"regexp"
 	"strconv"
@@ -53,18 +54,18 @@ func NameFromTag(tag Tag) string {
 /* "Exported" constructors for fgg (monomorph) */
 
 func NewPrimitiveLiteral(pload interface{}, tag Tag) PrimitiveLiteral {
-	return PrimitiveLiteral{pload, tag}
+	return PrimitiveLiteral{pload, tag, base.Span{}}
 }
 
 func NewTypedPrimitiveValue(lit PrimitiveLiteral, typ Type) TypedPrimitiveValue {
-	return TypedPrimitiveValue{lit, typ}
+	return TypedPrimitiveValue{lit, typ, base.Span{}}
 }
 
 /* Remaining "exported" constructors */
 
 func NewBoolLit(lit string) PrimitiveLiteral {
 	b, _ := strconv.ParseBool(lit)
-	return PrimitiveLiteral{b, BOOL}
+	return PrimitiveLiteral{b, BOOL, base.Span{}}
 }
 
 func NewIntLit(lit string) PrimitiveLiteral {
@@ -93,7 +94,7 @@ func NewFloatLit(lit string) PrimitiveLiteral {
 
 func NewStringLit(lit string) PrimitiveLiteral {
 	trim := strings.ReplaceAll(lit, "\"", "")
-	return PrimitiveLiteral{trim, STRING}
+	return PrimitiveLiteral{trim, STRING, base.Span{}}
 }
 
 /******************************************************************************/
@@ -111,14 +112,19 @@ func (x TypedPrimitiveValue) Val() interface{} { return x.lit.payload }
 /* PrimitiveLiteral */
 
 // Represents a literal whose type is still undefined
-//  (e.g. 123 is 'assignable' to either int32, int64 or MyInt, but the type is
-//        only determined upon 'assignment')
+//
+//	(e.g. 123 is 'assignable' to either int32, int64 or MyInt, but the type is
+//	      only determined upon 'assignment')
+//
 // An int/float payload is saved as int64/float64.
 type PrimitiveLiteral struct {
 	payload interface{}
 	tag     Tag
+	span    base.Span // Source position, not part of node identity
 }
 
+func (x PrimitiveLiteral) GetSpan() base.Span { return x.span }
+
 var _ PrimtValue = PrimitiveLiteral{}
 
 func (x PrimitiveLiteral) Payload() interface{} { return x.payload }
@@ -140,34 +146,37 @@ func (x PrimitiveLiteral) IsValue() bool {
 	return true
 }
 
+func (x PrimitiveLiteral) IsPanic() bool {
+	return false
+}
+
 func (x PrimitiveLiteral) CanEval([]base.Decl) bool {
 	return false
 }
 
+// Go literal syntax -- reparses to the same payload and tag (cf. NewIntLit, NewFloatLit)
 func (x PrimitiveLiteral) String() string {
-	var payload string
 	switch p := x.payload.(type) {
 	case bool:
-		payload = strconv.FormatBool(p)
+		return strconv.FormatBool(p)
 	case string:
-		payload = "\"" + p + "\""
+		return "\"" + p + "\""
 	case int32:
-		payload = strconv.FormatInt(int64(p), 10)
+		return strconv.FormatInt(int64(p), 10)
 	case int64:
-		payload = strconv.FormatInt(p, 10)
+		return strconv.FormatInt(p, 10)
 	case float32:
-		payload = strconv.FormatFloat(float64(p), 'E', -1, 32)
+		return formatFloatLit(float64(p), 32)
 	case float64:
-		payload = strconv.FormatFloat(p, 'E', -1, 64)
+		return formatFloatLit(p, 64)
 	default:
 		panic("PrimitiveLiteral.String() for unsupported type")
 	}
-	tag := NameFromTag(x.tag)
-	return "PrimitiveLiteral{payload=" + payload + ", tag=" + tag + "}"
 }
 
+// Cf. fmt.Printf("%#v", ...) of the payload
 func (x PrimitiveLiteral) ToGoString([]base.Decl) string {
-	return x.String()
+	return fmt.Sprintf("%#v", x.payload)
 }
 
 /******************************************************************************/
@@ -176,10 +185,13 @@ func (x PrimitiveLiteral) ToGoString([]base.Decl) string {
 // Essentially a PrimitiveLiteral whose type was already determined.
 // Need this in order not to break type-safety at each (small) step of evaluation.
 type TypedPrimitiveValue struct {
-	lit PrimitiveLiteral
-	typ Type
+	lit  PrimitiveLiteral
+	typ  Type
+	span base.Span // Source position, not part of node identity
 }
 
+func (x TypedPrimitiveValue) GetSpan() base.Span { return x.span }
+
 var _ FGExpr = TypedPrimitiveValue{}
 
 func (t TypedPrimitiveValue) Subs(subs map[Variable]FGExpr) FGExpr {
@@ -198,42 +210,57 @@ func (t TypedPrimitiveValue) IsValue() bool {
 	return true
 }
 
+func (t TypedPrimitiveValue) IsPanic() bool {
+	return false
+}
+
 func (t TypedPrimitiveValue) CanEval(ds []base.Decl) bool {
 	return false
 }
 
+// Cf. Convert, e.g., int32(1)
 func (t TypedPrimitiveValue) String() string {
 	var b strings.Builder
 	b.WriteString(t.typ.String())
-	b.WriteString("Val(")
+	b.WriteString("(")
 	b.WriteString(t.lit.String())
 	b.WriteString(")")
 	return b.String()
 }
 
 func (t TypedPrimitiveValue) ToGoString(ds []base.Decl) string {
-	return t.String()
+	return t.lit.ToGoString(ds)
 }
 
 /******************************************************************************/
 /* Helpers */
 
+// The shortest representation that reparses as the same float -- N.B. not, e.g.,
+// "3" or "3.0" (parsed as int literals), so integral values use an exponent
+func formatFloatLit(f float64, bitSize int) string {
+	res := strconv.FormatFloat(f, 'g', -1, bitSize)
+	if !strings.ContainsAny(res, ".eIN") { // Cf. Inf, NaN
+		res = strconv.FormatFloat(f, 'e', -1, bitSize)
+	}
+	return res
+}
+
 func newIntLit(lit string) (PrimitiveLiteral, bool) {
 	if i, err := strconv.ParseInt(lit, 10, 32); err == nil {
-		return PrimitiveLiteral{int32(i), INT32}, true
+		return PrimitiveLiteral{int32(i), INT32, base.Span{}}, true
 	}
 	if i, err := strconv.ParseInt(lit, 10, 64); err == nil {
-		return PrimitiveLiteral{i, INT64}, true
+		return PrimitiveLiteral{i, INT64, base.Span{}}, true
 	}
 	return PrimitiveLiteral{}, false
 }
 
 func newFloatLit(lit string) (PrimitiveLiteral, bool) {
 	if f, err := strconv.ParseFloat(lit, 32); err == nil {
-		return PrimitiveLiteral{float32(f), FLOAT32}, true
+		return PrimitiveLiteral{float32(f), FLOAT32, base.Span{}}, true
 	}
 	if f, err := strconv.ParseFloat(lit, 64); err == nil {
-		return PrimitiveLiteral{f, FLOAT64}, true
+		return PrimitiveLiteral{f, FLOAT64, base.Span{}}, true
 	}
 	return PrimitiveLiteral{}, false
 }
@@ -257,12 +284,12 @@ func truncateFractionalPart(x string) string {
 type PrimtPredicate func(PrimType) bool
 
 var (
-	isBool       = func(t_P PrimType) bool { return t_P.Tag() == BOOL }
-	isString     = func(t_P PrimType) bool { return t_P.Tag() == STRING }
-	isInt        = func(t_P PrimType) bool { return t_P.Tag() == INT32 || t_P.Tag() == INT64 }
-	isFloat      = func(t_P PrimType) bool { return t_P.Tag() == FLOAT32 || t_P.Tag() == FLOAT64 }
-	isNumeric    = Or(isInt, isFloat)
-	isComparable = func(t_P PrimType) bool { return t_P.Tag() != BOOL }
+	isBool    = func(t_P PrimType) bool { return t_P.Tag() == BOOL }
+	isString  = func(t_P PrimType) bool { return t_P.Tag() == STRING }
+	isInt     = func(t_P PrimType) bool { return t_P.Tag() == INT32 || t_P.Tag() == INT64 }
+	isFloat   = func(t_P PrimType) bool { return t_P.Tag() == FLOAT32 || t_P.Tag() == FLOAT64 }
+	isNumeric = Or(isInt, isFloat)
+	isOrdered = Or(isNumeric, isString)
 )
 
 func Or(pred1, pred2 PrimtPredicate) PrimtPredicate {
@@ -276,3 +303,20 @@ func evalPrimtPredicate(ds []Decl, predicate PrimtPredicate, t Type) bool {
 	}
 	return false
 }
+
+// Go "comparable" types (cf. ==): primitives, interfaces, and structs whose
+// fields are all comparable
+func isComparableType(ds []Decl, t Type) bool {
+	switch under := t.Underlying(ds).(type) {
+	case PrimType, ITypeLit:
+		return true
+	case STypeLit:
+		for _, v := range under.fDecls {
+			if !isComparableType(ds, v.t) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -1,7 +1,9 @@
 package fg_test
 
 import (
+	"github.com/rhu1/fgg/internal/base"
 	"github.com/rhu1/fgg/internal/base/testutils"
+	"strings"
 	"testing"
 )
 
@@ -150,4 +152,229 @@ func TestConvs(t *testing.T) {
 	e := "S{}.foo(100 + 25.52)"
 	prog := fgParseAndOkGood(t, S, Sm, e)
 	testutils.EvalAndOkGood(t, prog, 4)
-}
\ No newline at end of file
+}
+
+/******************************************************************************/
+/* Multiplicative and bitwise ops */
+
+func TestArith001(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int32, y int32) int32 { return x * y / 3 % 4 - (x & y | x ^ y) + (x << 2 >> 1) }"
+	e := "A{}.f(17, 5)"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 30)
+	if res.GetMain().String() != "int32(14)" {
+		t.Errorf("Expected int32(14), got: " + res.GetMain().String())
+	}
+}
+
+// The right operand of a shift may be any integer type
+func TestArith002(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int64, y int32) int64 { return x << y >> 1 }"
+	e := "A{}.f(3, 4)"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int64(24)" {
+		t.Errorf("Expected int64(24), got: " + res.GetMain().String())
+	}
+}
+
+func TestArith003(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x float64, y float64) float64 { return x % y }"
+	e := "A{}"
+	fgParseAndOkBad(t, "operator % not defined for type: float64", A, Am, e)
+}
+
+func TestArith003b(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int32) int32 { return x & 1.5 }"
+	e := "A{}"
+	fgParseAndOkBad(t, "operator & not defined for type", A, Am, e)
+}
+
+func TestArith004(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int32, y int32) int32 { return x / y }"
+	e := "A{}.f(1, 0)"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	testutils.EvalToValueBad(t, prog, "integer divide by zero", 10)
+}
+
+func TestArith004b(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int64, y int32) int64 { return x << y }"
+	e := "A{}.f(1, 0 - 1)"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	testutils.EvalToValueBad(t, prog, "negative shift amount", 10)
+}
+
+// A constant divisor must not be zero
+func TestArith004c(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int32) int32 { return x % 0 }"
+	e := "A{}"
+	fgParseAndOkBad(t, "invalid operation: division by zero", A, Am, e)
+}
+
+func TestArith004d(t *testing.T) {
+	A := "type A struct {}"
+	e := "1 / 0"
+	fgParseAndOkBad(t, "invalid operation: division by zero", A, e)
+}
+
+/******************************************************************************/
+/* Equality and ordering */
+
+func TestEq001(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int32, y int32) bool { return x == y && x != y + 1 && x <= y && x >= y }"
+	e := "A{}.f(3, 3)"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 30)
+	if !strings.Contains(res.GetMain().String(), "true") {
+		t.Errorf("Expected true, got: " + res.GetMain().String())
+	}
+}
+
+// bool and string equality
+func TestEq002(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x bool, y string) bool { return x == (1 < 2) && y != \"b\" && y < \"b\" }"
+	e := "A{}.f(true, \"a\")"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 30)
+	if !strings.Contains(res.GetMain().String(), "true") {
+		t.Errorf("Expected true, got: " + res.GetMain().String())
+	}
+}
+
+// Struct equality, including interface fields
+func TestEq003(t *testing.T) {
+	Any := "type Any interface {}"
+	A := "type A struct {}"
+	B := "type B struct {}"
+	P := "type P struct { x int32; y Any }"
+	e := "P{1, A{}} == P{1, A{}} && P{1, A{}} != P{1, B{}} && P{1, A{}} != P{2, A{}}"
+	prog := fgParseAndOkGood(t, Any, A, B, P, e)
+	res := testutils.EvalToValueGood(t, prog, 30)
+	if res.GetMain().String() != "true" {
+		t.Errorf("Expected true, got: " + res.GetMain().String())
+	}
+}
+
+// bool is not ordered
+func TestEq004(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x bool) bool { return x < true }"
+	e := "A{}"
+	fgParseAndCheckBad(t, []base.DiagnosticKind{base.DIAG_BAD_OPERATION}, A, Am, e)
+}
+
+func TestEq004b(t *testing.T) {
+	A := "type A struct {}"
+	B := "type B struct {}"
+	e := "A{} == B{}"
+	fgParseAndCheckBad(t, []base.DiagnosticKind{base.DIAG_BAD_OPERATION}, A, B, e)
+}
+
+/******************************************************************************/
+/* Unary operators */
+
+func TestUnary001(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int32, y int32) int32 { return -x * 3 - -y }"
+	e := "A{}.f(2, 5)"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "int32(-1)" {
+		t.Errorf("Expected int32(-1), got: " + res.GetMain().String())
+	}
+}
+
+func TestUnary002(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x float64, b bool) bool { return !b && -x < 0 }"
+	e := "A{}.f(1.5, false)"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if !strings.Contains(res.GetMain().String(), "true") {
+		t.Errorf("Expected true, got: " + res.GetMain().String())
+	}
+}
+
+// An untyped constant stays untyped, so it can be assigned to any numeric type
+func TestUnary003(t *testing.T) {
+	A := "type A struct { f float32 }"
+	e := "A{-2}.f"
+	prog := fgParseAndOkGood(t, A, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "float32(-2e+00)" {
+		t.Errorf("Expected float32(-2e+00), got: " + res.GetMain().String())
+	}
+}
+
+func TestUnary004(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x string) string { return -x }"
+	e := "A{}"
+	fgParseAndOkBad(t, "operator - not defined for type: string", A, Am, e)
+}
+
+func TestUnary004b(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int32) int32 { return !x }"
+	e := "A{}"
+	fgParseAndOkBad(t, "operator ! not defined for type: int32", A, Am, e)
+}
+
+/******************************************************************************/
+/* Conversions */
+
+func TestConv001(t *testing.T) {
+	A := "type MyInt int32"
+	Am := "func (x MyInt) inc() MyInt { return x + 1 }"
+	e := "MyInt(3).inc()"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "MyInt(4)" {
+		t.Errorf("Expected MyInt(4), got: " + res.GetMain().String())
+	}
+}
+
+func TestConv002(t *testing.T) {
+	S := "type S struct {}"
+	e := "S(struct{}{})"
+	prog := fgParseAndOkGood(t, S, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "S{}" {
+		t.Errorf("Expected S{}, got: " + res.GetMain().String())
+	}
+}
+
+// Conversions between numeric types -- float to int truncates
+func TestConv003(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int32, y float64) float32 { return float32(x) + float32(int64(y)) }"
+	e := "A{}.f(1, -2.7)"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "float32(-1e+00)" {
+		t.Errorf("Expected float32(-1e+00), got: " + res.GetMain().String())
+	}
+}
+
+// But a constant must be representable by the target type
+func TestConv004(t *testing.T) {
+	A := "type A struct {}"
+	e := "int32(1.5)"
+	fgParseAndOkBad(t, "Invalid type conversion from float32(undefined) to int32", A, e)
+}
+
+func TestConv004b(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int32) string { return string(x) }"
+	e := "A{}"
+	fgParseAndOkBad(t, "Invalid type conversion from int32 to string", A, Am, e)
+}
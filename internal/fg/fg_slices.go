@@ -0,0 +1,457 @@
+/*
+ * This file contains defs for slice values and the builtin slice operations:
+ * literals, indexing, len and append.  Cf. TSlice, in fg_types.go.
+ */
+
+package fg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rhu1/fgg/internal/base"
+)
+
+/* "Exported" constructors for fgg (monomorph) */
+
+func NewSliceLit(t Type, es []FGExpr) SliceLit { return SliceLit{t, es, base.Span{}} }
+func NewIndex(e FGExpr, i FGExpr) Index        { return Index{e, i, base.Span{}} }
+func NewLen(e FGExpr) Len                      { return Len{e, base.Span{}} }
+func NewAppend(e FGExpr, es []FGExpr) Append   { return Append{e, es, base.Span{}} }
+
+// The type of len(e)
+var lenType = NewTPrimitive(INT32)
+
+/* SliceLit */
+
+// t{e1, ..., en}, where t is a slice type or a defined type whose underlying
+// type is a slice type -- the latter is parsed as a StructLit, cf. StructLit.Typing
+type SliceLit struct {
+	typ   Type
+	elems []FGExpr
+	span  base.Span // Source position, not part of node identity
+}
+
+func (s SliceLit) GetSpan() base.Span { return s.span }
+
+var _ FGExpr = SliceLit{}
+
+func (s SliceLit) GetType() Type      { return s.typ }
+func (s SliceLit) GetElems() []FGExpr { return s.elems }
+
+func (s SliceLit) Subs(subs map[Variable]FGExpr) FGExpr {
+	es := make([]FGExpr, len(s.elems))
+	for i := 0; i < len(s.elems); i++ {
+		es[i] = s.elems[i].Subs(subs)
+	}
+	return SliceLit{s.typ, es, s.span}
+}
+
+// Cf. StructLit.Eval
+func (s SliceLit) Eval(ds []Decl) (FGExpr, string) {
+	es := make([]FGExpr, len(s.elems))
+	done := false
+	var rule string
+	for i := 0; i < len(s.elems); i++ {
+		v := s.elems[i]
+		if !done && !v.IsValue() {
+			v, rule = v.Eval(ds)
+			done = true
+		}
+		es[i] = v
+	}
+	if !done {
+		panic("Cannot reduce: " + s.String())
+	}
+	return SliceLit{s.typ, es, s.span}, rule
+}
+
+func (s SliceLit) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	s.typ.Ok(ds)
+	t_S, ok := s.typ.Underlying(ds).(TSlice)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, s,
+			"Slice literal: "+s.typ.String()+" is not a slice type"))
+	}
+	elems := make([]FGExpr, len(s.elems))
+	for i, v := range s.elems {
+		t, newSubtree := v.Typing(ds, gamma, allowStupid)
+		ok, coercion := t.AssignableTo(ds, t_S.elem)
+		if !ok {
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, s,
+				"Slice element must be assignable to element type: elem="+t.String()+
+					", expected="+t_S.elem.String()))
+		}
+		elems[i] = coercion(newSubtree)
+	}
+	return s.typ, SliceLit{s.typ, elems, s.span}
+}
+
+// From base.Expr
+func (s SliceLit) IsValue() bool {
+	for _, v := range s.elems {
+		if !v.IsValue() {
+			return false
+		}
+	}
+	return true
+}
+
+func (s SliceLit) IsPanic() bool {
+	return false
+}
+
+func (s SliceLit) CanEval(ds []Decl) bool {
+	for _, v := range s.elems {
+		if v.CanEval(ds) {
+			return true
+		} else if !v.IsValue() {
+			return false
+		}
+	}
+	return false
+}
+
+func (s SliceLit) String() string {
+	var b strings.Builder
+	b.WriteString(s.typ.String())
+	b.WriteString("{")
+	writeExprs(&b, s.elems)
+	b.WriteString("}")
+	return b.String()
+}
+
+func (s SliceLit) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString(toGoTypeString(s.typ))
+	b.WriteString("{")
+	writeToGoExprs(ds, &b, s.elems)
+	b.WriteString("}")
+	return b.String()
+}
+
+/* Index */
+
+// e_S[e_idx], where e_S is a slice or a map -- cf. mapIndex for a missing key
+type Index struct {
+	e_S   FGExpr
+	e_idx FGExpr
+	span  base.Span // Source position, not part of node identity
+}
+
+func (x Index) GetSpan() base.Span { return x.span }
+
+var _ FGExpr = Index{}
+
+func (x Index) GetExpr() FGExpr  { return x.e_S }
+func (x Index) GetIndex() FGExpr { return x.e_idx }
+
+func (x Index) Subs(subs map[Variable]FGExpr) FGExpr {
+	return Index{x.e_S.Subs(subs), x.e_idx.Subs(subs), x.span}
+}
+
+func (x Index) Eval(ds []Decl) (FGExpr, string) {
+	if !x.e_S.IsValue() {
+		e, rule := x.e_S.Eval(ds)
+		return Index{e, x.e_idx, x.span}, rule
+	}
+	if !x.e_idx.IsValue() {
+		e, rule := x.e_idx.Eval(ds)
+		return Index{x.e_S, e, x.span}, rule
+	}
+	if m, ok := x.e_S.(MapLit); ok {
+		v, _ := mapIndex(ds, m, x.e_idx, x)
+		if v.IsPanic() {
+			return v, "IndexPanic"
+		}
+		return v, "Index"
+	}
+	s := x.e_S.(SliceLit)
+	i := toInt64(x.e_idx.(PrimtValue).Val())
+	if i < 0 || i >= int64(len(s.elems)) { // Cf. Go run-time panic
+		msg := fmt.Sprintf("index out of range [%d] with length %d", i, len(s.elems))
+		return runtimePanic(ds, x, msg), "IndexPanic"
+	}
+	return s.elems[i], "Index"
+}
+
+func (x Index) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	t, e_S := x.e_S.Typing(ds, gamma, allowStupid)
+	switch t_S := t.Underlying(ds).(type) {
+	case TSlice:
+		t_idx, e_idx := x.e_idx.Typing(ds, gamma, allowStupid)
+		checkIndex(ds, x, t_idx, e_idx)
+		return t_S.elem, Index{e_S, e_idx, x.span}
+	case TMap:
+		e_idx := typeMapOperand(ds, gamma, allowStupid, x, x.e_idx, t_S.key, "key", "key")
+		return t_S.elem, Index{e_S, e_idx, x.span}
+	}
+	panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, x,
+		"Cannot index non-slice/map: "+x.e_S.String()+" of type "+t.String()))
+}
+
+// An index must be of integer type, and not a negative constant
+func checkIndex(ds []Decl, x Index, t_idx Type, e_idx FGExpr) {
+	if !evalPrimtPredicate(ds, isInt, t_idx) {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, x,
+			"Index must be of integer type: "+x.e_idx.String()+" of type "+t_idx.String()))
+	}
+	if lit, ok := e_idx.(PrimitiveLiteral); ok && toInt64(lit.payload) < 0 {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, x,
+			"Index must not be negative: "+x.e_idx.String()))
+	}
+}
+
+// From base.Expr
+func (x Index) IsValue() bool {
+	return false
+}
+
+func (x Index) IsPanic() bool {
+	return false
+}
+
+// N.B. true for an out of range index, cf. the run-time panic in Eval
+func (x Index) CanEval(ds []Decl) bool {
+	if x.e_S.CanEval(ds) {
+		return true
+	} else if !x.e_S.IsValue() {
+		return false
+	}
+	return x.e_idx.IsValue() || x.e_idx.CanEval(ds)
+}
+
+func (x Index) String() string {
+	var b strings.Builder
+	writeIndexed(&b, x.e_S, x.e_S.String())
+	b.WriteString("[")
+	b.WriteString(x.e_idx.String())
+	b.WriteString("]")
+	return b.String()
+}
+
+func (x Index) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	writeIndexed(&b, x.e_S, x.e_S.ToGoString(ds))
+	b.WriteString("[")
+	b.WriteString(x.e_idx.ToGoString(ds))
+	b.WriteString("]")
+	return b.String()
+}
+
+// Parenthesises e_S if it is an operation or a function literal, cf. writeFunc
+func writeIndexed(b *strings.Builder, e_S FGExpr, s string) {
+	switch e_S.(type) {
+	case UnaryOperation, BinaryOperation, Comparison, FuncLit:
+		b.WriteString("(")
+		b.WriteString(s)
+		b.WriteString(")")
+	default:
+		b.WriteString(s)
+	}
+}
+
+/* Len */
+
+// len(e_S)
+type Len struct {
+	e_S  FGExpr
+	span base.Span // Source position, not part of node identity
+}
+
+func (l Len) GetSpan() base.Span { return l.span }
+
+var _ FGExpr = Len{}
+
+func (l Len) GetExpr() FGExpr { return l.e_S }
+
+func (l Len) Subs(subs map[Variable]FGExpr) FGExpr {
+	return Len{l.e_S.Subs(subs), l.span}
+}
+
+func (l Len) Eval(ds []Decl) (FGExpr, string) {
+	if !l.e_S.IsValue() {
+		e, rule := l.e_S.Eval(ds)
+		return Len{e, l.span}, rule
+	}
+	var n int
+	switch e_S := l.e_S.(type) {
+	case SliceLit:
+		n = len(e_S.elems)
+	case MapLit:
+		n = len(e_S.entries)
+	}
+	lit := PrimitiveLiteral{int32(n), lenType.tag, l.span}
+	return TypedPrimitiveValue{lit, lenType, l.span}, "Len"
+}
+
+func (l Len) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	t, e_S := l.e_S.Typing(ds, gamma, allowStupid)
+	switch t.Underlying(ds).(type) {
+	case TSlice, TMap:
+	default:
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, l,
+			"Invalid argument for len: "+l.e_S.String()+" of type "+t.String()))
+	}
+	return lenType, Len{e_S, l.span}
+}
+
+// From base.Expr
+func (l Len) IsValue() bool {
+	return false
+}
+
+func (l Len) IsPanic() bool {
+	return false
+}
+
+func (l Len) CanEval(ds []Decl) bool {
+	return l.e_S.IsValue() || l.e_S.CanEval(ds)
+}
+
+func (l Len) String() string {
+	return "len(" + l.e_S.String() + ")"
+}
+
+func (l Len) ToGoString(ds []Decl) string {
+	return "len(" + l.e_S.ToGoString(ds) + ")"
+}
+
+/* Append */
+
+// append(e_S, e1, ..., en) -- slices are immutable values, so the result
+// never aliases e_S
+type Append struct {
+	e_S   FGExpr
+	elems []FGExpr
+	span  base.Span // Source position, not part of node identity
+}
+
+func (a Append) GetSpan() base.Span { return a.span }
+
+var _ FGExpr = Append{}
+
+func (a Append) GetExpr() FGExpr    { return a.e_S }
+func (a Append) GetElems() []FGExpr { return a.elems }
+
+func (a Append) Subs(subs map[Variable]FGExpr) FGExpr {
+	es := make([]FGExpr, len(a.elems))
+	for i := 0; i < len(a.elems); i++ {
+		es[i] = a.elems[i].Subs(subs)
+	}
+	return Append{a.e_S.Subs(subs), es, a.span}
+}
+
+// Cf. Call.Eval
+func (a Append) Eval(ds []Decl) (FGExpr, string) {
+	if !a.e_S.IsValue() {
+		e, rule := a.e_S.Eval(ds)
+		return Append{e, a.elems, a.span}, rule
+	}
+	es := make([]FGExpr, len(a.elems))
+	done := false
+	var rule string
+	for i := 0; i < len(a.elems); i++ {
+		e := a.elems[i]
+		if !done && !e.IsValue() {
+			e, rule = e.Eval(ds)
+			done = true
+		}
+		es[i] = e
+	}
+	if done {
+		return Append{a.e_S, es, a.span}, rule
+	}
+	s := a.e_S.(SliceLit)
+	res := make([]FGExpr, 0, len(s.elems)+len(a.elems))
+	res = append(res, s.elems...)
+	res = append(res, a.elems...)
+	return SliceLit{s.typ, res, a.span}, "Append"
+}
+
+// The result has the (possibly defined) type of e_S, as in Go
+func (a Append) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	t, e_S := a.e_S.Typing(ds, gamma, allowStupid)
+	t_S, ok := t.Underlying(ds).(TSlice)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, a,
+			"Invalid argument for append: "+a.e_S.String()+" of type "+t.String()))
+	}
+	elems := make([]FGExpr, len(a.elems))
+	for i, v := range a.elems {
+		t_v, newSubtree := v.Typing(ds, gamma, allowStupid)
+		ok, coercion := t_v.AssignableTo(ds, t_S.elem)
+		if !ok {
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, a,
+				"Slice element must be assignable to element type: elem="+t_v.String()+
+					", expected="+t_S.elem.String()))
+		}
+		elems[i] = coercion(newSubtree)
+	}
+	return t, Append{e_S, elems, a.span}
+}
+
+// From base.Expr
+func (a Append) IsValue() bool {
+	return false
+}
+
+func (a Append) IsPanic() bool {
+	return false
+}
+
+func (a Append) CanEval(ds []Decl) bool {
+	if a.e_S.CanEval(ds) {
+		return true
+	} else if !a.e_S.IsValue() {
+		return false
+	}
+	for _, v := range a.elems {
+		if v.CanEval(ds) {
+			return true
+		} else if !v.IsValue() {
+			return false
+		}
+	}
+	return true
+}
+
+func (a Append) String() string {
+	var b strings.Builder
+	b.WriteString("append(")
+	b.WriteString(a.e_S.String())
+	for _, v := range a.elems {
+		b.WriteString(", ")
+		b.WriteString(v.String())
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (a Append) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString("append(")
+	b.WriteString(a.e_S.ToGoString(ds))
+	for _, v := range a.elems {
+		b.WriteString(", ")
+		b.WriteString(v.ToGoString(ds))
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+/* Helpers */
+
+// E.g., "[]main.A" -- cf. the "%#v" output of Go
+func toGoTypeString(t Type) string {
+	switch t := t.(type) {
+	case TNamed:
+		return "main." + t.String()
+	case TSlice:
+		return "[]" + toGoTypeString(t.elem)
+	case TMap:
+		return "map[" + toGoTypeString(t.key) + "]" + toGoTypeString(t.elem)
+	default:
+		return t.String()
+	}
+}
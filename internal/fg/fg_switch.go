@@ -0,0 +1,276 @@
+/*
+ * This file contains defs for type switches: a method body (or branch of one)
+ * that selects a case by the dynamic type of an interface value.  Cf. Assert,
+ * in fg_exprs.go.
+ */
+
+package fg
+
+import (
+	"strings"
+
+	"github.com/rhu1/fgg/internal/base"
+)
+
+/* "Exported" constructors for fgg (monomorph) */
+
+func NewTypeCase(t Type, e FGExpr) TypeCase { return TypeCase{t, e} }
+
+// x may be empty, i.e., no binding; e_def may be nil, i.e., no default
+// (rejected by typing); t may be nil, cf. Cond
+func NewTypeSwitch(x Name, e_I FGExpr, cases []TypeCase, e_def FGExpr, t Type) TypeSwitch {
+	return TypeSwitch{x, e_I, cases, e_def, t, base.Span{}}
+}
+
+/* TypeSwitch */
+
+// "case t: e_body"
+type TypeCase struct {
+	t      Type
+	e_body FGExpr
+}
+
+func (c TypeCase) GetType() Type   { return c.t }
+func (c TypeCase) GetBody() FGExpr { return c.e_body }
+
+func (c TypeCase) String() string {
+	var b strings.Builder
+	b.WriteString("case " + c.t.String() + ": ")
+	writeBody(&b, c.e_body)
+	return b.String()
+}
+
+// A method body (or branch of one):
+// switch x := e_I.(type) { case t1: e1; ...; case tn: en; default: e_def }.
+// The first case whose type the dynamic type of e_I is assignable to (cf.
+// Assert) is selected, o/w the default -- which is required (cf. Go's
+// "missing return"), so that a type switch never gets stuck, whether or not
+// the cases are exhaustive.  In each case, x has the case type; in the
+// default, the type of e_I.  typ is as for Cond.
+type TypeSwitch struct {
+	x     Name // "" for "switch e_I.(type)"
+	e_I   FGExpr
+	cases []TypeCase
+	e_def FGExpr // nil if no default
+	typ   Type
+	span  base.Span // Source position, not part of node identity
+}
+
+func (s TypeSwitch) GetSpan() base.Span { return s.span }
+
+var _ FGExpr = TypeSwitch{}
+
+func (s TypeSwitch) GetVar() Name         { return s.x }
+func (s TypeSwitch) GetExpr() FGExpr      { return s.e_I }
+func (s TypeSwitch) GetCases() []TypeCase { return s.cases }
+func (s TypeSwitch) GetDefault() FGExpr   { return s.e_def }
+func (s TypeSwitch) GetType() Type        { return s.typ }
+
+// x is bound in each case (and the default), so is not substituted there
+func (s TypeSwitch) Subs(subs map[Variable]FGExpr) FGExpr {
+	subs1 := subs
+	if s.x != "" {
+		subs1 = make(map[Variable]FGExpr)
+		for k, v := range subs {
+			subs1[k] = v
+		}
+		subs1[NewVariable(s.x)] = NewVariable(s.x)
+	}
+	cases := make([]TypeCase, len(s.cases))
+	for i, c := range s.cases {
+		cases[i] = TypeCase{c.t, c.e_body.Subs(subs1)}
+	}
+	var e_def FGExpr
+	if s.e_def != nil {
+		e_def = s.e_def.Subs(subs1)
+	}
+	return TypeSwitch{s.x, s.e_I.Subs(subs), cases, e_def, s.typ, s.span}
+}
+
+// N.B. only e_I is evaluated, the cases are not
+func (s TypeSwitch) Eval(ds []Decl) (FGExpr, string) {
+	if !s.e_I.IsValue() {
+		e, rule := s.e_I.Eval(ds)
+		return TypeSwitch{s.x, e, s.cases, s.e_def, s.typ, s.span}, rule
+	}
+	if e, ok := s.selectCase(ds); ok {
+		return s.bind(e), "TypeSwitch"
+	}
+	if s.e_def == nil {
+		panic("No matching case in type switch, and no default: " + s.String())
+	}
+	return s.bind(s.e_def), "TypeSwitchDefault"
+}
+
+// Pre: s.e_I is a value
+func (s TypeSwitch) selectCase(ds []Decl) (FGExpr, bool) {
+	t := concreteType(s.e_I)
+	for _, c := range s.cases {
+		if ok, _ := t.AssignableTo(ds, c.t); ok {
+			return c.e_body, true
+		}
+	}
+	return nil, false
+}
+
+// Substitutes the value of e_I for x in e, a case (or the default)
+func (s TypeSwitch) bind(e FGExpr) FGExpr {
+	if s.x == "" {
+		return e
+	}
+	return e.Subs(map[Variable]FGExpr{NewVariable(s.x): s.e_I})
+}
+
+// N.B. unlike Let, x may shadow a variable in gamma, as each case is a new
+// scope (e.g., "switch x := x.(type)")
+func (s TypeSwitch) Typing(ds []Decl, gamma Gamma, allowStupid bool) (Type, FGExpr) {
+	u_I, e_I := s.e_I.Typing(ds, gamma, allowStupid)
+	isI := isInterfaceType(ds, u_I)
+	if !isI && !allowStupid {
+		panic(base.NewDiagnostic(base.DIAG_NOT_INTERFACE, s,
+			"Type switch expr must be an interface type (in a non-stupid context): found "+
+				u_I.String()))
+	}
+	if s.e_def == nil {
+		panic(base.NewDiagnostic(base.DIAG_OTHER, s,
+			"Type switch must have a default case (cf. Go's missing return): "+
+				s.String()))
+	}
+	ts := make([]Type, len(s.cases)+1) // The branch types, the default last
+	es := make([]FGExpr, len(s.cases)+1)
+	for i, c := range s.cases {
+		c.t.Ok(ds)
+		for _, prev := range s.cases[:i] {
+			if prev.t.Equals(c.t) {
+				panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, s,
+					"Duplicate case in type switch: "+c.t.String()))
+			}
+		}
+		// Cf. T-ASSERT_S
+		if isI && !isInterfaceType(ds, c.t) && !Impls(ds, c.t, getInterface(ds, u_I)) {
+			panic(base.NewDiagnostic(base.DIAG_BAD_ASSERT, s,
+				"Type switch case must implement expr type: case="+
+					c.t.String()+", expr="+u_I.String()))
+		}
+		ts[i], es[i] = c.e_body.Typing(ds, s.extend(gamma, c.t), allowStupid)
+	}
+	n := len(s.cases)
+	ts[n], es[n] = s.e_def.Typing(ds, s.extend(gamma, u_I), allowStupid)
+
+	t_res := s.typ
+	if t_res == nil { // The join of the branch types, cf. Cond
+		t_res = joinBranches(ds, ts)
+		if t_res == nil {
+			strs := make([]string, len(ts))
+			for i, v := range ts {
+				strs[i] = v.String()
+			}
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, s,
+				"Mismatched branch types: "+strings.Join(strs, ", ")))
+		}
+	}
+	for i := range es {
+		ok, coercion := ts[i].AssignableTo(ds, t_res)
+		if !ok {
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, s,
+				"Branch must be assignable to declared return type: found="+
+					ts[i].String()+", expected="+t_res.String()))
+		}
+		es[i] = coerceBody(es[i], coercion)
+	}
+	cases := make([]TypeCase, n)
+	for i, c := range s.cases {
+		cases[i] = TypeCase{c.t, es[i]}
+	}
+	return t_res, TypeSwitch{s.x, e_I, cases, es[n], s.typ, s.span}
+}
+
+// gamma with x (if any) bound to t
+func (s TypeSwitch) extend(gamma Gamma, t Type) Gamma {
+	if s.x == "" {
+		return gamma
+	}
+	gamma1 := make(Gamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	gamma1[s.x] = t
+	return gamma1
+}
+
+// The first of ts to which all of ts are assignable, if any, o/w nil
+func joinBranches(ds []Decl, ts []Type) Type {
+	for _, t := range ts {
+		ok := true
+		for _, t1 := range ts {
+			if ok, _ = t1.AssignableTo(ds, t); !ok {
+				break
+			}
+		}
+		if ok {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s TypeSwitch) IsValue() bool {
+	return false
+}
+
+func (s TypeSwitch) IsPanic() bool {
+	return false
+}
+
+func (s TypeSwitch) CanEval(ds []Decl) bool {
+	if s.e_I.CanEval(ds) {
+		return true
+	} else if !s.e_I.IsValue() {
+		return false
+	}
+	_, ok := s.selectCase(ds)
+	return ok || s.e_def != nil
+}
+
+func (s TypeSwitch) String() string {
+	var b strings.Builder
+	s.writeHeader(&b, s.e_I.String())
+	for _, c := range s.cases {
+		b.WriteString(c.String())
+		b.WriteString("; ")
+	}
+	if s.e_def != nil {
+		b.WriteString("default: ")
+		writeBody(&b, s.e_def)
+		b.WriteString(" ")
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+func (s TypeSwitch) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	s.writeHeader(&b, s.e_I.ToGoString(ds))
+	for _, c := range s.cases {
+		b.WriteString("case " + toGoTypeString(c.t) + ": ")
+		writeToGoBody(ds, &b, c.e_body)
+		b.WriteString("; ")
+	}
+	if s.e_def != nil {
+		b.WriteString("default: ")
+		writeToGoBody(ds, &b, s.e_def)
+		b.WriteString(" ")
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// "switch x := e_I.(type) { ", or "switch e_I.(type) { "
+func (s TypeSwitch) writeHeader(b *strings.Builder, e_I string) {
+	b.WriteString("switch ")
+	if s.x != "" {
+		b.WriteString(s.x + " := ")
+	}
+	b.WriteString(e_I)
+	b.WriteString(".(type) { ")
+}
@@ -5,6 +5,7 @@ package fg_test // Separate package, can test "API"
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/rhu1/fgg/internal/base"
@@ -26,6 +27,12 @@ func fgParseAndOkBad(t *testing.T, msg string, elems ...string) base.Program {
 	return testutils.ParseAndOkBad(t, msg, &adptr, fg.MakeFgProgram(elems...))
 }
 
+// Checks the kinds of all collected diagnostics, cf. fgParseAndOkBad
+func fgParseAndCheckBad(t *testing.T, kinds []base.DiagnosticKind, elems ...string) []base.Diagnostic {
+	var adptr parser.FGAdaptor
+	return testutils.ParseAndCheckBad(t, &adptr, fg.MakeFgProgram(elems...), kinds...)
+}
+
 /* Syntax and typing */
 
 // TOOD: make translation to FGG and compare results to -fgg
@@ -422,3 +429,984 @@ func TestEval008(t *testing.T) {
 	prog := fgParseAndOkGood(t, imp, A, e)
 	testutils.EvalAndOkGood(t, prog, 1)
 }
+
+// A bad import is a syntax error, located at the import
+func TestImport001(t *testing.T) {
+	src := "package main;\nimport \"os\";\ntype A struct {};\nfunc main() { _ = A{} }"
+	var adptr parser.FGAdaptor
+	_, errs := adptr.Parse(true, src)
+	if len(errs) != 1 {
+		t.Fatalf("Expected one syntax error, got: %v", errs)
+	}
+	d, ok := errs[0].(base.Diagnostic)
+	if !ok || d.Kind != base.DIAG_SYNTAX || d.Span.String() != "2:0-2:11" {
+		t.Errorf("Expected syntax diagnostic at 2:0-2:11, got: " + errs[0].Error())
+	}
+}
+
+func TestImport002(t *testing.T) {
+	src := "package main;\ntype A struct {};\nfunc main() { fmt.Printf(\"%#v\", A{}) }"
+	var adptr parser.FGAdaptor
+	_, errs := adptr.Parse(true, src)
+	if len(errs) != 1 {
+		t.Fatalf("Expected one syntax error, got: %v", errs)
+	}
+	d, ok := errs[0].(base.Diagnostic)
+	if !ok || d.Kind != base.DIAG_SYNTAX || d.Span.String() != "3:14-3:24" {
+		t.Errorf("Expected syntax diagnostic at 3:14-3:24, got: " + errs[0].Error())
+	}
+}
+
+/* Conditionals */
+
+// Each branch need only be assignable to the return type
+func TestCond001(t *testing.T) {
+	I := "type I interface {}"
+	A := "type A struct {}"
+	B := "type B struct {}"
+	Am := "func (x0 A) f(x int32) I { if x < 0 { return A{} } else if x == 0 { return B{} } else { return x } }"
+	e := "A{}.f(0)"
+	prog := fgParseAndOkGood(t, I, A, B, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "B{}" {
+		t.Errorf("Expected B{}, got: " + res.GetMain().String())
+	}
+}
+
+// Only the taken branch is evaluated
+func TestCond002(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int32) int32 { if x != 0 { return 10 / x } else { return 0 } }"
+	e := "A{}.f(0)"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(0)" {
+		t.Errorf("Expected int32(0), got: " + res.GetMain().String())
+	}
+}
+
+func TestCond003(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int32) int32 { if x { return 1 } else { return 2 } }"
+	e := "A{}"
+	fgParseAndOkBad(t, "Non-boolean condition: int32", A, Am, e)
+}
+
+func TestCond003b(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(b bool) A { if b { return A{} } else { return 1 } }"
+	e := "A{}"
+	fgParseAndOkBad(t, "Branch must be assignable to declared return type", A, Am, e)
+}
+
+/* Local bindings */
+
+func TestLet001(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int32) int32 { y := x * x; var z int32 = y + 1; return y + z }"
+	e := "A{}.f(3)"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(19)" {
+		t.Errorf("Expected int32(19), got: " + res.GetMain().String())
+	}
+}
+
+// An untyped constant stays untyped, and a binding may be local to a branch
+func TestLet002(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(b bool) float32 { if b { y := 1; return y } else { return 2 } }"
+	e := "A{}.f(true)"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "float32(1e+00)" {
+		t.Errorf("Expected float32(1e+00), got: " + res.GetMain().String())
+	}
+}
+
+func TestLet003(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f(x int32) int32 { x := 1; return x }"
+	e := "A{}"
+	fgParseAndOkBad(t, "Variable already declared: x", A, Am, e)
+}
+
+func TestLet003b(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) f() A { var y A = 1; return y }"
+	e := "A{}"
+	fgParseAndOkBad(t, "Definition of y must be assignable to its declared type", A, Am, e)
+}
+
+/* Functions */
+
+func TestFunc001(t *testing.T) {
+	Fsq := "func sq(x int32) int32 { return x * x }"
+	Fadd := "func add(x int32, y int32) int32 { return x + y }"
+	e := "add(sq(3), 1)"
+	prog := fgParseAndOkGood(t, Fsq, Fadd, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(10)" {
+		t.Errorf("Expected int32(10), got: " + res.GetMain().String())
+	}
+}
+
+// A function may be called before its decl
+func TestFunc002(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) m() A { return mk() }"
+	Fmk := "func mk() A { return A{} }"
+	e := "A{}.m()"
+	prog := fgParseAndOkGood(t, A, Am, Fmk, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "A{}" {
+		t.Errorf("Expected A{}, got: " + res.GetMain().String())
+	}
+}
+
+func TestFunc003(t *testing.T) {
+	Fadd := "func add(x int32, y int32) int32 { return x + y }"
+	e := "add(1)"
+	fgParseAndOkBad(t, "Arity mismatch", Fadd, e)
+}
+
+func TestFunc003b(t *testing.T) {
+	A := "type A struct {}"
+	e := "f(A{}, A{})"
+	fgParseAndOkBad(t, "Function not found: f", A, e)
+}
+
+func TestFunc003c(t *testing.T) {
+	A := "type A struct {}"
+	FA := "func A() A { return A{} }"
+	e := "A{}"
+	fgParseAndOkBad(t, "Function name already declared as a type: A", A, FA, e)
+}
+
+/* Function values */
+
+func TestFuncLit001(t *testing.T) {
+	Fadder := "func adder(n int32) func(int32) int32 { return func(x int32) int32 { return x + n } }"
+	e := "adder(3)(4)"
+	prog := fgParseAndOkGood(t, Fadder, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(7)" {
+		t.Errorf("Expected int32(7), got: " + res.GetMain().String())
+	}
+}
+
+// A method on a function type, and a call of a local function variable
+func TestFuncLit002(t *testing.T) {
+	F := "type F func(int32) int32"
+	Ftwice := "func (f F) twice(x int32) int32 { return f(f(x)) }"
+	Fadder := "func adder(n int32) func(int32) int32 { return func(x int32) int32 { return x + n } }"
+	e := "F(adder(3)).twice(1)"
+	prog := fgParseAndOkGood(t, F, Ftwice, Fadder, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "int32(7)" {
+		t.Errorf("Expected int32(7), got: " + res.GetMain().String())
+	}
+}
+
+// A func-typed field is applied as "(x.f)(e)", cf. the Call "x.f(e)"
+func TestFuncLit002b(t *testing.T) {
+	A := "type A struct { f func(int32) int32 }"
+	e := "(A{func(x int32) int32 { return -x }}.f)(1)"
+	prog := fgParseAndOkGood(t, A, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(-1)" {
+		t.Errorf("Expected int32(-1), got: " + res.GetMain().String())
+	}
+}
+
+func TestFuncLit003(t *testing.T) {
+	A := "type A struct {}"
+	e := "A{}(A{})"
+	fgParseAndOkBad(t, "Cannot call non-function", A, e)
+}
+
+func TestFuncLit003b(t *testing.T) {
+	Fadder := "func adder(n int32) func(int32) int32 { return func(x int32) int32 { return x + n } }"
+	e := "adder(3)(4, 5)"
+	fgParseAndOkBad(t, "Arity mismatch", Fadder, e)
+}
+
+// Function values are not comparable
+func TestFuncLit003c(t *testing.T) {
+	Fadder := "func adder(n int32) func(int32) int32 { return func(x int32) int32 { return x + n } }"
+	e := "adder(3) == adder(3)"
+	fgParseAndOkBad(t, "operator == not defined", Fadder, e)
+}
+
+// A captured variable cannot be redeclared in the body, cf. Let.Typing
+func TestFuncLit003d(t *testing.T) {
+	Fadder := "func adder(n int32) func(int32) int32 { return func(x int32) int32 { n := x; return n } }"
+	e := "adder(3)(4)"
+	fgParseAndOkBad(t, "Variable already declared: n", Fadder, e)
+}
+
+/* Slices */
+
+// A method on a defined slice type, using len, indexing and append
+func TestSlice001(t *testing.T) {
+	Ints := "type Ints []int32"
+	Isum := "func (xs Ints) sum(i int32) int32 { " +
+		"if i < len(xs) { return xs[i] + xs.sum(i + 1) } else { return 0 } }"
+	e := "append(Ints{1, 2}, 3).sum(0)"
+	prog := fgParseAndOkGood(t, Ints, Isum, e)
+	res := testutils.EvalToValueGood(t, prog, 50)
+	if res.GetMain().String() != "int32(6)" {
+		t.Errorf("Expected int32(6), got: " + res.GetMain().String())
+	}
+}
+
+func TestSlice002(t *testing.T) {
+	e := "[]int32{1, 2}[2]"
+	prog := fgParseAndOkGood(t, e)
+	testutils.EvalToValueBad(t, prog, "index out of range [2] with length 2", 10)
+}
+
+func TestSlice003(t *testing.T) {
+	A := "type A struct {}"
+	e := "A{}[0]"
+	fgParseAndOkBad(t, "Cannot index non-slice", A, e)
+}
+
+func TestSlice003b(t *testing.T) {
+	A := "type A struct {}"
+	e := "append([]int32{}, A{})"
+	fgParseAndOkBad(t, "Slice element must be assignable to element type", A, e)
+}
+
+// Slices are not comparable
+func TestSlice003c(t *testing.T) {
+	e := "[]int32{} == []int32{}"
+	fgParseAndOkBad(t, "operator == not defined", e)
+}
+
+/* Maps */
+
+// Lookup, (functional) update, comma-ok and len
+func TestMap001(t *testing.T) {
+	Ages := "type Ages map[string]int32"
+	f := "func f(m Ages) int32 { m[\"c\"] = 3; v, ok := m[\"z\"]; " +
+		"if ok { return 0 } else { return m[\"a\"] + m[\"c\"] + v + len(m) } }"
+	e := "f(Ages{\"b\": 2, \"a\": 1})"
+	prog := fgParseAndOkGood(t, Ages, f, e)
+	res := testutils.EvalToValueGood(t, prog, 50)
+	if res.GetMain().String() != "int32(7)" {
+		t.Errorf("Expected int32(7), got: " + res.GetMain().String())
+	}
+}
+
+// A map value is ordered by key, and the last entry for a key is kept
+func TestMap002(t *testing.T) {
+	f := "func f(m map[int32]bool) map[int32]bool { m[2] = false; return m }"
+	e := "f(map[int32]bool{3: true, 2: true, 1: true})"
+	prog := fgParseAndOkGood(t, f, e)
+	res := testutils.EvalToValueGood(t, prog, 50)
+	exp := "map[int32]bool{int32(1): bool(true), int32(2): bool(false), int32(3): bool(true)}"
+	if res.GetMain().String() != exp {
+		t.Errorf("Expected " + exp + ", got: " + res.GetMain().String())
+	}
+}
+
+// A missing key gives the zero value of the element type...
+func TestMap003(t *testing.T) {
+	A := "type A struct { x int32; y []bool }"
+	e := "map[int32]A{}[1]"
+	prog := fgParseAndOkGood(t, A, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "A{int32(0), []bool{}}" {
+		t.Errorf("Expected A{int32(0), []bool{}}, got: " + res.GetMain().String())
+	}
+}
+
+// ...if it has one -- FG has no nil
+func TestMap003b(t *testing.T) {
+	Any := "type Any interface {}"
+	e := "map[int32]Any{}[1]"
+	prog := fgParseAndOkGood(t, Any, e)
+	testutils.EvalToValueBad(t, prog, "missing map key, and no zero value of type Any", 10)
+}
+
+func TestMap004(t *testing.T) {
+	e := "map[[]int32]bool{}"
+	fgParseAndOkBad(t, "Invalid map key type", e)
+}
+
+func TestMap004b(t *testing.T) {
+	e := "map[int32]bool{1: true, 1: false}"
+	fgParseAndOkBad(t, "Duplicate key in map literal", e)
+}
+
+func TestMap004c(t *testing.T) {
+	f := "func f(xs []int32) int32 { v, ok := xs[0]; return v }"
+	e := "f([]int32{1})"
+	fgParseAndOkBad(t, "Comma-ok binding requires a map index", f, e)
+}
+
+func TestMap004d(t *testing.T) {
+	A := "type A struct {}"
+	e := "map[int32]bool{1: A{}}"
+	fgParseAndOkBad(t, "Map element must be assignable to element type", A, e)
+}
+
+/* Type switches */
+
+// The first matching case is selected, x has the case type
+func TestTypeSwitch001(t *testing.T) {
+	Any := "type Any interface {}"
+	A := "type A struct { x int32 }"
+	B := "type B struct {}"
+	f := "func f(a Any) int32 { switch v := a.(type) { case B: return 1; case A: return v.x; " +
+		"case int32: return v + 10; default: return 0 } }"
+	e := "f(A{3}) + f(B{}) + f(int32(7)) + f(\"s\")"
+	prog := fgParseAndOkGood(t, Any, A, B, f, e)
+	res := testutils.EvalToValueGood(t, prog, 50)
+	if res.GetMain().String() != "int32(21)" {
+		t.Errorf("Expected int32(21), got: " + res.GetMain().String())
+	}
+}
+
+// An interface case, and shadowing the switched variable
+func TestTypeSwitch002(t *testing.T) {
+	Any := "type Any interface {}"
+	I := "type I interface { m() int32 }"
+	A := "type A struct {}"
+	Am := "func (x0 A) m() int32 { return 5 }"
+	f := "func f(a Any) int32 { switch a := a.(type) { case I: return a.m(); default: return 0 } }"
+	e := "f(A{})"
+	prog := fgParseAndOkGood(t, Any, I, A, Am, f, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "int32(5)" {
+		t.Errorf("Expected int32(5), got: " + res.GetMain().String())
+	}
+}
+
+func TestTypeSwitch003(t *testing.T) {
+	Any := "type Any interface {}"
+	A := "type A struct {}"
+	f := "func f(a Any) int32 { switch a.(type) { case A: return 1 } }"
+	e := "f(A{})"
+	fgParseAndOkBad(t, "Type switch must have a default case", Any, A, f, e)
+}
+
+func TestTypeSwitch003b(t *testing.T) {
+	A := "type A struct {}"
+	f := "func f(a A) int32 { switch a.(type) { case A: return 1; default: return 0 } }"
+	e := "f(A{})"
+	fgParseAndOkBad(t, "Type switch expr must be an interface type", A, f, e)
+}
+
+func TestTypeSwitch003c(t *testing.T) {
+	Any := "type Any interface {}"
+	A := "type A struct {}"
+	f := "func f(a Any) int32 { switch a.(type) { case A: return 1; case A: return 2; default: return 0 } }"
+	e := "f(A{})"
+	fgParseAndOkBad(t, "Duplicate case in type switch", Any, A, f, e)
+}
+
+func TestTypeSwitch003d(t *testing.T) {
+	I := "type I interface { m() int32 }"
+	A := "type A struct {}"
+	f := "func f(a I) int32 { switch a.(type) { case A: return 1; default: return 0 } }"
+	e := "f(A{})"
+	fgParseAndOkBad(t, "Type switch case must implement expr type", I, A, f, e)
+}
+
+func TestTypeSwitch003e(t *testing.T) {
+	Any := "type Any interface {}"
+	A := "type A struct {}"
+	f := "func f(a Any) int32 { switch v := a.(type) { case A: return v; default: return 0 } }"
+	e := "f(A{})"
+	fgParseAndOkBad(t, "Branch must be assignable to declared return type", Any, A, f, e)
+}
+
+/* Comma-ok assertions */
+
+// A failed assertion gives the zero value, rather than getting stuck
+func TestAssertOk001(t *testing.T) {
+	Any := "type Any interface {}"
+	A := "type A struct { x int32 }"
+	B := "type B struct {}"
+	f := "func f(a Any) int32 { v, ok := a.(A); if ok { return v.x } else { return v.x - 1 } }"
+	e := "f(A{3}) + f(B{})"
+	prog := fgParseAndOkGood(t, Any, A, B, f, e)
+	res := testutils.EvalToValueGood(t, prog, 30)
+	if res.GetMain().String() != "int32(2)" {
+		t.Errorf("Expected int32(2), got: " + res.GetMain().String())
+	}
+}
+
+func TestAssertOk002(t *testing.T) {
+	Any := "type Any interface {}"
+	f := "func f(a Any) string { v, ok := a.(string); return v }"
+	e := "f(1)"
+	prog := fgParseAndOkGood(t, Any, f, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "string(\"\")" {
+		t.Errorf("Expected string(\"\"), got: " + res.GetMain().String())
+	}
+}
+
+// FG has no nil, so no zero value for an interface type
+func TestAssertOk003(t *testing.T) {
+	Any := "type Any interface {}"
+	I := "type I interface { m() int32 }"
+	f := "func f(a Any) bool { v, ok := a.(I); return ok }"
+	e := "f(1)"
+	fgParseAndOkBad(t, "Comma-ok assertion requires a type with a zero value", Any, I, f, e)
+}
+
+// The diagnostic names the cause, e.g., an interface-typed field
+func TestAssertOk003b(t *testing.T) {
+	Any := "type Any interface {}"
+	I := "type I interface { m() int32 }"
+	A := "type A struct { x int32; y I }"
+	f := "func f(a Any) bool { v, ok := a.(A); return ok }"
+	e := "f(1)"
+	kinds := []base.DiagnosticKind{base.DIAG_NO_ZERO_VALUE}
+	errs := fgParseAndCheckBad(t, kinds, Any, I, A, f, e)
+	if len(errs) == 1 && !strings.Contains(errs[0].Message, "field y of A: I is an interface type") {
+		t.Errorf("Expected the interface-typed field as the cause, got: " + errs[0].Message)
+	}
+}
+
+/* Panics */
+
+func TestPanic001(t *testing.T) {
+	A := "type A struct { f int32 }"
+	Am := "func (x0 A) m() A { panic(\"boom\") }"
+	e := "A{1}.m().f + 1"
+	prog := fgParseAndOkGood(t, A, Am, e)
+	testutils.EvalToValueBad(t, prog, "panic(\"boom\")", 10)
+}
+
+// The argument is evaluated first -- here, to a nested panic
+func TestPanic002(t *testing.T) {
+	A := "type A struct {}"
+	f := "func f(x int32) int32 { if x > 0 { return x } else { panic(g(x)) } }"
+	g := "func g(x int32) A { panic(x - 1) }"
+	e := "f(1) + f(0)"
+	prog := fgParseAndOkGood(t, A, f, g, e)
+	testutils.EvalToValueBad(t, prog, "panic(int32(-1))", 20)
+}
+
+// A failed assertion reduces to a panic, as do the other run-time errors
+func TestPanic003(t *testing.T) {
+	Any := "type Any interface {}"
+	ToAny := "type ToAny struct { any Any }"
+	A := "type A struct {}"
+	B := "type B struct {}"
+	e := "ToAny{ToAny{B{}}.any.(A)}"
+	prog := fgParseAndOkGood(t, Any, ToAny, A, B, e)
+	testutils.EvalToValueBad(t, prog, "interface conversion: B is not A", 10)
+}
+
+func TestPanic004(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) m() A { panic(x1) }"
+	e := "A{}.m()"
+	fgParseAndOkBad(t, "Var not in env", A, Am, e)
+}
+
+// Comparing interface values of the same uncomparable dynamic type is a
+// run-time error...
+func TestPanic005(t *testing.T) {
+	Any := "type Any interface {}"
+	eq := "func eq(x Any, y Any) bool { return x == y }"
+	e := "eq(func() int32 { return 1 }, func() int32 { return 1 })"
+	prog := fgParseAndOkGood(t, Any, eq, e)
+	testutils.EvalToValueBad(t, prog, "comparing uncomparable type func() int32", 10)
+}
+
+// ...but of different dynamic types, they are just unequal
+func TestPanic005b(t *testing.T) {
+	Any := "type Any interface {}"
+	eq := "func eq(x Any, y Any) bool { return x == y }"
+	e := "eq(func() int32 { return 1 }, 1)"
+	prog := fgParseAndOkGood(t, Any, eq, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "bool(false)" {
+		t.Errorf("Expected bool(false), got: " + res.GetMain().String())
+	}
+}
+
+// As is an uncomparable map key, e.g., held by an interface key
+func TestPanic006(t *testing.T) {
+	Any := "type Any interface {}"
+	e := "map[Any]int32{1: 2}[func() int32 { return 1 }]"
+	prog := fgParseAndOkGood(t, Any, e)
+	testutils.EvalToValueBad(t, prog, "hash of unhashable type func() int32", 10)
+}
+
+// panic is a statement: it may be the body of a func literal...
+func TestPanic007(t *testing.T) {
+	A := "type A struct {}"
+	e := "(func() int32 { panic(A{}) })() + 1"
+	prog := fgParseAndOkGood(t, A, e)
+	testutils.EvalToValueBad(t, prog, "panic(A{})", 10)
+}
+
+// ...but not an expression
+func TestPanic008(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) m() A { return panic(A{}) }"
+	e := "A{}.m()"
+	var adptr parser.FGAdaptor
+	_, errs := adptr.Parse(true, fg.MakeFgProgram(A, Am, e))
+	if len(errs) == 0 {
+		t.Fatalf("Expected syntax error")
+	}
+	if d, ok := errs[0].(base.Diagnostic); !ok || d.Kind != base.DIAG_SYNTAX {
+		t.Errorf("Expected syntax diagnostic, got: " + errs[0].Error())
+	}
+}
+
+/* Embedded fields */
+
+// A promoted field, at depth 2
+func TestEmbed001(t *testing.T) {
+	A := "type A struct { f int32 }"
+	B := "type B struct { A; g int32 }"
+	C := "type C struct { B }"
+	e := "C{B{A{1}, 2}}.f + C{B{A{1}, 2}}.g"
+	prog := fgParseAndOkGood(t, A, B, C, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "int32(3)" {
+		t.Errorf("Expected int32(3), got: " + res.GetMain().String())
+	}
+}
+
+// A promoted method; a declared method of the same name takes precedence
+func TestEmbed002(t *testing.T) {
+	A := "type A struct { f int32 }"
+	Am := "func (x0 A) m() int32 { return x0.f }"
+	An := "func (x0 A) n() int32 { return 0 }"
+	B := "type B struct { A }"
+	Bn := "func (x0 B) n() int32 { return 10 }"
+	e := "B{A{1}}.m() + B{A{1}}.n()"
+	prog := fgParseAndOkGood(t, A, Am, An, B, Bn, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "int32(11)" {
+		t.Errorf("Expected int32(11), got: " + res.GetMain().String())
+	}
+}
+
+// An interface satisfied via a promoted method, dynamically dispatched
+func TestEmbed003(t *testing.T) {
+	I := "type I interface { m() int32 }"
+	A := "type A struct { f int32 }"
+	Am := "func (x0 A) m() int32 { return x0.f }"
+	B := "type B struct { A }"
+	f := "func f(x I) int32 { return x.m() }"
+	e := "f(B{A{5}})"
+	prog := fgParseAndOkGood(t, I, A, Am, B, f, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "int32(5)" {
+		t.Errorf("Expected int32(5), got: " + res.GetMain().String())
+	}
+}
+
+// An embedded interface: its methods are promoted, and dispatched on its value
+func TestEmbed004(t *testing.T) {
+	I := "type I interface { m() int32 }"
+	A := "type A struct {}"
+	Am := "func (x0 A) m() int32 { return 7 }"
+	B := "type B struct { I }"
+	f := "func f(x I) int32 { return x.m() }"
+	e := "f(B{A{}})"
+	prog := fgParseAndOkGood(t, I, A, Am, B, f, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "int32(7)" {
+		t.Errorf("Expected int32(7), got: " + res.GetMain().String())
+	}
+}
+
+// A shallower field hides the deeper ones
+func TestEmbed005(t *testing.T) {
+	A := "type A struct { f int32 }"
+	B := "type B struct { A }"
+	C := "type C struct { B; f bool }"
+	e := "C{B{A{1}}, true}.f"
+	prog := fgParseAndOkGood(t, A, B, C, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "bool(true)" {
+		t.Errorf("Expected bool(true), got: " + res.GetMain().String())
+	}
+}
+
+func TestEmbed006(t *testing.T) {
+	A := "type A struct { f int32 }"
+	B := "type B struct { f int32 }"
+	C := "type C struct { A; B }"
+	e := "C{A{1}, B{2}}.f"
+	fgParseAndOkBad(t, "Ambiguous selector f", A, B, C, e)
+}
+
+// An ambiguous method is not in the method set
+func TestEmbed006b(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) m() int32 { return 1 }"
+	B := "type B struct {}"
+	Bm := "func (x0 B) m() int32 { return 2 }"
+	C := "type C struct { A; B }"
+	e := "C{A{}, B{}}.m()"
+	fgParseAndOkBad(t, "Ambiguous selector m", A, Am, B, Bm, C, e)
+}
+
+func TestEmbed006c(t *testing.T) {
+	I := "type I interface { m() int32 }"
+	A := "type A struct {}"
+	Am := "func (x0 A) m() int32 { return 1 }"
+	B := "type B struct {}"
+	Bm := "func (x0 B) m() int32 { return 2 }"
+	C := "type C struct { A; B }"
+	f := "func f(x I) int32 { return x.m() }"
+	e := "f(C{A{}, B{}})"
+	fgParseAndOkBad(t, "Arg expr must be assignable to param type", I, A, Am, B, Bm, C, f, e)
+}
+
+func TestEmbed007(t *testing.T) {
+	A := "type A struct { B }"
+	B := "type B struct { A }"
+	e := "A{}"
+	fgParseAndOkBad(t, "Invalid cyclic declaration", A, B, e)
+}
+
+func TestEmbed008(t *testing.T) {
+	A := "type A struct { f int32 }"
+	B := "type B struct { A; A int32 }"
+	e := "A{1}"
+	fgParseAndOkBad(t, "Multiple fields with name: A", A, B, e)
+}
+
+/* Diagnostics */
+
+func TestDiag001(t *testing.T) {
+	A := "type A struct {}"
+	Am1 := "func (x0 A) m1() A { return x1 }"
+	Am2 := "func (x0 A) m2() A { return B{} }"
+	e := "A{}.f"
+	kinds := []base.DiagnosticKind{base.DIAG_UNKNOWN_VAR, base.DIAG_UNKNOWN_TYPE,
+		base.DIAG_UNKNOWN_FIELD}
+	fgParseAndCheckBad(t, kinds, A, Am1, Am2, e)
+}
+
+func TestDiag002(t *testing.T) {
+	A := "type A struct {}"
+	A2 := "type A struct { f A }"
+	Am1 := "func (x0 A) m1(x1 A) A { return x0 }"
+	e := "A{}.m1()"
+	kinds := []base.DiagnosticKind{base.DIAG_DUPLICATE_DECL, base.DIAG_ARITY}
+	fgParseAndCheckBad(t, kinds, A, A2, Am1, e)
+}
+
+func TestDiag003(t *testing.T) {
+	A := "type A struct {}"
+	Am1 := "func (x0 A) m1() A { return x0 }"
+	e := "A{}.m1()"
+	fgParseAndCheckBad(t, nil, A, Am1, e)
+}
+
+func TestDiag004(t *testing.T) {
+	A := "type A struct {}"
+	Am1 := "func (x0 A) m1() A { return x1 }"
+	e := "A{}.f"
+	kinds := []base.DiagnosticKind{base.DIAG_UNKNOWN_VAR, base.DIAG_UNKNOWN_FIELD}
+	errs := fgParseAndCheckBad(t, kinds, A, Am1, e)
+	if len(errs) != 2 {
+		return
+	}
+	exp := []base.Span{{StartLine: 3, StartCol: 28, EndLine: 3, EndCol: 30},
+		{StartLine: 4, StartCol: 18, EndLine: 4, EndCol: 23}}
+	for i, d := range errs {
+		if d.Span != exp[i] {
+			t.Errorf("Expected span " + exp[i].String() + ", got: " + d.String())
+		}
+	}
+}
+
+// Errors on a type are located at the type itself, not the enclosing decl
+func TestDiag005(t *testing.T) {
+	A := "type A struct { f B }"
+	C := "type C struct {}"
+	e := "C{}"
+	kinds := []base.DiagnosticKind{base.DIAG_UNKNOWN_TYPE}
+	errs := fgParseAndCheckBad(t, kinds, A, C, e)
+	if len(errs) != 1 {
+		return
+	}
+	exp := base.Span{StartLine: 2, StartCol: 18, EndLine: 2, EndCol: 19}
+	if errs[0].Span != exp {
+		t.Errorf("Expected span " + exp.String() + ", got: " + errs[0].String())
+	}
+}
+
+/* Syntax errors */
+
+func TestParseErr001(t *testing.T) {
+	A := "type A struct {"
+	e := "A{}"
+	var adptr parser.FGAdaptor
+	for _, strict := range []bool{true, false} {
+		_, errs := adptr.Parse(strict, fg.MakeFgProgram(A, e))
+		if len(errs) == 0 {
+			t.Errorf("Expected syntax error, strict=" + fmt.Sprint(strict))
+		}
+		for _, err := range errs {
+			if d, ok := err.(base.Diagnostic); !ok || d.Kind != base.DIAG_SYNTAX ||
+				!d.Span.IsKnown() {
+				t.Errorf("Expected located syntax diagnostic, got: " + err.Error())
+			}
+		}
+	}
+}
+
+func TestParseErr002(t *testing.T) {
+	A := "type A struct {}"
+	e := "A{} # A{}"
+	var adptr parser.FGAdaptor
+	_, errs := adptr.Parse(true, fg.MakeFgProgram(A, e))
+	if len(errs) == 0 {
+		t.Fatalf("Expected lexical error")
+	}
+	d := errs[0].(base.Diagnostic)
+	exp := base.Span{StartLine: 3, StartCol: 22, EndLine: 3, EndCol: 23}
+	if !strings.HasPrefix(d.Message, "Lexical error") || d.Span != exp {
+		t.Errorf("Expected lexical error at " + exp.String() + ", got: " + d.String())
+	}
+}
+
+/* Formatting */
+
+func fgFormatGood(t *testing.T, src string) string {
+	var adptr parser.FGAdaptor
+	return testutils.FormatAndReparseGood(t, &adptr, src)
+}
+
+func TestFormat001(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) m(x1 int32, x2 bool) A { return x0 }"
+	e := "A{}.m(1 - (2 - 3) + 4, ((1 + 2) > 3 && true) || (false || true))"
+	out := fgFormatGood(t, fg.MakeFgProgram(A, Am, e))
+	exp := "_ = A{}.m(1 - (2 - 3) + 4, 1 + 2 > 3 && true || (false || true))"
+	if !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat002(t *testing.T) {
+	A := "type A struct { f float64; g string; h int64 }"
+	e := "A{1.0, \"a b\", 2}"
+	out := fgFormatGood(t, fg.MakeFgProgram(A, e))
+	exp := "_ = A{1, \"a b\", 2}" // "1.0" has no fractional part, so is parsed as an int, cf. NewFloatLit
+	if !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat003(t *testing.T) {
+	src := `package main;
+// A comment
+type A struct { f A; // field
+	g A };
+
+func (x A) m() A { return x.f };
+func main() { _ = A{}.m() } /* end */`
+	exp := `package main;
+
+// A comment
+type A struct {
+	f A; // field
+	g A
+};
+
+func (x A) m() A {
+	return x.f
+};
+
+func main() {
+	_ = A{}.m() /* end */
+}
+`
+	if out := fgFormatGood(t, src); out != exp {
+		t.Errorf("Expected:\n" + exp + "\ngot:\n" + out)
+	}
+}
+
+// Nested negations are parenthesised, cf. "--" in Go
+func TestFormat004(t *testing.T) {
+	A := "type A struct {}"
+	Am := "func (x0 A) m(x1 int32, x2 bool) A { return x0 }"
+	e := "A{}.m(- -1 * -(2 + 3), !(true && false))"
+	out := fgFormatGood(t, fg.MakeFgProgram(A, Am, e))
+	exp := "_ = A{}.m(-(-1) * -(2 + 3), !(true && false))"
+	if !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat005(t *testing.T) {
+	src := `package main;
+type A struct {};
+func (x A) m(y int32) A { if y < 0 { return x } else { if y == 0 { return A{} } else { return x.m(y - 1) } } };
+func main() { _ = A{}.m(1) }`
+	exp := `package main;
+
+type A struct {};
+func (x A) m(y int32) A {
+	if y < 0 {
+		return x
+	} else if y == 0 {
+		return A{}
+	} else {
+		return x.m(y - 1)
+	}
+};
+
+func main() {
+	_ = A{}.m(1)
+}
+`
+	if out := fgFormatGood(t, src); out != exp {
+		t.Errorf("Expected:\n" + exp + "\ngot:\n" + out)
+	}
+}
+
+func TestFormat006(t *testing.T) {
+	src := `package main;
+type A struct {};
+func (x A) m(y int32) int32 { z := y + 1; var w int32 = z * z; return w };
+func main() { _ = A{}.m(1) }`
+	exp := `func (x A) m(y int32) int32 {
+	z := y + 1;
+	var w int32 = z * z;
+	return w
+};`
+	if out := fgFormatGood(t, src); !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat007(t *testing.T) {
+	src := `package main;
+type A struct {};
+func id(x A) A { return x };
+func mk() A { return id(A{}) };
+func main() { _ = mk() }`
+	exp := `func id(x A) A {
+	return x
+};
+func mk() A {
+	return id(A{})
+};`
+	if out := fgFormatGood(t, src); !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat008(t *testing.T) {
+	src := `package main;
+type F func(int32) int32;
+func adder(n int32) F { return F(func(x int32) int32 { if x > 0 { return x + n } else { return n } }) };
+func main() { _ = (adder(1))(2) }`
+	exp := `func adder(n int32) F {
+	return F(func(x int32) int32 { if x > 0 { return x + n } else { return n } })
+};
+
+func main() {
+	_ = adder(1)(2)
+}`
+	if out := fgFormatGood(t, src); !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat009(t *testing.T) {
+	src := `package main;
+type Ints []int32;
+func (xs Ints) first() int32 { return (xs)[0] };
+func main() { _ = append(Ints{1}, len([]bool{})).first() }`
+	exp := `type Ints []int32;
+func (xs Ints) first() int32 {
+	return xs[0]
+};
+
+func main() {
+	_ = append(Ints{1}, len([]bool{})).first()
+}`
+	if out := fgFormatGood(t, src); !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat010(t *testing.T) {
+	src := `package main;
+type Ages map[string]int32;
+func f(m Ages) bool { m["a"]=1; v, ok := m["b"]; return ok };
+func main() { _ = f(Ages{"b" : 2}) }`
+	exp := `func f(m Ages) bool {
+	m["a"] = 1;
+	v, ok := m["b"];
+	return ok
+};
+
+func main() {
+	_ = f(Ages{"b": 2})
+}`
+	if out := fgFormatGood(t, src); !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat011(t *testing.T) {
+	src := `package main;
+type Any interface {};
+type A struct {};
+func f(a Any) int32 { switch v := a.(type) { case A: return 1; default: x := v; return 0 } };
+func main() { _ = f(A{}) }`
+	exp := `func f(a Any) int32 {
+	switch v := a.(type) {
+	case A:
+		return 1
+	default:
+		x := v;
+		return 0
+	}
+};`
+	if out := fgFormatGood(t, src); !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat012(t *testing.T) {
+	src := `package main;
+func f(x int32) int32 { if x < 0 { panic("negative") } else { return x } };
+func main() { _ = f(1) }`
+	exp := `func f(x int32) int32 {
+	if x < 0 {
+		panic("negative")
+	} else {
+		return x
+	}
+};`
+	if out := fgFormatGood(t, src); !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat013(t *testing.T) {
+	src := `package main;
+type A struct { f int32 };
+type B struct { A; g int32 };
+func main() { _ = B{A{1}, 2}.f }`
+	exp := `type B struct {
+	A;
+	g int32
+};`
+	if out := fgFormatGood(t, src); !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
@@ -8,11 +8,14 @@ import (
 
 /* Export */
 
-func NewTNamed(t Name) TNamed                  { return TNamed(t) }
-func NewITypeLit(ss []Spec) ITypeLit           { return ITypeLit{ss} }
-func NewSTypeLit(fds []FieldDecl) STypeLit     { return STypeLit{fds} }
-func NewTPrimitive(t Tag) TPrimitive           { return TPrimitive{t} }
+func NewTNamed(t Name) TNamed                  { return TNamed{t, base.Span{}} }
+func NewITypeLit(ss []Spec) ITypeLit           { return ITypeLit{ss, base.Span{}} }
+func NewSTypeLit(fds []FieldDecl) STypeLit     { return STypeLit{fds, base.Span{}} }
+func NewTPrimitive(t Tag) TPrimitive           { return TPrimitive{t, base.Span{}} }
 func NewUndefTPrimitive(t Tag) UndefTPrimitive { return UndefTPrimitive{t} }
+func NewTFunc(ts []Type, t Type) TFunc         { return TFunc{ts, t, base.Span{}} }
+func NewTSlice(t Type) TSlice                  { return TSlice{t, base.Span{}} }
+func NewTMap(k Type, t Type) TMap              { return TMap{k, t, base.Span{}} }
 
 // Factors t0 <: t_I for every Type t0, since the test is always the same.
 // Pre: isInterfaceType(t_I)
@@ -38,12 +41,16 @@ func EqualsOrImpls(ds []Decl, t0 Type, t Type) bool {
 /* Named (defined) types */
 
 // Represents types declared/defined by the user
-type TNamed Name
+type TNamed struct {
+	name Name
+	span base.Span // Source position, not part of node identity
+}
 
-var _ Type = TNamed("")
-var _ Spec = TNamed("")
+var _ Type = TNamed{}
+var _ Spec = TNamed{}
 
-func (t0 TNamed) GetName() Name { return Name(t0) }
+func (t0 TNamed) GetName() Name      { return t0.name }
+func (t0 TNamed) GetSpan() base.Span { return t0.span }
 
 func (t0 TNamed) AssignableTo(ds []Decl, t Type) (bool, Coercion) {
 
@@ -51,10 +58,11 @@ func (t0 TNamed) AssignableTo(ds []Decl, t Type) (bool, Coercion) {
 		return true, noOpCoercion
 	}
 	// if t is not a defined type
-	if _, ok := t.(STypeLit); ok {
+	switch t.(type) {
+	case STypeLit, TFunc, TSlice, TMap:
 		if t0.Underlying(ds).Equals(t) {
 			coercion := func(expr FGExpr) FGExpr {
-				return Convert{t, expr}
+				return Convert{t, expr, base.Span{}}
 			}
 			return true, coercion
 		}
@@ -63,7 +71,9 @@ func (t0 TNamed) AssignableTo(ds []Decl, t Type) (bool, Coercion) {
 }
 
 func (t0 TNamed) Ok(ds []Decl) {
-	getTDecl(ds, Name(t0)) // panics if decl not found
+	if !isTypeName(ds, t0.name) {
+		panic(base.NewDiagnostic(base.DIAG_UNKNOWN_TYPE, t0, "Type not found: "+t0.name))
+	}
 }
 
 // t_I is a Spec, but not t_S -- this aspect is currently "dynamically typed"
@@ -71,7 +81,8 @@ func (t0 TNamed) Ok(ds []Decl) {
 func (t0 TNamed) GetSigs(ds []Decl) []Sig {
 	t_I, ok := t0.Underlying(ds).(ITypeLit)
 	if !ok {
-		panic("Cannot use non-interface type as a Spec: " + t0.String())
+		panic(base.NewDiagnostic(base.DIAG_NOT_INTERFACE, t0,
+			"Cannot use non-interface type as a Spec: "+t0.String()))
 	}
 	var res []Sig
 	for _, s := range t_I.specs {
@@ -85,15 +96,15 @@ func (t0 TNamed) Equals(t base.Type) bool {
 	if _, ok := t_fg.(TNamed); !ok {
 		return false
 	}
-	return t0 == t_fg.(TNamed)
+	return t0.name == t_fg.(TNamed).name
 }
 
 func (t0 TNamed) String() string {
-	return string(t0)
+	return t0.name
 }
 
 func (t0 TNamed) Underlying(ds []Decl) Type {
-	td := getTDecl(ds, Name(t0))
+	td := getTDecl(ds, t0.name)
 	return td.GetSourceType().Underlying(ds)
 }
 
@@ -110,12 +121,14 @@ type PrimType interface {
 /* Defined primitive types - int32, float32, string, etc. */
 
 type TPrimitive struct {
-	tag Tag
+	tag  Tag
+	span base.Span // Source position, not part of node identity
 }
 
 var _ Type = TPrimitive{}
 
-func (t0 TPrimitive) Tag() Tag { return t0.tag }
+func (t0 TPrimitive) Tag() Tag           { return t0.tag }
+func (t0 TPrimitive) GetSpan() base.Span { return t0.span }
 
 func (t0 TPrimitive) Ok(ds []Decl) { /* nothing to check */ }
 
@@ -131,7 +144,7 @@ func (t0 TPrimitive) Equals(t base.Type) bool {
 	if _, ok := t_fg.(TPrimitive); !ok {
 		return false
 	}
-	return t0 == t_fg.(TPrimitive)
+	return t0.tag == t_fg.(TPrimitive).tag
 }
 
 func (t0 TPrimitive) String() string {
@@ -160,7 +173,7 @@ func (t0 UndefTPrimitive) AssignableTo(ds []Decl, t Type) (bool, Coercion) {
 	}
 	if t0.RepresentableBy(ds, t) {
 		coercion := func(expr FGExpr) FGExpr {
-			return Convert{t, expr}
+			return Convert{t, expr, base.Span{}}
 		}
 		return true, coercion
 	}
@@ -208,8 +221,11 @@ func (t0 UndefTPrimitive) Underlying(ds []Decl) Type {
 
 type STypeLit struct {
 	fDecls []FieldDecl
+	span   base.Span // Source position, not part of node identity
 }
 
+func (s STypeLit) GetSpan() base.Span { return s.span }
+
 var _ Type = STypeLit{}
 
 func (s STypeLit) GetFieldDecls() []FieldDecl { return s.fDecls }
@@ -218,9 +234,14 @@ func (s STypeLit) Ok(ds []Decl) {
 	fs := make(map[Name]FieldDecl)
 	for _, v := range s.fDecls {
 		if _, ok := fs[v.name]; ok {
-			panic("Multiple fields with name: " + v.name + "\n\t" + s.String())
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, s,
+				"Multiple fields with name: "+v.name))
 		}
 		fs[v.name] = v
+		if _, ok := v.t.(TNamed); v.embedded && !ok {
+			panic(base.NewDiagnostic(base.DIAG_OTHER, s,
+				"Embedded field must be a type name, not: "+v.t.String()))
+		}
 		v.t.Ok(ds)
 	}
 }
@@ -231,7 +252,7 @@ func (s STypeLit) AssignableTo(ds []Decl, t Type) (bool, Coercion) {
 	}
 	if s.Equals(t.Underlying(ds)) {
 		coercion := func(expr FGExpr) FGExpr {
-			return Convert{t, expr}
+			return Convert{t, expr, base.Span{}}
 		}
 		return true, coercion
 	}
@@ -272,22 +293,33 @@ func (s STypeLit) Underlying(ds []Decl) Type {
 
 // Rename FDecl?
 type FieldDecl struct {
-	name Name
-	t    Type
+	name     Name
+	t        Type
+	embedded bool      // An embedded field, named by its type name -- cf. selections
+	span     base.Span // Source position, not part of node identity
 }
 
+func (f FieldDecl) GetSpan() base.Span { return f.span }
+
 var _ FGNode = FieldDecl{}
 
-func (f FieldDecl) GetType() Type { return f.t }
+func (f FieldDecl) GetType() Type    { return f.t }
+func (f FieldDecl) IsEmbedded() bool { return f.embedded }
 
 // From Decl
 func (f FieldDecl) GetName() Name { return f.name }
 
 func (fd FieldDecl) Equals(other FieldDecl) bool {
-	return fd.name == other.name && fd.t.Equals(other.t)
+	return fd.name == other.name && fd.t.Equals(other.t) &&
+		fd.embedded == other.embedded
 }
 
+// N.B. an embedded field is written as its type, "t" -- unless its name is not
+// the type name, i.e., a monomorphised embedded field (cf. fgg.monomSTypeLit1)
 func (fd FieldDecl) String() string {
+	if fd.embedded && fd.name == fd.t.String() {
+		return fd.t.String()
+	}
 	return fd.name + " " + fd.t.String()
 }
 
@@ -296,8 +328,11 @@ func (fd FieldDecl) String() string {
 
 type ITypeLit struct {
 	specs []Spec
+	span  base.Span // Source position, not part of node identity
 }
 
+func (i ITypeLit) GetSpan() base.Span { return i.span }
+
 var _ Type = ITypeLit{}
 
 func (i ITypeLit) GetSpecs() []Spec { return i.specs }
@@ -308,13 +343,14 @@ func (i ITypeLit) Ok(ds []Decl) {
 		switch s := v.(type) {
 		case Sig:
 			if _, ok := seen[s.meth]; ok {
-				panic("Multiple sigs with name: " + s.meth + "\n\t" + i.String())
+				panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, i,
+					"Multiple sigs with name: "+s.meth))
 			}
 			seen[s.meth] = s
 		case Type:
 			if !isInterfaceType(ds, s) {
-				panic("Embedded type must be an interface, not: " + s.String() +
-					"\n\t" + s.String())
+				panic(base.NewDiagnostic(base.DIAG_NOT_INTERFACE, i,
+					"Embedded type must be an interface, not: "+s.String()))
 			}
 		}
 	}
@@ -379,6 +415,176 @@ func (i ITypeLit) Underlying(ds []Decl) Type {
 	return i
 }
 
+/******************************************************************************/
+/* Function types */
+
+// func(t1, ..., tn) t_ret -- the type of a function value (cf. FuncLit)
+type TFunc struct {
+	params []Type
+	t_ret  Type
+	span   base.Span // Source position, not part of node identity
+}
+
+func (f TFunc) GetSpan() base.Span { return f.span }
+
+var _ Type = TFunc{}
+
+func (f TFunc) GetParams() []Type { return f.params }
+func (f TFunc) GetReturn() Type   { return f.t_ret }
+
+func (f TFunc) Ok(ds []Decl) {
+	for _, v := range f.params {
+		v.Ok(ds)
+	}
+	f.t_ret.Ok(ds)
+}
+
+// Cf. STypeLit.AssignableTo
+func (f TFunc) AssignableTo(ds []Decl, t Type) (bool, Coercion) {
+	if EqualsOrImpls(ds, f, t) {
+		return true, noOpCoercion
+	}
+	if f.Equals(t.Underlying(ds)) {
+		coercion := func(expr FGExpr) FGExpr {
+			return Convert{t, expr, base.Span{}}
+		}
+		return true, coercion
+	}
+	return false, nil
+}
+
+func (f TFunc) Equals(t base.Type) bool {
+	other, ok := t.(TFunc)
+	if !ok || len(f.params) != len(other.params) {
+		return false
+	}
+	for i, v := range f.params {
+		if !v.Equals(other.params[i]) {
+			return false
+		}
+	}
+	return f.t_ret.Equals(other.t_ret)
+}
+
+func (f TFunc) String() string {
+	var b strings.Builder
+	b.WriteString("func(")
+	if len(f.params) > 0 {
+		b.WriteString(f.params[0].String())
+		for _, v := range f.params[1:] {
+			b.WriteString(", ")
+			b.WriteString(v.String())
+		}
+	}
+	b.WriteString(") ")
+	b.WriteString(f.t_ret.String())
+	return b.String()
+}
+
+func (f TFunc) Underlying(ds []Decl) Type {
+	return f
+}
+
+/******************************************************************************/
+/* Slice types */
+
+// []t_elem -- the type of a slice value (cf. SliceLit)
+type TSlice struct {
+	elem Type
+	span base.Span // Source position, not part of node identity
+}
+
+func (s TSlice) GetSpan() base.Span { return s.span }
+
+var _ Type = TSlice{}
+
+func (s TSlice) GetElem() Type { return s.elem }
+
+func (s TSlice) Ok(ds []Decl) {
+	s.elem.Ok(ds)
+}
+
+// Cf. STypeLit.AssignableTo
+func (s TSlice) AssignableTo(ds []Decl, t Type) (bool, Coercion) {
+	if EqualsOrImpls(ds, s, t) {
+		return true, noOpCoercion
+	}
+	if s.Equals(t.Underlying(ds)) {
+		coercion := func(expr FGExpr) FGExpr {
+			return Convert{t, expr, base.Span{}}
+		}
+		return true, coercion
+	}
+	return false, nil
+}
+
+func (s TSlice) Equals(t base.Type) bool {
+	other, ok := t.(TSlice)
+	return ok && s.elem.Equals(other.elem)
+}
+
+func (s TSlice) String() string {
+	return "[]" + s.elem.String()
+}
+
+func (s TSlice) Underlying(ds []Decl) Type {
+	return s
+}
+
+/******************************************************************************/
+/* Map types */
+
+// map[t_key]t_elem -- the type of a map value (cf. MapLit)
+type TMap struct {
+	key  Type
+	elem Type
+	span base.Span // Source position, not part of node identity
+}
+
+func (m TMap) GetSpan() base.Span { return m.span }
+
+var _ Type = TMap{}
+
+func (m TMap) GetKey() Type  { return m.key }
+func (m TMap) GetElem() Type { return m.elem }
+
+// The key type must be comparable, as in Go
+func (m TMap) Ok(ds []Decl) {
+	m.key.Ok(ds)
+	m.elem.Ok(ds)
+	if !isComparableType(ds, m.key) {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, m,
+			"Invalid map key type: "+m.key.String()))
+	}
+}
+
+// Cf. STypeLit.AssignableTo
+func (m TMap) AssignableTo(ds []Decl, t Type) (bool, Coercion) {
+	if EqualsOrImpls(ds, m, t) {
+		return true, noOpCoercion
+	}
+	if m.Equals(t.Underlying(ds)) {
+		coercion := func(expr FGExpr) FGExpr {
+			return Convert{t, expr, base.Span{}}
+		}
+		return true, coercion
+	}
+	return false, nil
+}
+
+func (m TMap) Equals(t base.Type) bool {
+	other, ok := t.(TMap)
+	return ok && m.key.Equals(other.key) && m.elem.Equals(other.elem)
+}
+
+func (m TMap) String() string {
+	return "map[" + m.key.String() + "]" + m.elem.String()
+}
+
+func (m TMap) Underlying(ds []Decl) Type {
+	return m
+}
+
 /******************************************************************************/
 /* Aux */
 
@@ -15,11 +15,14 @@ var _ = strconv.AppendBool
 
 /* Export */
 
-func IsStructType(ds []Decl, u Type) bool      { return isStructType(ds, u) }
-func IsIfaceType(ds []Decl, u Type) bool       { return isIfaceType(ds, u) }
-func IsIfaceLikeType(ds []Decl, u Type) bool   { return isIfaceLikeType(ds, u) }
-func NewTFormal(name TParam, u_I Type) TFormal { return TFormal{name, u_I} }
-func NewBigPsi(tFormals []TFormal) BigPsi      { return BigPsi{tFormals} }
+func IsStructType(ds []Decl, u Type) bool         { return isStructType(ds, u) }
+func IsIfaceType(ds []Decl, u Type) bool          { return isIfaceType(ds, u) }
+func IsEmbedding(ds []Decl, u TNamed) bool        { return isEmbedding(ds, u) }
+func IsIfaceLikeType(ds []Decl, u Type) bool      { return isIfaceLikeType(ds, u) }
+func WithUniverse(ds []Decl) []Decl               { return withUniverse(ds) }
+func ZeroValue(ds []Decl, u Type) (FGGExpr, bool) { return zeroValue(ds, u) }
+func NewTFormal(name TParam, u_I Type) TFormal    { return TFormal{name, u_I} }
+func NewBigPsi(tFormals []TFormal) BigPsi         { return BigPsi{tFormals} }
 
 /* Aliases from base */
 
@@ -71,15 +74,16 @@ func (Psi BigPsi) Ok(ds []Decl, env Delta) {
 	extendedEnv := env.Clone()
 	for _, v := range Psi.tFormals {
 		if _, ok := extendedEnv[v.name]; ok {
-			panic("Duplicate param name " + string(v.name) + " under context: " +
-				env.String() + "\n\t" + Psi.String())
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, Psi,
+				"Duplicate param name "+string(v.name)+" under context: "+
+					env.String()))
 		}
 		extendedEnv[v.name] = v.u_I
 	} // Delta built
 	for _, v := range Psi.tFormals {
 		if !isIfaceType(ds, v.u_I) {
-			panic("Upper bound must be an interface type: not " + v.u_I.String() +
-				"\n\t" + Psi.String())
+			panic(base.NewDiagnostic(base.DIAG_NOT_INTERFACE, Psi,
+				"Upper bound must be an interface type: not "+v.u_I.String()))
 		}
 		v.u_I.Ok(ds, extendedEnv) // Checks params bound under env -- N.B. can forward ref (not restricted left-to-right)
 	}
@@ -170,7 +174,7 @@ func (x0 SmallPsi) Equals(x SmallPsi) bool {
 
 /* Context, Type context, Substitutions */
 
-//type Gamma map[Variable]Type
+// type Gamma map[Variable]Type
 type Gamma map[Name]Type
 type Delta map[TParam]Type // Type intended to be an upper bound
 
@@ -259,9 +263,141 @@ type FGGExpr interface {
 	// gamma and delta should be treated immutably
 	Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Type, FGGExpr)
 	Eval(ds []Decl) (FGGExpr, string)
+	// Returns the inferred type and an annotated expr, i.e., with any omitted
+	// type actuals filled in (cf. Call.Infer)
+	Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) // todo retornar um Delta que representa bounds de TVar ainda nao unificada
+}
 
-	Infer(ds []Decl, delta Delta, gamma Gamma) Type // todo retornar um Delta que representa bounds de TVar ainda nao unificada
-
+/* Source spans */
+
+// Returns a copy of n located at span -- for the parser adaptor.
+// N.B. TParam is a bare name (a Delta key), so is returned unchanged (errors
+// on it are located by the enclosing node).
+func SetSpan(n FGGNode, span base.Span) FGGNode {
+	switch n1 := n.(type) {
+	case FGGProgram:
+		n1.span = span
+		return n1
+	case TypeDecl:
+		n1.span = span
+		return n1
+	case MethDecl:
+		n1.span = span
+		return n1
+	case FuncDecl:
+		n1.span = span
+		return n1
+	case ParamDecl:
+		n1.span = span
+		return n1
+	case Sig:
+		n1.span = span
+		return n1
+	case FieldDecl:
+		n1.span = span
+		return n1
+	case TNamed:
+		n1.span = span
+		return n1
+	case TPrimitive:
+		n1.span = span
+		return n1
+	case STypeLit:
+		n1.span = span
+		return n1
+	case ITypeLit:
+		n1.span = span
+		return n1
+	case TFunc:
+		n1.span = span
+		return n1
+	case TSlice:
+		n1.span = span
+		return n1
+	case TMap:
+		n1.span = span
+		return n1
+	case Variable:
+		n1.span = span
+		return n1
+	case StructLit:
+		n1.span = span
+		return n1
+	case Select:
+		n1.span = span
+		return n1
+	case Call:
+		n1.span = span
+		return n1
+	case FuncCall:
+		n1.span = span
+		return n1
+	case FuncLit:
+		n1.span = span
+		return n1
+	case Apply:
+		n1.span = span
+		return n1
+	case SliceLit:
+		n1.span = span
+		return n1
+	case Index:
+		n1.span = span
+		return n1
+	case Len:
+		n1.span = span
+		return n1
+	case Append:
+		n1.span = span
+		return n1
+	case MapLit:
+		n1.span = span
+		return n1
+	case MapAssign:
+		n1.span = span
+		return n1
+	case CommaOk:
+		n1.span = span
+		return n1
+	case Assert:
+		n1.span = span
+		return n1
+	case Convert:
+		n1.span = span
+		return n1
+	case Cond:
+		n1.span = span
+		return n1
+	case Let:
+		n1.span = span
+		return n1
+	case TypeSwitch:
+		n1.span = span
+		return n1
+	case Panic:
+		n1.span = span
+		return n1
+	case Sprintf:
+		n1.span = span
+		return n1
+	case UnaryOperation:
+		n1.span = span
+		return n1
+	case BinaryOperation:
+		n1.span = span
+		return n1
+	case Comparison:
+		n1.span = span
+		return n1
+	case PrimitiveLiteral:
+		n1.span = span
+		return n1
+	case TypedPrimitiveValue:
+		n1.span = span
+		return n1
+	default:
+		return n
+	}
 }
 
 /* Helpers */
@@ -3,6 +3,8 @@ package fgg
 import (
 	"fmt"
 	"strconv"
+
+	"github.com/rhu1/fgg/internal/base"
 )
 
 var _ = fmt.Errorf
@@ -13,6 +15,7 @@ func Bounds(delta Delta, u Type) Type          { return bounds(delta, u) }
 func Fields(ds []Decl, u_S TNamed) []FieldDecl { return fields(ds, u_S) }
 func Methods(ds []Decl, u Type) map[Name]Sig   { return methods(ds, u) }
 func GetTDecl(ds []Decl, t Name) TypeDecl      { return getTDecl(ds, t) }
+func GetFuncDecl(ds []Decl, f Name) FuncDecl   { return getFuncDecl(ds, f) }
 
 /* bounds(delta, u), fields(u_S), methods(u), body(u_S, m) */
 
@@ -34,6 +37,123 @@ func fields(ds []Decl, u_S Type) []FieldDecl {
 	return s.GetFieldDecls()
 }
 
+// A field or method found by selections: path is the sequence of embedded
+// fields to follow from the original type, and u is the type of the field
+// or, for a method, the type that declares it
+type selection struct {
+	path   []Name
+	u      Type
+	isMeth bool
+}
+
+// Go's selector rule: the field or method x of u at the shallowest depth
+// through embedded fields, cf. fields (depth 0) and methodsDelta.  Returns
+// the number of candidates at that depth -- 0 if none, >1 if ambiguous.
+func selections(ds []Decl, delta Delta, u Type, x Name) (selection, int) {
+	level := []selection{{[]Name{}, u, false}}
+	seen := make(map[string]bool) // Embedded types are TNamed, cf. STypeLit.Ok
+	for len(level) > 0 {
+		var res selection
+		n := 0
+		var next []selection
+		for _, v := range level {
+			if _, ok := declaredMethods(ds, delta, v.u)[x]; ok {
+				res = selection{v.path, v.u, true}
+				n++
+			}
+			s, ok := v.u.Underlying(ds).(STypeLit)
+			if !ok {
+				continue
+			}
+			for _, fd := range s.fDecls {
+				path := append(append([]Name{}, v.path...), fd.field)
+				if fd.field == x {
+					res = selection{path, fd.u, false}
+					n++
+				}
+				if fd.embedded && !seen[fd.u.String()] {
+					next = append(next, selection{path, fd.u, false})
+				}
+			}
+		}
+		if n > 0 {
+			return res, n
+		}
+		for _, v := range next { // N.B. equal-depth duplicates are not pruned, cf. ambiguity
+			seen[v.u.String()] = true
+		}
+		level = next
+	}
+	return selection{}, 0
+}
+
+// The methods of u itself, i.e., excluding those promoted from embedded fields
+func declaredMethods(ds []Decl, delta Delta, u Type) MethodSet {
+	if u_N, ok := u.(TNamed); ok && !isIfaceType(ds, u_N) {
+		res := make(MethodSet)
+		for _, v := range ds {
+			md, ok := v.(MethDecl)
+			if ok && md.t_recv == u_N.t_name {
+				if ok, eta := MakeEtaDelta(ds, delta, md.Psi_recv, u_N.u_args); ok {
+					res[md.name] = md.ToSig().SubsEtaOpen(eta)
+				}
+			}
+		}
+		return res
+	}
+	return methodsDelta(ds, delta, u)
+}
+
+// The names of all methods declared by the types embedded, at any depth, in u
+func promotableMethNames(ds []Decl, delta Delta, u TNamed) []Name {
+	var res []Name
+	seen := map[string]bool{u.String(): true}
+	todo := []Type{u}
+	for len(todo) > 0 {
+		s, ok := todo[0].Underlying(ds).(STypeLit)
+		todo = todo[1:]
+		if !ok {
+			continue
+		}
+		for _, fd := range s.fDecls {
+			if fd.embedded && !seen[fd.u.String()] {
+				seen[fd.u.String()] = true
+				todo = append(todo, fd.u)
+				for m := range declaredMethods(ds, delta, fd.u) {
+					res = append(res, m)
+				}
+			}
+		}
+	}
+	return res
+}
+
+// The (elaborated) selection of path from e, cf. selections
+func selectPath(e FGGExpr, path []Name) FGGExpr {
+	for _, f := range path {
+		e = Select{e, f, base.Span{}}
+	}
+	return e
+}
+
+// Pre: v is a value whose concrete type has (possibly promoted) method m
+// Returns the receiver value (and its concrete type) that declares m
+func methodRecv(ds []Decl, v FGGExpr, m Name) (FGGExpr, TNamed) {
+	for {
+		u := concreteType(v).(TNamed)
+		if _, ok := declaredMethods(ds, make(Delta), u)[m]; ok {
+			return v, u
+		}
+		sel, n := selections(ds, make(Delta), u, m)
+		if n != 1 || !sel.isMeth {
+			panic("Method not found: " + u.String() + "." + m)
+		}
+		for _, f := range sel.path {
+			v, _ = Select{v, f, base.Span{}}.Eval(ds)
+		}
+	}
+}
+
 // Go has no overloading, meth names are a unique key
 func methods(ds []Decl, u Type) MethodSet { // CHECKME: deprecate?
 	return methodsDelta(ds, make(Delta), u)
@@ -58,17 +178,18 @@ func methodsDelta(ds []Decl, delta Delta, u Type) MethodSet {
 	case TNamed:
 		// The method set of an interface type is its interface.
 		// The method set of any other TNamed T consists of all methods
-		// declared with receiver type T
+		// declared with receiver type T, and those promoted from its
+		// embedded fields (if unambiguous, cf. selections)
 		if u_I, ok := u_cast.Underlying(ds).(ITypeLit); ok {
 			return methodsDelta(ds, delta, u_I)
 		} else {
-			res := make(MethodSet)
-			for _, v := range ds {
-				md, ok := v.(MethDecl)
-				if ok && md.t_recv == u_cast.t_name {
-					if ok, eta := MakeEtaDelta(ds, delta, md.Psi_recv, u_cast.u_args); ok {
-						res[md.name] = md.ToSig().SubsEtaOpen(eta)
-					}
+			res := declaredMethods(ds, delta, u_cast)
+			for _, m := range promotableMethNames(ds, delta, u_cast) {
+				if _, ok := res[m]; ok {
+					continue
+				}
+				if sel, n := selections(ds, delta, u_cast, m); n == 1 && sel.isMeth {
+					res[m] = declaredMethods(ds, delta, sel.u)[m]
 				}
 			}
 			return res
@@ -82,7 +203,10 @@ func methodsDelta(ds []Decl, delta Delta, u Type) MethodSet {
 		//return methodsDelta(ds, delta, bounds(delta, u_cast)) // !!! delegate to bounds
 		return methodsDelta(ds, delta, upper)
 
-	case TPrimitive, UndefTPrimitive, STypeLit:
+	case FreshTVar: // Cf. FreshTVar.ImplsDelta
+		return methodsDelta(ds, delta, u_cast.bound)
+
+	case TPrimitive, UndefTPrimitive, STypeLit, TFunc, TSlice, TMap:
 		return MethodSet{} // primitives don't implement any methods
 
 	default:
@@ -92,23 +216,35 @@ func methodsDelta(ds []Decl, delta Delta, u Type) MethodSet {
 
 // Pre: t_S is a concrete type
 // Submission version, m(~\rho) informal notation
-//func body(ds []Decl, u_S TNamed, m Name, targs []Type) (Name, []Name, FGGExpr) {
+// func body(ds []Decl, u_S TNamed, m Name, targs []Type) (Name, []Name, FGGExpr) {
 func body(ds []Decl, u_S TNamed, m Name, targs []Type) (ParamDecl, []ParamDecl, FGGExpr) {
 	md := getMethDecl(ds, u_S.t_name, m)             // panics if not found
 	theta := MakeEtaOpen(md.Psi_recv, u_S.u_args)    // cf MakeEta
 	for i := 0; i < len(md.Psi_meth.tFormals); i++ { //TODO TSubs.add() ..., TSubs vs SubsEtaOpen
 		theta[md.Psi_meth.tFormals[i].name] = targs[i]
 	}
-	recv := ParamDecl{md.x_recv, u_S}
+	recv := NewParamDecl(md.x_recv, u_S)
 	pds := make([]ParamDecl, len(md.pDecls))
 	for i := 0; i < len(md.pDecls); i++ {
 		tmp := md.pDecls[i]
-		pds[i] = ParamDecl{tmp.name, tmp.u.SubsEtaOpen(theta)}
+		pds[i] = ParamDecl{tmp.name, tmp.u.SubsEtaOpen(theta), tmp.span}
 	}
 	//return md.x_recv, xs, md.e_body.TSubs(subs)
 	return recv, pds, md.e_body.TSubs(theta)
 }
 
+// Cf. body, for a top-level function
+func funcBody(ds []Decl, f Name, targs []Type) ([]ParamDecl, FGGExpr) {
+	fd := getFuncDecl(ds, f) // panics if not found
+	theta := MakeEtaOpen(fd.Psi, targs)
+	pds := make([]ParamDecl, len(fd.pDecls))
+	for i := 0; i < len(fd.pDecls); i++ {
+		tmp := fd.pDecls[i]
+		pds[i] = ParamDecl{tmp.name, tmp.u.SubsEtaOpen(theta), tmp.span}
+	}
+	return pds, fd.e_body.TSubs(theta)
+}
+
 // Represents the aux function type() defined in fig.16 of the paper.
 // Returns the exact run-time type of a value expression.
 func concreteType(e FGGExpr) Type {
@@ -119,6 +255,12 @@ func concreteType(e FGGExpr) Type {
 		return e1.typ
 	case PrimitiveLiteral:
 		return UndefTPrimitive{e1.tag}
+	case FuncLit:
+		return e1.GetType()
+	case SliceLit:
+		return e1.typ
+	case MapLit:
+		return e1.typ
 	}
 	panic("concreteType: expression is not a value: " + e.String())
 }
@@ -172,16 +314,198 @@ func makeParamIndexSubs(Psi BigPsi) EtaOpen {
 	return subs
 }
 
+// The zero value of u, if any -- FGG has no nil, so interface and function
+// types have none
+// Pre: u is closed, e.g., the element type of a map value
+func zeroValue(ds []Decl, u Type) (FGGExpr, bool) {
+	switch u1 := u.Underlying(ds).(type) {
+	case TPrimitive:
+		return TypedPrimitiveValue{zeroLiteral(u1.tag), u, base.Span{}}, true
+	case UndefTPrimitive:
+		return zeroLiteral(u1.tag), true
+	case STypeLit:
+		es := make([]FGGExpr, len(u1.fDecls))
+		for i, v := range u1.fDecls {
+			e, ok := zeroValue(ds, v.u)
+			if !ok {
+				return nil, false
+			}
+			es[i] = e
+		}
+		return StructLit{u, es, base.Span{}}, true
+	case TSlice:
+		return SliceLit{u, []FGGExpr{}, base.Span{}}, true
+	case TMap:
+		return MapLit{u, []MapEntry{}, base.Span{}}, true
+	}
+	return nil, false
+}
+
+// Whether u has a zero value, cf. zeroValue -- u may be open: a type param
+// has one if its bound has a type set, each type of which has one
+func hasZeroValue(ds []Decl, delta Delta, u Type) bool {
+	if a, ok := u.(TParam); ok {
+		u_I, ok := delta[a]
+		if !ok {
+			return false
+		}
+		ts := typeSetDelta(ds, delta, u_I).terms
+		for _, v := range ts {
+			if !hasZeroValue(ds, delta, v.u) {
+				return false
+			}
+		}
+		return len(ts) > 0
+	}
+	switch u1 := u.Underlying(ds).(type) {
+	case TPrimitive, UndefTPrimitive, TSlice, TMap:
+		return true
+	case STypeLit:
+		for _, v := range u1.fDecls {
+			if !hasZeroValue(ds, delta, v.u) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Why u has no zero value (cf. hasZeroValue), e.g., "I() is an interface type"
+func noZeroValueCause(ds []Decl, delta Delta, u Type) string {
+	if a, ok := u.(TParam); ok {
+		u_I, ok := delta[a]
+		if !ok {
+			return u.String() + " has no zero value"
+		}
+		ts := typeSetDelta(ds, delta, u_I)
+		for _, v := range ts.terms {
+			if !hasZeroValue(ds, delta, v.u) {
+				return "type param " + u.String() + ", with " + v.u.String() +
+					" in the type set of " + u_I.String() + ": " +
+					noZeroValueCause(ds, delta, v.u)
+			}
+		}
+		return "type param " + u.String() + ": the type set of " + u_I.String() +
+			" is " + ts.String()
+	}
+	switch u1 := u.Underlying(ds).(type) {
+	case ITypeLit:
+		return u.String() + " is an interface type"
+	case TFunc:
+		return u.String() + " is a function type"
+	case STypeLit:
+		for _, v := range u1.fDecls {
+			if !hasZeroValue(ds, delta, v.u) {
+				return "field " + v.field + " of " + u.String() + ": " +
+					noZeroValueCause(ds, delta, v.u)
+			}
+		}
+	}
+	return u.String() + " has no zero value"
+}
+
+func zeroLiteral(tag Tag) PrimitiveLiteral {
+	var payload interface{}
+	switch tag {
+	case BOOL:
+		payload = false
+	case INT32:
+		payload = int32(0)
+	case INT64:
+		payload = int64(0)
+	case FLOAT32:
+		payload = float32(0)
+	case FLOAT64:
+		payload = float64(0)
+	case STRING:
+		payload = ""
+	}
+	return PrimitiveLiteral{payload, tag, base.Span{}}
+}
+
 /* Additional */
 
+// N.B. falls back to the predeclared types, cf. universe
 func getTDecl(ds []Decl, t Name) TypeDecl {
+	if td, ok := lookupTDecl(ds, t); ok {
+		return td
+	}
+	panic(base.NewDiagnostic(base.DIAG_UNKNOWN_TYPE, nil, "Type not found: "+t))
+}
+
+func isTypeName(ds []Decl, t Name) bool {
+	_, ok := lookupTDecl(ds, t)
+	return ok
+}
+
+// Scans ds, then universe -- same result as scanning withUniverse(ds), which
+// this avoids allocating on every lookup
+func lookupTDecl(ds []Decl, t Name) (TypeDecl, bool) {
+	for _, v := range ds {
+		if td, ok := v.(TypeDecl); ok && td.GetName() == t {
+			return td, true
+		}
+	}
+	for _, v := range universe {
+		if td := v.(TypeDecl); td.GetName() == t {
+			return td, true
+		}
+	}
+	return TypeDecl{}, false
+}
+
+/* Predeclared types */
+
+const (
+	ANY        Name = "any"
+	COMPARABLE Name = "comparable"
+)
+
+// type any(type ) interface {}
+// type comparable(type ) interface { comparable } -- cf. comparableElem
+// Both are ordinary interfaces for monom/oblit, cf. withUniverse
+var universe = []Decl{
+	NewTypeDecl(ANY, NewBigPsi(nil), NewITypeLit(nil, nil)),
+	NewTypeDecl(COMPARABLE, NewBigPsi(nil), NewITypeLit([]Spec{comparableElem{}}, nil)),
+}
+
+// The predeclared types not (re)declared in ds, followed by ds
+func withUniverse(ds []Decl) []Decl {
+	var res []Decl
+	for _, v := range universe {
+		t := v.(TypeDecl).GetName()
+		declared := false
+		for _, d := range ds {
+			if td, ok := d.(TypeDecl); ok && td.GetName() == t {
+				declared = true
+				break
+			}
+		}
+		if !declared {
+			res = append(res, v)
+		}
+	}
+	return append(res, ds...)
+}
+
+func isFuncName(ds []Decl, f Name) bool {
 	for _, v := range ds {
-		td, ok := v.(TypeDecl)
-		if ok && td.GetName() == t {
-			return td
+		if fd, ok := v.(FuncDecl); ok && fd.GetName() == f {
+			return true
+		}
+	}
+	return false
+}
+
+func getFuncDecl(ds []Decl, f Name) FuncDecl {
+	for _, d := range ds {
+		fd, ok := d.(FuncDecl)
+		if ok && fd.name == f {
+			return fd
 		}
 	}
-	panic("Type not found: " + t)
+	panic(base.NewDiagnostic(base.DIAG_UNKNOWN_FUNC, nil, "Function not found: "+f))
 }
 
 func getMethDecl(ds []Decl, recv Name, m Name) MethDecl {
@@ -191,5 +515,6 @@ func getMethDecl(ds []Decl, recv Name, m Name) MethDecl {
 			return md
 		}
 	}
-	panic("Method not found: " + recv + "." + m)
+	panic(base.NewDiagnostic(base.DIAG_UNKNOWN_METHOD, nil,
+		"Method not found: "+recv+"."+m))
 }
@@ -40,6 +40,13 @@ func (c *fg2fgg) convert() error {
 			}
 			c.fggProg.decls = append(c.fggProg.decls, mDecl)
 
+		case fg.FuncDecl:
+			fDecl, err := c.convertFuncDecl(decl)
+			if err != nil {
+				return err
+			}
+			c.fggProg.decls = append(c.fggProg.decls, fDecl)
+
 		default:
 			return fmt.Errorf("unknown declaration type: %T", decl)
 		}
@@ -60,17 +67,17 @@ func (c *fg2fgg) convertTDecl(td fg.TypeDecl) (TypeDecl, error) {
 	if err != nil {
 		return TypeDecl{}, err
 	}
-	return TypeDecl{td.GetName(), emptyPsi, src}, nil
+	return TypeDecl{td.GetName(), emptyPsi, src, td.GetSpan()}, nil
 }
 
 // convertType converts a fg type to a fgg (parameterised) type
 func (c *fg2fgg) convertType(t fg.Type) (Type, error) {
 	switch t := t.(type) {
 	case fg.TNamed:
-		return TNamed{t.String(), nil}, nil
+		return NewTNamed(t.String(), nil), nil
 
 	case fg.TPrimitive:
-		return TPrimitive{Tag(t.Tag())}, nil
+		return NewTPrimitive(Tag(t.Tag())), nil
 
 	case fg.UndefTPrimitive:
 		return UndefTPrimitive{Tag(t.Tag())}, nil
@@ -81,6 +88,39 @@ func (c *fg2fgg) convertType(t fg.Type) (Type, error) {
 	case fg.ITypeLit:
 		return c.convertITypeLit(t)
 
+	case fg.TFunc:
+		var params []Type
+		for _, v := range t.GetParams() {
+			u, err := c.convertType(v)
+			if err != nil {
+				return nil, err
+			}
+			params = append(params, u)
+		}
+		ret, err := c.convertType(t.GetReturn())
+		if err != nil {
+			return nil, err
+		}
+		return TFunc{params, ret, t.GetSpan()}, nil
+
+	case fg.TSlice:
+		elem, err := c.convertType(t.GetElem())
+		if err != nil {
+			return nil, err
+		}
+		return TSlice{elem, t.GetSpan()}, nil
+
+	case fg.TMap:
+		key, err := c.convertType(t.GetKey())
+		if err != nil {
+			return nil, err
+		}
+		elem, err := c.convertType(t.GetElem())
+		if err != nil {
+			return nil, err
+		}
+		return TMap{key, elem, t.GetSpan()}, nil
+
 	default:
 		return nil, fmt.Errorf("unknown fg.Type type: %T", t)
 	}
@@ -95,7 +135,7 @@ func (c *fg2fgg) convertSTypeLit(s fg.STypeLit) (STypeLit, error) {
 		}
 		fieldDecls = append(fieldDecls, fd)
 	}
-	return STypeLit{fieldDecls}, nil
+	return STypeLit{fieldDecls, s.GetSpan()}, nil
 }
 
 func (c *fg2fgg) convertITypeLit(i fg.ITypeLit) (ITypeLit, error) {
@@ -119,13 +159,14 @@ func (c *fg2fgg) convertITypeLit(i fg.ITypeLit) (ITypeLit, error) {
 				Psi:    BigPsi{tFormals: nil},
 				pDecls: paramDecls,
 				u_ret:  retTypeName,
+				span:   sig.GetSpan(),
 			})
 		case fg.TNamed:
 			emb, _ := c.convertType(spec)
 			specs = append(specs, emb.(TNamed))
 		}
 	}
-	return ITypeLit{specs, nil}, nil
+	return ITypeLit{specs, nil, i.GetSpan()}, nil
 }
 
 func (c *fg2fgg) convertFieldDecl(fd fg.FieldDecl) (FieldDecl, error) {
@@ -133,7 +174,7 @@ func (c *fg2fgg) convertFieldDecl(fd fg.FieldDecl) (FieldDecl, error) {
 	if err != nil {
 		return FieldDecl{}, err
 	}
-	return FieldDecl{field: fd.GetName(), u: typeName}, nil
+	return FieldDecl{field: fd.GetName(), u: typeName, embedded: fd.IsEmbedded(), span: fd.GetSpan()}, nil
 }
 
 func (c *fg2fgg) convertParamDecl(pd fg.ParamDecl) (ParamDecl, error) {
@@ -141,7 +182,7 @@ func (c *fg2fgg) convertParamDecl(pd fg.ParamDecl) (ParamDecl, error) {
 	if err != nil {
 		return ParamDecl{}, err
 	}
-	return ParamDecl{name: pd.GetName(), u: typeName}, nil
+	return ParamDecl{name: pd.GetName(), u: typeName, span: pd.GetSpan()}, nil
 }
 
 func (c *fg2fgg) convertMDecl(md fg.MethDecl) (MethDecl, error) {
@@ -171,13 +212,40 @@ func (c *fg2fgg) convertMDecl(md fg.MethDecl) (MethDecl, error) {
 		pDecls:   paramDecls,
 		u_ret:    retType,
 		e_body:   methImpl,
+		span:     md.GetSpan(),
+	}, nil
+}
+
+func (c *fg2fgg) convertFuncDecl(fd fg.FuncDecl) (FuncDecl, error) {
+	var paramDecls []ParamDecl
+	for _, p := range fd.GetParamDecls() {
+		pd, err := c.convertParamDecl(p)
+		if err != nil {
+			return FuncDecl{}, err
+		}
+		paramDecls = append(paramDecls, pd)
+	}
+
+	retType, _ := c.convertType(fd.GetReturn())
+	funcImpl, err := c.convertExpr(fd.GetBody())
+	if err != nil {
+		return FuncDecl{}, err
+	}
+
+	return FuncDecl{
+		name:   Name(fd.GetName()),
+		Psi:    BigPsi{nil}, // empty parameter
+		pDecls: paramDecls,
+		u_ret:  retType,
+		e_body: funcImpl,
+		span:   fd.GetSpan(),
 	}, nil
 }
 
 func (c *fg2fgg) convertExpr(expr base.Expr) (FGGExpr, error) {
 	switch expr := expr.(type) {
 	case fg.Variable:
-		return Variable{name: expr.String()}, nil
+		return Variable{name: expr.String(), span: expr.GetSpan()}, nil
 
 	case fg.StructLit:
 		sLitExpr, err := c.convertStructLit(expr)
@@ -193,12 +261,23 @@ func (c *fg2fgg) convertExpr(expr base.Expr) (FGGExpr, error) {
 		}
 		return callExpr, nil
 
+	case fg.FuncCall:
+		var args []FGGExpr
+		for _, arg := range expr.GetArgs() {
+			argExpr, err := c.convertExpr(arg)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, argExpr)
+		}
+		return FuncCall{fun: Name(expr.GetFunc()), args: args, span: expr.GetSpan()}, nil
+
 	case fg.Select:
 		selExpr, err := c.convertExpr(expr.GetExpr())
 		if err != nil {
 			return nil, err
 		}
-		return Select{e_S: selExpr, field: Name(expr.GetField())}, nil
+		return Select{e_S: selExpr, field: Name(expr.GetField()), span: expr.GetSpan()}, nil
 
 	case fg.Assert:
 		assertExpr, err := c.convertExpr(expr.GetExpr())
@@ -206,12 +285,137 @@ func (c *fg2fgg) convertExpr(expr base.Expr) (FGGExpr, error) {
 			return nil, err
 		}
 		assType, _ := c.convertType(expr.GetType())
-		return Assert{e_I: assertExpr, u_cast: assType}, nil
+		return Assert{e_I: assertExpr, u_cast: assType, span: expr.GetSpan()}, nil
+
+	case fg.Let:
+		letExpr, err := c.convertLet(expr)
+		if err != nil {
+			return nil, err
+		}
+		return letExpr, nil
+
+	case fg.FuncLit:
+		return c.convertFuncLit(expr)
+
+	case fg.Apply:
+		funExpr, err := c.convertExpr(expr.GetFunc())
+		if err != nil {
+			return nil, err
+		}
+		var args []FGGExpr
+		for _, arg := range expr.GetArgs() {
+			argExpr, err := c.convertExpr(arg)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, argExpr)
+		}
+		return Apply{e_fun: funExpr, args: args, span: expr.GetSpan()}, nil
+
+	case fg.SliceLit:
+		sliceType, err := c.convertType(expr.GetType())
+		if err != nil {
+			return nil, err
+		}
+		elems, err := c.convertExprs(expr.GetElems())
+		if err != nil {
+			return nil, err
+		}
+		return SliceLit{typ: sliceType, elems: elems, span: expr.GetSpan()}, nil
+
+	case fg.MapLit:
+		mapType, err := c.convertType(expr.GetType())
+		if err != nil {
+			return nil, err
+		}
+		var entries []MapEntry
+		for _, v := range expr.GetEntries() {
+			kv, err := c.convertExprs([]fg.FGExpr{v.GetKey(), v.GetVal()})
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, MapEntry{kv[0], kv[1]})
+		}
+		return MapLit{typ: mapType, entries: entries, span: expr.GetSpan()}, nil
+
+	case fg.MapAssign:
+		es, err := c.convertExprs([]fg.FGExpr{expr.GetMap(), expr.GetKey(), expr.GetVal(),
+			expr.GetBody()})
+		if err != nil {
+			return nil, err
+		}
+		return MapAssign{x: Name(expr.GetVar()), e_M: es[0], e_key: es[1], e_val: es[2],
+			e_body: es[3], span: expr.GetSpan()}, nil
+
+	case fg.CommaOk:
+		es, err := c.convertExprs([]fg.FGExpr{expr.GetDef(), expr.GetBody()})
+		if err != nil {
+			return nil, err
+		}
+		return CommaOk{x: Name(expr.GetVar()), x_ok: Name(expr.GetOkVar()), e_def: es[0],
+			e_body: es[1], span: expr.GetSpan()}, nil
+
+	case fg.TypeSwitch:
+		return c.convertTypeSwitch(expr)
+
+	case fg.Panic:
+		arg, err := c.convertExpr(expr.GetArg())
+		if err != nil {
+			return nil, err
+		}
+		var u Type // nil until SetBodyType
+		if expr.GetType() != nil {
+			if u, err = c.convertType(expr.GetType()); err != nil {
+				return nil, err
+			}
+		}
+		return Panic{e_arg: arg, typ: u, span: expr.GetSpan()}, nil
+
+	case fg.Index:
+		sliceExpr, err := c.convertExpr(expr.GetExpr())
+		if err != nil {
+			return nil, err
+		}
+		idxExpr, err := c.convertExpr(expr.GetIndex())
+		if err != nil {
+			return nil, err
+		}
+		return Index{e_S: sliceExpr, e_idx: idxExpr, span: expr.GetSpan()}, nil
+
+	case fg.Len:
+		sliceExpr, err := c.convertExpr(expr.GetExpr())
+		if err != nil {
+			return nil, err
+		}
+		return Len{e_S: sliceExpr, span: expr.GetSpan()}, nil
+
+	case fg.Append:
+		sliceExpr, err := c.convertExpr(expr.GetExpr())
+		if err != nil {
+			return nil, err
+		}
+		elems, err := c.convertExprs(expr.GetElems())
+		if err != nil {
+			return nil, err
+		}
+		return Append{e_S: sliceExpr, elems: elems, span: expr.GetSpan()}, nil
 	}
 
 	return nil, fmt.Errorf("unknown expression type: %T", expr)
 }
 
+func (c *fg2fgg) convertExprs(es []fg.FGExpr) ([]FGGExpr, error) {
+	var res []FGGExpr
+	for _, e := range es {
+		converted, err := c.convertExpr(e)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, converted)
+	}
+	return res, nil
+}
+
 func (c *fg2fgg) convertStructLit(sLit fg.StructLit) (StructLit, error) {
 	structType, _ := c.convertType(sLit.GetType())
 
@@ -224,7 +428,7 @@ func (c *fg2fgg) convertStructLit(sLit fg.StructLit) (StructLit, error) {
 		es = append(es, fieldExpr)
 	}
 
-	return StructLit{u_S: structType.(TNamed), elems: es}, nil
+	return StructLit{u_S: structType.(TNamed), elems: es, span: sLit.GetSpan()}, nil
 }
 
 func (c *fg2fgg) convertCall(call fg.Call) (Call, error) {
@@ -242,5 +446,79 @@ func (c *fg2fgg) convertCall(call fg.Call) (Call, error) {
 		args = append(args, argExpr)
 	}
 
-	return Call{e_recv: e, meth: Name(call.GetMethod()), args: args}, nil
+	return Call{e_recv: e, meth: Name(call.GetMethod()), args: args, span: call.GetSpan()}, nil
+}
+
+func (c *fg2fgg) convertLet(let fg.Let) (Let, error) {
+	var u Type // nil for "x := e"
+	if let.GetType() != nil {
+		var err error
+		if u, err = c.convertType(let.GetType()); err != nil {
+			return Let{}, err
+		}
+	}
+	def, err := c.convertExpr(let.GetDef())
+	if err != nil {
+		return Let{}, err
+	}
+	body, err := c.convertExpr(let.GetBody())
+	if err != nil {
+		return Let{}, err
+	}
+	return Let{x: Name(let.GetVar()), u: u, e_def: def, e_body: body, span: let.GetSpan()}, nil
+}
+
+func (c *fg2fgg) convertTypeSwitch(s fg.TypeSwitch) (TypeSwitch, error) {
+	e_I, err := c.convertExpr(s.GetExpr())
+	if err != nil {
+		return TypeSwitch{}, err
+	}
+	var cases []TypeCase
+	for _, v := range s.GetCases() {
+		u, err := c.convertType(v.GetType())
+		if err != nil {
+			return TypeSwitch{}, err
+		}
+		body, err := c.convertExpr(v.GetBody())
+		if err != nil {
+			return TypeSwitch{}, err
+		}
+		cases = append(cases, TypeCase{u, body})
+	}
+	var e_def FGGExpr // nil if no default
+	if s.GetDefault() != nil {
+		if e_def, err = c.convertExpr(s.GetDefault()); err != nil {
+			return TypeSwitch{}, err
+		}
+	}
+	var u Type // nil until SetBodyType
+	if s.GetType() != nil {
+		if u, err = c.convertType(s.GetType()); err != nil {
+			return TypeSwitch{}, err
+		}
+	}
+	return TypeSwitch{x: Name(s.GetVar()), e_I: e_I, cases: cases, e_def: e_def, typ: u,
+		span: s.GetSpan()}, nil
+}
+
+// N.B. the source is as parsed, so the FuncLit is not (yet) converted to a
+// defined function type
+func (c *fg2fgg) convertFuncLit(f fg.FuncLit) (FuncLit, error) {
+	var paramDecls []ParamDecl
+	for _, p := range f.GetParamDecls() {
+		pd, err := c.convertParamDecl(p)
+		if err != nil {
+			return FuncLit{}, err
+		}
+		paramDecls = append(paramDecls, pd)
+	}
+	retType, err := c.convertType(f.GetReturn())
+	if err != nil {
+		return FuncLit{}, err
+	}
+	body, err := c.convertExpr(f.GetBody())
+	if err != nil {
+		return FuncLit{}, err
+	}
+	return FuncLit{pDecls: paramDecls, u_ret: retType, e_body: body, span: f.GetSpan()}, nil
 }
@@ -20,11 +20,11 @@ var _ = reflect.Append
 
 // TODO: rename NewFGGProgram
 func NewProgram(ds []Decl, e FGGExpr, printf bool) FGGProgram {
-	return FGGProgram{ds, e, printf}
+	return FGGProgram{ds, e, printf, base.Span{}}
 }
 
 func NewTypeDecl(name Name, Psi BigPsi, srcType Type) TypeDecl {
-	return TypeDecl{name, Psi, srcType}
+	return TypeDecl{name, Psi, srcType, base.Span{}}
 }
 
 func NewMethDecl(
@@ -36,7 +36,7 @@ func NewMethDecl(
 	pDecls []ParamDecl,
 	u_ret Type,
 	e_body FGGExpr) MethDecl {
-	return MethDecl{x_recv, t_recv, Psi_recv, name, Psi_meth, pDecls, u_ret, e_body}
+	return MethDecl{x_recv, t_recv, Psi_recv, name, Psi_meth, pDecls, u_ret, e_body, base.Span{}}
 }
 
 // TODO: rename NewMethDecl
@@ -48,11 +48,15 @@ func NewMDecl(x_recv Name,
 	pDecls []ParamDecl,
 	u_ret Type,
 	e_body FGGExpr) MethDecl {
-	return MethDecl{x_recv, t_recv, Psi_recv, name, Psi_meth, pDecls, u_ret, e_body}
+	return MethDecl{x_recv, t_recv, Psi_recv, name, Psi_meth, pDecls, u_ret, e_body, base.Span{}}
 }
-func NewFieldDecl(f Name, t Type) FieldDecl                  { return FieldDecl{f, t} }
-func NewParamDecl(x Name, t Type) ParamDecl                  { return ParamDecl{x, t} }     // For fgg_monom.MakeWMap
-func NewSig(m Name, Psi BigPsi, pds []ParamDecl, t Type) Sig { return Sig{m, Psi, pds, t} } // For fgg_monom.MakeWMap
+func NewFuncDecl(name Name, Psi BigPsi, pDecls []ParamDecl, u_ret Type, e_body FGGExpr) FuncDecl {
+	return FuncDecl{name, Psi, pDecls, u_ret, e_body, base.Span{}}
+}
+func NewFieldDecl(f Name, t Type) FieldDecl                  { return FieldDecl{f, t, false, base.Span{}} }
+func NewEmbeddedFieldDecl(f Name, t Type) FieldDecl          { return FieldDecl{f, t, true, base.Span{}} }
+func NewParamDecl(x Name, t Type) ParamDecl                  { return ParamDecl{x, t, base.Span{}} }     // For fgg_monom.MakeWMap
+func NewSig(m Name, Psi BigPsi, pds []ParamDecl, t Type) Sig { return Sig{m, Psi, pds, t, base.Span{}} } // For fgg_monom.MakeWMap
 
 /* Program */
 
@@ -61,8 +65,11 @@ type FGGProgram struct {
 	e_main FGGExpr
 	printf bool // false = "original" `_ = e_main` syntax; true = import-fmt/printf syntax
 	// N.B. coincidentally "behaves" like an actual printf because interpreter prints out final eval result
+	span base.Span // From "package" to EOF -- for placing comments, cf. Format
 }
 
+func (p FGGProgram) GetSpan() base.Span { return p.span }
+
 var _ base.Program = FGGProgram{}
 var _ FGGNode = FGGProgram{}
 
@@ -70,54 +77,112 @@ func (p FGGProgram) GetDecls() []Decl   { return p.decls } // Return a copy?
 func (p FGGProgram) GetMain() base.Expr { return p.e_main }
 func (p FGGProgram) IsPrintf() bool     { return p.printf } // HACK
 
+// Panics with the first Diagnostic found, if any -- cf. Check
 func (p FGGProgram) Ok(allowStupid bool, mode base.TypingMode) (base.Type, base.Program) {
+	typ, p1, errs := p.Check(allowStupid, mode)
+	base.Diagnostics(errs).PanicIfAny()
+	return typ, p1
+}
+
+// Each decl (and main) is checked independently, so all of them are reported.
+// The returned Type is nil if main is not well typed.
+func (p FGGProgram) Check(allowStupid bool, mode base.TypingMode) (base.Type, base.Program, []base.Diagnostic) {
+	var errs base.Diagnostics
+	// Checked decls (e.g., method bodies, cf. okRet) replace the originals in a
+	// copy -- the checked program is returned, p itself is left unchanged
+	p.decls = append([]Decl{}, p.decls...)
 	tds := make(map[string]TypeDecl) // Type name
 	mds := make(map[string]MethDecl) // Hack, string = md.recv.t + "." + md.name
+	fds := make(map[string]FuncDecl) // Function name
 	for i, v := range p.decls {
-		switch d := v.(type) {
-		case TypeDecl:
-			d.Ok(p.decls)
-			t := d.GetName()
-			if _, ok := tds[t]; ok {
-				panic("Multiple declarations of type name: " + t + "\n\t" +
-					d.String())
+		errs.Catch(v, func() {
+			switch d := v.(type) {
+			case TypeDecl:
+				t := d.GetName()
+				if _, ok := tds[t]; ok {
+					panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, d,
+						"Multiple declarations of type name: "+t))
+				}
+				tds[t] = d
+				d.Ok(p.decls)
+			case MethDecl:
+				hash := string(d.t_recv) + "." + d.name
+				if _, ok := mds[hash]; ok {
+					panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, d,
+						"Multiple declarations for receiver "+string(d.t_recv)+
+							" of the method name: "+d.name))
+				}
+				mds[hash] = d
+				if mode == base.CHECK {
+					//d.Ok(p.decls)
+					d = d.okRet(p.decls)
+				} else if mode == base.INFER {
+					d = d.OkInfer(p.decls).okRet(p.decls)
+				}
+				mds[hash] = d
+				p.decls[i] = d
+			case FuncDecl:
+				f := d.GetName()
+				if _, ok := fds[f]; ok {
+					panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, d,
+						"Multiple declarations of function name: "+f))
+				}
+				if isTypeName(p.decls, f) {
+					panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, d,
+						"Function name already declared as a type: "+f))
+				}
+				fds[f] = d
+				if mode == base.CHECK {
+					d = d.okRet(p.decls)
+				} else if mode == base.INFER {
+					d = d.OkInfer(p.decls).okRet(p.decls)
+				}
+				p.decls[i] = d
+			default:
+				panic("Unknown decl: " + reflect.TypeOf(v).String() + "\n\t" +
+					v.String())
 			}
-			tds[t] = d
-		case MethDecl:
-			if mode == base.CHECK {
-				//d.Ok(p.decls)
-				d = d.okRet(p.decls)
-			} else if mode == base.INFER {
-				d.OkInfer(p.decls)
-			}
-			hash := string(d.t_recv) + "." + d.name
-			if _, ok := mds[hash]; ok {
-				panic("Multiple declarations for receiver " + string(d.t_recv) +
-					" of the method name: " + d.name + "\n\t" + d.String())
-			}
-			mds[hash] = d
-			p.decls[i] = d
-		default:
-			panic("Unknown decl: " + reflect.TypeOf(v).String() + "\n\t" +
-				v.String())
-		}
+		})
 	}
 	// Empty envs for main
 	var delta Delta
 	var gamma Gamma
 	var typ Type
 	e_main := p.e_main
-	if mode == base.CHECK {
-		typ, e_main = p.e_main.Typing(p.decls, delta, gamma, allowStupid)
-	} else if mode == base.INFER {
-		typ = p.e_main.Infer(p.decls, delta, gamma) // TODO should return the same Ast or an annotated one?
-	}
-	return typ, FGGProgram{p.decls, e_main, p.printf}
+	errs.Catch(p.e_main, func() {
+		if mode == base.CHECK {
+			typ, e_main = p.e_main.Typing(p.decls, delta, gamma, allowStupid)
+		} else if mode == base.INFER {
+			// The annotated main (inferred type actuals filled in) is then typed
+			// as in CHECK mode, for the elaborated Ast (cf. Typing)
+			_, e_main = p.e_main.Infer(p.decls, delta, gamma)
+			typ, e_main = e_main.Typing(p.decls, delta, gamma, allowStupid)
+		}
+	})
+	return typ, FGGProgram{p.decls, e_main, p.printf, p.span}, errs
 }
 
-func (p FGGProgram) Eval() (base.Program, string) {
+// E[panic(v)] --> panic(v): a raised panic unwinds the eval context (cf.
+// Panic.Eval), and is caught here -- at the type of main, cf. Panic.typ
+func (p FGGProgram) Eval() (res base.Program, rule string) {
+	if p.e_main.IsPanic() {
+		panic("Cannot reduce: " + p.e_main.String())
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			p1, ok := r.(Panic)
+			if !ok {
+				panic(r)
+			}
+			var delta Delta // Empty envs for main
+			var gamma Gamma
+			u, _ := p.e_main.Typing(p.decls, delta, gamma, true)
+			res = FGGProgram{p.decls, Panic{p1.e_arg, u, p1.span}, p.printf, p.span}
+			rule = "Panic"
+		}
+	}()
 	e, rule := p.e_main.Eval(p.decls)
-	return FGGProgram{p.decls, e.(FGGExpr), p.printf}, rule
+	return FGGProgram{p.decls, e.(FGGExpr), p.printf, p.span}, rule
 }
 
 func (p FGGProgram) String() string {
@@ -155,8 +220,11 @@ type MethDecl struct {
 	pDecls   []ParamDecl
 	u_ret    Type // Return
 	e_body   FGGExpr
+	span     base.Span // Source position, not part of node identity
 }
 
+func (md MethDecl) GetSpan() base.Span { return md.span }
+
 var _ Decl = MethDecl{}
 
 func (md MethDecl) GetRecvName() Name          { return md.x_recv }
@@ -181,35 +249,41 @@ func (md MethDecl) okRet(ds []Decl) MethDecl {
 
 	ok, coercion := u.AssignableToDelta(ds, delta, md.u_ret)
 	if !ok {
-		panic("Method body must be assignable to declared return type: found=" +
-			u.String() + ", expected=" + md.u_ret.String() + "\n\t" + md.String())
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, md,
+			"Method body must be assignable to declared return type: found="+
+				u.String()+", expected="+md.u_ret.String()))
 	}
 
-	md.e_body = coercion(e_body)
+	md.e_body = coerceBody(e_body, coercion)
 	return md
 }
 
-func (md MethDecl) OkInfer(ds []Decl) {
+// Returns an updated MethDecl, with the body annotated by inference, cf.
+// FGGExpr.Infer -- cf. okRet for the (subsequent) Typing
+func (md MethDecl) OkInfer(ds []Decl) MethDecl {
 	delta, gamma := md.okBase(ds)
 
-	u := md.e_body.Infer(ds, delta, gamma)
-	NewSubtypeConstr(u, md.u_ret).Unify(ds, delta) // todo maybe handle the error?
+	u, e_body := md.e_body.Infer(ds, delta, gamma)
+	subs := NewSubtypeConstr(u, md.u_ret, originAt(md.e_body, "result")).Unify(ds, delta)
+	md.e_body = e_body.TSubs(subs)
+	return md
 }
 
 func (md MethDecl) okBase(ds []Decl) (Delta, Gamma) {
 	// (type t_S(Phi') T ) ∈ D
 	recv_decl := getTDecl(ds, md.t_recv) // panics if not found
 	if isIfaceType(ds, recv_decl.GetSourceType()) {
-		panic("Invalid receiver type: " + md.t_recv +
-			"\n\t" + md.String())
+		panic(base.NewDiagnostic(base.DIAG_BAD_RECEIVER, md,
+			"Invalid receiver type: "+md.t_recv))
 	}
 	// Phi_md <: Phi_td
 	tfs_md := md.Psi_recv.tFormals
 	tfs_td := recv_decl.GetBigPsi().tFormals
 	if len(tfs_td) != len(tfs_md) {
-		panic("Receiver type parameter arity mismatch:\n\tmdecl=" + md.t_recv +
-			md.Psi_recv.String() + ", tdecl=" + recv_decl.GetName() +
-			"\n\t" + recv_decl.GetBigPsi().String())
+		panic(base.NewDiagnostic(base.DIAG_ARITY, md,
+			"Receiver type parameter arity mismatch: mdecl="+md.t_recv+
+				md.Psi_recv.String()+", tdecl="+recv_decl.GetName()+
+				recv_decl.GetBigPsi().String()))
 	}
 	subs_md := makeParamIndexSubs(md.Psi_recv)
 	subs_td := makeParamIndexSubs(recv_decl.GetBigPsi())
@@ -217,9 +291,9 @@ func (md MethDecl) okBase(ds []Decl) (Delta, Gamma) {
 		md_bound := tfs_md[i].u_I.SubsEtaOpen(subs_md) // Canonicalised
 		td_bound := tfs_td[i].u_I.SubsEtaOpen(subs_td) // ^
 		if !ImplsDelta(ds, make(Delta), md_bound, getInterface(ds, td_bound)) {
-			panic("Receiver parameter upperbound not a subtype of type decl upperbound:" +
-				"\n\tmdecl=" + tfs_md[i].String() +
-				", tdecl=" + tfs_td[i].String())
+			panic(base.NewDiagnostic(base.DIAG_BAD_BOUND, md,
+				"Receiver parameter upperbound not a subtype of type decl upperbound: mdecl="+
+					tfs_md[i].String()+", tdecl="+tfs_td[i].String()))
 		}
 	}
 	// Phi, Psi ok
@@ -230,25 +304,26 @@ func (md MethDecl) okBase(ds []Decl) (Delta, Gamma) {
 		delta[v.name] = v.u_I
 	}
 	// distinct; params ok; construct gamma for body typing
-	as := md.Psi_recv.Hat()                          // !!! submission version, x:t_S(a) => x:t_S(~a)
-	gamma := Gamma{md.x_recv: TNamed{md.t_recv, as}} // CHECKME: can we give the bounds directly here instead of 'as'?
+	as := md.Psi_recv.Hat()                             // !!! submission version, x:t_S(a) => x:t_S(~a)
+	gamma := Gamma{md.x_recv: NewTNamed(md.t_recv, as)} // CHECKME: can we give the bounds directly here instead of 'as'?
 	seen := make(map[Name]Name)
 	seen[md.x_recv] = md.x_recv
 	for _, v := range md.pDecls {
 		if _, ok := seen[v.name]; ok {
-			panic("Duplicate receiver/param name: " + v.name + "\n\t" + md.String())
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, md,
+				"Duplicate receiver/param name: "+v.name))
 		}
 		seen[v.name] = v.name
-		v.u.Ok(ds, delta)
+		okValueType(ds, delta, v.u)
 		gamma[v.name] = v.u
 	}
-	md.u_ret.Ok(ds, delta)
+	okValueType(ds, delta, md.u_ret)
 
 	return delta, gamma
 }
 
 func (md MethDecl) ToSig() Sig {
-	return Sig{md.name, md.Psi_meth, md.pDecls, md.u_ret}
+	return Sig{md.name, md.Psi_meth, md.pDecls, md.u_ret, md.span}
 }
 
 func (md MethDecl) String() string {
@@ -266,8 +341,8 @@ func (md MethDecl) String() string {
 	writeParamDecls(&b, md.pDecls)
 	b.WriteString(") ")
 	b.WriteString(md.u_ret.String())
-	b.WriteString(" { return ")
-	b.WriteString(md.e_body.String())
+	b.WriteString(" { ")
+	writeBody(&b, md.e_body)
 	b.WriteString(" }")
 	return b.String()
 }
@@ -276,8 +351,11 @@ func (md MethDecl) String() string {
 type ParamDecl struct {
 	name Name // CHECKME: Variable?
 	u    Type
+	span base.Span // Source position, not part of node identity
 }
 
+func (pd ParamDecl) GetSpan() base.Span { return pd.span }
+
 var _ FGGNode = ParamDecl{}
 
 func (pd ParamDecl) GetName() Name { return pd.name }
@@ -287,6 +365,96 @@ func (pd ParamDecl) String() string {
 	return pd.name + " " + pd.u.String()
 }
 
+/* FuncDecl */
+
+// A top-level function -- cf. MethDecl, without the receiver
+type FuncDecl struct {
+	name   Name
+	Psi    BigPsi
+	pDecls []ParamDecl
+	u_ret  Type
+	e_body FGGExpr
+	span   base.Span // Source position, not part of node identity
+}
+
+func (fd FuncDecl) GetSpan() base.Span { return fd.span }
+
+var _ Decl = FuncDecl{}
+
+func (fd FuncDecl) GetName() Name              { return fd.name }
+func (fd FuncDecl) GetFDeclPsi() BigPsi        { return fd.Psi } // FDecl in name to prevent false capture by TDecl interface
+func (fd FuncDecl) GetParamDecls() []ParamDecl { return fd.pDecls }
+func (fd FuncDecl) GetReturn() Type            { return fd.u_ret }
+func (fd FuncDecl) GetBody() FGGExpr           { return fd.e_body }
+
+func (fd FuncDecl) Ok(ds []Decl) {
+	_ = fd.okRet(ds)
+}
+
+// Cf. MethDecl.okRet
+func (fd FuncDecl) okRet(ds []Decl) FuncDecl {
+	delta, gamma := fd.okBase(ds)
+	allowStupid := false
+	u, e_body := fd.e_body.Typing(ds, delta, gamma, allowStupid)
+
+	ok, coercion := u.AssignableToDelta(ds, delta, fd.u_ret)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, fd,
+			"Function body must be assignable to declared return type: found="+
+				u.String()+", expected="+fd.u_ret.String()))
+	}
+
+	fd.e_body = coerceBody(e_body, coercion)
+	return fd
+}
+
+// Cf. MethDecl.OkInfer
+func (fd FuncDecl) OkInfer(ds []Decl) FuncDecl {
+	delta, gamma := fd.okBase(ds)
+
+	u, e_body := fd.e_body.Infer(ds, delta, gamma)
+	subs := NewSubtypeConstr(u, fd.u_ret, originAt(fd.e_body, "result")).Unify(ds, delta)
+	fd.e_body = e_body.TSubs(subs)
+	return fd
+}
+
+func (fd FuncDecl) okBase(ds []Decl) (Delta, Gamma) {
+	fd.Psi.Ok(ds, make(Delta))
+	delta := fd.Psi.ToDelta()
+	// distinct; params ok; construct gamma for body typing
+	gamma := make(Gamma)
+	for _, v := range fd.pDecls {
+		if _, ok := gamma[v.name]; ok {
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, fd,
+				"Duplicate param name: "+v.name))
+		}
+		okValueType(ds, delta, v.u)
+		gamma[v.name] = v.u
+	}
+	okValueType(ds, delta, fd.u_ret)
+
+	return delta, gamma
+}
+
+func (fd FuncDecl) ToSig() Sig {
+	return Sig{fd.name, fd.Psi, fd.pDecls, fd.u_ret, fd.span}
+}
+
+func (fd FuncDecl) String() string {
+	var b strings.Builder
+	b.WriteString("func ")
+	b.WriteString(fd.name)
+	b.WriteString(fd.Psi.String())
+	b.WriteString("(")
+	writeParamDecls(&b, fd.pDecls)
+	b.WriteString(") ")
+	b.WriteString(fd.u_ret.String())
+	b.WriteString(" { ")
+	writeBody(&b, fd.e_body)
+	b.WriteString(" }")
+	return b.String()
+}
+
 /* Sig */
 
 type Sig struct {
@@ -294,8 +462,11 @@ type Sig struct {
 	Psi    BigPsi // Add-meth-tparams
 	pDecls []ParamDecl
 	u_ret  Type
+	span   base.Span // Source position, not part of node identity
 }
 
+func (g Sig) GetSpan() base.Span { return g.span }
+
 var _ Spec = Sig{}
 
 func (g Sig) GetMethod() Name            { return g.meth }
@@ -303,21 +474,59 @@ func (g Sig) GetPsi() BigPsi             { return g.Psi }
 func (g Sig) GetParamDecls() []ParamDecl { return g.pDecls }
 func (g Sig) GetReturn() Type            { return g.u_ret }
 
-//func (g Sig) TSubs(subs map[TParam]Type) Sig {
+// func (g Sig) TSubs(subs map[TParam]Type) Sig {
 // Only makes sense to have SubsEtaOpen, as eta will never contain mappings
-// for the type vars belonging to g.Psi. TODO this is not true!!! cf. internal/frontend/Frontend.go#renameParams
+// for the type vars belonging to g.Psi. TODO this is not true!!! cf. internal/frontend/Frontend.go#RenameParams
 // The parameters are only fully instantiated in monomSig1 [fgg_monom.go]
+// N.B. g's own type params are renamed if they would capture a type param
+// of eta's range, cf. avoidCapture
 func (g Sig) SubsEtaOpen(eta EtaOpen) Sig {
+	eta = g.avoidCapture(eta)
 	tfs := make([]TFormal, len(g.Psi.tFormals))
 	for i, tf := range g.Psi.tFormals {
 		tfs[i] = tf.SubsEtaOpen(eta)
 	}
 	ps := make([]ParamDecl, len(g.pDecls))
 	for i, pd := range g.pDecls {
-		ps[i] = ParamDecl{pd.name, pd.u.SubsEtaOpen(eta)}
+		ps[i] = ParamDecl{pd.name, pd.u.SubsEtaOpen(eta), pd.span}
 	}
 	u := g.u_ret.SubsEtaOpen(eta)
-	return Sig{g.meth, BigPsi{tfs}, ps, u}
+	return Sig{g.meth, BigPsi{tfs}, ps, u, g.span}
+}
+
+// E.g., b in "Map(type b Any())(f Function(a, b)) List(b)" for a := b, as
+// when typing "Nil(b){}" against List(b) in a method with its own "type b"
+// (not renamed by the frontend, cf. RenameParams, in INFER mode) -- renamed
+// to b' (not a source name)
+func (g Sig) avoidCapture(eta EtaOpen) EtaOpen {
+	free := make(map[TParam]bool)
+	for _, u := range eta {
+		for _, a := range fv(u) {
+			free[a] = true
+		}
+	}
+	var res EtaOpen
+	for _, tf := range g.Psi.tFormals {
+		if _, ok := eta[tf.name]; ok || !free[tf.name] {
+			continue
+		}
+		if res == nil {
+			res = make(EtaOpen)
+			for k, v := range eta {
+				res[k] = v
+			}
+		}
+		a := tf.name + "'"
+		for free[a] {
+			a += "'"
+		}
+		free[a] = true
+		res[tf.name] = a
+	}
+	if res == nil {
+		return eta
+	}
+	return res
 }
 
 func (g Sig) Ok(ds []Decl, env Delta) {
@@ -329,12 +538,13 @@ func (g Sig) Ok(ds []Decl, env Delta) {
 	seen := make(map[Name]ParamDecl)
 	for _, v := range g.pDecls {
 		if _, ok := seen[v.name]; ok {
-			panic("Duplicate variable name " + v.name + ":\n\t" + g.String())
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, g,
+				"Duplicate variable name "+v.name))
 		}
 		seen[v.name] = v
-		v.u.Ok(ds, extendedEnv)
+		okValueType(ds, extendedEnv, v.u)
 	}
-	g.u_ret.Ok(ds, extendedEnv)
+	okValueType(ds, extendedEnv, g.u_ret)
 }
 
 func (g Sig) GetSigs(_ []Decl) []Sig {
@@ -358,8 +568,11 @@ type TypeDecl struct {
 	name    Name
 	Psi     BigPsi
 	srcType Type
+	span    base.Span // Source position, not part of node identity
 }
 
+func (t TypeDecl) GetSpan() base.Span { return t.span }
+
 var _ Decl = TypeDecl{}
 
 func (t TypeDecl) GetName() Name       { return t.name }
@@ -408,13 +621,14 @@ func checkCyclicTypeDecl(ds []Decl, decl TypeDecl, target Type) {
 		return
 	case TNamed:
 		if target.GetName() == decl.GetName() {
-			panic("Invalid cyclic declaration: " + decl.String())
+			panic(base.NewDiagnostic(base.DIAG_CYCLIC_DECL, decl,
+				"Invalid cyclic declaration: "+decl.GetName()))
 		}
 		targetDecl := getTDecl(ds, target.GetName())
 		checkCyclicTypeDecl(ds, decl, targetDecl.GetSourceType())
 
 	case STypeLit:
-		for _, f := range target.GetFieldDecls() {
+		for _, f := range target.GetFieldDecls() { // N.B. includes embedded fields, always TNamed
 			if u, ok := f.u.(TNamed); ok {
 				//if isStructType(ds, u) // CHECKME: without this check, the next call may be needlessly checking for cycles in u_I's -- cf. commented checkCyclicTypeDecl
 				checkCyclicTypeDecl(ds, decl, u)
@@ -422,8 +636,8 @@ func checkCyclicTypeDecl(ds []Decl, decl TypeDecl, target Type) {
 		}
 	case ITypeLit:
 		for _, s := range target.GetSpecs() {
-			if u, ok := s.(TNamed); ok {
-				// u is a u_I, checked in Ok
+			if u, ok := s.(TNamed); ok && isEmbedding(ds, u) {
+				// o/w u is a single-term union, cf. ITypeLit.Ok
 				checkCyclicTypeDecl(ds, decl, u)
 			}
 		}
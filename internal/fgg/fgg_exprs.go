@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+
+	"github.com/rhu1/fgg/internal/base"
 )
 
 var _ = fmt.Errorf
@@ -17,25 +19,46 @@ var _ = strings.Compare
 
 /* Public constructors */
 
-func NewVariable(id Name) Variable                            { return Variable{id} }
-func NewStructLit(u_S Type, es []FGGExpr) StructLit         { return StructLit{u_S, es} }
-func NewSelect(e FGGExpr, f Name) Select                      { return Select{e, f} }
-func NewCall(e FGGExpr, m Name, us []Type, es []FGGExpr) Call { return Call{e, m, us, es} }
-func NewAssert(e FGGExpr, t Type) Assert                      { return Assert{e, t} }
-func NewSprintf(format string, args []FGGExpr) Sprintf        { return Sprintf{format, args} }
+func NewVariable(id Name) Variable                            { return Variable{id, base.Span{}} }
+func NewStructLit(u_S Type, es []FGGExpr) StructLit           { return StructLit{u_S, es, base.Span{}} }
+func NewSelect(e FGGExpr, f Name) Select                      { return Select{e, f, base.Span{}} }
+func NewCall(e FGGExpr, m Name, us []Type, es []FGGExpr) Call { return Call{e, m, us, es, base.Span{}} }
+func NewAssert(e FGGExpr, t Type) Assert                      { return Assert{e, t, base.Span{}} }
+func NewConvert(u Type, e FGGExpr) Convert                    { return Convert{u, e, base.Span{}} }
+func NewSprintf(format string, args []FGGExpr) Sprintf        { return Sprintf{format, args, base.Span{}} }
+func NewFuncCall(f Name, us []Type, es []FGGExpr) FuncCall    { return FuncCall{f, us, es, base.Span{}} }
+func NewApply(e FGGExpr, es []FGGExpr) Apply                  { return Apply{e, es, base.Span{}} }
+
+// u may be nil, cf. FuncLit
+func NewFuncLit(pds []ParamDecl, u_ret Type, e FGGExpr, u Type) FuncLit {
+	return FuncLit{pds, u_ret, e, u, base.Span{}}
+}
+
+// u may be nil, cf. Cond
+func NewCond(cond FGGExpr, e_then FGGExpr, e_else FGGExpr, u Type) Cond {
+	return Cond{cond, e_then, e_else, u, base.Span{}}
+}
+
+// u may be nil, cf. Let
+func NewLet(x Name, u Type, e_def FGGExpr, e_body FGGExpr) Let {
+	return Let{x, u, e_def, e_body, base.Span{}}
+}
 
 /* Variable */
 
 type Variable struct {
 	name Name
+	span base.Span // Source position, not part of node identity
 }
 
+func (x Variable) GetSpan() base.Span { return x.span }
+
 var _ FGGExpr = Variable{}
 
 func (x Variable) GetName() Name { return x.name }
 
 func (x Variable) Subs(m map[Variable]FGGExpr) FGGExpr {
-	res, ok := m[x]
+	res, ok := m[NewVariable(x.name)] // N.B. subs keys carry no span
 	if !ok {
 		panic("Unknown var: " + x.String())
 	}
@@ -55,7 +78,8 @@ func (x Variable) Typing(ds []Decl, delta Delta, gamma Gamma,
 	allowStupid bool) (Type, FGGExpr) {
 	res, ok := gamma[x.name]
 	if !ok {
-		panic("Var not in env: " + x.String())
+		panic(base.NewDiagnostic(base.DIAG_UNKNOWN_VAR, x,
+			"Var not in env: "+x.String()))
 	}
 	return res, x
 }
@@ -65,6 +89,10 @@ func (x Variable) IsValue() bool {
 	return false
 }
 
+func (x Variable) IsPanic() bool {
+	return false
+}
+
 func (x Variable) CanEval(ds []Decl) bool {
 	return false
 }
@@ -82,12 +110,15 @@ func (x Variable) ToGoString(ds []Decl) string {
 type StructLit struct {
 	u_S   Type
 	elems []FGGExpr
+	span  base.Span // Source position, not part of node identity
 }
 
+func (s StructLit) GetSpan() base.Span { return s.span }
+
 var _ FGGExpr = StructLit{}
 
 func (s StructLit) GetNamedType() TNamed { panic("GetNamedType kinda deprecated") }
-func (s StructLit) GetType() Type { return s.u_S }
+func (s StructLit) GetType() Type        { return s.u_S }
 func (s StructLit) GetElems() []FGGExpr  { return s.elems }
 
 func (s StructLit) Subs(subs map[Variable]FGGExpr) FGGExpr {
@@ -95,7 +126,7 @@ func (s StructLit) Subs(subs map[Variable]FGGExpr) FGGExpr {
 	for i := 0; i < len(s.elems); i++ {
 		es[i] = s.elems[i].Subs(subs)
 	}
-	return StructLit{s.u_S, es}
+	return StructLit{s.u_S, es, s.span}
 }
 
 func (s StructLit) TSubs(subs EtaOpen) FGGExpr {
@@ -103,7 +134,7 @@ func (s StructLit) TSubs(subs EtaOpen) FGGExpr {
 	for i := 0; i < len(s.elems); i++ {
 		es[i] = s.elems[i].TSubs(subs)
 	}
-	return StructLit{s.u_S.SubsEtaOpen(subs).(TNamed), es}
+	return StructLit{s.u_S.SubsEtaOpen(subs).(TNamed), es, s.span}
 }
 
 func (s StructLit) Eval(ds []Decl) (FGGExpr, string) {
@@ -119,7 +150,7 @@ func (s StructLit) Eval(ds []Decl) (FGGExpr, string) {
 		es[i] = v
 	}
 	if done {
-		return StructLit{s.u_S, es}, rule
+		return StructLit{s.u_S, es, s.span}, rule
 	} else {
 		panic("Cannot reduce: " + s.String())
 	}
@@ -128,8 +159,19 @@ func (s StructLit) Eval(ds []Decl) (FGGExpr, string) {
 func (s StructLit) Typing(ds []Decl, delta Delta, gamma Gamma,
 	allowStupid bool) (Type, FGGExpr) {
 	s.u_S.Ok(ds, delta)
+	if _, ok := s.u_S.Underlying(ds).(TSlice); ok { // E.g., "[]int32{1, 2}", or a defined slice type
+		return SliceLit{s.u_S, s.elems, s.span}.Typing(ds, delta, gamma, allowStupid)
+	}
+	if _, ok := s.u_S.Underlying(ds).(TMap); ok { // "u{}", cf. MapLit
+		if len(s.elems) > 0 {
+			panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, s,
+				"Missing key in map literal: "+s.String()))
+		}
+		return MapLit{s.u_S, []MapEntry{}, s.span}.Typing(ds, delta, gamma, allowStupid)
+	}
 	if !isStructType(ds, s.u_S) {
-		panic("Struct literal: " + s.u_S.String() + " is not a struct type")
+		panic(base.NewDiagnostic(base.DIAG_NOT_STRUCT, s,
+			"Struct literal: "+s.u_S.String()+" is not a struct type"))
 	}
 	fs := fields(ds, s.u_S)
 	if len(s.elems) != len(fs) {
@@ -138,9 +180,8 @@ func (s StructLit) Typing(ds []Decl, delta Delta, gamma Gamma,
 		writeExprs(&b, s.elems)
 		b.WriteString("], fields=[")
 		writeFieldDecls(&b, fs)
-		b.WriteString("]\n\t")
-		b.WriteString(s.String())
-		panic(b.String())
+		b.WriteString("]")
+		panic(base.NewDiagnostic(base.DIAG_ARITY, s, b.String()))
 	}
 	elems := make([]FGGExpr, len(s.elems))
 	for i := 0; i < len(s.elems); i++ {
@@ -148,12 +189,13 @@ func (s StructLit) Typing(ds []Decl, delta Delta, gamma Gamma,
 		u_f := fs[i].u
 		ok, coercion := u.AssignableToDelta(ds, delta, u_f)
 		if !ok {
-			panic("Arg expr must be assignable to field type: arg=" + u.String() +
-				", field=" + u_f.String() + "\n\t" + s.String())
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, s,
+				"Arg expr must be assignable to field type: arg="+u.String()+
+					", field="+u_f.String()))
 		}
 		elems[i] = coercion(newSubtree)
 	}
-	return s.u_S, StructLit{s.u_S, elems}
+	return s.u_S, StructLit{s.u_S, elems, s.span}
 }
 
 // From base.Expr
@@ -166,6 +208,10 @@ func (s StructLit) IsValue() bool {
 	return true
 }
 
+func (s StructLit) IsPanic() bool {
+	return false
+}
+
 func (s StructLit) CanEval(ds []Decl) bool {
 	for _, v := range s.elems {
 		if v.CanEval(ds) {
@@ -211,25 +257,28 @@ func (s StructLit) ToGoString(ds []Decl) string {
 type Select struct {
 	e_S   FGGExpr
 	field Name
+	span  base.Span // Source position, not part of node identity
 }
 
+func (s Select) GetSpan() base.Span { return s.span }
+
 var _ FGGExpr = Select{}
 
 func (s Select) GetExpr() FGGExpr { return s.e_S }
 func (s Select) GetField() Name   { return s.field }
 
 func (s Select) Subs(subs map[Variable]FGGExpr) FGGExpr {
-	return Select{s.e_S.Subs(subs), s.field}
+	return Select{s.e_S.Subs(subs), s.field, s.span}
 }
 
 func (s Select) TSubs(subs EtaOpen) FGGExpr {
-	return Select{s.e_S.TSubs(subs), s.field}
+	return Select{s.e_S.TSubs(subs), s.field, s.span}
 }
 
 func (s Select) Eval(ds []Decl) (FGGExpr, string) {
 	if !s.e_S.IsValue() {
 		e, rule := s.e_S.Eval(ds)
-		return Select{e, s.field}, rule
+		return Select{e, s.field, s.span}, rule
 	}
 	v := s.e_S.(StructLit)
 	fds := fields(ds, v.u_S)
@@ -245,17 +294,20 @@ func (s Select) Typing(ds []Decl, delta Delta, gamma Gamma,
 	allowStupid bool) (Type, FGGExpr) {
 	u, e_S := s.e_S.Typing(ds, delta, gamma, allowStupid)
 	if !IsStructType(ds, u) {
-		panic("Illegal select on expr of non-struct type: " + u.String() +
-			"\n\t" + s.String())
+		panic(base.NewDiagnostic(base.DIAG_NOT_STRUCT, s,
+			"Illegal select on expr of non-struct type: "+u.String()))
 	}
-	fds := fields(ds, u.(TNamed))
-	for _, v := range fds {
-		if v.field == s.field {
-			return v.u, Select{e_S, s.field}
-		}
+	sel, n := selections(ds, delta, u.(TNamed), s.field)
+	if n > 1 {
+		panic(base.NewDiagnostic(base.DIAG_AMBIGUOUS, s,
+			"Ambiguous selector "+s.field+" in type: "+u.String()))
+	} else if n == 0 || sel.isMeth {
+		panic(base.NewDiagnostic(base.DIAG_UNKNOWN_FIELD, s,
+			"Field "+s.field+" not found in type: "+u.String()))
 	}
-	panic("Field " + s.field + " not found in type: " + u.String() +
-		"\n\t" + s.String())
+	// A promoted field is elaborated to the explicit path of embedded fields
+	path := sel.path[:len(sel.path)-1]
+	return sel.u, Select{selectPath(e_S, path), s.field, s.span}
 }
 
 // From base.Expr
@@ -263,6 +315,10 @@ func (s Select) IsValue() bool {
 	return false
 }
 
+func (s Select) IsPanic() bool {
+	return false
+}
+
 func (s Select) CanEval(ds []Decl) bool {
 	if s.e_S.CanEval(ds) {
 		return true
@@ -292,8 +348,11 @@ type Call struct {
 	meth   Name
 	t_args []Type // Rename u_args?
 	args   []FGGExpr
+	span   base.Span // Source position, not part of node identity
 }
 
+func (c Call) GetSpan() base.Span { return c.span }
+
 var _ FGGExpr = Call{}
 
 func (c Call) GetRecv() FGGExpr   { return c.e_recv } // Called GetReceiver in fg
@@ -307,7 +366,7 @@ func (c Call) Subs(subs map[Variable]FGGExpr) FGGExpr {
 	for i := 0; i < len(c.args); i++ {
 		args[i] = c.args[i].Subs(subs)
 	}
-	return Call{e, c.meth, c.t_args, args}
+	return Call{e, c.meth, c.t_args, args, c.span}
 }
 
 func (c Call) TSubs(subs EtaOpen) FGGExpr {
@@ -319,13 +378,13 @@ func (c Call) TSubs(subs EtaOpen) FGGExpr {
 	for i := 0; i < len(c.args); i++ {
 		args[i] = c.args[i].TSubs(subs)
 	}
-	return Call{c.e_recv.TSubs(subs), c.meth, targs, args}
+	return Call{c.e_recv.TSubs(subs), c.meth, targs, args, c.span}
 }
 
 func (c Call) Eval(ds []Decl) (FGGExpr, string) {
 	if !c.e_recv.IsValue() {
 		e, rule := c.e_recv.Eval(ds)
-		return Call{e, c.meth, c.t_args, c.args}, rule
+		return Call{e, c.meth, c.t_args, c.args, c.span}, rule
 	}
 	args := make([]FGGExpr, len(c.args))
 	done := false
@@ -339,16 +398,16 @@ func (c Call) Eval(ds []Decl) (FGGExpr, string) {
 		args[i] = e
 	}
 	if done {
-		return Call{c.e_recv, c.meth, c.t_args, args}, rule
+		return Call{c.e_recv, c.meth, c.t_args, args, c.span}, rule
 	}
 	// c.e and c.args all values
-	t := concreteType(c.e_recv).(TNamed)
-	x0, xs, e := body(ds, t, c.meth, c.t_args) // panics if method not found
+	recv, t := methodRecv(ds, c.e_recv, c.meth) // Dynamic dispatch may reach a promoted method
+	x0, xs, e := body(ds, t, c.meth, c.t_args)  // panics if method not found
 
 	subs := make(map[Variable]FGGExpr)
-	subs[Variable{x0.name}] = c.e_recv
+	subs[NewVariable(x0.name)] = recv
 	for i := 0; i < len(xs); i++ {
-		subs[Variable{xs[i].name}] = c.args[i]
+		subs[NewVariable(xs[i].name)] = c.args[i]
 	}
 	return e.Subs(subs), "Call" // N.B. single combined substitution map slightly different to R-Call
 }
@@ -357,19 +416,27 @@ func (c Call) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Typ
 	u0, e_recv := c.e_recv.Typing(ds, delta, gamma, allowStupid)
 	var g Sig
 	if tmp, ok := methodsDelta(ds, delta, bounds(delta, u0))[c.meth]; !ok { // !!! submission version had "methods(m)"
-		panic("Method not found: " + c.meth + " in " + u0.String())
+		if _, n := selections(ds, delta, bounds(delta, u0), c.meth); n > 1 {
+			panic(base.NewDiagnostic(base.DIAG_AMBIGUOUS, c,
+				"Ambiguous selector "+c.meth+" in type: "+u0.String()))
+		}
+		panic(base.NewDiagnostic(base.DIAG_UNKNOWN_METHOD, c,
+			"Method not found: "+c.meth+" in "+u0.String()))
 	} else {
 		g = tmp
 	}
+	if _, ok := u0.(TParam); !ok && !isIfaceType(ds, u0) { // A promoted method is called on the explicit embedded field
+		sel, _ := selections(ds, delta, u0, c.meth)
+		e_recv = selectPath(e_recv, sel.path)
+	}
 	if len(c.t_args) != len(g.Psi.tFormals) {
 		var b strings.Builder
 		b.WriteString("Arity mismatch: type actuals=[")
 		writeTypes(&b, c.t_args)
 		b.WriteString("], formals=[")
 		b.WriteString(g.Psi.String())
-		b.WriteString("]\n\t")
-		b.WriteString(c.String())
-		panic(b.String())
+		b.WriteString("]")
+		panic(base.NewDiagnostic(base.DIAG_ARITY, c, b.String()))
 	}
 	if len(c.args) != len(g.pDecls) {
 		var b strings.Builder
@@ -377,9 +444,8 @@ func (c Call) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Typ
 		writeExprs(&b, c.args)
 		b.WriteString("], params=[")
 		writeParamDecls(&b, g.pDecls)
-		b.WriteString("]\n\t")
-		b.WriteString(c.String())
-		panic(b.String())
+		b.WriteString("]")
+		panic(base.NewDiagnostic(base.DIAG_ARITY, c, b.String()))
 	}
 	// duplicates MakeEtaDelta
 	eta := MakeEtaOpen(g.Psi, c.t_args) // CHECKME: applying this subs vs. adding to a new delta?  // Cf. MakeEta TODO CHECK THIS
@@ -387,8 +453,9 @@ func (c Call) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Typ
 		u := g.Psi.tFormals[i].u_I.SubsEtaOpen(eta)
 		u_I := getInterface(ds, u)
 		if !ImplsDelta(ds, delta, c.t_args[i], u_I) {
-			panic("Type actual must implement type formal: actual=" +
-				c.t_args[i].String() + ", param=" + u.String() + "\n\t" + c.String())
+			panic(base.NewDiagnostic(base.DIAG_BAD_BOUND, c,
+				"Type actual must implement type formal: actual="+
+					c.t_args[i].String()+", param="+u.String()))
 		}
 	}
 	args := make([]FGGExpr, len(c.args))
@@ -402,13 +469,14 @@ func (c Call) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Typ
 		u_p := g.pDecls[i].u.SubsEtaOpen(eta)
 		ok, coercion := u_a.AssignableToDelta(ds, delta, u_p)
 		if !ok {
-			panic("Arg expr must be assignable to param type: arg=" + u_a.String() +
-				", param=" + u_p.String() + "\n\t" + c.String())
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+				"Arg expr must be assignable to param type: arg="+u_a.String()+
+					", param="+u_p.String()))
 		}
 		args[i] = coercion(newSubtree)
 	}
 	return g.u_ret.SubsEtaOpen(eta), // subs necessary, c.psi info (i.e., bounds) will be "lost" after leaving this context
-	Call{e_recv, c.meth, c.t_args, args}
+		Call{e_recv, c.meth, c.t_args, args, c.span}
 }
 
 // From base.Expr
@@ -416,6 +484,10 @@ func (c Call) IsValue() bool {
 	return false
 }
 
+func (c Call) IsPanic() bool {
+	return false
+}
+
 func (c Call) CanEval(ds []Decl) bool {
 	if c.e_recv.CanEval(ds) {
 		return true
@@ -429,7 +501,7 @@ func (c Call) CanEval(ds []Decl) bool {
 			return false
 		}
 	}
-	u_S := concreteType(c.e_recv).(TNamed)
+	_, u_S := methodRecv(ds, c.e_recv, c.meth)
 	md := getMethDecl(ds, u_S.t_name, c.meth)
 	return len(md.Psi_recv.tFormals) == len(u_S.u_args) && // Needed, or also disregard?
 		len(md.Psi_meth.tFormals) == len(c.t_args) &&
@@ -462,48 +534,546 @@ func (c Call) ToGoString(ds []Decl) string {
 	return b.String()
 }
 
+/* Function calls */
+
+type FuncCall struct {
+	fun    Name
+	t_args []Type
+	args   []FGGExpr
+	span   base.Span // Source position, not part of node identity
+}
+
+func (c FuncCall) GetSpan() base.Span { return c.span }
+
+var _ FGGExpr = FuncCall{}
+
+func (c FuncCall) GetFunc() Name      { return c.fun }
+func (c FuncCall) GetTArgs() []Type   { return c.t_args }
+func (c FuncCall) GetArgs() []FGGExpr { return c.args }
+
+func (c FuncCall) Subs(subs map[Variable]FGGExpr) FGGExpr {
+	args := make([]FGGExpr, len(c.args))
+	for i := 0; i < len(c.args); i++ {
+		args[i] = c.args[i].Subs(subs)
+	}
+	return FuncCall{c.fun, c.t_args, args, c.span}
+}
+
+func (c FuncCall) TSubs(subs EtaOpen) FGGExpr {
+	targs := make([]Type, len(c.t_args))
+	for i := 0; i < len(c.t_args); i++ {
+		targs[i] = c.t_args[i].SubsEtaOpen(subs)
+	}
+	args := make([]FGGExpr, len(c.args))
+	for i := 0; i < len(c.args); i++ {
+		args[i] = c.args[i].TSubs(subs)
+	}
+	return FuncCall{c.fun, targs, args, c.span}
+}
+
+// Cf. Call.Eval
+func (c FuncCall) Eval(ds []Decl) (FGGExpr, string) {
+	args := make([]FGGExpr, len(c.args))
+	done := false
+	var rule string
+	for i := 0; i < len(c.args); i++ {
+		e := c.args[i]
+		if !done && !e.IsValue() {
+			e, rule = e.Eval(ds)
+			done = true
+		}
+		args[i] = e
+	}
+	if done {
+		return FuncCall{c.fun, c.t_args, args, c.span}, rule
+	}
+	// c.args all values
+	xs, e := funcBody(ds, c.fun, c.t_args) // panics if function not found
+	subs := make(map[Variable]FGGExpr)
+	for i := 0; i < len(xs); i++ {
+		subs[NewVariable(xs[i].name)] = c.args[i]
+	}
+	return e.Subs(subs), "FuncCall"
+}
+
+// Cf. Call.Typing
+func (c FuncCall) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Type, FGGExpr) {
+	if e, ok := c.asApply(gamma); ok { // A local of function type, e.g., "f()(x, y)"
+		return e.Typing(ds, delta, gamma, allowStupid)
+	}
+	if !isFuncName(ds, c.fun) {
+		panic(base.NewDiagnostic(base.DIAG_UNKNOWN_FUNC, c,
+			"Function not found: "+c.fun))
+	}
+	g := getFuncDecl(ds, c.fun).ToSig()
+	if len(c.t_args) != len(g.Psi.tFormals) {
+		var b strings.Builder
+		b.WriteString("Arity mismatch: type actuals=[")
+		writeTypes(&b, c.t_args)
+		b.WriteString("], formals=[")
+		b.WriteString(g.Psi.String())
+		b.WriteString("]")
+		panic(base.NewDiagnostic(base.DIAG_ARITY, c, b.String()))
+	}
+	if len(c.args) != len(g.pDecls) {
+		var b strings.Builder
+		b.WriteString("Arity mismatch: args=[")
+		writeExprs(&b, c.args)
+		b.WriteString("], params=[")
+		writeParamDecls(&b, g.pDecls)
+		b.WriteString("]")
+		panic(base.NewDiagnostic(base.DIAG_ARITY, c, b.String()))
+	}
+	eta := MakeEtaOpen(g.Psi, c.t_args)
+	for i := 0; i < len(c.t_args); i++ {
+		u := g.Psi.tFormals[i].u_I.SubsEtaOpen(eta)
+		u_I := getInterface(ds, u)
+		if !ImplsDelta(ds, delta, c.t_args[i], u_I) {
+			panic(base.NewDiagnostic(base.DIAG_BAD_BOUND, c,
+				"Type actual must implement type formal: actual="+
+					c.t_args[i].String()+", param="+u.String()))
+		}
+	}
+	args := make([]FGGExpr, len(c.args))
+	for i := 0; i < len(c.args); i++ {
+		u_a, newSubtree := c.args[i].Typing(ds, delta, gamma, allowStupid)
+		u_p := g.pDecls[i].u.SubsEtaOpen(eta)
+		ok, coercion := u_a.AssignableToDelta(ds, delta, u_p)
+		if !ok {
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+				"Arg expr must be assignable to param type: arg="+u_a.String()+
+					", param="+u_p.String()))
+		}
+		args[i] = coercion(newSubtree)
+	}
+	return g.u_ret.SubsEtaOpen(eta), FuncCall{c.fun, c.t_args, args, c.span}
+}
+
+// If c.fun is a local (of function type), c is a parsed as a FuncCall, but is
+// really (nested) Apply -- e.g., "f(x)(y)", where the "type args" are locals
+func (c FuncCall) asApply(gamma Gamma) (FGGExpr, bool) {
+	if _, ok := gamma[c.fun]; !ok {
+		return nil, false
+	}
+	targs := make([]FGGExpr, len(c.t_args))
+	for i, v := range c.t_args {
+		e, ok := typeAsExpr(v, c.span)
+		if !ok {
+			return nil, false
+		}
+		targs[i] = e
+	}
+	f := Apply{Variable{c.fun, c.span}, targs, c.span}
+	return Apply{f, c.args, c.span}, true
+}
+
+// From base.Expr
+func (c FuncCall) IsValue() bool {
+	return false
+}
+
+func (c FuncCall) IsPanic() bool {
+	return false
+}
+
+func (c FuncCall) CanEval(ds []Decl) bool {
+	for _, v := range c.args {
+		if v.CanEval(ds) {
+			return true
+		} else if !v.IsValue() {
+			return false
+		}
+	}
+	if !isFuncName(ds, c.fun) {
+		return false
+	}
+	fd := getFuncDecl(ds, c.fun)
+	return len(fd.Psi.tFormals) == len(c.t_args) && len(fd.pDecls) == len(c.args)
+}
+
+func (c FuncCall) String() string {
+	var b strings.Builder
+	b.WriteString(c.fun)
+	b.WriteString("(")
+	writeTypes(&b, c.t_args)
+	b.WriteString(")(")
+	writeExprs(&b, c.args)
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c FuncCall) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString(c.fun)
+	b.WriteString("(")
+	writeToGoTypes(ds, &b, c.t_args)
+	b.WriteString(")(")
+	writeToGoExprs(ds, &b, c.args)
+	b.WriteString(")")
+	return b.String()
+}
+
+/* Function values */
+
+// func(x1 u1, ..., xn un) u_ret { e_body } -- a closure, i.e., the body may
+// refer to variables in scope, which are captured by substitution (as for
+// the params of a method body).  typ is nil unless the value has been
+// converted to a defined function type, e.g., F(func(x int32) int32 { ... }).
+// N.B. a FuncLit is not itself generic (cf. Go), but the param/return types
+// may refer to the type params in scope.
+type FuncLit struct {
+	pDecls []ParamDecl
+	u_ret  Type
+	e_body FGGExpr
+	typ    Type
+	span   base.Span // Source position, not part of node identity
+}
+
+func (f FuncLit) GetSpan() base.Span { return f.span }
+
+var _ FGGExpr = FuncLit{}
+
+func (f FuncLit) GetParamDecls() []ParamDecl { return f.pDecls }
+func (f FuncLit) GetReturn() Type            { return f.u_ret }
+func (f FuncLit) GetBody() FGGExpr           { return f.e_body }
+
+// The (possibly defined) type of this function value
+func (f FuncLit) GetType() Type {
+	if f.typ != nil {
+		return f.typ
+	}
+	return f.sigType()
+}
+
+func (f FuncLit) sigType() TFunc {
+	us := make([]Type, len(f.pDecls))
+	for i, v := range f.pDecls {
+		us[i] = v.u
+	}
+	return TFunc{us, f.u_ret, f.span}
+}
+
+// The params are bound in e_body, so are not substituted there (cf. Let)
+func (f FuncLit) Subs(subs map[Variable]FGGExpr) FGGExpr {
+	subs1 := make(map[Variable]FGGExpr)
+	for k, v := range subs {
+		subs1[k] = v
+	}
+	for _, v := range f.pDecls {
+		subs1[NewVariable(v.name)] = NewVariable(v.name)
+	}
+	return FuncLit{f.pDecls, f.u_ret, f.e_body.Subs(subs1), f.typ, f.span}
+}
+
+func (f FuncLit) TSubs(eta EtaOpen) FGGExpr {
+	pds := make([]ParamDecl, len(f.pDecls))
+	for i, v := range f.pDecls {
+		pds[i] = ParamDecl{v.name, v.u.SubsEtaOpen(eta), v.span}
+	}
+	var u Type
+	if f.typ != nil {
+		u = f.typ.SubsEtaOpen(eta)
+	}
+	return FuncLit{pds, f.u_ret.SubsEtaOpen(eta), f.e_body.TSubs(eta), u, f.span}
+}
+
+func (f FuncLit) Eval(ds []Decl) (FGGExpr, string) {
+	panic("Cannot reduce: " + f.String())
+}
+
+// N.B. unlike Let, the params may shadow variables already in gamma
+func (f FuncLit) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Type, FGGExpr) {
+	gamma1 := make(Gamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	seen := make(map[Name]ParamDecl)
+	for _, v := range f.pDecls {
+		if _, ok := seen[v.name]; ok {
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, f,
+				"Duplicate variable name "+v.name))
+		}
+		seen[v.name] = v
+		okValueType(ds, delta, v.u)
+		gamma1[v.name] = v.u
+	}
+	okValueType(ds, delta, f.u_ret)
+	u, e_body := f.e_body.Typing(ds, delta, gamma1, allowStupid)
+	ok, coercion := u.AssignableToDelta(ds, delta, f.u_ret)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, f,
+			"Function body must be assignable to declared return type: found="+
+				u.String()+", expected="+f.u_ret.String()))
+	}
+	return f.GetType(), FuncLit{f.pDecls, f.u_ret, coerceBody(e_body, coercion), f.typ, f.span}
+}
+
+// From base.Expr
+func (f FuncLit) IsValue() bool {
+	return true
+}
+
+func (f FuncLit) IsPanic() bool {
+	return false
+}
+
+func (f FuncLit) CanEval(ds []Decl) bool {
+	return false
+}
+
+func (f FuncLit) String() string {
+	var b strings.Builder
+	b.WriteString("func(")
+	writeParamDecls(&b, f.pDecls)
+	b.WriteString(") ")
+	b.WriteString(f.u_ret.String())
+	b.WriteString(" { ")
+	writeBody(&b, f.e_body)
+	b.WriteString(" }")
+	if f.typ != nil {
+		return f.typ.String() + "(" + b.String() + ")"
+	}
+	return b.String()
+}
+
+func (f FuncLit) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString("func(")
+	for i, v := range f.pDecls {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(v.name + " " + v.u.ToGoString(ds))
+	}
+	b.WriteString(") ")
+	b.WriteString(f.u_ret.ToGoString(ds))
+	b.WriteString(" { ")
+	writeToGoBody(ds, &b, f.e_body)
+	b.WriteString(" }")
+	if f.typ != nil {
+		return f.typ.ToGoString(ds) + "(" + b.String() + ")"
+	}
+	return b.String()
+}
+
+/* Apply */
+
+// e_fun(e1, ..., en) -- a call on a function value, cf. FuncCall
+type Apply struct {
+	e_fun FGGExpr
+	args  []FGGExpr
+	span  base.Span // Source position, not part of node identity
+}
+
+func (a Apply) GetSpan() base.Span { return a.span }
+
+var _ FGGExpr = Apply{}
+
+func (a Apply) GetFunc() FGGExpr   { return a.e_fun }
+func (a Apply) GetArgs() []FGGExpr { return a.args }
+
+func (a Apply) Subs(subs map[Variable]FGGExpr) FGGExpr {
+	args := make([]FGGExpr, len(a.args))
+	for i := 0; i < len(a.args); i++ {
+		args[i] = a.args[i].Subs(subs)
+	}
+	return Apply{a.e_fun.Subs(subs), args, a.span}
+}
+
+func (a Apply) TSubs(eta EtaOpen) FGGExpr {
+	args := make([]FGGExpr, len(a.args))
+	for i := 0; i < len(a.args); i++ {
+		args[i] = a.args[i].TSubs(eta)
+	}
+	return Apply{a.e_fun.TSubs(eta), args, a.span}
+}
+
+// Cf. Call.Eval
+func (a Apply) Eval(ds []Decl) (FGGExpr, string) {
+	if !a.e_fun.IsValue() {
+		e, rule := a.e_fun.Eval(ds)
+		return Apply{e, a.args, a.span}, rule
+	}
+	args := make([]FGGExpr, len(a.args))
+	done := false
+	var rule string
+	for i := 0; i < len(a.args); i++ {
+		e := a.args[i]
+		if !done && !e.IsValue() {
+			e, rule = e.Eval(ds)
+			done = true
+		}
+		args[i] = e
+	}
+	if done {
+		return Apply{a.e_fun, args, a.span}, rule
+	}
+	// a.e_fun and a.args all values
+	f := a.e_fun.(FuncLit)
+	subs := make(map[Variable]FGGExpr)
+	for i := 0; i < len(f.pDecls); i++ {
+		subs[NewVariable(f.pDecls[i].name)] = a.args[i]
+	}
+	return f.e_body.Subs(subs), "Apply"
+}
+
+func (a Apply) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Type, FGGExpr) {
+	u, e_fun := a.e_fun.Typing(ds, delta, gamma, allowStupid)
+	u_F, ok := u.Underlying(ds).(TFunc)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, a,
+			"Cannot call non-function: "+a.e_fun.String()+" of type "+u.String()))
+	}
+	if len(a.args) != len(u_F.params) {
+		var b strings.Builder
+		b.WriteString("Arity mismatch: args=[")
+		writeExprs(&b, a.args)
+		b.WriteString("], params=")
+		b.WriteString(u_F.String())
+		panic(base.NewDiagnostic(base.DIAG_ARITY, a, b.String()))
+	}
+	args := make([]FGGExpr, len(a.args))
+	for i := 0; i < len(a.args); i++ {
+		u_a, newSubtree := a.args[i].Typing(ds, delta, gamma, allowStupid)
+		u_p := u_F.params[i]
+		ok, coercion := u_a.AssignableToDelta(ds, delta, u_p)
+		if !ok {
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, a,
+				"Arg expr must be assignable to param type: arg="+u_a.String()+
+					", param="+u_p.String()))
+		}
+		args[i] = coercion(newSubtree)
+	}
+	return u_F.u_ret, Apply{e_fun, args, a.span}
+}
+
+// From base.Expr
+func (a Apply) IsValue() bool {
+	return false
+}
+
+func (a Apply) IsPanic() bool {
+	return false
+}
+
+func (a Apply) CanEval(ds []Decl) bool {
+	if a.e_fun.CanEval(ds) {
+		return true
+	} else if !a.e_fun.IsValue() {
+		return false
+	}
+	for _, v := range a.args {
+		if v.CanEval(ds) {
+			return true
+		} else if !v.IsValue() {
+			return false
+		}
+	}
+	f, ok := a.e_fun.(FuncLit)
+	return ok && len(f.pDecls) == len(a.args)
+}
+
+func (a Apply) String() string {
+	var b strings.Builder
+	writeFunc(&b, a.e_fun, a.e_fun.String())
+	b.WriteString("(")
+	writeExprs(&b, a.args)
+	b.WriteString(")")
+	return b.String()
+}
+
+func (a Apply) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	writeFunc(&b, a.e_fun, a.e_fun.ToGoString(ds))
+	b.WriteString("(")
+	writeToGoExprs(ds, &b, a.args)
+	b.WriteString(")")
+	return b.String()
+}
+
+// Parenthesises e_fun unless it is a variable, call, etc. -- e.g., "(x.f)(e)"
+// and "(func(x t) t { ... })(e)", cf. the Call and FuncLit grammar rules
+func writeFunc(b *strings.Builder, e_fun FGGExpr, s string) {
+	switch e_fun.(type) {
+	case Variable, FuncCall, Apply, Call:
+		b.WriteString(s)
+	default:
+		b.WriteString("(")
+		b.WriteString(s)
+		b.WriteString(")")
+	}
+}
+
+// The expr denoted by a type in a position parsed as a type, but that is
+// really an expr -- e.g., "f(x)" in "f(x)(y)", where f and x are locals,
+// cf. FuncCall.asApply, Convert.Typing
+func typeAsExpr(u Type, span base.Span) (FGGExpr, bool) {
+	switch u1 := u.(type) {
+	case TParam:
+		return Variable{Name(u1), span}, true
+	case TNamed:
+		args := make([]FGGExpr, len(u1.u_args))
+		for i, v := range u1.u_args {
+			e, ok := typeAsExpr(v, span)
+			if !ok {
+				return nil, false
+			}
+			args[i] = e
+		}
+		return Apply{Variable{u1.t_name, span}, args, span}, true
+	default:
+		return nil, false
+	}
+}
+
 /* Assert */
 
 type Assert struct {
 	e_I    FGGExpr
 	u_cast Type
+	span   base.Span // Source position, not part of node identity
 }
 
+func (a Assert) GetSpan() base.Span { return a.span }
+
 var _ FGGExpr = Assert{}
 
 func (a Assert) GetExpr() FGGExpr { return a.e_I }
 func (a Assert) GetType() Type    { return a.u_cast }
 
 func (a Assert) Subs(subs map[Variable]FGGExpr) FGGExpr {
-	return Assert{a.e_I.Subs(subs), a.u_cast}
+	return Assert{a.e_I.Subs(subs), a.u_cast, a.span}
 }
 
 func (a Assert) TSubs(subs EtaOpen) FGGExpr {
-	return Assert{a.e_I.TSubs(subs), a.u_cast.SubsEtaOpen(subs)}
+	return Assert{a.e_I.TSubs(subs), a.u_cast.SubsEtaOpen(subs), a.span}
 }
 
 func (a Assert) Eval(ds []Decl) (FGGExpr, string) {
 	if !a.e_I.IsValue() {
 		e, rule := a.e_I.Eval(ds)
-		return Assert{e, a.u_cast}, rule
+		return Assert{e, a.u_cast, a.span}, rule
 	}
-	ok, _ := concreteType(a.e_I).AssignableToDelta(ds, Delta{}, a.u_cast) // Empty Delta -- not super clear in submission version
+	u := concreteType(a.e_I)
+	ok, _ := u.AssignableToDelta(ds, Delta{}, a.u_cast) // Empty Delta -- not super clear in submission version
 	if ok {
 		return a.e_I, "Assert"
 	}
-	panic("Cannot reduce: " + a.String())
+	return runtimePanic(ds, a, "interface conversion: "+u.String()+" is not "+
+		a.u_cast.String()), "AssertPanic"
 }
 
 func (a Assert) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Type, FGGExpr) {
 	a.u_cast.Ok(ds, delta)
 	u, e_I := a.e_I.Typing(ds, delta, gamma, allowStupid)
-	newAst := Assert{e_I, a.u_cast}
+	newAst := Assert{e_I, a.u_cast, a.span}
 	if !IsIfaceLikeType(ds, u) {
 		if allowStupid {
 			return a.u_cast, newAst
 		} else {
-			panic("Expr must be an interface-like type (in a non-stupid context): found " +
-				u.String() + " for\n\t" + a.String())
+			panic(base.NewDiagnostic(base.DIAG_NOT_INTERFACE, a,
+				"Expr must be an interface-like type (in a non-stupid context): found "+
+					u.String()))
 		}
 	}
 	// u is a TParam or an interface type TName
@@ -514,8 +1084,9 @@ func (a Assert) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (T
 		if ImplsDelta(ds, delta, a.u_cast, getInterface(ds, u_bound)) {
 			return a.u_cast, newAst
 		}
-		panic("Struct type assertion must implement expr type: asserted=" +
-			a.u_cast.String() + ", expr=" + u.String())
+		panic(base.NewDiagnostic(base.DIAG_BAD_ASSERT, a,
+			"Struct type assertion must implement expr type: asserted="+
+				a.u_cast.String()+", expr="+u.String()))
 	}
 }
 
@@ -525,14 +1096,13 @@ func (a Assert) IsValue() bool {
 	return false
 }
 
+func (a Assert) IsPanic() bool {
+	return false
+}
+
+// N.B. true for a failed assertion, cf. the run-time panic in Eval
 func (a Assert) CanEval(ds []Decl) bool {
-	if a.e_I.CanEval(ds) {
-		return true
-	} else if !a.e_I.IsValue() {
-		return false
-	}
-	ok, _ := concreteType(a.e_I).AssignableToDelta(ds, Delta{}, a.u_cast)
-	return ok
+	return a.e_I.IsValue() || a.e_I.CanEval(ds)
 }
 
 func (a Assert) String() string {
@@ -559,28 +1129,35 @@ func (a Assert) ToGoString(ds []Decl) string {
 // The main goal is to allow conversions such as:
 //   - int32(1), MyInt(1), MyInt(int32(1))
 //   - S(struct{}{}), struct{}(S{})
+//   - float32(int32(1)), int32(x) for x of type float64 (truncated)
+//
 // Essentially, it only supports conversions between types with similar
-// underlying types (Cf. validConversion).
-// In particular, conversions such as float32(int32(1)) are not supported.
+// underlying types, and between numeric types (Cf. validConversion).
 type Convert struct {
 	typ  Type
 	expr FGGExpr
+	span base.Span // Source position, not part of node identity
 }
 
+func (c Convert) GetSpan() base.Span { return c.span }
+
 var _ FGGExpr = Convert{}
 
+func (c Convert) GetType() Type    { return c.typ }
+func (c Convert) GetExpr() FGGExpr { return c.expr }
+
 func (c Convert) Subs(subs map[Variable]FGGExpr) FGGExpr {
-	return Convert{c.typ, c.expr.Subs(subs)}
+	return Convert{c.typ, c.expr.Subs(subs), c.span}
 }
 
 func (c Convert) TSubs(eta EtaOpen) FGGExpr {
-	return Convert{c.typ.SubsEtaOpen(eta), c.expr.TSubs(eta)}
+	return Convert{c.typ.SubsEtaOpen(eta), c.expr.TSubs(eta), c.span}
 }
 
 func (c Convert) Eval(ds []Decl) (FGGExpr, string) {
 	if !c.expr.IsValue() {
 		e, rule := c.expr.Eval(ds)
-		return Convert{c.typ, e}, rule
+		return Convert{c.typ, e, c.span}, rule
 	}
 
 	var converted FGGExpr
@@ -591,12 +1168,23 @@ func (c Convert) Eval(ds []Decl) (FGGExpr, string) {
 		if _, ok := c.typ.(UndefTPrimitive); ok {
 			converted = convdLit
 		} else {
-			converted = TypedPrimitiveValue{convdLit, c.typ}
+			converted = TypedPrimitiveValue{convdLit, c.typ, c.span}
 		}
 	case TypedPrimitiveValue:
-		converted = TypedPrimitiveValue{e.lit, c.typ}
+		ptype := c.typ.Underlying(ds).(PrimType)
+		converted = TypedPrimitiveValue{rawConversion(e.lit, ptype.Tag()), c.typ, c.span}
 	case StructLit:
-		converted = StructLit{c.typ, e.elems}
+		converted = StructLit{c.typ, e.elems, c.span}
+	case FuncLit:
+		var u Type // nil if c.typ is not a defined type, cf. FuncLit
+		if _, ok := c.typ.(TFunc); !ok {
+			u = c.typ
+		}
+		converted = FuncLit{e.pDecls, e.u_ret, e.e_body, u, c.span}
+	case SliceLit:
+		converted = SliceLit{c.typ, e.elems, c.span}
+	case MapLit:
+		converted = MapLit{c.typ, e.entries, c.span}
 	default:
 		panic("Unsupported conversion: " + c.String())
 	}
@@ -614,14 +1202,26 @@ func rawConversion(lit PrimitiveLiteral, tag Tag) PrimitiveLiteral {
 		convdPayload = lit.payload // payload can only be bool/string
 
 	case INT32:
-		pload := lit.payload.(int32) // lit.payload can only be int32
-		convdPayload = int32(pload)  //  redundant
+		switch pload := lit.payload.(type) {
+		case int32:
+			convdPayload = int32(pload) // redundant
+		case int64:
+			convdPayload = int32(pload)
+		case float32:
+			convdPayload = int32(pload) // Truncated, as in Go
+		case float64:
+			convdPayload = int32(pload)
+		}
 	case INT64:
 		switch pload := lit.payload.(type) {
 		case int32:
 			convdPayload = int64(pload)
 		case int64:
 			convdPayload = int64(pload) // redundant
+		case float32:
+			convdPayload = int64(pload)
+		case float64:
+			convdPayload = int64(pload)
 		}
 	case FLOAT32:
 		switch pload := lit.payload.(type) {
@@ -631,6 +1231,8 @@ func rawConversion(lit PrimitiveLiteral, tag Tag) PrimitiveLiteral {
 			convdPayload = float32(pload)
 		case float32:
 			convdPayload = float32(pload) // redundant
+		case float64:
+			convdPayload = float32(pload)
 		}
 	case FLOAT64:
 		switch pload := lit.payload.(type) {
@@ -644,33 +1246,68 @@ func rawConversion(lit PrimitiveLiteral, tag Tag) PrimitiveLiteral {
 			convdPayload = float64(pload) // redundant
 		}
 	}
-	return PrimitiveLiteral{convdPayload, tag}
+	return PrimitiveLiteral{convdPayload, tag, base.Span{}}
 }
 
 func (c Convert) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Type, FGGExpr) {
+	if e, ok := c.asApply(gamma); ok { // A local of function type, e.g., "f(x)"
+		return e.Typing(ds, delta, gamma, allowStupid)
+	}
 	c.typ.Ok(ds, delta)
 	u_expr, expr := c.expr.Typing(ds, delta, gamma, allowStupid)
 	if validConversion(ds, delta, u_expr, c.typ) {
-		return c.typ, Convert{c.typ, expr}
+		return c.typ, Convert{c.typ, expr, c.span}
+	}
+	panic(base.NewDiagnostic(base.DIAG_BAD_CONVERSION, c,
+		"Invalid type conversion from "+u_expr.String()+" to "+c.typ.String()))
+}
+
+// Cf. FuncCall.asApply
+func (c Convert) asApply(gamma Gamma) (FGGExpr, bool) {
+	var f Name
+	switch u := c.typ.(type) {
+	case TParam:
+		f = Name(u)
+	case TNamed:
+		f = u.t_name
+	default:
+		return nil, false
+	}
+	if _, ok := gamma[f]; !ok {
+		return nil, false
 	}
-	panic("Invalid type conversion: " + c.String())
+	e_fun, ok := typeAsExpr(c.typ, c.span) // e.g., f for "f(x)", or f(x) for "f(x)(y)"
+	if !ok {
+		return nil, false
+	}
+	return Apply{e_fun, []FGGExpr{c.expr}, c.span}, true
 }
 
 func validConversion(ds []Decl, delta Delta, u1, u2 Type) bool {
 	if u1.Underlying(ds).Equals(u2.Underlying(ds)) {
 		return true
 	}
-	if u1, ok := u1.(UndefTPrimitive); ok {
+	if u1, ok := u1.(UndefTPrimitive); ok { // Constants must be representable, e.g., not int32(1.5)
 		return u1.RepresentableBy(ds, delta, u2)
 	}
-	return false
+	// N.B. for a type param, all the types in (the type list of) its bound must be numeric
+	return evalPrimtPredicate(ds, delta, isNumeric, u1) && evalPrimtPredicate(ds, delta, isNumeric, u2)
 }
 
 func (c Convert) IsValue() bool {
 	return false
 }
 
+func (c Convert) IsPanic() bool {
+	return false
+}
+
 func (c Convert) CanEval(ds []Decl) bool {
+	if c.expr.CanEval(ds) {
+		return true
+	} else if !c.expr.IsValue() {
+		return false
+	}
 	t_expr := concreteType(c.expr)
 	return validConversion(ds, Delta{}, t_expr, c.typ)
 }
@@ -694,13 +1331,330 @@ func (c Convert) ToGoString(ds []Decl) string {
 	return b.String()
 }
 
+/* Conditionals */
+
+// A method body (or branch of one): if cond { return e_then } else { return e_else }.
+// typ is the declared return type of the method (cf. SetBodyType), to which
+// each branch must be assignable -- if nil, the type is the join of the branches.
+type Cond struct {
+	cond   FGGExpr
+	e_then FGGExpr
+	e_else FGGExpr
+	typ    Type
+	span   base.Span // Source position, not part of node identity
+}
+
+func (c Cond) GetSpan() base.Span { return c.span }
+
+var _ FGGExpr = Cond{}
+
+func (c Cond) GetCond() FGGExpr { return c.cond }
+func (c Cond) GetThen() FGGExpr { return c.e_then }
+func (c Cond) GetElse() FGGExpr { return c.e_else }
+func (c Cond) GetType() Type    { return c.typ }
+
+func (c Cond) Subs(subs map[Variable]FGGExpr) FGGExpr {
+	return Cond{c.cond.Subs(subs), c.e_then.Subs(subs), c.e_else.Subs(subs), c.typ, c.span}
+}
+
+func (c Cond) TSubs(eta EtaOpen) FGGExpr {
+	var u Type
+	if c.typ != nil {
+		u = c.typ.SubsEtaOpen(eta)
+	}
+	return Cond{c.cond.TSubs(eta), c.e_then.TSubs(eta), c.e_else.TSubs(eta), u, c.span}
+}
+
+// N.B. only the condition is evaluated, the branches are not
+func (c Cond) Eval(ds []Decl) (FGGExpr, string) {
+	if !c.cond.IsValue() {
+		e, rule := c.cond.Eval(ds)
+		return Cond{e, c.e_then, c.e_else, c.typ, c.span}, rule
+	}
+	if c.cond.(PrimtValue).Val().(bool) {
+		return c.e_then, "IfTrue"
+	}
+	return c.e_else, "IfFalse"
+}
+
+func (c Cond) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Type, FGGExpr) {
+	u_cond, cond := c.cond.Typing(ds, delta, gamma, allowStupid)
+	if !evalPrimtPredicate(ds, delta, isBool, u_cond) {
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+			"Non-boolean condition: "+u_cond.String()))
+	}
+	u_then, e_then := c.e_then.Typing(ds, delta, gamma, allowStupid)
+	u_else, e_else := c.e_else.Typing(ds, delta, gamma, allowStupid)
+
+	if c.typ != nil {
+		e_then = c.coerceBranch(ds, delta, u_then, e_then)
+		e_else = c.coerceBranch(ds, delta, u_else, e_else)
+		return c.typ, Cond{cond, e_then, e_else, c.typ, c.span}
+	}
+	// o/w the join of the branch types
+	if ok, coercion := u_then.AssignableToDelta(ds, delta, u_else); ok {
+		return u_else, Cond{cond, coerceBody(e_then, coercion), e_else, c.typ, c.span}
+	}
+	if ok, coercion := u_else.AssignableToDelta(ds, delta, u_then); ok {
+		return u_then, Cond{cond, e_then, coerceBody(e_else, coercion), c.typ, c.span}
+	}
+	panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+		"Mismatched branch types: "+u_then.String()+" and "+u_else.String()))
+}
+
+func (c Cond) coerceBranch(ds []Decl, delta Delta, u Type, e FGGExpr) FGGExpr {
+	ok, coercion := u.AssignableToDelta(ds, delta, c.typ)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+			"Branch must be assignable to declared return type: found="+
+				u.String()+", expected="+c.typ.String()))
+	}
+	return coerceBody(e, coercion)
+}
+
+func (c Cond) IsValue() bool {
+	return false
+}
+
+func (c Cond) IsPanic() bool {
+	return false
+}
+
+func (c Cond) CanEval(ds []Decl) bool {
+	if c.cond.CanEval(ds) {
+		return true
+	}
+	return c.cond.IsValue()
+}
+
+func (c Cond) String() string {
+	var b strings.Builder
+	b.WriteString("if ")
+	b.WriteString(c.cond.String())
+	b.WriteString(" { ")
+	writeBody(&b, c.e_then)
+	b.WriteString(" } else { ")
+	writeBody(&b, c.e_else)
+	b.WriteString(" }")
+	return b.String()
+}
+
+func (c Cond) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString("if ")
+	b.WriteString(c.cond.ToGoString(ds))
+	b.WriteString(" { ")
+	writeToGoBody(ds, &b, c.e_then)
+	b.WriteString(" } else { ")
+	writeToGoBody(ds, &b, c.e_else)
+	b.WriteString(" }")
+	return b.String()
+}
+
+/* Local bindings */
+
+// A method body (or branch of one): x := e_def; e_body, or var x u = e_def; e_body.
+// If u is nil, x has the type of e_def -- N.B. an untyped constant stays
+// untyped, cf. Go's const.  Evaluation is call-by-value: e_def is evaluated
+// before it is substituted into e_body.
+type Let struct {
+	x      Name
+	u      Type
+	e_def  FGGExpr
+	e_body FGGExpr
+	span   base.Span // Source position, not part of node identity
+}
+
+func (l Let) GetSpan() base.Span { return l.span }
+
+var _ FGGExpr = Let{}
+
+func (l Let) GetVar() Name     { return l.x }
+func (l Let) GetType() Type    { return l.u }
+func (l Let) GetDef() FGGExpr  { return l.e_def }
+func (l Let) GetBody() FGGExpr { return l.e_body }
+
+// x is bound in e_body, so is not substituted there
+func (l Let) Subs(subs map[Variable]FGGExpr) FGGExpr {
+	subs1 := make(map[Variable]FGGExpr)
+	for k, v := range subs {
+		subs1[k] = v
+	}
+	subs1[NewVariable(l.x)] = NewVariable(l.x)
+	return Let{l.x, l.u, l.e_def.Subs(subs), l.e_body.Subs(subs1), l.span}
+}
+
+func (l Let) TSubs(eta EtaOpen) FGGExpr {
+	var u Type
+	if l.u != nil {
+		u = l.u.SubsEtaOpen(eta)
+	}
+	return Let{l.x, u, l.e_def.TSubs(eta), l.e_body.TSubs(eta), l.span}
+}
+
+func (l Let) Eval(ds []Decl) (FGGExpr, string) {
+	if !l.e_def.IsValue() {
+		e, rule := l.e_def.Eval(ds)
+		return Let{l.x, l.u, e, l.e_body, l.span}, rule
+	}
+	subs := map[Variable]FGGExpr{NewVariable(l.x): l.e_def}
+	return l.e_body.Subs(subs), "Let"
+}
+
+// N.B. x may not shadow a variable already in gamma (cf. Go's "no new variables")
+// -- a simplification: this includes a variable captured by a FuncLit body,
+// which Go allows to be redeclared there (cf. the FuncLit params, which may)
+func (l Let) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Type, FGGExpr) {
+	if _, ok := gamma[l.x]; ok {
+		panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, l,
+			"Variable already declared: "+l.x))
+	}
+	u_def, e_def := l.e_def.Typing(ds, delta, gamma, allowStupid)
+	u_x := u_def
+	if l.u != nil {
+		okValueType(ds, delta, l.u)
+		ok, coercion := u_def.AssignableToDelta(ds, delta, l.u)
+		if !ok {
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, l,
+				"Definition of "+l.x+" must be assignable to its declared type: found="+
+					u_def.String()+", expected="+l.u.String()))
+		}
+		u_x, e_def = l.u, coercion(e_def)
+	}
+	gamma1 := make(Gamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	gamma1[l.x] = u_x
+	u_body, e_body := l.e_body.Typing(ds, delta, gamma1, allowStupid)
+	return u_body, Let{l.x, l.u, e_def, e_body, l.span}
+}
+
+func (l Let) IsValue() bool {
+	return false
+}
+
+func (l Let) IsPanic() bool {
+	return false
+}
+
+func (l Let) CanEval(ds []Decl) bool {
+	if l.e_def.CanEval(ds) {
+		return true
+	}
+	return l.e_def.IsValue()
+}
+
+func (l Let) String() string {
+	var b strings.Builder
+	u := ""
+	if l.u != nil {
+		u = l.u.String()
+	}
+	l.writeBinding(&b, u, l.e_def.String())
+	writeBody(&b, l.e_body)
+	return b.String()
+}
+
+func (l Let) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	u := ""
+	if l.u != nil {
+		u = l.u.ToGoString(ds)
+	}
+	l.writeBinding(&b, u, l.e_def.ToGoString(ds))
+	writeToGoBody(ds, &b, l.e_body)
+	return b.String()
+}
+
+// "x := e_def; ", or "var x u = e_def; "
+func (l Let) writeBinding(b *strings.Builder, u string, e_def string) {
+	if l.u == nil {
+		b.WriteString(l.x + " := ")
+	} else {
+		b.WriteString("var " + l.x + " " + u + " = ")
+	}
+	b.WriteString(e_def)
+	b.WriteString("; ")
+}
+
+/* Method bodies */
+
+// Sets the type of the conditionals (and type switches, and panics) in e, the body of a method with return type u
+func SetBodyType(e FGGExpr, u Type) FGGExpr {
+	switch e1 := e.(type) {
+	case Panic:
+		return Panic{e1.e_arg, u, e1.span}
+	case Cond:
+		return Cond{e1.cond, SetBodyType(e1.e_then, u), SetBodyType(e1.e_else, u), u, e1.span}
+	case Let:
+		return Let{e1.x, e1.u, e1.e_def, SetBodyType(e1.e_body, u), e1.span}
+	case MapAssign:
+		return MapAssign{e1.x, e1.e_M, e1.e_key, e1.e_val, SetBodyType(e1.e_body, u), e1.span}
+	case CommaOk:
+		return CommaOk{e1.x, e1.x_ok, e1.e_def, SetBodyType(e1.e_body, u), e1.span}
+	case TypeSwitch:
+		cases := make([]TypeCase, len(e1.cases))
+		for i, c := range e1.cases {
+			cases[i] = TypeCase{c.u, SetBodyType(c.e_body, u)}
+		}
+		var e_def FGGExpr
+		if e1.e_def != nil {
+			e_def = SetBodyType(e1.e_def, u)
+		}
+		return TypeSwitch{e1.x, e1.e_I, cases, e_def, u, e1.span}
+	default:
+		return e
+	}
+}
+
+// Applies coercion to the result of e, a method body (or branch of one) --
+// i.e., under any bindings, so that e is still printed as a body
+func coerceBody(e FGGExpr, coercion Coercion) FGGExpr {
+	switch e1 := e.(type) {
+	case Let:
+		return Let{e1.x, e1.u, e1.e_def, coerceBody(e1.e_body, coercion), e1.span}
+	case MapAssign:
+		return MapAssign{e1.x, e1.e_M, e1.e_key, e1.e_val, coerceBody(e1.e_body, coercion), e1.span}
+	case CommaOk:
+		return CommaOk{e1.x, e1.x_ok, e1.e_def, coerceBody(e1.e_body, coercion), e1.span}
+	case Panic: // Already has the declared return type, cf. SetBodyType
+		return e
+	}
+	return coercion(e)
+}
+
+// "return e", a conditional, a type switch, a binding or a panic -- cf. MethDecl.String
+func writeBody(b *strings.Builder, e FGGExpr) {
+	switch e.(type) {
+	case Cond, Let, MapAssign, CommaOk, TypeSwitch, Panic:
+		b.WriteString(e.String())
+	default:
+		b.WriteString("return ")
+		b.WriteString(e.String())
+	}
+}
+
+func writeToGoBody(ds []Decl, b *strings.Builder, e FGGExpr) {
+	switch e.(type) {
+	case Cond, Let, MapAssign, CommaOk, TypeSwitch, Panic:
+		b.WriteString(e.ToGoString(ds))
+	default:
+		b.WriteString("return ")
+		b.WriteString(e.ToGoString(ds))
+	}
+}
+
 /* fmt.Sprintf */
 
 type Sprintf struct {
 	format string // Includes surrounding quotes
 	args   []FGGExpr
+	span   base.Span // Source position, not part of node identity
 }
 
+func (s Sprintf) GetSpan() base.Span { return s.span }
+
 var _ FGGExpr = Sprintf{}
 
 func (s Sprintf) GetFormat() string  { return s.format }
@@ -711,7 +1665,7 @@ func (s Sprintf) Subs(subs map[Variable]FGGExpr) FGGExpr {
 	for i := 0; i < len(args); i++ {
 		args[i] = s.args[i].Subs(subs)
 	}
-	return Sprintf{s.format, args}
+	return Sprintf{s.format, args, s.span}
 }
 
 func (s Sprintf) TSubs(subs EtaOpen) FGGExpr {
@@ -731,7 +1685,7 @@ func (s Sprintf) Eval(ds []Decl) (FGGExpr, string) {
 		args[i] = v
 	}
 	if done {
-		return Sprintf{s.format, args}, rule
+		return Sprintf{s.format, args, s.span}, rule
 	} else {
 		cast := make([]interface{}, len(args))
 		for i := range args {
@@ -751,7 +1705,7 @@ func (s Sprintf) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (
 	for i := 0; i < len(s.args); i++ {
 		_, args[i] = s.args[i].Typing(ds, delta, gamma, allowStupid)
 	}
-	return NewTPrimitive(STRING), Sprintf{s.format, args}
+	return NewTPrimitive(STRING), Sprintf{s.format, args, s.span}
 }
 
 // From base.Expr
@@ -759,6 +1713,10 @@ func (s Sprintf) IsValue() bool {
 	return false
 }
 
+func (s Sprintf) IsPanic() bool {
+	return false
+}
+
 func (s Sprintf) CanEval(ds []Decl) bool {
 	return true
 }
@@ -0,0 +1,440 @@
+package fgg
+
+import (
+	"github.com/rhu1/fgg/internal/base"
+	"reflect"
+	"strings"
+)
+
+/* Formatting, cf. the fgg fmt command, and fg.FGProgram.Format */
+
+// Returns the canonical (indented, reparsable) source of p -- the result
+// reparses to p, modulo spans.  The comments (cf. base.Adaptor.GetComments)
+// are placed using the node spans, so p should be as parsed (e.g., before
+// frontend.RenameParams).
+func (p FGGProgram) Format(comments []base.Comment) string {
+	pr := base.NewPrinter(comments)
+	header := p.span // Just "package"
+	header.EndLine, header.EndCol = header.StartLine, header.StartCol+1
+	pr.Item(header, "package main;")
+	if p.printf {
+		pr.Item(base.Span{}, "import \"fmt\";")
+	}
+	pr.Blank()
+	for _, v := range p.decls {
+		formatDecl(pr, v)
+	}
+	pr.FlushBefore(spanOf(p.e_main).StartLine)
+	pr.Blank()
+	pr.Open(base.Span{}, "func main() {")
+	if p.printf {
+		pr.Item(spanOf(p.e_main), "fmt.Printf(\"%#v\", "+formatExpr(p.e_main)+")")
+	} else {
+		pr.Item(spanOf(p.e_main), "_ = "+formatExpr(p.e_main))
+	}
+	pr.Close(base.Span{}, "}") // Any comments after main are printed last
+	return pr.String()
+}
+
+func formatDecl(pr *base.Printer, d Decl) {
+	switch d := d.(type) {
+	case TypeDecl:
+		header := "type " + d.name + formatBigPsi(d.Psi) + " "
+		switch t := d.srcType.(type) {
+		case STypeLit:
+			if len(t.fDecls) == 0 {
+				break
+			}
+			pr.Open(d.span, header+"struct {")
+			for i, v := range t.fDecls {
+				pr.Item(v.span, formatFieldDecl(v)+sep(i, len(t.fDecls)))
+			}
+			pr.Close(d.span, "};")
+			return
+		case ITypeLit:
+			if len(t.specs) == 0 && !t.HasTList() {
+				break
+			}
+			pr.Open(d.span, header+"interface {")
+			if t.HasTList() {
+				pr.Item(base.Span{}, formatTypeList(t.tlist)) // N.B. no ";" before the specs
+			}
+			for i, v := range t.specs {
+				pr.Item(spanOf(v), formatSpec(v)+sep(i, len(t.specs)))
+			}
+			pr.Close(d.span, "};")
+			return
+		}
+		pr.Item(d.span, header+formatType(d.srcType)+";")
+	case MethDecl:
+		pr.Open(d.span, "func ("+d.x_recv+" "+d.t_recv+formatBigPsi(d.Psi_recv)+") "+
+			d.name+formatBigPsi(d.Psi_meth)+formatSigRest(d.pDecls, d.u_ret)+" {")
+		formatBody(pr, d.e_body)
+		pr.Close(d.span, "};")
+	case FuncDecl:
+		pr.Open(d.span, "func "+d.name+formatBigPsi(d.Psi)+formatSigRest(d.pDecls, d.u_ret)+" {")
+		formatBody(pr, d.e_body)
+		pr.Close(d.span, "};")
+	default:
+		panic("Unknown Decl: " + reflect.TypeOf(d).String() + "\n\t" + d.String())
+	}
+}
+
+// "return e", a conditional, a type switch, a binding or a panic -- N.B. an "else if" chain is printed as such
+func formatBody(pr *base.Printer, e FGGExpr) {
+	switch e1 := e.(type) {
+	case Panic:
+		pr.Item(spanOf(e), formatPanic(e1))
+	case Cond:
+		formatCond(pr, e1)
+	case TypeSwitch:
+		formatTypeSwitch(pr, e1)
+	case Let:
+		pr.Item(bindingSpan(e1.span, e1.e_def), formatBinding(e1)+";")
+		formatBody(pr, e1.e_body)
+	case MapAssign:
+		pr.Item(bindingSpan(e1.span, e1.e_val), formatMapAssign(e1)+";")
+		formatBody(pr, e1.e_body)
+	case CommaOk:
+		pr.Item(bindingSpan(e1.span, e1.e_def), formatCommaOk(e1)+";")
+		formatBody(pr, e1.e_body)
+	default:
+		pr.Item(spanOf(e), "return "+formatExpr(e))
+	}
+}
+
+// Cf. formatBody, but on a single line -- for the body of a FuncLit
+func formatInlineBody(e FGGExpr) string {
+	switch e1 := e.(type) {
+	case Panic:
+		return formatPanic(e1)
+	case Cond:
+		return "if " + formatExpr(e1.cond) + " { " + formatInlineBody(e1.e_then) +
+			" } else { " + formatInlineBody(e1.e_else) + " }"
+	case TypeSwitch:
+		var b strings.Builder
+		b.WriteString(formatSwitchHeader(e1) + " ")
+		for _, c := range e1.cases {
+			b.WriteString("case " + formatType(c.u) + ": " + formatInlineBody(c.e_body) + "; ")
+		}
+		if e1.e_def != nil {
+			b.WriteString("default: " + formatInlineBody(e1.e_def) + " ")
+		}
+		b.WriteString("}")
+		return b.String()
+	case Let:
+		return formatBinding(e1) + "; " + formatInlineBody(e1.e_body)
+	case MapAssign:
+		return formatMapAssign(e1) + "; " + formatInlineBody(e1.e_body)
+	case CommaOk:
+		return formatCommaOk(e1) + "; " + formatInlineBody(e1.e_body)
+	default:
+		return "return " + formatExpr(e)
+	}
+}
+
+func formatPanic(p Panic) string {
+	return "panic(" + formatExpr(p.e_arg) + ")"
+}
+
+// "x := e_def", or "var x t = e_def"
+func formatBinding(l Let) string {
+	if l.u == nil {
+		return l.x + " := " + formatExpr(l.e_def)
+	}
+	return "var " + l.x + " " + formatType(l.u) + " = " + formatExpr(l.e_def)
+}
+
+// "x[e_key] = e_val" -- N.B. e_M is just x in source, cf. MapAssign
+func formatMapAssign(m MapAssign) string {
+	return m.x + "[" + formatExpr(m.e_key) + "] = " + formatExpr(m.e_val)
+}
+
+// "x, x_ok := e_def"
+func formatCommaOk(c CommaOk) string {
+	return c.x + ", " + c.x_ok + " := " + formatExpr(c.e_def)
+}
+
+// Just the binding part of span, up to the end of last, i.e., not the body
+func bindingSpan(span base.Span, last FGGExpr) base.Span {
+	end := spanOf(last)
+	span.EndLine, span.EndCol = end.EndLine, end.EndCol
+	return span
+}
+
+func formatCond(pr *base.Printer, c Cond) {
+	pr.Open(c.span, "if "+formatExpr(c.cond)+" {")
+	for {
+		formatBody(pr, c.e_then)
+		c1, ok := c.e_else.(Cond)
+		if !ok {
+			break
+		}
+		pr.Middle("} else if " + formatExpr(c1.cond) + " {")
+		c = c1
+	}
+	pr.Middle("} else {")
+	formatBody(pr, c.e_else)
+	pr.Close(c.span, "}")
+}
+
+// The cases are printed at the indentation of the switch, cf. gofmt
+func formatTypeSwitch(pr *base.Printer, s TypeSwitch) {
+	pr.Open(s.span, formatSwitchHeader(s))
+	for _, c := range s.cases {
+		pr.Middle("case " + formatType(c.u) + ":")
+		formatBody(pr, c.e_body)
+	}
+	if s.e_def != nil {
+		pr.Middle("default:")
+		formatBody(pr, s.e_def)
+	}
+	pr.Close(s.span, "}")
+}
+
+// "switch x := e_I.(type) {", or "switch e_I.(type) {"
+func formatSwitchHeader(s TypeSwitch) string {
+	x := ""
+	if s.x != "" {
+		x = s.x + " := "
+	}
+	return "switch " + x + formatExpr(s.e_I) + ".(type) {"
+}
+
+// Field decls and specs are separated, not terminated, by ";"
+func sep(i int, n int) string {
+	if i < n-1 {
+		return ";"
+	}
+	return ""
+}
+
+/* Types */
+
+// "(type a Any(), b Any())"
+func formatBigPsi(Psi BigPsi) string {
+	fs := make([]string, len(Psi.tFormals))
+	for i, v := range Psi.tFormals {
+		fs[i] = string(v.name) + " " + formatType(v.u_I)
+	}
+	return "(type " + strings.Join(fs, ", ") + ")"
+}
+
+// cf. FieldDecl.String
+func formatFieldDecl(fd FieldDecl) string {
+	if fd.embedded {
+		return formatType(fd.u)
+	}
+	return fd.field + " " + formatType(fd.u)
+}
+
+// N.B. STypeLit/ITypeLit String have a leading space, and are not used here
+func formatType(u Type) string {
+	switch u := u.(type) {
+	case TNamed:
+		return u.t_name + "(" + formatTypes(u.u_args) + ")"
+	case STypeLit:
+		if len(u.fDecls) == 0 {
+			return "struct {}"
+		}
+		fs := make([]string, len(u.fDecls))
+		for i, v := range u.fDecls {
+			fs[i] = formatFieldDecl(v)
+		}
+		return "struct { " + strings.Join(fs, "; ") + " }"
+	case ITypeLit:
+		var ss []string
+		if u.HasTList() {
+			ss = append(ss, formatTypeList(u.tlist))
+		}
+		for i, v := range u.specs {
+			ss = append(ss, formatSpec(v)+sep(i, len(u.specs)))
+		}
+		if len(ss) == 0 {
+			return "interface {}"
+		}
+		return "interface { " + strings.Join(ss, " ") + " }"
+	case TFunc:
+		return "func(" + formatTypes(u.params) + ") " + formatType(u.u_ret)
+	case TSlice:
+		return "[]" + formatType(u.elem)
+	case TMap:
+		return "map[" + formatType(u.key) + "]" + formatType(u.elem)
+	default: // TParam, TPrimitive
+		return u.String()
+	}
+}
+
+func formatTypes(us []Type) string {
+	ss := make([]string, len(us))
+	for i, v := range us {
+		ss[i] = formatType(v)
+	}
+	return strings.Join(ss, ", ")
+}
+
+// "type int32, int64"
+func formatTypeList(tlist TypeList) string {
+	return "type " + formatTypes(tlist)
+}
+
+func formatSpec(s Spec) string {
+	switch s := s.(type) {
+	case Sig:
+		return s.meth + formatBigPsi(s.Psi) + formatSigRest(s.pDecls, s.u_ret)
+	case Type: // Embedded interface
+		return formatType(s)
+	case Union:
+		ts := make([]string, len(s))
+		for i, t := range s {
+			ts[i] = formatType(t.u)
+			if t.tilde {
+				ts[i] = "~" + ts[i]
+			}
+		}
+		return strings.Join(ts, " | ")
+	default:
+		panic("Unknown Spec: " + reflect.TypeOf(s).String() + "\n\t" + s.String())
+	}
+}
+
+// "(x A(), y B()) C()"
+func formatSigRest(pds []ParamDecl, u_ret Type) string {
+	ps := make([]string, len(pds))
+	for i, v := range pds {
+		ps[i] = v.name + " " + formatType(v.u)
+	}
+	return "(" + strings.Join(ps, ", ") + ") " + formatType(u_ret)
+}
+
+/* Expressions */
+
+// Binary operator precedence, cf. the order of the BinaryOp alternatives in
+// FGG.g4 -- higher binds tighter
+func precedence(op Operator) int {
+	switch op {
+	case LOR:
+		return 1
+	case LAND:
+		return 2
+	case EQL, NEQ, GT, LT, GEQ, LEQ:
+		return 3
+	case ADD, SUB, OR, XOR:
+		return 4
+	default: // MUL, QUO, REM, SHL, SHR, AND
+		return 5
+	}
+}
+
+const unaryPrecedence = 6 // Binds tighter than any binary op, cf. UnaryOp in the grammar
+
+const maxPrecedence = 7 // Variables, struct literals, selects, calls, etc.
+
+func exprPrecedence(e FGGExpr) int {
+	switch e := e.(type) {
+	case UnaryOperation:
+		return unaryPrecedence
+	case BinaryOperation:
+		return precedence(e.op)
+	case Comparison:
+		return precedence(e.op)
+	default:
+		return maxPrecedence
+	}
+}
+
+// Parenthesised if e binds less tightly than prec
+func formatOperand(e FGGExpr, prec int) string {
+	if exprPrecedence(e) < prec {
+		return "(" + formatExpr(e) + ")"
+	}
+	return formatExpr(e)
+}
+
+// The receiver of a select, call or assert -- e.g., "(1).m()()", not "1.m()()"
+func formatRecv(e FGGExpr) string {
+	if _, ok := e.(PrimitiveLiteral); ok {
+		return "(" + formatExpr(e) + ")"
+	}
+	return formatOperand(e, maxPrecedence)
+}
+
+func formatBinaryOp(b BaseBinaryOperation) string {
+	prec := precedence(b.op) // Left associative
+	return formatOperand(b.left, prec) + " " + string(b.op) + " " +
+		formatOperand(b.right, prec+1)
+}
+
+func formatExpr(e FGGExpr) string {
+	switch e := e.(type) {
+	case Variable:
+		return e.name
+	case StructLit:
+		return formatType(e.u_S) + "{" + formatExprs(e.elems) + "}"
+	case Select:
+		return formatRecv(e.e_S) + "." + e.field
+	case Call:
+		return formatRecv(e.e_recv) + "." + e.meth + "(" + formatTypes(e.t_args) + ")(" +
+			formatExprs(e.args) + ")"
+	case FuncCall:
+		return e.fun + "(" + formatTypes(e.t_args) + ")(" + formatExprs(e.args) + ")"
+	case FuncLit:
+		return "func" + formatSigRest(e.pDecls, e.u_ret) + " { " + formatInlineBody(e.e_body) + " }"
+	case Apply:
+		if _, ok := e.e_fun.(Select); ok { // "(x.f)(e)", not the Call "x.f(e)"
+			return "(" + formatExpr(e.e_fun) + ")(" + formatExprs(e.args) + ")"
+		}
+		return formatRecv(e.e_fun) + "(" + formatExprs(e.args) + ")"
+	case SliceLit:
+		return formatType(e.typ) + "{" + formatExprs(e.elems) + "}"
+	case MapLit:
+		es := make([]string, len(e.entries))
+		for i, v := range e.entries {
+			es[i] = formatExpr(v.key) + ": " + formatExpr(v.val)
+		}
+		return formatType(e.typ) + "{" + strings.Join(es, ", ") + "}"
+	case Index:
+		return formatRecv(e.e_S) + "[" + formatExpr(e.e_idx) + "]"
+	case Len:
+		return "len(" + formatExpr(e.e_S) + ")"
+	case Append:
+		return "append(" + formatExprs(append([]FGGExpr{e.e_S}, e.elems...)) + ")"
+	case Assert:
+		return formatRecv(e.e_I) + ".(" + formatType(e.u_cast) + ")"
+	case Convert:
+		return formatType(e.typ) + "(" + formatExpr(e.expr) + ")"
+	case Sprintf:
+		if len(e.args) == 0 {
+			return "fmt.Sprintf(" + e.format + ")"
+		}
+		return "fmt.Sprintf(" + e.format + ", " + formatExprs(e.args) + ")"
+	case UnaryOperation:
+		if u, ok := e.e.(UnaryOperation); ok && u.op == e.op { // "-(-x)", not "--x"
+			return string(e.op) + "(" + formatExpr(u) + ")"
+		}
+		return string(e.op) + formatOperand(e.e, unaryPrecedence)
+	case BinaryOperation:
+		return formatBinaryOp(e.BaseBinaryOperation)
+	case Comparison:
+		return formatBinaryOp(e.BaseBinaryOperation)
+	case PrimitiveLiteral, TypedPrimitiveValue:
+		return e.String()
+	default:
+		panic("Unknown FGGExpr: " + reflect.TypeOf(e).String() + "\n\t" + e.String())
+	}
+}
+
+func formatExprs(es []FGGExpr) string {
+	ss := make([]string, len(es))
+	for i, v := range es {
+		ss[i] = formatExpr(v)
+	}
+	return strings.Join(ss, ", ")
+}
+
+func spanOf(n FGGNode) base.Span {
+	if s, ok := n.(base.Spanned); ok {
+		return s.GetSpan()
+	}
+	return base.Span{}
+}
@@ -3,33 +3,132 @@ package fgg
 import (
 	"strconv"
 	"strings"
+
+	"github.com/rhu1/fgg/internal/base"
 )
 
 /* Subtype and Equality constraints - definition and basic methods */
 
+// Common interface of SubtypeConstr and EqualityConstr, for reporting
+// inference failures -- cf. Origin
+type Constraint interface {
+	String() string
+	GetOrigin() Origin
+}
+
 // Constraint of the form u1 <: u2
-type SubtypeConstr struct{
+type SubtypeConstr struct {
 	u1, u2 Type
+	origin Origin
 }
 
-func NewSubtypeConstr(s, t Type) SubtypeConstr {
-	return SubtypeConstr{s, t}
+var _ Constraint = SubtypeConstr{}
+
+func NewSubtypeConstr(s, t Type, o Origin) SubtypeConstr {
+	return SubtypeConstr{s, t, o}
 }
 func (c SubtypeConstr) SubsEtaOpen(eta EtaOpen) SubtypeConstr {
-	return SubtypeConstr{c.u1.SubsEtaOpen(eta), c.u2.SubsEtaOpen(eta)}
+	return SubtypeConstr{c.u1.SubsEtaOpen(eta), c.u2.SubsEtaOpen(eta), c.origin}
+}
+func (c SubtypeConstr) GetOrigin() Origin { return c.origin }
+func (c SubtypeConstr) String() string {
+	return c.u1.String() + " <: " + c.u2.String()
 }
 
 // Constraint of the form u1 == u2
-type EqualityConstr struct{
+type EqualityConstr struct {
 	u1, u2 Type
+	origin Origin
 }
 
-func NewEqualityConstr(t1, t2 Type) EqualityConstr {
-	return EqualityConstr{t1, t2}
+var _ Constraint = EqualityConstr{}
+
+func NewEqualityConstr(t1, t2 Type, o Origin) EqualityConstr {
+	return EqualityConstr{t1, t2, o}
 }
 
 func (c EqualityConstr) SubsEtaOpen(eta EtaOpen) EqualityConstr {
-	return EqualityConstr{c.u1.SubsEtaOpen(eta), c.u2.SubsEtaOpen(eta)}
+	return EqualityConstr{c.u1.SubsEtaOpen(eta), c.u2.SubsEtaOpen(eta), c.origin}
+}
+func (c EqualityConstr) GetOrigin() Origin { return c.origin }
+func (c EqualityConstr) String() string {
+	return c.u1.String() + " == " + c.u2.String()
+}
+
+/* Constraint provenance */
+
+// Where a constraint comes from: the role (e.g., "arg 2") of a subexpr of
+// node (e.g., a Call) -- or, if derived, the constraints it was derived from:
+// by decomposition (e.g., α <: β from List(α) <: List(β)), or by substituting
+// the binding found by unifying another constraint (cf. subsBinding)
+type Origin struct {
+	node FGGNode // nil if derived
+	role string
+	from []Constraint
+}
+
+func originAt(node FGGNode, role string) Origin {
+	return Origin{node, role, nil}
+}
+
+func derivedFrom(cs ...Constraint) Origin {
+	return Origin{nil, "", cs}
+}
+
+func (o Origin) String() string {
+	if o.node == nil {
+		return "derived"
+	}
+	var b strings.Builder
+	b.WriteString(o.role)
+	b.WriteString(" of ")
+	b.WriteString(o.node.String())
+	if n, ok := o.node.(base.Spanned); ok && n.GetSpan().IsKnown() {
+		b.WriteString(" at ")
+		b.WriteString(n.GetSpan().String())
+	}
+	return b.String()
+}
+
+// Panics with msg followed by the chain of constraints that c was derived
+// from, each down to the expr it was collected from, e.g.,
+//
+//	S() <: N()
+//		S() <: αα3  -- arg 2 of pair()(N(){}, S(){})
+//		N() <: αα3  -- arg 1 of pair()(N(){}, S(){})
+func failConstr(c Constraint, msg string) {
+	var b strings.Builder
+	b.WriteString(msg)
+	writeOrigins(&b, c, 1)
+	panic(base.NewDiagnostic(base.DIAG_INFER, originNode(c), b.String()))
+}
+
+func writeOrigins(b *strings.Builder, c Constraint, depth int) {
+	b.WriteString("\n")
+	b.WriteString(strings.Repeat("\t", depth))
+	b.WriteString(c.String())
+	o := c.GetOrigin()
+	if o.node != nil {
+		b.WriteString("  -- ")
+		b.WriteString(o.String())
+	}
+	for _, v := range o.from {
+		writeOrigins(b, v, depth+1)
+	}
+}
+
+// The first expr that c was (ultimately) collected from, if any
+func originNode(c Constraint) FGGNode {
+	o := c.GetOrigin()
+	if o.node != nil {
+		return o.node
+	}
+	for _, v := range o.from {
+		if n := originNode(v); n != nil {
+			return n
+		}
+	}
+	return nil
 }
 
 /* Sets of constraints - duplicated functionalities for lack of a better solution (e.g. generics) */
@@ -42,14 +141,19 @@ func NewEqConstraintSet() EqConstraintSet {
 	return make(EqConstraintSet, 0, 10)
 }
 
-func (cs EqConstraintSet) SubsEtaOpen(eta EtaOpen) EqConstraintSet {
-	for i, c := range cs {
-		cs[i] = c.SubsEtaOpen(eta)
+// Substitutes the binding eta, found by unifying c -- a constraint changed by
+// eta is then (also) derived from c
+func (cs EqConstraintSet) subsBinding(eta EtaOpen, c Constraint) EqConstraintSet {
+	for i, v := range cs {
+		if v1 := v.SubsEtaOpen(eta); !v1.u1.Equals(v.u1) || !v1.u2.Equals(v.u2) {
+			v1.origin = derivedFrom(v, c)
+			cs[i] = v1
+		}
 	}
 	return cs
 }
 
-func (cs EqConstraintSet) Add(c... EqualityConstr) EqConstraintSet {
+func (cs EqConstraintSet) Add(c ...EqualityConstr) EqConstraintSet {
 	return append(cs, c...)
 }
 
@@ -59,8 +163,8 @@ func (cs EqConstraintSet) UnifyAll(ds []Decl, delta Delta) EtaOpen {
 	}
 	c, cs_ := cs[0], cs[1:]
 	eta := c.Unify(ds, delta)
-	cs_ = cs_.SubsEtaOpen(eta)
-	return compose(cs_.UnifyAll(ds, delta), eta)
+	cs_ = cs_.subsBinding(eta, c)
+	return compose(ds, delta, c, cs_.UnifyAll(ds, delta), eta)
 	// se unifyAll retornasse (EtaOpen, error), o que quereria fazer é algo como
 	// unifyAll(cs.SubsEtaOpen(subs), delta) >>= \s' -> compose(s', subs)
 }
@@ -73,31 +177,58 @@ func NewSubConstraintSet() SubConstraintSet {
 	return make(SubConstraintSet, 0, 10)
 }
 
-func (cs SubConstraintSet) SubsEtaOpen(eta EtaOpen) SubConstraintSet {
-	for i, c := range cs {
-		cs[i] = c.SubsEtaOpen(eta)
+// Cf. EqConstraintSet.subsBinding
+func (cs SubConstraintSet) subsBinding(eta EtaOpen, c Constraint) SubConstraintSet {
+	for i, v := range cs {
+		if v1 := v.SubsEtaOpen(eta); !v1.u1.Equals(v.u1) || !v1.u2.Equals(v.u2) {
+			v1.origin = derivedFrom(v, c)
+			cs[i] = v1
+		}
 	}
 	return cs
 }
 
-func (cs SubConstraintSet) Add(c... SubtypeConstr) SubConstraintSet {
+func (cs SubConstraintSet) Add(c ...SubtypeConstr) SubConstraintSet {
 	return append(cs, c...)
 }
 
 func (cs SubConstraintSet) UnifyAll(ds []Decl, delta Delta) EtaOpen {
+	return cs.untypedLast().unifyAll(ds, delta)
+}
+
+// Constraints from untyped constants are unified last, so that a type var
+// is bound to a defined type where there is one, e.g., "f(1, x)" with x:int64
+func (cs SubConstraintSet) untypedLast() SubConstraintSet {
+	res := NewSubConstraintSet()
+	for _, c := range cs {
+		if _, ok := c.u1.(UndefTPrimitive); !ok {
+			res = res.Add(c)
+		}
+	}
+	for _, c := range cs {
+		if _, ok := c.u1.(UndefTPrimitive); ok {
+			res = res.Add(c)
+		}
+	}
+	return res
+}
+
+func (cs SubConstraintSet) unifyAll(ds []Decl, delta Delta) EtaOpen {
 	if len(cs) == 0 {
 		return EtaOpen{}
 	}
 	c, cs_ := cs[0], cs[1:]
 	eta := c.Unify(ds, delta)
-	cs_ = cs_.SubsEtaOpen(eta)
-	return compose(cs_.UnifyAll(ds, delta), eta)
+	cs_ = cs_.subsBinding(eta, c)
+	return compose(ds, delta, c, cs_.unifyAll(ds, delta), eta)
 	// se unifyAll retornasse (EtaOpen, error), o que quereria fazer é algo como
 	// unifyAll(cs.SubsEtaOpen(subs), delta) >>= \s' -> compose(s', subs)
 }
 
-// s1 `compose` s2
-func compose(s1, s2 EtaOpen) EtaOpen {
+// s1 `compose` s2, where s2 was found by unifying c
+// If both map the same var, the more general type is kept, e.g., for
+// "f(x, y)" with x:S(), y:Any() and f's param types both α
+func compose(ds []Decl, delta Delta, c Constraint, s1, s2 EtaOpen) EtaOpen {
 	res := make(EtaOpen)
 	for tParam, u := range s2 {
 		res[tParam] = u.SubsEtaOpen(s1)
@@ -106,13 +237,13 @@ func compose(s1, s2 EtaOpen) EtaOpen {
 	for tParam, u := range s1 {
 		if u2, present := res[tParam]; present {
 			// try to find mapping to most general type todo or should it be to the most specific type? e.g. MyInt <: Any()
-			if u.ImplsDelta([]Decl{}, make(Delta), u2) {
+			if u.ImplsDelta(ds, delta, u2) {
 				res[tParam] = u2
-			} else if u2.ImplsDelta([]Decl{}, make(Delta), u) {
+			} else if u2.ImplsDelta(ds, delta, u) {
 				res[tParam] = u
 			} else {
-				panic("Incompatible mappings for " + tParam.String() + ": " +
-					u.String() + " and " + u2.String())
+				failConstr(c, "Cannot infer type argument "+tParam.String()+
+					": conflicting types "+u.String()+" and "+u2.String())
 			}
 		} else {
 			res[tParam] = u
@@ -129,24 +260,37 @@ func compose(s1, s2 EtaOpen) EtaOpen {
 
 /* Unification of a Subtype/Equality constraint */
 
-func bindSub(x FreshTVar, u Type) EtaOpen {
+func bindSub(ds []Decl, delta Delta, c Constraint, x FreshTVar, u Type) EtaOpen {
 	if x.Equals(u) {
 		return EtaOpen{}
 	} else if occursCheck(x, u) {
-		panic("Cannot construct infinite type: " + x.String() + " ~ " + u.String())
-	} else {
-		// if u1.ImplsDelta( delta[u2_cast] ) ... todo check bounds
-		return EtaOpen{x.TParam: u}
+		failConstr(c, "Cannot construct infinite type: "+x.String()+" ~ "+u.String())
 	}
+	checkBound(ds, delta, c, x, u)
+	return EtaOpen{x.TParam: u}
 }
 
 // todo are these 2 binds different? If they are, it is due to the way the bounds are checked
-func bindEq(x FreshTVar, u Type) EtaOpen {
+func bindEq(ds []Decl, delta Delta, c Constraint, x FreshTVar, u Type) EtaOpen {
 	if occursCheck(x, u) {
-		panic("Cannot construct infinite type: " + x.String() + " ~ " + u.String())
-	} else {
-		// if u1.ImplsDelta( delta[u2_cast] ) ... todo check bounds
-		return EtaOpen{x.TParam: u}
+		failConstr(c, "Cannot construct infinite type: "+x.String()+" ~ "+u.String())
+	}
+	checkBound(ds, delta, c, x, u)
+	return EtaOpen{x.TParam: u}
+}
+
+// Checks that u, bound to x, implements the bound of x (i.e., of the TFormal
+// instantiated by x, cf. instantiatePsi).  Skipped while u or the bound is
+// not yet known, e.g., Ord(αα1) for "T Ord(T)", or α1 when unifying method
+// sigs (cf. collectSigConstrs) -- the annotated expr is anyway checked by
+// Typing, cf. FGGProgram.Check
+func checkBound(ds []Decl, delta Delta, c Constraint, x FreshTVar, u Type) {
+	if hasFreshTVars(u) || !isClosedIn(delta, u) || !isClosedIn(delta, x.bound) {
+		return
+	}
+	if !u.ImplsDelta(ds, delta, x.bound) {
+		failConstr(c, "Inferred type actual must implement type formal: actual="+
+			u.String()+", param="+x.bound.String())
 	}
 }
 
@@ -156,10 +300,10 @@ func (c SubtypeConstr) Unify(ds []Decl, delta Delta) EtaOpen {
 	u2 := c.u2
 
 	if u1_cast, ok := u1.(FreshTVar); ok {
-		return bindSub(u1_cast, u2)
+		return bindSub(ds, delta, c, u1_cast, u2)
 	}
 	if u2_cast, ok := u2.(FreshTVar); ok {
-		return bindSub(u2_cast, u1) // todo when considering bounds, will this test that bound(u2) <: bound(u1) ??
+		return bindSub(ds, delta, c, u2_cast, u1) // todo when considering bounds, will this test that bound(u2) <: bound(u1) ??
 	}
 
 	u1_named, ok1 := u1.(TNamed)
@@ -175,27 +319,43 @@ func (c SubtypeConstr) Unify(ds []Decl, delta Delta) EtaOpen {
 			constrs := NewSubConstraintSet()
 			for i, u_arg := range u2_named.u_args {
 				if hasFreshTVars(u_arg) {
-					c := NewSubtypeConstr(u1_named.u_args[i], u_arg) // TODO should I be collecting constraints inside unify?
-					constrs = constrs.Add(c)                        //   Or maybe add that logic to a method AddConstraints that searches for name-matching TNameds?
+					c1 := NewSubtypeConstr(u1_named.u_args[i], u_arg, derivedFrom(c)) // TODO should I be collecting constraints inside unify?
+					constrs = constrs.Add(c1)                                         //   Or maybe add that logic to a method AddConstraints that searches for name-matching TNameds?
 				} else if !u1_named.u_args[i].ImplsDelta(ds, delta, u_arg) {
-					panic("")
+					failConstr(c, "Can't unify (<:) types "+u1.String()+" and "+u2.String())
 				}
 			}
 			return constrs.UnifyAll(ds, delta)
 
+		} else if !isIfaceType(ds, u2) {
+			failConstr(c, "Can't unify (<:) types "+u1.String()+" and "+u2.String())
 		} else {
 			ms_t1 := methodsDelta(ds, delta, u1)
 			ms_t2 := methodsDelta(ds, delta, u2)
-			return unifyMethods(ds, delta, ms_t1, ms_t2)
+			return unifyMethods(ds, delta, c, ms_t1, ms_t2)
 		}
 	}
 	// either u1 or u2 not a TNamed
 
-	if u1.ImplsDelta(ds, delta, u2) {
-		return EtaOpen{}
-	} else {
-		panic("Can't unify (<:) types " + u1.String() + " and " + u2.String())
+	if f2, ok := u2.(TFunc); ok { // e.g., a defined function type <: func(α) α
+		if f1, ok := u1.Underlying(ds).(TFunc); ok {
+			return unifyFuncTypes(ds, delta, c, f1, f2)
+		}
 	}
+	if s2, ok := u2.(TSlice); ok { // e.g., a defined slice type <: []α
+		if s1, ok := u1.Underlying(ds).(TSlice); ok {
+			return NewEqualityConstr(s1.elem, s2.elem, derivedFrom(c)).Unify(ds, delta)
+		}
+	}
+	if m2, ok := u2.(TMap); ok { // e.g., a defined map type <: map[α]β
+		if m1, ok := u1.Underlying(ds).(TMap); ok {
+			return unifyMapTypes(ds, delta, c, m1, m2)
+		}
+	}
+	if !u1.ImplsDelta(ds, delta, u2) {
+		failConstr(c, "Can't unify (<:) types "+u1.String()+" and "+u2.String())
+	}
+	return EtaOpen{}
 
 }
 
@@ -204,10 +364,10 @@ func (c EqualityConstr) Unify(ds []Decl, delta Delta) EtaOpen {
 	u2 := c.u2
 
 	if u1_cast, ok := u1.(FreshTVar); ok {
-		return bindEq(u1_cast, u2)
+		return bindEq(ds, delta, c, u1_cast, u2)
 	}
 	if u2_cast, ok := u2.(FreshTVar); ok {
-		return bindEq(u2_cast, u1)
+		return bindEq(ds, delta, c, u2_cast, u1)
 	}
 
 	u1_cast, ok1 := u1.(TNamed)
@@ -215,8 +375,8 @@ func (c EqualityConstr) Unify(ds []Decl, delta Delta) EtaOpen {
 	if ok1 && ok2 && u1_cast.t_name == u2_cast.t_name {
 		constrs := NewEqConstraintSet()
 		for i, u_arg := range u2_cast.u_args {
-			c := NewEqualityConstr(u1_cast.u_args[i], u_arg) // TODO should I be collecting constraints inside unify?
-			constrs = constrs.Add(c)
+			c1 := NewEqualityConstr(u1_cast.u_args[i], u_arg, derivedFrom(c)) // TODO should I be collecting constraints inside unify?
+			constrs = constrs.Add(c1)
 
 			//if hasFreshTVars(u_arg) {
 			//	c := NewEqualityConstr(u1_cast.u_args[i], u_arg)
@@ -227,34 +387,72 @@ func (c EqualityConstr) Unify(ds []Decl, delta Delta) EtaOpen {
 		}
 		return constrs.UnifyAll(ds, delta)
 	}
-	// either u1 or u2 not a TNamed
+	f1, ok1 := u1.(TFunc)
+	f2, ok2 := u2.(TFunc)
+	if ok1 && ok2 {
+		return unifyFuncTypes(ds, delta, c, f1, f2)
+	}
+	s1, ok1 := u1.(TSlice)
+	s2, ok2 := u2.(TSlice)
+	if ok1 && ok2 { // Slice types are invariant, cf. TSlice.AssignableToDelta
+		return NewEqualityConstr(s1.elem, s2.elem, derivedFrom(c)).Unify(ds, delta)
+	}
+	m1, ok1 := u1.(TMap)
+	m2, ok2 := u2.(TMap)
+	if ok1 && ok2 { // Map types are invariant, cf. TMap.AssignableToDelta
+		return unifyMapTypes(ds, delta, c, m1, m2)
+	}
+	// either u1 or u2 not a TNamed (or TFunc, or TSlice, or TMap)
 	// TODO consider untyped constants here
-	if u1.Equals(u2) {
-		return EtaOpen{}
-	} else {
-		panic("Can't unify (==) types " + u1.String() + " and " + u2.String())
+	if !u1.Equals(u2) {
+		failConstr(c, "Can't unify (==) types "+u1.String()+" and "+u2.String())
+	}
+	return EtaOpen{}
+}
+
+// Function types are invariant (cf. TFunc.AssignableToDelta), so
+// the param and return types must be equal
+func unifyFuncTypes(ds []Decl, delta Delta, c Constraint, f1, f2 TFunc) EtaOpen {
+	if len(f1.params) != len(f2.params) {
+		failConstr(c, "Can't unify function types "+f1.String()+" and "+f2.String())
+	}
+	constrs := NewEqConstraintSet()
+	for i, u := range f1.params {
+		constrs = constrs.Add(NewEqualityConstr(u, f2.params[i], derivedFrom(c)))
 	}
+	constrs = constrs.Add(NewEqualityConstr(f1.u_ret, f2.u_ret, derivedFrom(c)))
+	return constrs.UnifyAll(ds, delta)
+}
+
+// Map types are invariant (cf. TMap.AssignableToDelta), so the key and
+// element types must be equal
+func unifyMapTypes(ds []Decl, delta Delta, c Constraint, m1, m2 TMap) EtaOpen {
+	constrs := NewEqConstraintSet()
+	constrs = constrs.Add(NewEqualityConstr(m1.key, m2.key, derivedFrom(c)))
+	constrs = constrs.Add(NewEqualityConstr(m1.elem, m2.elem, derivedFrom(c)))
+	return constrs.UnifyAll(ds, delta)
 }
 
 // On successful unification, returns eta
 // s.t. ms1 [is a superset of/at least equal to] ms2[eta]
-func unifyMethods(ds []Decl, delta Delta, ms1, ms2 MethodSet) EtaOpen {
+// N.B. c is the constraint being unified, for failure reports
+func unifyMethods(ds []Decl, delta Delta, c Constraint, ms1, ms2 MethodSet) EtaOpen {
 	constrs := NewEqConstraintSet()
 	for name, sig2 := range ms2 {
 		sig1, ok := ms1[name]
 		if !ok {
-			panic("Cant make ms2 a subset of ms1 -- method " + name + " of ms2 not present in ms1")
+			failConstr(c, "Cant make ms2 a subset of ms1 -- method "+name+" of ms2 not present in ms1")
 		}
 		if len(sig1.Psi.tFormals) != len(sig2.Psi.tFormals) || len(sig1.pDecls) != len(sig2.pDecls) {
-			panic("Can't unify signatures:\n\tsig1 = " + sig1.String() + "\n\tsig2 = " + sig2.String())
+			failConstr(c, "Can't unify signatures: sig1 = "+sig1.String()+", sig2 = "+sig2.String())
 		}
-		sigConstrs := collectSigConstrs(sig1, sig2)
+		sigConstrs := collectSigConstrs(c, sig1, sig2)
 		constrs = constrs.Add(sigConstrs...)
 	}
 	return constrs.UnifyAll(ds, delta)
 }
 
-func collectSigConstrs(g1, g2 Sig) EqConstraintSet {
+func collectSigConstrs(c Constraint, g1, g2 Sig) EqConstraintSet {
 	subs1 := makeParamIndexSubs(g1.Psi) // todo maybe factor this + subsEtaOpen as e.g. canonicalizeSig
 	subs2 := makeParamIndexSubs(g2.Psi)
 	sig1 := g1.SubsEtaOpen(subs1)
@@ -262,32 +460,47 @@ func collectSigConstrs(g1, g2 Sig) EqConstraintSet {
 
 	constrs := NewEqConstraintSet()
 	for i, tf1 := range sig1.Psi.tFormals {
-		c := NewEqualityConstr(tf1.u_I, sig2.Psi.tFormals[i].u_I)
-		constrs = constrs.Add(c)
+		c1 := NewEqualityConstr(tf1.u_I, sig2.Psi.tFormals[i].u_I, derivedFrom(c))
+		constrs = constrs.Add(c1)
 	}
 	for i, pd1 := range sig1.pDecls {
-		c := NewEqualityConstr(pd1.u, sig2.pDecls[i].u)
-		constrs = constrs.Add(c)
+		c1 := NewEqualityConstr(pd1.u, sig2.pDecls[i].u, derivedFrom(c))
+		constrs = constrs.Add(c1)
 	}
-	return constrs.Add(NewEqualityConstr(sig1.u_ret, sig2.u_ret))
+	return constrs.Add(NewEqualityConstr(sig1.u_ret, sig2.u_ret, derivedFrom(c)))
 }
 
 /******************************************************************************/
 /* Inference of expressions' types */
 
-func (x Variable) Infer(ds []Decl, delta Delta, gamma Gamma) Type {
+// N.B. each Infer also returns the annotated expr: any omitted type actuals
+// (cf. Call, FuncCall, StructLit) are filled in by the substitution found by
+// unification, cf. FGGProgram.Check (INFER mode)
+
+func (x Variable) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
 	res, ok := gamma[x.name]
 	if !ok {
 		panic("Var not in env: " + x.String())
 	}
-	return res
+	return res, x
 }
 
-func (s StructLit) Infer(ds []Decl, delta Delta, gamma Gamma) Type {
-
-	td := getTDecl(ds, s.u_S.GetName()) // panics if not found
-	u_S := instantiateType(s.u_S.GetName(), td.GetBigPsi())
-
+func (s StructLit) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	u_S := s.u_S
+	if u_named, ok := s.u_S.(TNamed); ok && len(u_named.u_args) == 0 { // e.g., "Box(){false}"
+		td := getTDecl(ds, u_named.t_name) // panics if not found
+		u_S = instantiateType(u_named.t_name, td.GetBigPsi())
+	}
+	if _, ok := u_S.Underlying(ds).(TSlice); ok { // Cf. StructLit.Typing
+		return SliceLit{u_S, s.elems, s.span}.Infer(ds, delta, gamma)
+	}
+	if _, ok := u_S.Underlying(ds).(TMap); ok && len(s.elems) == 0 { // "u{}"
+		return MapLit{u_S, []MapEntry{}, s.span}.Infer(ds, delta, gamma)
+	}
+	if !isStructType(ds, u_S) {
+		panic("Struct literal: " + s.u_S.String() + " is not a struct type" +
+			"\n\t" + s.String())
+	}
 	fs := fields(ds, u_S)
 	if len(s.elems) != len(fs) {
 		var b strings.Builder
@@ -299,33 +512,30 @@ func (s StructLit) Infer(ds []Decl, delta Delta, gamma Gamma) Type {
 		b.WriteString(s.String())
 		panic(b.String())
 	}
-	//elems := make([]FGGExpr, len(s.elems))
+	elems := make([]FGGExpr, len(s.elems))
 	constraints := NewSubConstraintSet()
 	for i := 0; i < len(s.elems); i++ {
-		u := s.elems[i].Infer(ds, delta, gamma)
-		constr := NewSubtypeConstr(u, fs[i].u)
-		constraints = constraints.Add(constr)
-
-		//elems[i] = newSubtree
-		//// if newSubtree is a PrimitiveLiteral node, convert it to the Ast node
-		//// corresponding to a value of the expected type (r)
-		//if lit, ok := newSubtree.(PrimitiveLiteral); ok {
-		//	elems[i] = ConvertLitNode(lit, u_decl)
-		//}
+		u, e := s.elems[i].Infer(ds, delta, gamma)
+		constraints = constraints.Add(NewSubtypeConstr(u, fs[i].u,
+			originAt(s, "field "+fs[i].field)))
+		elems[i] = e
 	}
-	subs := constraints.UnifyAll(ds, delta)
+	subs := defaultUntyped(constraints.UnifyAll(ds, delta))
+	return u_S.SubsEtaOpen(subs), StructLit{u_S, elems, s.span}.TSubs(subs)
+}
 
-	return u_S.SubsEtaOpen(subs) //, StructLit{s.u_S, elems}
+// E.g., "arg 2" -- cf. Origin
+func argRole(i int) string {
+	return "arg " + strconv.Itoa(i+1)
 }
 
-func (c Call) Infer(ds []Decl, delta Delta, gamma Gamma) Type {
-	u_recv := c.e_recv.Infer(ds, delta, gamma)
-	var g Sig
-	//if tmp, ok := methodsDelta(ds, delta, bounds(delta, u_recv))[c.meth]; !ok { // !!! submission version had "methods(m)"
-	if tmp, ok := methods(ds, u_recv)[c.meth]; !ok { // !!! submission version had "methods(m)"
+// Omitted type actuals, "x.m()(args)", are inferred from the args --
+// the result is written back into the annotated Call's t_args
+func (c Call) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	u_recv, e_recv := c.e_recv.Infer(ds, delta, gamma)
+	g, ok := methodsDelta(ds, delta, u_recv)[c.meth]
+	if !ok {
 		panic("Method not found: " + c.meth + " in " + u_recv.String())
-	} else {
-		g = tmp
 	}
 	if len(c.args) != len(g.pDecls) {
 		var b strings.Builder
@@ -337,112 +547,435 @@ func (c Call) Infer(ds []Decl, delta Delta, gamma Gamma) Type {
 		b.WriteString(c.String())
 		panic(b.String())
 	}
-	//args := make([]FGGExpr, len(c.args))
+	t_args, sigInst := instantiateSig(g, c.t_args)
+
+	args := make([]FGGExpr, len(c.args))
+	constraints := NewSubConstraintSet()
+	for i := 0; i < len(c.args); i++ {
+		u_a, e := c.args[i].Infer(ds, delta, gamma)
+		constraints = constraints.Add(NewSubtypeConstr(u_a, sigInst.pDecls[i].u,
+			originAt(c, argRole(i))))
+		args[i] = e
+	}
+	subs := defaultUntyped(constraints.UnifyAll(ds, delta))
+	res := Call{e_recv, c.meth, t_args, args, c.span}.TSubs(subs)
+	return sigInst.u_ret.SubsEtaOpen(subs), res
+}
 
-	sigInst := instantiateSig(g)
+// Cf. Call.Infer
+func (c FuncCall) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	if e, ok := c.asApply(gamma); ok {
+		return e.Infer(ds, delta, gamma)
+	}
+	if !isFuncName(ds, c.fun) {
+		panic("Function not found: " + c.fun)
+	}
+	g := getFuncDecl(ds, c.fun).ToSig()
+	if len(c.args) != len(g.pDecls) {
+		var b strings.Builder
+		b.WriteString("Arity mismatch: args=[")
+		writeExprs(&b, c.args)
+		b.WriteString("], params=[")
+		writeParamDecls(&b, g.pDecls)
+		b.WriteString("]\n\t")
+		b.WriteString(c.String())
+		panic(b.String())
+	}
+	t_args, sigInst := instantiateSig(g, c.t_args)
 
+	args := make([]FGGExpr, len(c.args))
 	constraints := NewSubConstraintSet()
 	for i := 0; i < len(c.args); i++ {
-		u_a := c.args[i].Infer(ds, delta, gamma)
+		u_a, e := c.args[i].Infer(ds, delta, gamma)
+		constraints = constraints.Add(NewSubtypeConstr(u_a, sigInst.pDecls[i].u,
+			originAt(c, argRole(i))))
+		args[i] = e
+	}
+	subs := defaultUntyped(constraints.UnifyAll(ds, delta))
+	res := FuncCall{c.fun, t_args, args, c.span}.TSubs(subs)
+	return sigInst.u_ret.SubsEtaOpen(subs), res
+}
+
+// Cf. FuncLit.Typing
+func (f FuncLit) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	gamma1 := make(Gamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	for _, v := range f.pDecls {
+		gamma1[v.name] = v.u
+	}
+	u_body, e_body := f.e_body.Infer(ds, delta, gamma1)
+	subs := NewSubtypeConstr(u_body, f.u_ret, originAt(f, "body")).Unify(ds, delta)
+	return f.GetType(), FuncLit{f.pDecls, f.u_ret, e_body.TSubs(subs), f.typ, f.span}
+}
+
+// Cf. FuncCall.Infer
+func (a Apply) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	u, e_fun := a.e_fun.Infer(ds, delta, gamma)
+	u_F, ok := u.Underlying(ds).(TFunc)
+	if !ok {
+		panic("Cannot call non-function: " + a.e_fun.String() + " of type " +
+			u.String())
+	}
+	if len(a.args) != len(u_F.params) {
+		var b strings.Builder
+		b.WriteString("Arity mismatch: args=[")
+		writeExprs(&b, a.args)
+		b.WriteString("], params=")
+		b.WriteString(u_F.String())
+		b.WriteString("\n\t")
+		b.WriteString(a.String())
+		panic(b.String())
+	}
+	args := make([]FGGExpr, len(a.args))
+	constraints := NewSubConstraintSet()
+	for i := 0; i < len(a.args); i++ {
+		u_a, e := a.args[i].Infer(ds, delta, gamma)
+		constraints = constraints.Add(NewSubtypeConstr(u_a, u_F.params[i],
+			originAt(a, argRole(i))))
+		args[i] = e
+	}
+	subs := constraints.UnifyAll(ds, delta)
+	return u_F.u_ret.SubsEtaOpen(subs), Apply{e_fun, args, a.span}.TSubs(subs)
+}
+
+// Cf. StructLit.Infer
+func (s SliceLit) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	u := s.typ
+	if u_named, ok := s.typ.(TNamed); ok && len(u_named.u_args) == 0 { // e.g., "List(){...}"
+		td := getTDecl(ds, u_named.t_name) // panics if not found
+		u = instantiateType(u_named.t_name, td.GetBigPsi())
+	}
+	u_S, ok := u.Underlying(ds).(TSlice)
+	if !ok {
+		panic("Slice literal: " + s.typ.String() + " is not a slice type" +
+			"\n\t" + s.String())
+	}
+	elems := make([]FGGExpr, len(s.elems))
+	constraints := NewSubConstraintSet()
+	for i := 0; i < len(s.elems); i++ {
+		u_e, e := s.elems[i].Infer(ds, delta, gamma)
+		constraints = constraints.Add(NewSubtypeConstr(u_e, u_S.elem,
+			originAt(s, "elem "+strconv.Itoa(i+1))))
+		elems[i] = e
+	}
+	subs := defaultUntyped(constraints.UnifyAll(ds, delta))
+	return u.SubsEtaOpen(subs), SliceLit{u, elems, s.span}.TSubs(subs)
+}
 
-		constr := NewSubtypeConstr(u_a, sigInst.pDecls[i].u)
-		constraints = constraints.Add(constr)
+func (x Index) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	u, e_S := x.e_S.Infer(ds, delta, gamma)
+	if u_M, ok := u.Underlying(ds).(TMap); ok {
+		u_key, e_idx := x.e_idx.Infer(ds, delta, gamma)
+		subs := NewSubtypeConstr(u_key, u_M.key, originAt(x, "key")).Unify(ds, delta)
+		return u_M.elem, Index{e_S, e_idx.TSubs(subs), x.span}
+	}
+	u_S, ok := u.Underlying(ds).(TSlice)
+	if !ok {
+		panic("Cannot index non-slice/map: " + x.e_S.String() + " of type " +
+			u.String())
+	}
+	u_idx, e_idx := x.e_idx.Infer(ds, delta, gamma)
+	if !evalPrimtPredicate(ds, delta, isInt, u_idx) {
+		panic("Index must be of integer type: " + x.e_idx.String() + " of type " +
+			u_idx.String())
+	}
+	return u_S.elem, Index{e_S, e_idx, x.span}
+}
+
+func (l Len) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	u, e_S := l.e_S.Infer(ds, delta, gamma)
+	switch u.Underlying(ds).(type) {
+	case TSlice, TMap:
+	default:
+		panic("Invalid argument for len: " + l.e_S.String() + " of type " +
+			u.String())
+	}
+	return lenType, Len{e_S, l.span}
+}
 
-		//args[i] = newSubtree
-		//// if newSubtree is a PrimitiveLiteral node, convert it to the Ast node
-		//// corresponding to a value of the expected type (u_p)
-		//if lit, ok := newSubtree.(PrimitiveLiteral); ok {
-		//	args[i] = ConvertLitNode(lit, u_p)
-		//}
+// Cf. Apply.Infer
+func (a Append) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	u, e_S := a.e_S.Infer(ds, delta, gamma)
+	u_S, ok := u.Underlying(ds).(TSlice)
+	if !ok {
+		panic("Invalid argument for append: " + a.e_S.String() + " of type " +
+			u.String())
+	}
+	elems := make([]FGGExpr, len(a.elems))
+	constraints := NewSubConstraintSet()
+	for i := 0; i < len(a.elems); i++ {
+		u_e, e := a.elems[i].Infer(ds, delta, gamma)
+		constraints = constraints.Add(NewSubtypeConstr(u_e, u_S.elem,
+			originAt(a, "elem "+strconv.Itoa(i+1))))
+		elems[i] = e
 	}
 	subs := constraints.UnifyAll(ds, delta)
-	return sigInst.u_ret.SubsEtaOpen(subs) //, Call{e_recv, c.meth, c.t_args, args}
+	return u.SubsEtaOpen(subs), Append{e_S, elems, a.span}.TSubs(subs)
 }
 
-func (s Select) Infer(ds []Decl, delta Delta, gamma Gamma) Type {
-	u := s.e_S.Infer(ds, delta, gamma)
+// Cf. SliceLit.Infer
+func (m MapLit) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	u := m.typ
+	if u_named, ok := m.typ.(TNamed); ok && len(u_named.u_args) == 0 { // e.g., "Set(){...}"
+		td := getTDecl(ds, u_named.t_name) // panics if not found
+		u = instantiateType(u_named.t_name, td.GetBigPsi())
+	}
+	u_M, ok := u.Underlying(ds).(TMap)
+	if !ok {
+		panic("Map literal: " + m.typ.String() + " is not a map type" +
+			"\n\t" + m.String())
+	}
+	entries := make([]MapEntry, len(m.entries))
+	constraints := NewSubConstraintSet()
+	for i, v := range m.entries {
+		u_k, e_k := v.key.Infer(ds, delta, gamma)
+		u_v, e_v := v.val.Infer(ds, delta, gamma)
+		constraints = constraints.Add(
+			NewSubtypeConstr(u_k, u_M.key, originAt(m, "key "+strconv.Itoa(i+1))),
+			NewSubtypeConstr(u_v, u_M.elem, originAt(m, "value "+strconv.Itoa(i+1))))
+		entries[i] = MapEntry{e_k, e_v}
+	}
+	subs := defaultUntyped(constraints.UnifyAll(ds, delta))
+	return u.SubsEtaOpen(subs), MapLit{u, entries, m.span}.TSubs(subs)
+}
+
+// Cf. Let.Infer
+func (m MapAssign) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	u, e_M := m.e_M.Infer(ds, delta, gamma)
+	u_M, ok := u.Underlying(ds).(TMap)
+	if !ok {
+		panic("Map assignment to non-map: " + m.x + " of type " + u.String())
+	}
+	u_key, e_key := m.e_key.Infer(ds, delta, gamma)
+	e_key = e_key.TSubs(NewSubtypeConstr(u_key, u_M.key, originAt(m, "key")).Unify(ds, delta))
+	u_val, e_val := m.e_val.Infer(ds, delta, gamma)
+	e_val = e_val.TSubs(NewSubtypeConstr(u_val, u_M.elem, originAt(m, "value")).Unify(ds, delta))
+	gamma1 := make(Gamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	gamma1[m.x] = u
+	u_body, e_body := m.e_body.Infer(ds, delta, gamma1)
+	return u_body, MapAssign{m.x, e_M, e_key, e_val, e_body, m.span}
+}
+
+func (c CommaOk) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	ok := false
+	switch x := c.e_def.(type) {
+	case Assert:
+		ok = true
+	case Index:
+		u, _ := x.e_S.Infer(ds, delta, gamma)
+		_, ok = u.Underlying(ds).(TMap)
+	}
+	if !ok {
+		panic("Comma-ok binding requires a map index or a type assertion: " + c.e_def.String())
+	}
+	u_def, e_def := c.e_def.Infer(ds, delta, gamma)
+	gamma1 := make(Gamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	gamma1[c.x] = u_def
+	gamma1[c.x_ok] = NewTPrimitive(BOOL)
+	u_body, e_body := c.e_body.Infer(ds, delta, gamma1)
+	return u_body, CommaOk{c.x, c.x_ok, e_def, e_body, c.span}
+}
+
+// Cf. Select.Typing -- a promoted field is elaborated by Typing, not here
+func (s Select) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	u, e_S := s.e_S.Infer(ds, delta, gamma)
 	if !IsStructType(ds, u) {
 		panic("Illegal select on expr of non-struct type: " + u.String() +
 			"\n\t" + s.String())
 	}
-	u_S := u.Underlying(ds).(STypeLit)
-	for _, fd := range u_S.GetFieldDecls() {
-		if fd.field == s.field {
-			return fd.u //, Select{e_S, s.field}
+	sel, n := selections(ds, delta, u, s.field)
+	if n > 1 {
+		panic("Ambiguous selector " + s.field + " in type: " + u.String() +
+			"\n\t" + s.String())
+	} else if n == 0 || sel.isMeth {
+		panic("Field " + s.field + " not found in type: " + u.String() +
+			"\n\t" + s.String())
+	}
+	return sel.u, Select{e_S, s.field, s.span}
+}
+
+func (a Assert) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	_, e_I := a.e_I.Infer(ds, delta, gamma)
+	return a.u_cast, Assert{e_I, a.u_cast, a.span} // TODO
+}
+
+func (c Convert) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	_, e := c.expr.Infer(ds, delta, gamma)
+	return c.typ, Convert{c.typ, e, c.span} // Cf. Convert.Typing
+}
+
+func (c Cond) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	u_cond, e_cond := c.cond.Infer(ds, delta, gamma)
+	if !evalPrimtPredicate(ds, delta, isBool, u_cond) {
+		panic("Non-boolean condition: " + u_cond.String())
+	}
+	u_then, e_then := c.e_then.Infer(ds, delta, gamma)
+	u_else, e_else := c.e_else.Infer(ds, delta, gamma)
+	if c.typ != nil { // Cf. MethDecl.OkInfer
+		e_then = e_then.TSubs(NewSubtypeConstr(u_then, c.typ, originAt(c, "then branch")).Unify(ds, delta))
+		e_else = e_else.TSubs(NewSubtypeConstr(u_else, c.typ, originAt(c, "else branch")).Unify(ds, delta))
+		return c.typ, Cond{e_cond, e_then, e_else, c.typ, c.span}
+	}
+	res := Cond{e_cond, e_then, e_else, c.typ, c.span}
+	if u_then.ImplsDelta(ds, delta, u_else) {
+		return u_else, res
+	}
+	if u_else.ImplsDelta(ds, delta, u_then) {
+		return u_then, res
+	}
+	panic("Mismatched branch types: " + u_then.String() + " and " + u_else.String())
+}
+
+// Cf. Cond.Infer -- each case is inferred with x bound to the case type
+func (s TypeSwitch) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	u_I, e_I := s.e_I.Infer(ds, delta, gamma)
+	if s.e_def == nil {
+		panic("Type switch must have a default case: " + s.String())
+	}
+	us := make([]Type, 0, len(s.cases)+1)
+	es := make([]FGGExpr, 0, len(s.cases)+1)
+	for _, c := range s.cases {
+		u, e := c.e_body.Infer(ds, delta, s.extend(gamma, c.u))
+		us, es = append(us, u), append(es, e)
+	}
+	u, e := s.e_def.Infer(ds, delta, s.extend(gamma, u_I))
+	us, es = append(us, u), append(es, e)
+	if s.typ != nil { // Cf. MethDecl.OkInfer
+		for i, u := range us {
+			role := "default case"
+			if i < len(s.cases) {
+				role = "case " + s.cases[i].u.String()
+			}
+			es[i] = es[i].TSubs(NewSubtypeConstr(u, s.typ, originAt(s, role)).Unify(ds, delta))
+		}
+	}
+	cases := make([]TypeCase, len(s.cases))
+	for i, c := range s.cases {
+		cases[i] = TypeCase{c.u, es[i]}
+	}
+	res := TypeSwitch{s.x, e_I, cases, es[len(s.cases)], s.typ, s.span}
+	if s.typ != nil {
+		return s.typ, res
+	}
+	for _, u := range us {
+		ok := true
+		for _, u1 := range us {
+			if ok = u1.ImplsDelta(ds, delta, u); !ok {
+				break
+			}
 		}
+		if ok {
+			return u, res
+		}
+	}
+	strs := make([]string, len(us))
+	for i, v := range us {
+		strs[i] = v.String()
 	}
-	panic("Field " + s.field + " not found in type: " + u.String() +
-		"\n\t" + s.String())
+	panic("Mismatched branch types: " + strings.Join(strs, ", "))
 }
 
-func (a Assert) Infer(ds []Decl, delta Delta, gamma Gamma) Type {
-	return a.u_cast // TODO
+func (p Panic) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	_, e_arg := p.e_arg.Infer(ds, delta, gamma)
+	if p.typ == nil {
+		panic("panic is a statement, allowed only as a body (or a branch of one): " +
+			p.String())
+	}
+	return p.typ, Panic{e_arg, p.typ, p.span}
 }
 
-func (x Sprintf) Infer(ds []Decl, delta Delta, gamma Gamma) Type {
-	// todo type the arguments, return String type
-	return TPrimitive{tag: STRING}
+func (l Let) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	u_x, e_def := l.e_def.Infer(ds, delta, gamma)
+	if l.u != nil {
+		e_def = e_def.TSubs(NewSubtypeConstr(u_x, l.u, originAt(l, "definition")).Unify(ds, delta))
+		u_x = l.u
+	}
+	gamma1 := make(Gamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	gamma1[l.x] = u_x
+	u_body, e_body := l.e_body.Infer(ds, delta, gamma1)
+	return u_body, Let{l.x, l.u, e_def, e_body, l.span}
 }
 
-func (b BinaryOperation) Infer(ds []Decl, delta Delta, gamma Gamma) Type {
-	ltype := b.left.Infer(ds, delta, gamma)
-	rtype := b.right.Infer(ds, delta, gamma)
+func (x Sprintf) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	// todo type the arguments, return String type
+	args := make([]FGGExpr, len(x.args))
+	for i, v := range x.args {
+		_, args[i] = v.Infer(ds, delta, gamma)
+	}
+	return NewTPrimitive(STRING), Sprintf{x.format, args, x.span}
+}
 
-	var pred PrimtPredicate
-	switch b.op {
-	case ADD:
-		pred = Or(isNumeric, isString)
-	case SUB:
-		pred = isNumeric
-	case LAND, LOR:
-		pred = isBool
+func (u UnaryOperation) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	t, e := u.e.Infer(ds, delta, gamma)
+	if ok := evalPrimtPredicate(ds, delta, unaryOperandPredicate(u.op), t); !ok {
+		panic(undefinedOpMsg(ds, delta, u.op, unaryOperandPredicate(u.op), t))
 	}
+	return t, UnaryOperation{e, u.op, u.span}
+}
+
+func (b BinaryOperation) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	ltype, left := b.left.Infer(ds, delta, gamma)
+	rtype, right := b.right.Infer(ds, delta, gamma)
+	newTree := BinaryOperation{BaseBinaryOperation{left, right, b.op, b.span}}
+
+	pred := operandPredicate(b.op)
 	if ok := evalPrimtPredicate(ds, delta, pred, ltype); !ok {
-		panic("operator " + string(b.op) + " not defined for type: " + ltype.String())
+		panic(undefinedOpMsg(ds, delta, b.op, pred, ltype))
 	}
 	if ok := evalPrimtPredicate(ds, delta, pred, rtype); !ok {
-		panic("operator " + string(b.op) + " not defined for type: " + rtype.String())
+		panic(undefinedOpMsg(ds, delta, b.op, pred, rtype))
+	}
+	if isShift(b.op) {
+		return ltype, newTree
 	}
 
 	// verify that ltype and rtype are compatible;
 	// if they are, return the most general type
 	if ltype.ImplsDelta(ds, delta, rtype) {
-		return rtype //, newTree
+		return rtype, newTree
 	}
 	if rtype.ImplsDelta(ds, delta, ltype) {
-		return ltype //, newTree
+		return ltype, newTree
 	}
 	panic("mismatched types " + ltype.String() + " and " + rtype.String())
 }
 
-func (c Comparison) Infer(ds []Decl, delta Delta, gamma Gamma) Type {
-	ltype := c.left.Infer(ds, delta, gamma)
-	rtype := c.right.Infer(ds, delta, gamma)
+func (c Comparison) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	ltype, left := c.left.Infer(ds, delta, gamma)
+	rtype, right := c.right.Infer(ds, delta, gamma)
 
-	if ok := evalPrimtPredicate(ds, delta, isComparable, ltype); !ok {
-		panic("operator " + string(c.op) + " not defined for type: " + ltype.String())
+	if ok := comparisonDefined(ds, delta, c.op, ltype); !ok {
+		panic(undefinedComparisonMsg(ds, delta, c.op, ltype))
 	}
-	if ok := evalPrimtPredicate(ds, delta, isComparable, rtype); !ok {
-		panic("operator " + string(c.op) + " not defined for type: " + rtype.String())
+	if ok := comparisonDefined(ds, delta, c.op, rtype); !ok {
+		panic(undefinedComparisonMsg(ds, delta, c.op, rtype))
 	}
 
 	if !ltype.ImplsDelta(ds, delta, rtype) && !rtype.ImplsDelta(ds, delta, ltype) {
 		panic("mismatched types " + ltype.String() + " and " + rtype.String())
 	}
 
-	return TPrimitive{tag: BOOL} //, NewBinaryOp(ltree, rtree, c.op)
+	return NewTPrimitive(BOOL), Comparison{BaseBinaryOperation{left, right, c.op, c.span}}
 }
 
-func (x NamedPrimitiveLiteral) Infer(ds []Decl, delta Delta, gamma Gamma) Type { return x.typ }
-func (x PrimitiveLiteral) Infer(ds []Decl, delta Delta, gamma Gamma) Type {
-	return NewUndefTPrimitive(x.tag)
+func (x PrimitiveLiteral) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	return NewUndefTPrimitive(x.tag), x
+}
+
+func (x TypedPrimitiveValue) Infer(ds []Decl, delta Delta, gamma Gamma) (Type, FGGExpr) {
+	return x.typ, x
 }
-func (x BoolVal) Infer(ds []Decl, delta Delta, gamma Gamma) Type    { return TPrimitive{tag: BOOL} }
-func (x Int32Val) Infer(ds []Decl, delta Delta, gamma Gamma) Type   { return TPrimitive{tag: INT32} }
-func (x Int64Val) Infer(ds []Decl, delta Delta, gamma Gamma) Type   { return TPrimitive{tag: INT64} }
-func (x Float32Val) Infer(ds []Decl, delta Delta, gamma Gamma) Type { return TPrimitive{tag: FLOAT32} }
-func (x Float64Val) Infer(ds []Decl, delta Delta, gamma Gamma) Type { return TPrimitive{tag: FLOAT64} }
-func (x StringVal) Infer(ds []Decl, delta Delta, gamma Gamma) Type  { return TPrimitive{tag: STRING} }
 
 /******************************************************************************/
 /* Fresh type variables */
@@ -473,10 +1006,26 @@ func (tv FreshTVar) Underlying(ds []Decl) Type {
 	return tv
 }
 
+// Overrides the embedded TParam.Equals, under which a fresh var is equal to
+// no fresh var (itself included) -- cf. occursCheck
+func (tv FreshTVar) Equals(t base.Type) bool {
+	if tv1, ok := asFGGType(t).(FreshTVar); ok {
+		return tv.TParam == tv1.TParam
+	}
+	return false
+}
+
+// An uninferred type var left in an annotated expr, e.g., "Nil(){}" as main
+func (tv FreshTVar) Ok(ds []Decl, delta Delta) {
+	panic(base.NewDiagnostic(base.DIAG_INFER, nil,
+		"Cannot infer type argument: "+tv.String()))
+}
+
 // Adds recorded bound to Delta before calling the normal TParam.ImplsDelta.
 // Needed because the bound for a fresh type var may not be in context
 // e.g., inferring the type of the empty List results in a Nil(ααX),
-//       but since Infer doesn't return a Delta, the context for ααX is lost.
+//
+//	but since Infer doesn't return a Delta, the context for ααX is lost.
 func (tv FreshTVar) ImplsDelta(ds []Decl, delta Delta, u Type) bool {
 	extendedDelta := make(Delta)
 	for param, bound := range delta {
@@ -487,6 +1036,7 @@ func (tv FreshTVar) ImplsDelta(ds []Decl, delta Delta, u Type) bool {
 }
 
 const FreshPrefix = "αα"
+
 var freshCount = 0 // global var
 func freshName() TParam {
 	res := TParam(FreshPrefix + strconv.Itoa(freshCount+1))
@@ -503,12 +1053,24 @@ func hasFreshTVars(u Type) bool {
 	if isFreshTVar(u) {
 		return true
 	}
-	if u_cast, ok := u.(TNamed); ok {
+	switch u_cast := u.(type) {
+	case TNamed:
 		for _, u_arg := range u_cast.u_args {
 			if hasFreshTVars(u_arg) {
 				return true
 			}
 		}
+	case TFunc:
+		for _, u_p := range u_cast.params {
+			if hasFreshTVars(u_p) {
+				return true
+			}
+		}
+		return hasFreshTVars(u_cast.u_ret)
+	case TSlice:
+		return hasFreshTVars(u_cast.elem)
+	case TMap:
+		return hasFreshTVars(u_cast.key) || hasFreshTVars(u_cast.elem)
 	}
 	return false
 }
@@ -541,34 +1103,60 @@ func instantiateType(tname Name, Psi BigPsi) TNamed {
 	return NewTNamed(tname, args)
 }
 
-func instantiateSig(sig Sig) Sig {
-	//subs := make(map[TParam]Type)
-	//for _, tf := range sig.Psi.tFormals {
-	//	subs[tf.name] = freshName()
-	//}
-	//delta := make(Delta)
-	//for _, tf := range sig.Psi.tFormals {
-	//	x := subs[tf.name].(TParam)
-	//	delta[x] = tf.u_I.SubsEtaOpen(subs)
-	//}
-
-	insts := instantiatePsi(sig.Psi)
-	// "cast" insts to EtaOpen to take advantage of existing .SubsEtaOpen "infrastructure"
-	eta := make(EtaOpen)
-	for param, tVar := range insts {
-		eta[param] = tVar
+// Instantiates the type params of sig with t_args, or with fresh type vars if
+// the type actuals are omitted, e.g., "x.m()(args)".  Returns the type
+// actuals used, cf. Call.Infer
+func instantiateSig(sig Sig, t_args []Type) ([]Type, Sig) {
+	tfs := sig.Psi.GetTFormals()
+	if len(t_args) == 0 {
+		insts := instantiatePsi(sig.Psi)
+		t_args = make([]Type, len(tfs))
+		for i, tf := range tfs {
+			t_args[i] = insts[tf.name]
+		}
+	} else if len(t_args) != len(tfs) {
+		var b strings.Builder
+		b.WriteString("Arity mismatch: type actuals=[")
+		writeTypes(&b, t_args)
+		b.WriteString("], formals=[")
+		b.WriteString(sig.Psi.String())
+		b.WriteString("]\n\t")
+		b.WriteString(sig.String())
+		panic(b.String())
 	}
+	eta := MakeEtaOpen(sig.Psi, t_args)
 
 	// subs in param declarations' types
 	ps := make([]ParamDecl, len(sig.pDecls))
 	for i, pd := range sig.pDecls {
-		ps[i] = ParamDecl{pd.name, pd.u.SubsEtaOpen(eta)}
+		ps[i] = ParamDecl{pd.name, pd.u.SubsEtaOpen(eta), pd.span}
 	}
 	// subs in u_ret
 	u_ret := sig.u_ret.SubsEtaOpen(eta)
-	return Sig{sig.meth,
-		BigPsi{}, // todo all the type parameters were instantiated (?)
-		ps, u_ret}
+	return t_args, Sig{sig.meth,
+		BigPsi{}, // all the type parameters were instantiated
+		ps, u_ret, sig.span}
+}
+
+// Untyped constants default to their defined type, e.g., "x.id()(1)" infers
+// int32 for id's type param
+func defaultUntyped(subs EtaOpen) EtaOpen {
+	for a, u := range subs {
+		if u_U, ok := u.(UndefTPrimitive); ok {
+			subs[a] = NewTPrimitive(u_U.tag)
+		}
+	}
+	return subs
+}
+
+// All the type params of u are in delta
+func isClosedIn(delta Delta, u Type) bool {
+	for _, a := range fv(u) {
+		if _, ok := delta[a]; !ok {
+			return false
+		}
+	}
+	return true
 }
 
 func occursCheck(a FreshTVar, u Type) bool {
@@ -586,10 +1174,20 @@ func ftvs(u Type) []FreshTVar {
 		return []FreshTVar{cast}
 	}
 	res := []FreshTVar{}
-	if cast, ok := u.(TNamed); ok {
+	switch cast := u.(type) {
+	case TNamed:
 		for _, v := range cast.u_args {
 			res = append(res, ftvs(v)...)
 		}
+	case TFunc:
+		for _, v := range cast.params {
+			res = append(res, ftvs(v)...)
+		}
+		res = append(res, ftvs(cast.u_ret)...)
+	case TSlice:
+		res = ftvs(cast.elem)
+	case TMap:
+		res = append(ftvs(cast.key), ftvs(cast.elem)...)
 	}
 	return res
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/rhu1/fgg/internal/base/testutils"
 	"github.com/rhu1/fgg/internal/fgg"
 	"github.com/rhu1/fgg/internal/parser"
+	"strings"
 	"testing"
 )
 
@@ -17,6 +18,25 @@ func fggParseAndInferGood(t *testing.T, elems ...string) base.Program {
 	return p
 }
 
+func fggParseAndInferMonomGood(t *testing.T, elems ...string) base.Program {
+	p := fggParseAndInferGood(t, elems...).(fgg.FGGProgram)
+	if ok, msg := fgg.IsMonomOK(p); !ok {
+		t.Errorf("Unexpected nomono rejection:\n\t" + msg + "\n" +
+			p.String())
+	}
+	return fgg.Monomorph(p)
+}
+
+func fggParseAndInferBad(t *testing.T, msg string, elems ...string) base.Program {
+	var adptr parser.FGGAdaptor
+	return testutils.ParseAndInferBad(t, msg, &adptr, fgg.MakeFggProgram(elems...))
+}
+
+func fggParseAndInferCheckBad(t *testing.T, kinds []base.DiagnosticKind, elems ...string) []base.Diagnostic {
+	var adptr parser.FGGAdaptor
+	return testutils.ParseAndInferCheckBad(t, &adptr, fgg.MakeFggProgram(elems...), kinds...)
+}
+
 /* Common declarations */
 
 const NL = ";\n"
@@ -185,4 +205,146 @@ func TestInfer_mapExample004(t *testing.T) {
 
 // E objetivo principal será ter que unificar
 // Cons(S())  -- tipo que vai sair de Cons.Infer
-// List(aaX)  -- tipo resultante de generalizaçao de signature
\ No newline at end of file
+// List(aaX)  -- tipo resultante de generalizaçao de signature
+
+/* Inferred type actuals -- written back into the Ast, which then runs as usual */
+
+func TestInfer005(t *testing.T) {
+	Sm := "func (s S(type )) id(type T Any())(x T) T { return x }"
+	e := "S(){}.id()(S(){}).id()(1)"
+	prog := fggParseAndInferGood(t, Any, S, Sm, e)
+	if prog.GetMain().String() != "S(){}.id(S())(S(){}).id(int32)(int32(1))" {
+		t.Errorf("Expected inferred type actuals, got: " + prog.GetMain().String())
+	}
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(1)" {
+		t.Errorf("Expected int32(1), got: " + res.GetMain().String())
+	}
+	prog = fggParseAndInferMonomGood(t, Any, S, Sm, e)
+	res = testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(1)" {
+		t.Errorf("Expected int32(1), got: " + res.GetMain().String())
+	}
+}
+
+// Explicit type actuals are kept
+func TestInfer005b(t *testing.T) {
+	Sm := "func (s S(type )) id(type T Any())(x T) T { return x }"
+	e := "S(){}.id(int64)(1)"
+	prog := fggParseAndInferGood(t, Any, S, Sm, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int64(1)" {
+		t.Errorf("Expected int64(1), got: " + res.GetMain().String())
+	}
+}
+
+// A typed arg takes precedence over an untyped constant
+func TestInfer005c(t *testing.T) {
+	pair := "func pair(type T Any())(x T, y T) T { return y }"
+	e := "pair()(1, int64(2))"
+	prog := fggParseAndInferGood(t, Any, pair, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int64(2)" {
+		t.Errorf("Expected int64(2), got: " + res.GetMain().String())
+	}
+}
+
+func TestInfer006(t *testing.T) {
+	Str := "type Stringer(type ) interface { str(type )() string }"
+	N := "type N(type ) struct {}" + NL +
+		"func (n N(type )) str(type )() string { return \"n\" }"
+	Sm := "func (s S(type )) show(type T Stringer())(x T) string { return x.str()() }"
+	e := "S(){}.show()(N(){})"
+	prog := fggParseAndInferGood(t, S, Str, N, Sm, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "string(\"n\")" {
+		t.Errorf("Expected string(\"n\"), got: " + res.GetMain().String())
+	}
+	fggParseAndInferMonomGood(t, S, Str, N, Sm, e)
+}
+
+// Inferred type actual does not implement its bound
+func TestInfer006b(t *testing.T) {
+	Str := "type Stringer(type ) interface { str(type )() string }"
+	Sm := "func (s S(type )) show(type T Stringer())(x T) string { return x.str()() }"
+	e := "S(){}.show()(S(){})"
+	kinds := []base.DiagnosticKind{base.DIAG_INFER}
+	errs := fggParseAndInferCheckBad(t, kinds, S, Str, Sm, e)
+	if len(errs) == 1 {
+		msg := errs[0].Message
+		if !strings.Contains(msg, "Inferred type actual must implement type formal: actual=S(), param=Stringer()") {
+			t.Errorf("Expected the inferred actual and its bound, got: " + msg)
+		}
+	}
+}
+
+// Type actual cannot be inferred
+func TestInfer006c(t *testing.T) {
+	Nil := "type Nil(type a Any()) struct {}"
+	e := "Nil(){}"
+	fggParseAndInferBad(t, "Cannot infer type argument", Any, Nil, e)
+}
+
+// Failure report points at the conflicting args
+func TestInfer006d(t *testing.T) {
+	pair := "func pair(type T Any())(x T, y T) T { return y }"
+	N := "type N(type ) struct {}"
+	e := "pair()(N(){}, S(){})"
+	kinds := []base.DiagnosticKind{base.DIAG_INFER}
+	errs := fggParseAndInferCheckBad(t, kinds, Any, S, N, pair, e)
+	if len(errs) == 1 {
+		msg := errs[0].Message
+		if !strings.Contains(msg, "arg 1 of "+e) || !strings.Contains(msg, "arg 2 of "+e) {
+			t.Errorf("Expected both args in the failure report, got: " + msg)
+		}
+	}
+}
+
+// Failure report shows a conflict between equality constraints (slice types
+// are invariant) derived from both args
+func TestInfer006e(t *testing.T) {
+	first := "func first(type T Any())(x []T, y []T) T { return y[0] }"
+	e := "first()([]int32{1}, []int64{2})"
+	kinds := []base.DiagnosticKind{base.DIAG_INFER}
+	errs := fggParseAndInferCheckBad(t, kinds, Any, first, e)
+	if len(errs) == 1 {
+		msg := errs[0].Message
+		if !strings.Contains(msg, "int64 == int32") ||
+			!strings.Contains(msg, "arg 1 of "+e) || !strings.Contains(msg, "arg 2 of "+e) {
+			t.Errorf("Expected the equality conflict and both args in the failure report, got: " + msg)
+		}
+	}
+}
+
+// Failure report shows the constraints that an infinite type is derived from
+func TestInfer006f(t *testing.T) {
+	mk := "func mk(type T Any())() []T { return []T{} }"
+	g := "func g(type T Any())(x T, y T) T { return y }"
+	f := "func f(type )() Any() { x := mk()(); return g()(x, x[0]) }"
+	e := "f()()"
+	kinds := []base.DiagnosticKind{base.DIAG_INFER}
+	errs := fggParseAndInferCheckBad(t, kinds, Any, mk, g, f, e)
+	if len(errs) == 1 {
+		msg := errs[0].Message
+		call := "g()(x, x[0])"
+		if !strings.Contains(msg, "Cannot construct infinite type") ||
+			!strings.Contains(msg, "arg 1 of "+call) || !strings.Contains(msg, "arg 2 of "+call) {
+			t.Errorf("Expected the infinite type and both args in the failure report, got: " + msg)
+		}
+	}
+}
+
+func TestInfer_mapExample005(t *testing.T) {
+	foo := "type foo(type ) struct {}" + NL +
+		"func (this foo(type )) Apply(type )(x S()) bool {" +
+		"  return false" +
+		"}"
+	e := "Cons(){ S(){}, Nil(){} }.Map()(foo(){})"
+	prog := fggParseAndInferGood(t, Any, S, Function, List, MapImpl, foo, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "Cons(bool){bool(false), Nil(bool){}}" {
+		t.Errorf("Expected Cons(bool){bool(false), Nil(bool){}}, got: " +
+			res.GetMain().String())
+	}
+	fggParseAndInferMonomGood(t, Any, S, Function, List, MapImpl, foo, e)
+}
@@ -15,7 +15,8 @@ func IsMonomOK(p FGGProgram) (bool, string) {
 	ds := p.GetDecls()
 	for _, v := range ds {
 		if md, ok := v.(MethDecl); ok {
-			omega := Nomega{make(map[string]Type), make(map[string]MethInstanOpen)}
+			omega := Nomega{make(map[string]Type), make(map[string]MethInstanOpen),
+				make(map[string]FuncInstanOpen)}
 			delta := md.Psi_recv.ToDelta()
 			for _, tf := range md.Psi_meth.tFormals {
 				delta[tf.name] = tf.u_I
@@ -26,7 +27,7 @@ func IsMonomOK(p FGGProgram) (bool, string) {
 				psi_recv[i] = tf.name
 			}
 			//psi_recv = md.Psi_recv.Hat()
-			u_recv := TNamed{md.t_recv, psi_recv}
+			u_recv := NewTNamed(md.t_recv, psi_recv)
 			gamma[md.x_recv] = u_recv
 			omega.us[tokeyWtOpen(u_recv)] = u_recv
 			for _, pd := range md.pDecls { // TODO: factor out
@@ -36,11 +37,38 @@ func IsMonomOK(p FGGProgram) (bool, string) {
 			if ok, msg := nomonoOmega(ds, delta, md, omega); ok {
 				return false, msg
 			}
+		} else if fd, ok := v.(FuncDecl); ok {
+			omega := Nomega{make(map[string]Type), make(map[string]MethInstanOpen),
+				make(map[string]FuncInstanOpen)}
+			delta := fd.Psi.ToDelta()
+			gamma := make(Gamma)
+			for _, pd := range fd.pDecls {
+				gamma[pd.name] = pd.u
+			}
+			collectExprOpen(ds, delta, gamma, omega, fd.e_body)
+			if ok, msg := nomonoFuncOmega(ds, delta, fd, omega); ok {
+				return false, msg
+			}
 		}
 	}
 	return true, ""
 }
 
+// Cf. nomonoOmega -- N.B. the body may directly call the function
+func nomonoFuncOmega(ds []Decl, delta Delta, fd FuncDecl, omega Nomega) (bool, string) {
+	for {
+		for _, v := range omega.fs {
+			if v.fun == fd.name && occurs(fd.Psi, v.psi) {
+				return true, fd.name + fd.Psi.String() + " ->* " + fd.name +
+					"(" + v.psi.String() + ")"
+			}
+		}
+		if !auxGOpen(ds, delta, omega) {
+			return false, ""
+		}
+	}
+}
+
 // Return true if nomono
 func nomonoOmega(ds []Decl, delta Delta, md MethDecl, omega Nomega) (bool, string) {
 	for auxGOpen(ds, delta, omega) {
@@ -67,8 +95,8 @@ func nomonoOmega(ds []Decl, delta Delta, md MethDecl, omega Nomega) (bool, strin
 // Pre: len(Psi) == len(psi)
 func occurs(Psi BigPsi, psi SmallPsi) bool {
 	for i, v := range Psi.tFormals {
-		if cast, ok := psi[i].(TNamed); ok { // !!! simplified
-			for _, x := range fv(cast) {
+		if _, ok := psi[i].(TParam); !ok { // !!! simplified
+			for _, x := range fv(psi[i]) {
 				if x.Equals(v.name) {
 					return true
 				}
@@ -83,9 +111,20 @@ func fv(u Type) []TParam {
 		return []TParam{cast}
 	}
 	res := []TParam{}
-	cast := u.(TNamed)
-	for _, v := range cast.u_args {
-		res = append(res, fv(v)...)
+	switch cast := u.(type) {
+	case TNamed:
+		for _, v := range cast.u_args {
+			res = append(res, fv(v)...)
+		}
+	case TFunc:
+		for _, v := range cast.params {
+			res = append(res, fv(v)...)
+		}
+		res = append(res, fv(cast.u_ret)...)
+	case TSlice:
+		res = fv(cast.elem)
+	case TMap:
+		res = append(fv(cast.key), fv(cast.elem)...)
 	}
 	return res
 }
@@ -95,6 +134,7 @@ func fv(u Type) []TParam {
 type Nomega struct {
 	us map[string]Type
 	ms map[string]MethInstanOpen
+	fs map[string]FuncInstanOpen
 }
 
 func (w Nomega) addTInst(u Type) bool {
@@ -137,16 +177,29 @@ func (w Nomega) addMInsts(ms map[string]MethInstanOpen) bool {
 	return res
 }
 
+func (w Nomega) addFInst(f FuncInstanOpen) bool {
+	key := tokeyWfOpen(f)
+	if _, ok := w.fs[key]; !ok {
+		w.fs[key] = f
+		return true
+	}
+	return false
+}
+
 func (w Nomega) clone() Nomega {
 	us := make(map[string]Type)
 	ms := make(map[string]MethInstanOpen)
+	fs := make(map[string]FuncInstanOpen)
 	for k, v := range w.us {
 		us[k] = v
 	}
 	for k, v := range w.ms {
 		ms[k] = v
 	}
-	return Nomega{us, ms}
+	for k, v := range w.fs {
+		fs[k] = v
+	}
+	return Nomega{us, ms, fs}
 }
 
 func (w Nomega) Println() {
@@ -158,6 +211,10 @@ func (w Nomega) Println() {
 	for _, v := range w.ms {
 		fmt.Println(v.u_recv, v.meth, v.psi)
 	}
+	fmt.Println("--- Function instances:")
+	for _, v := range w.fs {
+		fmt.Println(v.fun, v.psi)
+	}
 	fmt.Println("===")
 }
 
@@ -168,6 +225,12 @@ type MethInstanOpen struct {
 	psi    SmallPsi
 }
 
+// Factor out with FuncInstan
+type FuncInstanOpen struct {
+	fun Name
+	psi SmallPsi
+}
+
 func tokeyWtOpen(u Type) string {
 	return u.String()
 }
@@ -176,6 +239,10 @@ func tokeyWmOpen(x MethInstanOpen) string {
 	return x.u_recv.String() + "_" + x.meth + "_" + x.psi.String()
 }
 
+func tokeyWfOpen(x FuncInstanOpen) string {
+	return x.fun + "_" + x.psi.String()
+}
+
 func collectExprOpen(ds []Decl, delta Delta, gamma Gamma, omega Nomega, e FGGExpr) bool {
 	res := false
 	switch e1 := e.(type) {
@@ -197,15 +264,93 @@ func collectExprOpen(ds []Decl, delta Delta, gamma Gamma, omega Nomega, e FGGExp
 		res = omega.addTInst(u_recv) || res
 		m := MethInstanOpen{u_recv, e1.meth, e1.GetTArgs()} // CHECKME: why add u_recv separately?
 		res = omega.addMInst(m) || res
+	case FuncCall:
+		res = collectExprsOpen(ds, delta, gamma, omega, e1.args...)
+		res = omega.addFInst(FuncInstanOpen{e1.fun, e1.t_args}) || res
+	case FuncLit:
+		gamma1 := make(Gamma)
+		for k, v := range gamma {
+			gamma1[k] = v
+		}
+		for _, pd := range e1.pDecls {
+			gamma1[pd.name] = pd.u
+		}
+		res = collectExprOpen(ds, delta, gamma1, omega, e1.e_body)
+		res = omega.addTInst(e1.GetType()) || res
+	case Apply:
+		res = collectExprOpen(ds, delta, gamma, omega, e1.e_fun)
+		res = collectExprsOpen(ds, delta, gamma, omega, e1.args...) || res
+	case SliceLit:
+		res = collectExprsOpen(ds, delta, gamma, omega, e1.elems...)
+		res = omega.addTInst(e1.typ) || res
+	case Index:
+		res = collectExprsOpen(ds, delta, gamma, omega, e1.e_S, e1.e_idx)
+	case Len:
+		res = collectExprOpen(ds, delta, gamma, omega, e1.e_S)
+	case Append:
+		res = collectExprOpen(ds, delta, gamma, omega, e1.e_S)
+		res = collectExprsOpen(ds, delta, gamma, omega, e1.elems...) || res
+	case MapLit:
+		for _, v := range e1.entries {
+			res = collectExprsOpen(ds, delta, gamma, omega, v.key, v.val) || res
+		}
+		res = omega.addTInst(e1.typ) || res
+	case MapAssign:
+		res = collectExprsOpen(ds, delta, gamma, omega, e1.e_M, e1.e_key, e1.e_val)
+		gamma1 := make(Gamma)
+		for k, v := range gamma {
+			gamma1[k] = v
+		}
+		gamma1[e1.x], _ = e1.e_M.Typing(ds, delta, gamma, false)
+		res = collectExprOpen(ds, delta, gamma1, omega, e1.e_body) || res
+	case CommaOk:
+		res = collectExprOpen(ds, delta, gamma, omega, e1.e_def)
+		gamma1 := make(Gamma)
+		for k, v := range gamma {
+			gamma1[k] = v
+		}
+		gamma1[e1.x], _ = e1.e_def.Typing(ds, delta, gamma, false)
+		gamma1[e1.x_ok] = NewTPrimitive(BOOL)
+		res = collectExprOpen(ds, delta, gamma1, omega, e1.e_body) || res
 	case Assert:
 		res = collectExprOpen(ds, delta, gamma, omega, e1.e_I)
 		res = omega.addTInst(e1.u_cast) || res
 	case Convert:
 		res = collectExprOpen(ds, delta, gamma, omega, e1.expr)
 		res = omega.addTInst(e1.typ) || res
+	case Cond:
+		res = collectExprsOpen(ds, delta, gamma, omega, e1.cond, e1.e_then, e1.e_else)
+	case TypeSwitch:
+		res = collectExprOpen(ds, delta, gamma, omega, e1.e_I)
+		u_I, _ := e1.e_I.Typing(ds, delta, gamma, false)
+		for _, c := range e1.cases {
+			res = omega.addTInst(c.u) || res
+			res = collectExprOpen(ds, delta, e1.extend(gamma, c.u), omega, c.e_body) || res
+		}
+		if e1.e_def != nil {
+			res = collectExprOpen(ds, delta, e1.extend(gamma, u_I), omega, e1.e_def) || res
+		}
+	case Panic:
+		res = collectExprOpen(ds, delta, gamma, omega, e1.e_arg)
+		res = omega.addTInst(e1.typ) || res
+	case Let:
+		res = collectExprOpen(ds, delta, gamma, omega, e1.e_def)
+		gamma1 := make(Gamma)
+		for k, v := range gamma {
+			gamma1[k] = v
+		}
+		if e1.u != nil {
+			gamma1[e1.x] = e1.u
+			res = omega.addTInst(e1.u) || res
+		} else {
+			gamma1[e1.x], _ = e1.e_def.Typing(ds, delta, gamma, false)
+		}
+		res = collectExprOpen(ds, delta, gamma1, omega, e1.e_body) || res
 	case Sprintf:
 		res = collectExprsOpen(ds, delta, gamma, omega, e1.args...)
 
+	case UnaryOperation:
+		res = collectExprOpen(ds, delta, gamma, omega, e1.e)
 	case BinaryOperation:
 		res = collectExprsOpen(ds, delta, gamma, omega, e1.left, e1.right)
 	case Comparison:
@@ -242,9 +387,14 @@ func collectExprsOpen(ds []Decl, delta Delta, gamma Gamma, omega Nomega, es ...F
 func auxGOpen(ds []Decl, delta Delta, omega Nomega) bool {
 	res := false
 	res = auxFOpen(ds, omega) || res
+	res = auxFTOpen(ds, omega) || res
+	res = auxSTOpen(ds, omega) || res
+	res = auxMTOpen(ds, omega) || res
 	res = auxIOpen(ds, delta, omega) || res
 	res = auxMOpen(ds, delta, omega) || res
 	res = auxSOpen(ds, delta, omega) || res
+	// top-level functions
+	res = auxFnOpen(ds, delta, omega) || res
 	// I/face embeddings
 	res = auxE1Open(ds, omega) || res
 	res = auxE2Open(ds, omega) || res
@@ -282,6 +432,40 @@ func auxFOpen(ds []Decl, omega Nomega) bool {
 	return omega.addTInsts(tmp)
 }
 
+func auxFTOpen(ds []Decl, omega Nomega) bool {
+	tmp := make(map[string]Type)
+	for _, u := range omega.us {
+		if u_F, ok := u.Underlying(ds).(TFunc); ok {
+			for _, u_p := range u_F.params {
+				tmp[tokeyWtOpen(u_p)] = u_p
+			}
+			tmp[tokeyWtOpen(u_F.u_ret)] = u_F.u_ret
+		}
+	}
+	return omega.addTInsts(tmp)
+}
+
+func auxSTOpen(ds []Decl, omega Nomega) bool {
+	tmp := make(map[string]Type)
+	for _, u := range omega.us {
+		if u_S, ok := u.Underlying(ds).(TSlice); ok {
+			tmp[tokeyWtOpen(u_S.elem)] = u_S.elem
+		}
+	}
+	return omega.addTInsts(tmp)
+}
+
+func auxMTOpen(ds []Decl, omega Nomega) bool {
+	tmp := make(map[string]Type)
+	for _, u := range omega.us {
+		if u_M, ok := u.Underlying(ds).(TMap); ok {
+			tmp[tokeyWtOpen(u_M.key)] = u_M.key
+			tmp[tokeyWtOpen(u_M.elem)] = u_M.elem
+		}
+	}
+	return omega.addTInsts(tmp)
+}
+
 func auxIOpen(ds []Decl, delta Delta, omega Nomega) bool {
 	tmp := make(map[string]MethInstanOpen)
 	for _, m := range omega.ms {
@@ -334,6 +518,13 @@ func auxSOpen(ds []Decl, delta Delta, omega Nomega) bool {
 			if !assignable {
 				continue
 			}
+			if _, ok := declaredMethods(ds, delta, u_N)[m.meth]; !ok { // Promoted, cf. auxS
+				if sel, n := selections(ds, delta, u_N, m.meth); n == 1 && sel.isMeth {
+					m1 := MethInstanOpen{sel.u, m.meth, m.psi}
+					tmp[tokeyWmOpen(m1)] = m1
+				}
+				continue
+			}
 
 			x0, xs, e := body(ds, u_N, m.meth, m.psi)
 			gamma := make(Gamma)
@@ -352,6 +543,28 @@ func auxSOpen(ds []Decl, delta Delta, omega Nomega) bool {
 	return omega.addMInsts(tmp) || res
 }
 
+// Cf. auxFn
+func auxFnOpen(ds []Decl, delta Delta, omega Nomega) bool {
+	res := false
+	tmp := make(map[string]Type)
+	clone := omega.clone()
+	for _, f := range clone.fs {
+		fd := getFuncDecl(ds, f.fun)
+		eta := MakeEtaOpen(fd.Psi, f.psi)
+		gamma := make(Gamma)
+		for _, pd := range fd.pDecls {
+			u_pd := pd.u.SubsEtaOpen(eta)
+			tmp[tokeyWtOpen(u_pd)] = u_pd
+			gamma[pd.name] = u_pd
+		}
+		u_ret := fd.u_ret.SubsEtaOpen(eta)
+		tmp[tokeyWtOpen(u_ret)] = u_ret
+		_, e := funcBody(ds, f.fun, f.psi)
+		res = collectExprOpen(ds, delta, gamma, omega, e) || res
+	}
+	return omega.addTInsts(tmp) || res
+}
+
 // Add embedded types
 func auxE1Open(ds []Decl, omega Nomega) bool {
 	res := false
@@ -359,7 +572,7 @@ func auxE1Open(ds []Decl, omega Nomega) bool {
 	for _, u := range omega.us {
 		if u_I, ok := u.Underlying(ds).(ITypeLit); ok {
 			for _, s := range u_I.specs {
-				if u_emb, ok := s.(TNamed); ok {
+				if u_emb, ok := s.(TNamed); ok && isEmbedding(ds, u_emb) {
 					tmp[tokeyWtOpen(u_emb)] = u_emb
 				}
 			}
@@ -385,7 +598,7 @@ func auxE2Open(ds []Decl, omega Nomega) bool {
 		}
 		u_I := getInterface(ds, m.u_recv)
 		for _, s := range u_I.GetSpecs() {
-			if u_emb, ok := s.(TNamed); ok {
+			if u_emb, ok := s.(TNamed); ok && isEmbedding(ds, u_emb) {
 				if _, hasMeth := methods(ds, u_emb)[m.meth]; hasMeth {
 					m_emb := MethInstanOpen{u_emb, m.meth, m.psi}
 					tmp[tokeyWmOpen(m_emb)] = m_emb
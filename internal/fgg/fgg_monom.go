@@ -55,7 +55,7 @@ func Monomorph(p FGGProgram) fg.FGProgram {
 
 func ApplyOmega(p FGGProgram, omega Omega) fg.FGProgram {
 	var ds_monom []Decl
-	for _, decl := range p.decls {
+	for _, decl := range withUniverse(p.decls) { // e.g., any() may be used as a type
 		switch d := decl.(type) {
 		case TypeDecl:
 			tds_monom := monomTDecl1(omega, d)
@@ -67,6 +67,11 @@ func ApplyOmega(p FGGProgram, omega Omega) fg.FGProgram {
 			for _, v := range mds_monom {
 				ds_monom = append(ds_monom, v)
 			}
+		case FuncDecl:
+			fds_monom := monomFuncDecl1(omega, d)
+			for _, v := range fds_monom {
+				ds_monom = append(ds_monom, v)
+			}
 		default:
 			panic("Unknown Decl kind: " + reflect.TypeOf(d).String() +
 				"\n\t" + d.String())
@@ -108,6 +113,16 @@ func monomType(fgg_type Type, eta EtaClosed, mu Mu, omega Omega) fg.Type {
 		return monomTNamed(t, eta)
 	case STypeLit:
 		return monomSTypeLit1(t, eta, omega)
+	case TFunc:
+		ts_monom := make([]fg.Type, len(t.params))
+		for i, v := range t.params {
+			ts_monom[i] = monomType(v, eta, nil, omega)
+		}
+		return fg.NewTFunc(ts_monom, monomType(t.u_ret, eta, nil, omega))
+	case TSlice:
+		return fg.NewTSlice(monomType(t.elem, eta, nil, omega))
+	case TMap:
+		return fg.NewTMap(monomType(t.key, eta, nil, omega), monomType(t.elem, eta, nil, omega))
 	case ITypeLit:
 		// convention: when this case is reached with mu == nil, it means
 		// that monomType was applied to an 'anonymous' interface.
@@ -131,7 +146,11 @@ func monomSTypeLit1(s STypeLit, eta EtaClosed, omega Omega) fg.STypeLit {
 	fds := make([]fg.FieldDecl, len(s.fDecls))
 	for i, fd := range s.fDecls {
 		t_monom := monomType(fd.u, eta, nil, omega)
-		fds[i] = fg.NewFieldDecl(fd.field, t_monom)
+		if fd.embedded { // Keeps the FGG field name, cf. fg.FieldDecl.String
+			fds[i] = fg.NewEmbeddedFieldDecl(fd.field, t_monom)
+		} else {
+			fds[i] = fg.NewFieldDecl(fd.field, t_monom)
+		}
 	}
 	return fg.NewSTypeLit(fds)
 }
@@ -158,6 +177,7 @@ func monomITypeLit1(c ITypeLit, eta EtaClosed, mu Mu, omega Omega) fg.ITypeLit {
 			ss = append(ss, hash)
 		case TNamed: // Embedded
 			ss = append(ss, monomTNamed(s, eta))
+		case Union, comparableElem: // Only constrains type args, already checked -- no counterpart in FG
 		default:
 			panic("Unknown Spec kind: " + reflect.TypeOf(spec).String() +
 				"\n\t" + spec.String())
@@ -167,8 +187,8 @@ func monomITypeLit1(c ITypeLit, eta EtaClosed, mu Mu, omega Omega) fg.ITypeLit {
 }
 
 // Encapsulates 2 rules:
-//  - M-MFormal (generate "monomorphised" method name)
-//  - M-Sig  (monomorphise pDecls' & return's types)
+//   - M-MFormal (generate "monomorphised" method name)
+//   - M-Sig  (monomorphise pDecls' & return's types)
 func monomSig1(g Sig, m MethInstan, eta EtaClosed, omega Omega) fg.Sig {
 	//getMonomMethName(omega Omega, m Name, targs []Type) Name {
 	m_monom := toMonomMethName1(m.meth, m.psi, eta, omega) // !!! small psi
@@ -193,8 +213,8 @@ func monomMDecl1(omega Omega, md MethDecl) []fg.MethDecl {
 		for i := 0; i < len(md.Psi_meth.tFormals); i++ {
 			theta[md.Psi_meth.tFormals[i].name] = m.psi[i].(GroundType)
 		}
-		recv_monom := fg.NewParamDecl(md.x_recv, toMonomId(u_recv))                           // !!! t_S(phi) already ground receiver
-		g_monom := monomSig1(Sig{md.name, md.Psi_meth, md.pDecls, md.u_ret}, m, theta, omega) // !!! small psi
+		recv_monom := fg.NewParamDecl(md.x_recv, toMonomId(u_recv))                              // !!! t_S(phi) already ground receiver
+		g_monom := monomSig1(NewSig(md.name, md.Psi_meth, md.pDecls, md.u_ret), m, theta, omega) // !!! small psi
 		e_monom := monomExpr1(md.e_body, theta, omega)
 		md_monom := fg.NewMDecl(recv_monom,
 			g_monom.GetMethod(), g_monom.GetParamDecls(), g_monom.GetReturn(),
@@ -218,6 +238,26 @@ func monomMDecl1(omega Omega, md MethDecl) []fg.MethDecl {
 	return res
 }
 
+// Cf. monomMDecl1 -- one decl per function instance, no dummies needed
+func monomFuncDecl1(omega Omega, fd FuncDecl) []fg.FuncDecl {
+	var res []fg.FuncDecl
+	for _, f := range omega.fs {
+		if f.fun != fd.name {
+			continue
+		}
+		theta := MakeEtaClosed(fd.Psi, f.psi)
+		f_monom := toMonomMethName1(fd.name, f.psi, theta, omega)
+		pds_monom := make([]fg.ParamDecl, len(fd.pDecls))
+		for i, pd := range fd.pDecls {
+			pds_monom[i] = fg.NewParamDecl(pd.name, monomType(pd.u, theta, nil, omega))
+		}
+		ret_monom := monomType(fd.u_ret, theta, nil, omega)
+		e_monom := monomExpr1(fd.e_body, theta, omega)
+		res = append(res, fg.NewFuncDecl(f_monom, pds_monom, ret_monom, e_monom))
+	}
+	return res
+}
+
 func monomExpr1(e1 FGGExpr, eta EtaClosed, omega Omega) fg.FGExpr {
 	switch e := e1.(type) {
 	case Variable:
@@ -239,6 +279,13 @@ func monomExpr1(e1 FGGExpr, eta EtaClosed, omega Omega) fg.FGExpr {
 			es_monom[i] = monomExpr1(e.args[i], eta, omega)
 		}
 		return fg.NewCall(e_monom, m_monom, es_monom)
+	case FuncCall:
+		f_monom := toMonomMethName1(e.fun, e.t_args, eta, omega)
+		es_monom := make([]fg.FGExpr, len(e.args))
+		for i := 0; i < len(e.args); i++ {
+			es_monom[i] = monomExpr1(e.args[i], eta, omega)
+		}
+		return fg.NewFuncCall(f_monom, es_monom)
 	case Assert:
 		e_monom := monomExpr1(e.e_I, eta, omega)
 		t_monom := monomType(e.u_cast, eta, nil, omega)
@@ -249,6 +296,106 @@ func monomExpr1(e1 FGGExpr, eta EtaClosed, omega Omega) fg.FGExpr {
 		e_monom := monomExpr1(e.expr, eta, omega)
 		return fg.NewConvert(t_monom, e_monom)
 
+	case Cond:
+		cond_monom := monomExpr1(e.cond, eta, omega)
+		then_monom := monomExpr1(e.e_then, eta, omega)
+		else_monom := monomExpr1(e.e_else, eta, omega)
+		var t_monom fg.Type
+		if e.typ != nil {
+			t_monom = monomType(e.typ, eta, nil, omega)
+		}
+		return fg.NewCond(cond_monom, then_monom, else_monom, t_monom)
+
+	case TypeSwitch:
+		// Distinct cases may coincide once instantiated, e.g., "case T" and
+		// "case int32" with T=int32 -- the first is selected, so the rest are dropped
+		cases_monom := []fg.TypeCase{}
+	next:
+		for _, c := range e.cases {
+			t_monom := monomType(c.u, eta, nil, omega)
+			for _, prev := range cases_monom {
+				if prev.GetType().Equals(t_monom) {
+					continue next
+				}
+			}
+			cases_monom = append(cases_monom, fg.NewTypeCase(t_monom, monomExpr1(c.e_body, eta, omega)))
+		}
+		var def_monom fg.FGExpr
+		if e.e_def != nil {
+			def_monom = monomExpr1(e.e_def, eta, omega)
+		}
+		var t_monom fg.Type
+		if e.typ != nil {
+			t_monom = monomType(e.typ, eta, nil, omega)
+		}
+		return fg.NewTypeSwitch(e.x, monomExpr1(e.e_I, eta, omega), cases_monom, def_monom, t_monom)
+
+	case Panic:
+		var t_monom fg.Type
+		if e.typ != nil {
+			t_monom = monomType(e.typ, eta, nil, omega)
+		}
+		return fg.NewPanic(monomExpr1(e.e_arg, eta, omega), t_monom)
+
+	case Let:
+		def_monom := monomExpr1(e.e_def, eta, omega)
+		body_monom := monomExpr1(e.e_body, eta, omega)
+		var t_monom fg.Type
+		if e.u != nil {
+			t_monom = monomType(e.u, eta, nil, omega)
+		}
+		return fg.NewLet(e.x, t_monom, def_monom, body_monom)
+
+	case FuncLit:
+		pds_monom := make([]fg.ParamDecl, len(e.pDecls))
+		for i, pd := range e.pDecls {
+			pds_monom[i] = fg.NewParamDecl(pd.name, monomType(pd.u, eta, nil, omega))
+		}
+		ret_monom := monomType(e.u_ret, eta, nil, omega)
+		body_monom := monomExpr1(e.e_body, eta, omega)
+		var t_monom fg.Type
+		if e.typ != nil {
+			t_monom = monomType(e.typ, eta, nil, omega)
+		}
+		return fg.NewFuncLit(pds_monom, ret_monom, body_monom, t_monom)
+	case Apply:
+		fun_monom := monomExpr1(e.e_fun, eta, omega)
+		es_monom := make([]fg.FGExpr, len(e.args))
+		for i := 0; i < len(e.args); i++ {
+			es_monom[i] = monomExpr1(e.args[i], eta, omega)
+		}
+		return fg.NewApply(fun_monom, es_monom)
+
+	case SliceLit:
+		es_monom := make([]fg.FGExpr, len(e.elems))
+		for i := 0; i < len(e.elems); i++ {
+			es_monom[i] = monomExpr1(e.elems[i], eta, omega)
+		}
+		return fg.NewSliceLit(monomType(e.typ, eta, nil, omega), es_monom)
+	case MapLit:
+		es_monom := make([]fg.MapEntry, len(e.entries))
+		for i, v := range e.entries {
+			es_monom[i] = fg.NewMapEntry(monomExpr1(v.key, eta, omega), monomExpr1(v.val, eta, omega))
+		}
+		return fg.NewMapLit(monomType(e.typ, eta, nil, omega), es_monom)
+	case MapAssign: // N.B. e_M is just x, in a source program
+		key_monom := monomExpr1(e.e_key, eta, omega)
+		val_monom := monomExpr1(e.e_val, eta, omega)
+		return fg.NewMapAssign(e.x, key_monom, val_monom, monomExpr1(e.e_body, eta, omega))
+	case CommaOk:
+		def_monom := monomExpr1(e.e_def, eta, omega)
+		return fg.NewCommaOk(e.x, e.x_ok, def_monom, monomExpr1(e.e_body, eta, omega))
+	case Index:
+		return fg.NewIndex(monomExpr1(e.e_S, eta, omega), monomExpr1(e.e_idx, eta, omega))
+	case Len:
+		return fg.NewLen(monomExpr1(e.e_S, eta, omega))
+	case Append:
+		es_monom := make([]fg.FGExpr, len(e.elems))
+		for i := 0; i < len(e.elems); i++ {
+			es_monom[i] = monomExpr1(e.elems[i], eta, omega)
+		}
+		return fg.NewAppend(monomExpr1(e.e_S, eta, omega), es_monom)
+
 	case Sprintf:
 		args := make([]fg.FGExpr, len(e.args))
 		for i := 0; i < len(e.args); i++ {
@@ -256,6 +403,10 @@ func monomExpr1(e1 FGGExpr, eta EtaClosed, omega Omega) fg.FGExpr {
 		}
 		return fg.NewSprintf(e.format, args)
 
+	case UnaryOperation:
+		e_monom := monomExpr1(e.e, eta, omega)
+		return fg.NewUnaryOp(e_monom, fg.Operator(e.op))
+
 	// todo factor this exactly-the-same code
 	case BinaryOperation:
 		left_monom := monomExpr1(e.left, eta, omega)
@@ -296,7 +447,7 @@ func getMonomMethName(omega Omega, m Name, targs []Type) Name {
 
 func toMonomId(u TNamed) fg.TNamed {
 	res := u.String()
-	res = strings.Replace(res, ",", ",,", -1) // TODO: refactor, cf. Frontend.monomOutputHack
+	res = strings.Replace(res, ",", ",,", -1) // TODO: refactor, cf. frontend.MonomOutputHack
 	res = strings.Replace(res, "(", "<", -1)
 	res = strings.Replace(res, ")", ">", -1)
 	res = strings.Replace(res, " ", "", -1)
@@ -315,20 +466,23 @@ func toMonomMethName1(m Name, psi SmallPsi, eta EtaClosed, omega Omega) Name {
 	res := m + "<" + first.String()
 	for _, v := range psi[1:] {
 		next := monomType(v, eta, nil, omega)
-		res = res + ",," + next.String() // Cf. Frontend.monomOutputHack -- TODO: factor out
+		res = res + ",," + next.String() // Cf. frontend.MonomOutputHack -- TODO: factor out
 	}
 	res = res + ">"
 	return Name(res)
 }
 
-/* Works because duck typing uses nominal method sets, cf.
+/*
+	Works because duck typing uses nominal method sets, cf.
+
 type Any1 interface {};
 type Any2 interface {};
 type A struct {};
 func (x0 A) foo() Any1 { return x0 };
 type IB interface { foo() Any2 };
 type toAny1 struct { any Any1 };
-func main() { _ = toAny1{A{}}.any.(IB) } // assertion failure */
+func main() { _ = toAny1{A{}}.any.(IB) } // assertion failure
+*/
 func toHashSig(g Sig) string {
 	/*subs := make(Delta)
 	for i := 0; i < len(g.Psi.tFormals); i++ {
@@ -360,46 +514,6 @@ func toHashSig(g Sig) string {
 	return res
 }
 
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
 /* Deprecated -- Simplistic isMonom check:
    no typeparam nested in a named type in typeargs of StructLit/Call exprs */
 
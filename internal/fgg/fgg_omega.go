@@ -20,6 +20,9 @@ func (u STypeLit) Ground()         {}
 func (u ITypeLit) Ground()         {}
 func (t0 TPrimitive) Ground()      {}
 func (t0 UndefTPrimitive) Ground() {}
+func (f TFunc) Ground()            {}
+func (s TSlice) Ground()           {}
+func (m TMap) Ground()             {}
 
 // Basically a Gamma for only ground types
 type GroundGamma map[Name]GroundType
@@ -33,19 +36,21 @@ type GroundGamma map[Name]GroundType
 
 // Pre: IsMonomOK
 func GetOmega(ds []Decl, e_main FGGExpr) Omega {
-	omega := Omega{make(map[string]GroundType), make(map[string]MethInstan)}
+	omega := Omega{make(map[string]GroundType), make(map[string]MethInstan),
+		make(map[string]FuncInstan)}
 	collectExpr(ds, make(GroundGamma), omega, e_main)
 	fixomega(ds, omega)
 	//omega.Println()
 	return omega
 }
 
-/* Omega, MethInstan */
+/* Omega, MethInstan, FuncInstan */
 
 type Omega struct {
-	// Keys given by toKey_Wt, toKey_Wm
+	// Keys given by toKey_Wt, toKey_Wm, toKey_Wf
 	us map[string]GroundType
 	ms map[string]MethInstan
+	fs map[string]FuncInstan
 }
 
 func (w Omega) addTInst(u GroundType) bool {
@@ -88,16 +93,29 @@ func (w Omega) addMInsts(ms map[string]MethInstan) bool {
 	return res
 }
 
+func (w Omega) addFInst(f FuncInstan) bool {
+	key := toKey_Wf(f)
+	if _, ok := w.fs[key]; !ok {
+		w.fs[key] = f
+		return true
+	}
+	return false
+}
+
 func (w Omega) clone() Omega {
 	us := make(map[string]GroundType)
 	ms := make(map[string]MethInstan)
+	fs := make(map[string]FuncInstan)
 	for k, v := range w.us {
 		us[k] = v
 	}
 	for k, v := range w.ms {
 		ms[k] = v
 	}
-	return Omega{us, ms}
+	for k, v := range w.fs {
+		fs[k] = v
+	}
+	return Omega{us, ms, fs}
 }
 
 func (w Omega) Println() {
@@ -109,6 +127,10 @@ func (w Omega) Println() {
 	for _, v := range w.ms {
 		fmt.Println(v.u_recv, v.meth, v.psi)
 	}
+	fmt.Println("--- Function instances:")
+	for _, v := range w.fs {
+		fmt.Println(v.fun, v.psi)
+	}
 	fmt.Println("===")
 }
 
@@ -125,6 +147,11 @@ type MethInstan struct {
 	psi  SmallPsi // Pre: all isGround
 }
 
+type FuncInstan struct {
+	fun Name
+	psi SmallPsi // Pre: all isGround
+}
+
 func toKey_Wt(u_ground GroundType) string {
 	return u_ground.String()
 }
@@ -133,6 +160,10 @@ func toKey_Wm(x MethInstan) string {
 	return x.u_recv.String() + "_" + x.meth + "_" + x.psi.String()
 }
 
+func toKey_Wf(x FuncInstan) string {
+	return x.fun + "_" + x.psi.String()
+}
+
 /* fixOmega */
 
 func fixomega(ds []Decl, omega Omega) {
@@ -174,6 +205,60 @@ func collectExpr(ds []Decl, gamma GroundGamma, omega Omega, e FGGExpr) bool {
 		m := MethInstan{ground_recv, e1.meth, e1.GetTArgs()} // N.B. type/method instans recorded separately
 		res = omega.addMInst(m) || res
 
+	case FuncCall:
+		res = collectExprs(ds, gamma, omega, e1.args...)
+		res = omega.addFInst(FuncInstan{e1.fun, e1.t_args}) || res
+	case FuncLit:
+		gamma1 := make(GroundGamma)
+		for k, v := range gamma {
+			gamma1[k] = v
+		}
+		for _, pd := range e1.pDecls {
+			gamma1[pd.name] = pd.u.(GroundType)
+		}
+		res = collectExpr(ds, gamma1, omega, e1.e_body)
+		res = omega.addTInst(e1.GetType().(GroundType)) || res
+	case Apply:
+		res = collectExpr(ds, gamma, omega, e1.e_fun)
+		res = collectExprs(ds, gamma, omega, e1.args...) || res
+	case SliceLit:
+		res = collectExprs(ds, gamma, omega, e1.elems...)
+		res = omega.addTInst(e1.typ.(GroundType)) || res
+	case Index:
+		res = collectExprs(ds, gamma, omega, e1.e_S, e1.e_idx)
+	case Len:
+		res = collectExpr(ds, gamma, omega, e1.e_S)
+	case Append:
+		res = collectExpr(ds, gamma, omega, e1.e_S)
+		res = collectExprs(ds, gamma, omega, e1.elems...) || res
+	case MapLit:
+		for _, v := range e1.entries {
+			res = collectExprs(ds, gamma, omega, v.key, v.val) || res
+		}
+		res = omega.addTInst(e1.typ.(GroundType)) || res
+	case MapAssign:
+		res = collectExprs(ds, gamma, omega, e1.e_M, e1.e_key, e1.e_val)
+		gamma1 := make(GroundGamma)
+		gamma2 := make(Gamma)
+		for k, v := range gamma {
+			gamma1[k] = v
+			gamma2[k] = v
+		}
+		tmp, _ := e1.e_M.Typing(ds, make(Delta), gamma2, false)
+		gamma1[e1.x] = tmp.(GroundType)
+		res = collectExpr(ds, gamma1, omega, e1.e_body) || res
+	case CommaOk:
+		res = collectExpr(ds, gamma, omega, e1.e_def)
+		gamma1 := make(GroundGamma)
+		gamma2 := make(Gamma)
+		for k, v := range gamma {
+			gamma1[k] = v
+			gamma2[k] = v
+		}
+		tmp, _ := e1.e_def.Typing(ds, make(Delta), gamma2, false)
+		gamma1[e1.x] = tmp.(GroundType)
+		gamma1[e1.x_ok] = NewTPrimitive(BOOL)
+		res = collectExpr(ds, gamma1, omega, e1.e_body) || res
 	case Assert:
 		res = collectExpr(ds, gamma, omega, e1.e_I)
 		ground := e1.u_cast.(GroundType)
@@ -182,9 +267,51 @@ func collectExpr(ds []Decl, gamma GroundGamma, omega Omega, e FGGExpr) bool {
 		res = collectExpr(ds, gamma, omega, e1.expr)
 		ground := e1.typ.(GroundType)
 		res = omega.addTInst(ground) || res
+	case Cond:
+		res = collectExprs(ds, gamma, omega, e1.cond, e1.e_then, e1.e_else)
+	case TypeSwitch:
+		res = collectExpr(ds, gamma, omega, e1.e_I)
+		gamma2 := make(Gamma)
+		for k, v := range gamma {
+			gamma2[k] = v
+		}
+		u_I, _ := e1.e_I.Typing(ds, make(Delta), gamma2, false)
+		for _, c := range e1.cases {
+			ground := c.u.(GroundType)
+			res = omega.addTInst(ground) || res
+			res = collectExpr(ds, extendGround(gamma, e1.x, ground), omega, c.e_body) || res
+		}
+		if e1.e_def != nil {
+			res = collectExpr(ds, extendGround(gamma, e1.x, u_I.(GroundType)), omega, e1.e_def) || res
+		}
+	case Panic:
+		res = collectExpr(ds, gamma, omega, e1.e_arg)
+		ground := e1.typ.(GroundType)
+		res = omega.addTInst(ground) || res
+	case Let:
+		res = collectExpr(ds, gamma, omega, e1.e_def)
+		gamma1 := make(GroundGamma)
+		for k, v := range gamma {
+			gamma1[k] = v
+		}
+		if e1.u != nil {
+			ground := e1.u.(GroundType)
+			gamma1[e1.x] = ground
+			res = omega.addTInst(ground) || res
+		} else {
+			gamma2 := make(Gamma)
+			for k, v := range gamma {
+				gamma2[k] = v
+			}
+			tmp, _ := e1.e_def.Typing(ds, make(Delta), gamma2, false)
+			gamma1[e1.x] = tmp.(GroundType)
+		}
+		res = collectExpr(ds, gamma1, omega, e1.e_body) || res
 	case Sprintf:
 		res = collectExprs(ds, gamma, omega, e1.args...)
 
+	case UnaryOperation:
+		res = collectExpr(ds, gamma, omega, e1.e)
 	case BinaryOperation:
 		res = collectExprs(ds, gamma, omega, e1.left, e1.right)
 	case Comparison:
@@ -205,6 +332,19 @@ func collectExpr(ds []Decl, gamma GroundGamma, omega Omega, e FGGExpr) bool {
 	}
 	return res
 }
+
+// gamma with x bound to u, if x is not "" -- cf. TypeSwitch.extend
+func extendGround(gamma GroundGamma, x Name, u GroundType) GroundGamma {
+	if x == "" {
+		return gamma
+	}
+	gamma1 := make(GroundGamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	gamma1[x] = u
+	return gamma1
+}
 func collectExprs(ds []Decl, gamma GroundGamma, omega Omega, es ...FGGExpr) bool {
 	res := false
 	for _, arg := range es {
@@ -222,9 +362,14 @@ func collectExprs(ds []Decl, gamma GroundGamma, omega Omega, es ...FGGExpr) bool
 func auxG(ds []Decl, omega Omega) bool {
 	res := false
 	res = auxF(ds, omega) || res
+	res = auxFT(ds, omega) || res
+	res = auxST(ds, omega) || res
+	res = auxMT(ds, omega) || res
 	res = auxI(ds, omega) || res
 	res = auxM(ds, omega) || res
 	res = auxS(ds, make(Delta), omega) || res
+	// top-level functions
+	res = auxFn(ds, omega) || res
 	// I/face embeddings
 	res = auxE1(ds, omega) || res
 	res = auxE2(ds, omega) || res
@@ -233,12 +378,12 @@ func auxG(ds []Decl, omega Omega) bool {
 	return res
 }
 
-//Pair := "type Pair(type X Any(), Y Any()) struct { x X; y Y}"
-//PairInt := "type PairInt(type ) Pair(int32, int32)"
+// Pair := "type Pair(type X Any(), Y Any()) struct { x X; y Y}"
+// PairInt := "type PairInt(type ) Pair(int32, int32)"
 //
-//Pair := "type Pair(type X Any(), Y Any()) struct { x X; y Y}"
-//PairEq := "type PairEq(type T Any()) Pair(T, T)"
-//PairInt := "type PairInt(type ) PairEq(int32)"
+// Pair := "type Pair(type X Any(), Y Any()) struct { x X; y Y}"
+// PairEq := "type PairEq(type T Any()) Pair(T, T)"
+// PairInt := "type PairInt(type ) PairEq(int32)"
 func auxT(ds []Decl, omega Omega) bool {
 	tmp := make(map[string]GroundType)
 	for _, u := range omega.us {
@@ -271,6 +416,48 @@ func auxF(ds []Decl, omega Omega) bool {
 	return omega.addTInsts(tmp)
 }
 
+// Cf. auxF -- the param/return types of (the underlying) function types
+func auxFT(ds []Decl, omega Omega) bool {
+	tmp := make(map[string]GroundType)
+	for _, u := range omega.us {
+		if u_F, ok := u.Underlying(ds).(TFunc); ok {
+			for _, u_p := range u_F.params {
+				ground := u_p.(GroundType)
+				tmp[toKey_Wt(ground)] = ground
+			}
+			ground := u_F.u_ret.(GroundType)
+			tmp[toKey_Wt(ground)] = ground
+		}
+	}
+	return omega.addTInsts(tmp)
+}
+
+// Cf. auxF -- the element types of (the underlying) slice types
+func auxST(ds []Decl, omega Omega) bool {
+	tmp := make(map[string]GroundType)
+	for _, u := range omega.us {
+		if u_S, ok := u.Underlying(ds).(TSlice); ok {
+			ground := u_S.elem.(GroundType)
+			tmp[toKey_Wt(ground)] = ground
+		}
+	}
+	return omega.addTInsts(tmp)
+}
+
+// Cf. auxF -- the key and element types of (the underlying) map types
+func auxMT(ds []Decl, omega Omega) bool {
+	tmp := make(map[string]GroundType)
+	for _, u := range omega.us {
+		if u_M, ok := u.Underlying(ds).(TMap); ok {
+			for _, v := range []Type{u_M.key, u_M.elem} {
+				ground := v.(GroundType)
+				tmp[toKey_Wt(ground)] = ground
+			}
+		}
+	}
+	return omega.addTInsts(tmp)
+}
+
 func auxI(ds []Decl, omega Omega) bool {
 	tmp := make(map[string]MethInstan)
 	for _, m := range omega.ms {
@@ -322,6 +509,15 @@ func auxS(ds []Decl, delta Delta, omega Omega) bool {
 			if !assignable {
 				continue
 			}
+			if _, ok := declaredMethods(ds, delta, u_N)[m.meth]; !ok {
+				// A promoted method: instantiate it for the embedded type that
+				// declares it, cf. selections -- sel.u is ground, as is u_N
+				if sel, n := selections(ds, delta, u_N, m.meth); n == 1 && sel.isMeth {
+					m1 := MethInstan{sel.u.(GroundType), m.meth, m.psi}
+					tmp[toKey_Wm(m1)] = m1
+				}
+				continue
+			}
 
 			x0, xs, e := body(ds, u_N, m.meth, m.psi)
 			gamma := make(GroundGamma)
@@ -340,13 +536,36 @@ func auxS(ds []Decl, delta Delta, omega Omega) bool {
 	return omega.addMInsts(tmp) || res
 }
 
+// Cf. auxM, auxS -- the param/return types and the (instantiated) body of
+// each function instance
+func auxFn(ds []Decl, omega Omega) bool {
+	res := false
+	tmp := make(map[string]GroundType)
+	clone := omega.clone()
+	for _, f := range clone.fs {
+		fd := getFuncDecl(ds, f.fun)
+		eta := MakeEtaClosed(fd.Psi, f.psi)
+		gamma := make(GroundGamma)
+		for _, pd := range fd.pDecls {
+			u_pd := pd.u.SubsEtaClosed(eta)
+			tmp[toKey_Wt(u_pd)] = u_pd
+			gamma[pd.name] = u_pd
+		}
+		u_ret := fd.u_ret.SubsEtaClosed(eta)
+		tmp[toKey_Wt(u_ret)] = u_ret
+		_, e := funcBody(ds, f.fun, f.psi)
+		res = collectExpr(ds, gamma, omega, e) || res
+	}
+	return omega.addTInsts(tmp) || res
+}
+
 // Add embedded types
 func auxE1(ds []Decl, omega Omega) bool {
 	tmp := make(map[string]GroundType)
 	for _, u := range omega.us {
 		if u_I, ok := u.Underlying(ds).(ITypeLit); ok {
 			for _, s := range u_I.specs {
-				if u_emb, ok := s.(TNamed); ok {
+				if u_emb, ok := s.(TNamed); ok && isEmbedding(ds, u_emb) {
 					// omega.us contains only ground types -> their underlying
 					// types are also ground types, thus no need for
 					// explicit substitutions over u_emb's
@@ -367,7 +586,7 @@ func auxE2(ds []Decl, omega Omega) bool {
 		}
 		u_I := getInterface(ds, m.u_recv)
 		for _, s := range u_I.GetSpecs() {
-			if u_emb, ok := s.(TNamed); ok {
+			if u_emb, ok := s.(TNamed); ok && isEmbedding(ds, u_emb) {
 				if _, hasMeth := methods(ds, u_emb)[m.meth]; hasMeth {
 					m_emb := MethInstan{u_emb, m.meth, m.psi}
 					tmp[toKey_Wm(m_emb)] = m_emb
@@ -0,0 +1,115 @@
+/*
+ * This file contains defs for explicit panics: panic(e), as a method body (or
+ * branch of one), and the panics that run-time errors (failed assertions,
+ * division by zero, index out of range, ...) reduce to.
+ */
+
+package fgg
+
+import (
+	"strings"
+
+	"github.com/rhu1/fgg/internal/base"
+)
+
+/* Public constructors */
+
+// u may be nil, cf. Cond
+func NewPanic(e FGGExpr, u Type) Panic {
+	return Panic{e, u, base.Span{}}
+}
+
+/* Panic */
+
+// panic(e_arg) -- e_arg may be of any type (cf. Go's panic(interface{})).
+// Source panics are statements, not expressions: the grammar allows panic(e)
+// only as a body (of a method, func or func literal), or a branch of one --
+// cf. Go's terminating statements -- so typ can be taken from the signature.
+// panic(v), i.e., once e_arg is a value, is a final result, like a value (cf.
+// IsPanic) -- and E[panic(v)] reduces to panic(v), cf. FGGProgram.Eval.
+// typ is the type of the term the panic stands for: the declared return type
+// of the method (cf. SetBodyType), o/w the type of the redex (or of main) that
+// reduced to it -- so that evaluation preserves types.
+type Panic struct {
+	e_arg FGGExpr
+	typ   Type
+	span  base.Span // Source position, not part of node identity
+}
+
+func (p Panic) GetSpan() base.Span { return p.span }
+
+var _ FGGExpr = Panic{}
+
+func (p Panic) GetArg() FGGExpr { return p.e_arg }
+func (p Panic) GetType() Type   { return p.typ }
+
+func (p Panic) Subs(subs map[Variable]FGGExpr) FGGExpr {
+	return Panic{p.e_arg.Subs(subs), p.typ, p.span}
+}
+
+func (p Panic) TSubs(eta EtaOpen) FGGExpr {
+	var u Type
+	if p.typ != nil {
+		u = p.typ.SubsEtaOpen(eta)
+	}
+	return Panic{p.e_arg.TSubs(eta), u, p.span}
+}
+
+// N.B. a raised panic is a Go panic, caught (outside the eval context) by FGGProgram.Eval
+func (p Panic) Eval(ds []Decl) (FGGExpr, string) {
+	if !p.e_arg.IsValue() {
+		e, rule := p.e_arg.Eval(ds)
+		return Panic{e, p.typ, p.span}, rule
+	}
+	panic(p)
+}
+
+func (p Panic) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Type, FGGExpr) {
+	_, e_arg := p.e_arg.Typing(ds, delta, gamma, allowStupid)
+	if p.typ == nil {
+		panic(base.NewDiagnostic(base.DIAG_OTHER, p,
+			"panic is a statement, allowed only as a body (or a branch of one): "+
+				p.String()))
+	}
+	p.typ.Ok(ds, delta)
+	return p.typ, Panic{e_arg, p.typ, p.span}
+}
+
+func (p Panic) IsValue() bool {
+	return false
+}
+
+func (p Panic) IsPanic() bool {
+	return p.e_arg.IsValue()
+}
+
+func (p Panic) CanEval(ds []Decl) bool {
+	return p.e_arg.IsValue() || p.e_arg.CanEval(ds)
+}
+
+func (p Panic) String() string {
+	var b strings.Builder
+	b.WriteString("panic(")
+	b.WriteString(p.e_arg.String())
+	b.WriteString(")")
+	return b.String()
+}
+
+func (p Panic) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString("panic(")
+	b.WriteString(p.e_arg.ToGoString(ds))
+	b.WriteString(")")
+	return b.String()
+}
+
+/* Run-time errors */
+
+// panic("msg: e"), for the Go run-time error msg raised by e, a (closed) redex
+// -- at the type of e (cf. Go's runtime.Error)
+func runtimePanic(ds []Decl, e FGGExpr, msg string) Panic {
+	u, _ := e.Typing(ds, make(Delta), make(Gamma), true)
+	lit := PrimitiveLiteral{msg + ": " + e.String(), STRING, base.Span{}}
+	v := TypedPrimitiveValue{lit, NewTPrimitive(STRING), base.Span{}}
+	return Panic{v, u, spanOf(e)}
+}
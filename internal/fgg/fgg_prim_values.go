@@ -1,6 +1,7 @@
 package fgg
 
 import (
+	"fmt"
 	"github.com/rhu1/fgg/internal/base"
 	"regexp"
 	"strconv"
@@ -54,7 +55,7 @@ func NameFromTag(tag Tag) string {
 
 func NewBoolLit(lit string) PrimitiveLiteral {
 	b, _ := strconv.ParseBool(lit)
-	return PrimitiveLiteral{b, BOOL}
+	return PrimitiveLiteral{b, BOOL, base.Span{}}
 }
 
 func NewIntLit(lit string) PrimitiveLiteral {
@@ -84,7 +85,11 @@ func NewFloatLit(lit string) PrimitiveLiteral {
 func NewStringLit(lit string) PrimitiveLiteral {
 	trim := strings.ReplaceAll(lit, "\"", "")
 
-	return PrimitiveLiteral{trim, STRING}
+	return PrimitiveLiteral{trim, STRING, base.Span{}}
+}
+
+func NewTypedPrimitiveValue(lit PrimitiveLiteral, u Type) TypedPrimitiveValue {
+	return TypedPrimitiveValue{lit, u, base.Span{}}
 }
 
 /******************************************************************************/
@@ -102,15 +107,20 @@ func (x TypedPrimitiveValue) Val() interface{} { return x.lit.payload }
 /* PrimitiveLiteral */
 
 // Represents a literal whose type is still undefined
-//  (e.g. 123 is 'assignable' to either int32, int64 or MyInt, but the type is
-//        only determined upon 'assignment')
+//
+//	(e.g. 123 is 'assignable' to either int32, int64 or MyInt, but the type is
+//	      only determined upon 'assignment')
+//
 // An int/float payload is saved as int64/float64
 // -> we don't support arbitrary precision numerical constants as found in Go.
 type PrimitiveLiteral struct {
 	payload interface{}
 	tag     Tag
+	span    base.Span // Source position, not part of node identity
 }
 
+func (x PrimitiveLiteral) GetSpan() base.Span { return x.span }
+
 var _ PrimtValue = PrimitiveLiteral{}
 
 func (x PrimitiveLiteral) Payload() interface{} { return x.payload }
@@ -136,34 +146,37 @@ func (x PrimitiveLiteral) IsValue() bool {
 	return true
 }
 
+func (x PrimitiveLiteral) IsPanic() bool {
+	return false
+}
+
 func (x PrimitiveLiteral) CanEval([]base.Decl) bool {
 	return false
 }
 
+// Go literal syntax -- reparses to the same payload and tag (cf. NewIntLit, NewFloatLit)
 func (x PrimitiveLiteral) String() string {
-	var payload string
 	switch p := x.payload.(type) {
 	case bool:
-		payload = strconv.FormatBool(p)
+		return strconv.FormatBool(p)
 	case string:
-		payload = "\"" + p + "\""
+		return "\"" + p + "\""
 	case int32:
-		payload = strconv.FormatInt(int64(p), 10)
+		return strconv.FormatInt(int64(p), 10)
 	case int64:
-		payload = strconv.FormatInt(p, 10)
+		return strconv.FormatInt(p, 10)
 	case float32:
-		payload = strconv.FormatFloat(float64(p), 'E', -1, 32)
+		return formatFloatLit(float64(p), 32)
 	case float64:
-		payload = strconv.FormatFloat(p, 'E', -1, 64)
+		return formatFloatLit(p, 64)
 	default:
 		panic("PrimitiveLiteral.String() for unsupported type")
 	}
-	tag := NameFromTag(x.tag)
-	return "PrimitiveLiteral{payload=" + payload + ", tag=" + tag + "}"
 }
 
+// Cf. fmt.Printf("%#v", ...) of the payload
 func (x PrimitiveLiteral) ToGoString([]base.Decl) string {
-	return x.String()
+	return fmt.Sprintf("%#v", x.payload)
 }
 
 /******************************************************************************/
@@ -172,10 +185,13 @@ func (x PrimitiveLiteral) ToGoString([]base.Decl) string {
 // Essentially a PrimitiveLiteral whose type was already determined.
 // Need this in order not to break type-safety at each (small) step of evaluation.
 type TypedPrimitiveValue struct {
-	lit PrimitiveLiteral
-	typ Type
+	lit  PrimitiveLiteral
+	typ  Type
+	span base.Span // Source position, not part of node identity
 }
 
+func (x TypedPrimitiveValue) GetSpan() base.Span { return x.span }
+
 var _ FGGExpr = TypedPrimitiveValue{}
 
 func (x TypedPrimitiveValue) Subs(subs map[Variable]FGGExpr) FGGExpr {
@@ -198,42 +214,57 @@ func (x TypedPrimitiveValue) IsValue() bool {
 	return true
 }
 
+func (x TypedPrimitiveValue) IsPanic() bool {
+	return false
+}
+
 func (x TypedPrimitiveValue) CanEval(ds []base.Decl) bool {
 	return false
 }
 
+// Cf. Convert, e.g., int32(1)
 func (x TypedPrimitiveValue) String() string {
 	var b strings.Builder
 	b.WriteString(x.typ.String())
-	b.WriteString("Val(")
+	b.WriteString("(")
 	b.WriteString(x.lit.String())
 	b.WriteString(")")
 	return b.String()
 }
 
 func (x TypedPrimitiveValue) ToGoString(ds []base.Decl) string {
-	return x.String()
+	return x.lit.ToGoString(ds)
 }
 
 /******************************************************************************/
 /* Helpers */
 
+// The shortest representation that reparses as the same float -- N.B. not, e.g.,
+// "3" or "3.0" (parsed as int literals), so integral values use an exponent
+func formatFloatLit(f float64, bitSize int) string {
+	res := strconv.FormatFloat(f, 'g', -1, bitSize)
+	if !strings.ContainsAny(res, ".eIN") { // Cf. Inf, NaN
+		res = strconv.FormatFloat(f, 'e', -1, bitSize)
+	}
+	return res
+}
+
 func newIntLit(lit string) (PrimitiveLiteral, bool) {
 	if i, err := strconv.ParseInt(lit, 10, 32); err == nil {
-		return PrimitiveLiteral{int32(i), INT32}, true
+		return PrimitiveLiteral{int32(i), INT32, base.Span{}}, true
 	}
 	if i, err := strconv.ParseInt(lit, 10, 64); err == nil {
-		return PrimitiveLiteral{i, INT64}, true
+		return PrimitiveLiteral{i, INT64, base.Span{}}, true
 	}
 	return PrimitiveLiteral{}, false
 }
 
 func newFloatLit(lit string) (PrimitiveLiteral, bool) {
 	if f, err := strconv.ParseFloat(lit, 32); err == nil {
-		return PrimitiveLiteral{float32(f), FLOAT32}, true
+		return PrimitiveLiteral{float32(f), FLOAT32, base.Span{}}, true
 	}
 	if f, err := strconv.ParseFloat(lit, 64); err == nil {
-		return PrimitiveLiteral{f, FLOAT64}, true
+		return PrimitiveLiteral{f, FLOAT64, base.Span{}}, true
 	}
 	return PrimitiveLiteral{}, false
 }
@@ -257,12 +288,12 @@ func truncateFractionalPart(x string) string {
 type PrimtPredicate func(PrimType) bool
 
 var (
-	isBool       = func(t_P PrimType) bool { return t_P.Tag() == BOOL }
-	isString     = func(t_P PrimType) bool { return t_P.Tag() == STRING }
-	isInt        = func(t_P PrimType) bool { return t_P.Tag() == INT32 || t_P.Tag() == INT64 }
-	isFloat      = func(t_P PrimType) bool { return t_P.Tag() == FLOAT32 || t_P.Tag() == FLOAT64 }
-	isNumeric    = Or(isInt, isFloat)
-	isComparable = func(t_P PrimType) bool { return t_P.Tag() != BOOL }
+	isBool    = func(t_P PrimType) bool { return t_P.Tag() == BOOL }
+	isString  = func(t_P PrimType) bool { return t_P.Tag() == STRING }
+	isInt     = func(t_P PrimType) bool { return t_P.Tag() == INT32 || t_P.Tag() == INT64 }
+	isFloat   = func(t_P PrimType) bool { return t_P.Tag() == FLOAT32 || t_P.Tag() == FLOAT64 }
+	isNumeric = Or(isInt, isFloat)
+	isOrdered = Or(isNumeric, isString)
 )
 
 func Or(pred1, pred2 PrimtPredicate) PrimtPredicate {
@@ -270,8 +301,9 @@ func Or(pred1, pred2 PrimtPredicate) PrimtPredicate {
 }
 
 // Verifies if the type u satisfies the predicate.
-// If the type u is an interface type with a type list,
-// verifies that each type in the list satisfies the predicate.
+// If the type u is an interface type with a type set (a type list or unions),
+// verifies that each type in the set satisfies the predicate -- for a ~T term,
+// it is enough that T does, since they all share T as the underlying type.
 func evalPrimtPredicate(ds []Decl, delta Delta, pred PrimtPredicate, u Type) bool {
 	switch under := u.Underlying(ds).(type) {
 	case PrimType:
@@ -279,14 +311,49 @@ func evalPrimtPredicate(ds []Decl, delta Delta, pred PrimtPredicate, u Type) boo
 	case TParam:
 		constr := bounds(delta, under)
 		return evalPrimtPredicate(ds, delta, pred, constr)
+	case ITypeLit: // A bound, cf. TParam: every type in its type set
+		ts := under.TypeSet(ds)
+		if ts.IsAll() || ts.IsEmpty() {
+			return false
+		}
+		for _, t := range ts.terms {
+			if !evalPrimtPredicate(ds, delta, pred, t.u) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Go "comparable" types (cf. ==): primitives, interfaces, and structs whose
+// fields are all comparable.  A type param is comparable if its bound is (or
+// embeds) the predeclared comparable, or if every type in its type set is.
+func isComparableType(ds []Decl, delta Delta, u Type) bool {
+	switch under := u.Underlying(ds).(type) {
+	case PrimType:
+		return true
+	case TParam:
+		u_I, ok := bounds(delta, under).Underlying(ds).(ITypeLit)
+		if !ok {
+			return false
+		}
+		ts := u_I.TypeSet(ds)
+		return ts.IsComparable() || !ts.IsAll() && isComparableType(ds, delta, u_I)
 	case ITypeLit:
-		if under.HasTList() {
-			res := true
-			for _, u2 := range under.FlatTList(ds) {
-				res = evalPrimtPredicate(ds, delta, pred, u2) && res
+		for _, t := range under.TypeSet(ds).terms { // A bound, cf. TParam
+			if !isComparableType(ds, delta, t.u) {
+				return false
+			}
+		}
+		return true
+	case STypeLit:
+		for _, v := range under.fDecls {
+			if !isComparableType(ds, delta, v.u) {
+				return false
 			}
-			return res
 		}
+		return true
 	}
 	return false
 }
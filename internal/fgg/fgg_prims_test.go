@@ -2,6 +2,7 @@ package fgg_test
 
 import (
 	"github.com/rhu1/fgg/internal/base/testutils"
+	"strings"
 	"testing"
 )
 
@@ -335,6 +336,241 @@ func TestTLists005b(t *testing.T) {
 	testutils.EvalAndOkGood(t, prog, 4)
 }
 
+// Operators on a type param are checked against every type in its bound's type list
+func TestTLists006(t *testing.T) {
+	Num := "type Number(type ) interface { type int32, int64, float64 }"
+	Sum := "type Sum(type a Number()) struct { v a }"
+	Add := "func (x Sum(type a Number())) add(type )(y a) a { return x.v + y }"
+	e := "Sum(int32){1}.add()(2)"
+	prog := fggParseAndOkMonomGood(t, Num, Sum, Add, e)
+	res := testutils.EvalToValueGood(t, prog, 5)
+	if !strings.Contains(res.GetMain().String(), "int32(3)") {
+		t.Errorf("Expected int32(3), got: " + res.GetMain().String())
+	}
+}
+
+// Monomorphises to one add per instance, each on the concrete type
+func TestTLists006b(t *testing.T) {
+	Num := "type Number(type ) interface { type int32, int64, float64 }"
+	Sum := "type Sum(type a Number()) struct { v a }"
+	Add := "func (x Sum(type a Number())) add(type )(y a) a { return x.v + y }"
+	Pair := "type Pair(type ) struct { i int32; f float64 }"
+	e := "Pair(){Sum(int32){1}.add()(2), Sum(float64){1.5}.add()(2.5)}"
+	prog := fggParseAndOkMonomGood(t, Num, Sum, Add, Pair, e)
+	for _, m := range []string{"Sum<int32>) add<>(y int32) int32",
+		"Sum<float64>) add<>(y float64) float64"} {
+		if !strings.Contains(prog.String(), m) {
+			t.Errorf("Expected monom method " + m + ", got:\n" + prog.String())
+		}
+	}
+	testutils.EvalAndOkGood(t, prog, 10)
+}
+
+// + is not defined for bool, which is in the type list
+func TestTLists006c(t *testing.T) {
+	NB := "type NB(type ) interface { type int32, bool }"
+	Sum := "type Sum(type a NB()) struct { v a }"
+	Add := "func (x Sum(type a NB())) add(type )(y a) a { return x.v + y }"
+	e := "Sum(int32){1}.add()(2)"
+	fggParseAndOkBad(t, "operator + not defined for type: a (~bool, in the type set of NB())",
+		NB, Sum, Add, e)
+}
+
+/* Type sets: unions and ~T terms */
+
+func TestTSets001(t *testing.T) {
+	Num := "type Number(type ) interface { ~int32 | ~int64 }"
+	S := "type S(type ) struct {}"
+	Sm := "func (s S(type )) add(type T Number())(x T) T { return x + x }"
+	MyInt := "type MyInt(type ) int32"
+	e := "S(){}.add(MyInt())(5)"
+	prog := fggParseAndOkMonomGood(t, Num, S, Sm, MyInt, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if !strings.Contains(res.GetMain().String(), "10") {
+		t.Errorf("Expected 10, got: " + res.GetMain().String())
+	}
+}
+
+// Not in the type set
+func TestTSets001b(t *testing.T) {
+	Num := "type Number(type ) interface { ~int32 | ~int64 }"
+	S := "type S(type ) struct {}"
+	Sm := "func (s S(type )) add(type T Number())(x T) T { return x + x }"
+	MyFloat := "type MyFloat(type ) float32"
+	e := "S(){}.add(MyFloat())(5.5)"
+	fggParseAndOkBad(t, "Type actual must implement type formal", Num, S, Sm, MyFloat, e)
+}
+
+// A term without ~ admits only that exact type
+func TestTSets001c(t *testing.T) {
+	Num := "type Number(type ) interface { int32 | int64 }"
+	S := "type S(type ) struct {}"
+	Sm := "func (s S(type )) add(type T Number())(x T) T { return x + x }"
+	MyInt := "type MyInt(type ) int32"
+	e := "S(){}.add(MyInt())(5)"
+	fggParseAndOkBad(t, "Type actual must implement type formal", Num, S, Sm, MyInt, e)
+}
+
+// A type param satisfies a bound if its own type set is included
+func TestTSets002(t *testing.T) {
+	Num := "type Number(type ) interface { ~int32 | ~int64 }"
+	I32 := "type I32(type ) interface { int32 }"
+	S := "type S(type ) struct {}"
+	Sm := "func (s S(type )) add(type T Number())(x T) T { return x + x }"
+	Sm2 := "func (s S(type )) twice(type T I32())(x T) T { return s.add(T)(x) }"
+	e := "S(){}.twice(int32)(21)"
+	prog := fggParseAndOkMonomGood(t, Num, I32, S, Sm, Sm2, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(42)" {
+		t.Errorf("Expected int32(42), got: " + res.GetMain().String())
+	}
+}
+
+func TestTSets002b(t *testing.T) {
+	Num := "type Number(type ) interface { ~int32 | ~int64 }"
+	I32 := "type I32(type ) interface { int32 }"
+	S := "type S(type ) struct {}"
+	Sm := "func (s S(type )) twice(type T I32())(x T) T { return x + x }"
+	Sm2 := "func (s S(type )) add(type T Number())(x T) T { return s.twice(T)(x) }"
+	e := "S(){}"
+	fggParseAndOkBad(t, "Type actual must implement type formal", Num, I32, S, Sm, Sm2, e)
+}
+
+// Embedding intersects the type sets
+func TestTSets003(t *testing.T) {
+	Num := "type Number(type ) interface { ~int32 | ~int64 }"
+	Empty := "type Empty(type ) interface { Number(); string }"
+	e := "1"
+	fggParseAndOkBad(t, "Empty type set", Num, Empty, e)
+}
+
+func TestTSets003b(t *testing.T) {
+	Bad := "type Bad(type ) interface { ~MyInt() }"
+	MyInt := "type MyInt(type ) int32"
+	e := "1"
+	fggParseAndOkBad(t, "Invalid use of ~", Bad, MyInt, e)
+}
+
+// A single term naming a non-interface type is a union, not an embedding
+func TestTSets004(t *testing.T) {
+	OnlyMyInt := "type OnlyMyInt(type ) interface { MyInt() }"
+	MyInt := "type MyInt(type ) int32"
+	S := "type S(type ) struct {}"
+	Sm := "func (s S(type )) add(type T OnlyMyInt())(x T) T { return x + x }"
+	e := "S(){}.add(MyInt())(5)"
+	prog := fggParseAndOkMonomGood(t, OnlyMyInt, MyInt, S, Sm, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if !strings.Contains(res.GetMain().String(), "10") {
+		t.Errorf("Expected 10, got: " + res.GetMain().String())
+	}
+}
+
+func TestTSets004b(t *testing.T) {
+	OnlyMyInt := "type OnlyMyInt(type ) interface { MyInt() }"
+	MyInt := "type MyInt(type ) int32"
+	S := "type S(type ) struct {}"
+	Sm := "func (s S(type )) add(type T OnlyMyInt())(x T) T { return x + x }"
+	e := "S(){}.add(int32)(5)"
+	fggParseAndOkBad(t, "Type actual must implement type formal", OnlyMyInt, MyInt, S, Sm, e)
+}
+
+// A non-basic interface can only be used as a bound
+func TestTSets005(t *testing.T) {
+	Num := "type Number(type ) interface { ~int32 | ~int64 }"
+	S := "type S(type ) struct { f Number() }"
+	e := "1"
+	fggParseAndOkBad(t, "Interface contains type constraints, can only be used as a bound",
+		Num, S, e)
+}
+
+func TestTSets005b(t *testing.T) {
+	Num := "type Number(type ) interface { ~int32 | ~int64 }"
+	S := "type S(type ) struct {}"
+	Sm := "func (s S(type )) id(type )(x Number()) Number() { return x }"
+	e := "1"
+	fggParseAndOkBad(t, "Interface contains type constraints, can only be used as a bound",
+		Num, S, Sm, e)
+}
+
+func TestTSets005c(t *testing.T) {
+	Eq := "type Eq(type ) interface { comparable() }"
+	f := "func id(type )(x int32) Eq() { return x }"
+	e := "1"
+	fggParseAndOkBad(t, "Interface contains type constraints, can only be used as a bound",
+		Eq, f, e)
+}
+
+// comparable drops the non-comparable terms of a type set
+func TestTSets006(t *testing.T) {
+	F := "type F(type ) struct { xs []int32 }"
+	Empty := "type Empty(type ) interface { comparable(); F() }"
+	e := "1"
+	fggParseAndOkBad(t, "Empty type set", F, Empty, e)
+}
+
+func TestTSets006b(t *testing.T) {
+	F := "type F(type ) struct { xs []int32 }"
+	Num := "type Num(type ) interface { comparable(); F() | int32 }"
+	S := "type S(type ) struct {}"
+	Sm := "func (s S(type )) add(type T Num())(x T) T { return x + x }"
+	e := "S(){}.add(int32)(5)"
+	prog := fggParseAndOkMonomGood(t, F, Num, S, Sm, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(10)" {
+		t.Errorf("Expected int32(10), got: " + res.GetMain().String())
+	}
+}
+
+/******************************************************************************/
+/* Predeclared types: any and comparable */
+
+func TestUniverse001(t *testing.T) {
+	Box := "type Box(type a any()) struct { f a }"
+	Boxm := "func (x0 Box(type a any())) get(type )() any() { return x0.f }"
+	e := "Box(int32){1}.get()()"
+	prog := fggParseAndOkMonomGood(t, Box, Boxm, e)
+	testutils.EvalToValueGood(t, prog, 10)
+}
+
+// Not comparable, cf. TestEq003
+func TestUniverse002(t *testing.T) {
+	F := "type F(type ) struct { xs []int32 }"
+	Box := "type Box(type a comparable()) struct { f a }"
+	e := "Box(F()){F(){[]int32{}}}"
+	fggParseAndOkBad(t, "Type actual must implement type formal", F, Box, e)
+}
+
+// A bare any or comparable names the predeclared type, as in Go
+func TestUniverse003(t *testing.T) {
+	Box := "type Box(type a comparable) struct { f a }"
+	Boxm := "func (x0 Box(type a comparable)) eq(type )(y any) bool { return x0.f == y }"
+	e := "Box(int32){1}.eq()(int32(1))"
+	prog := fggParseAndOkMonomGood(t, Box, Boxm, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if !strings.Contains(res.GetMain().String(), "true") {
+		t.Errorf("Expected true, got: " + res.GetMain().String())
+	}
+}
+
+func TestUniverse003b(t *testing.T) {
+	F := "type F(type ) struct { xs []int32 }"
+	Box := "type Box(type a comparable) struct { f a }"
+	e := "Box(F()){F(){[]int32{}}}"
+	fggParseAndOkBad(t, "Type actual must implement type formal", F, Box, e)
+}
+
+// ... unless shadowed by a type formal
+func TestUniverse003c(t *testing.T) {
+	Box := "type Box(type any comparable) struct { f any }"
+	Boxm := "func (x0 Box(type any comparable)) get(type )() any { return x0.f }"
+	e := "Box(int32){1}.get()()"
+	prog := fggParseAndOkMonomGood(t, Box, Boxm, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(1)" {
+		t.Errorf("Expected int32(1), got: " + res.GetMain().String())
+	}
+}
+
 /******************************************************************************/
 /* Tests that show that some form of coercion is necessary */
 
@@ -367,3 +603,129 @@ func TestConvs(t *testing.T) {
 //	e := "S(){}.Ret1(MyInt())( S(){}.AsMyInt()(1)   ).String()()"
 //	fggParseAndOkGood(t, S, NumericS, Sadd, MyInt, MyIntS, e)
 //}
+
+/******************************************************************************/
+/* Multiplicative and bitwise ops */
+
+func TestArith001(t *testing.T) {
+	A := "type A(type ) struct {}"
+	Am := "func (x0 A(type )) f(type )(x int64, y int64) int64 { return (x * y ^ 6) % 7 << 1 }"
+	e := "A(){}.f()(4, 5)"
+	prog := fggParseAndOkMonomGood(t, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int64(8)" {
+		t.Errorf("Expected int64(8), got: " + res.GetMain().String())
+	}
+}
+
+func TestArith002(t *testing.T) {
+	A := "type A(type ) struct {}"
+	Am := "func (x0 A(type )) f(type )(x int32) int32 { return 10 % x }"
+	e := "A(){}.f()(0)"
+	prog := fggParseAndOkGood(t, A, Am, e)
+	testutils.EvalToValueBad(t, prog, "integer divide by zero", 10)
+}
+
+// A constant divisor must not be zero
+func TestArith002b(t *testing.T) {
+	A := "type A(type ) struct {}"
+	e := "int32(1) / 0"
+	fggParseAndOkBad(t, "invalid operation: division by zero", A, e)
+}
+
+/******************************************************************************/
+/* Equality and ordering */
+
+// A type param is comparable if the types in (the type list of) its bound are
+func TestEq001(t *testing.T) {
+	Num := "type Num(type ) interface { type int32, int64 }"
+	Box := "type Box(type a Num()) struct { f a }"
+	Boxm := "func (x0 Box(type a Num())) eq(type )(y Box(a)) bool { return x0 == y && x0.f <= y.f }"
+	e := "Box(int32){1}.eq()(Box(int32){1})"
+	prog := fggParseAndOkMonomGood(t, Num, Box, Boxm, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if !strings.Contains(res.GetMain().String(), "true") {
+		t.Errorf("Expected true, got: " + res.GetMain().String())
+	}
+}
+
+func TestEq002(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	Box := "type Box(type a Any()) struct { f a }"
+	Boxm := "func (x0 Box(type a Any())) eq(type )(y a) bool { return x0.f == y }"
+	e := "Box(int32){1}"
+	fggParseAndOkBad(t, "operator == not defined for type: a", Any, Box, Boxm, e)
+}
+
+// Predeclared comparable, checked structurally
+func TestEq003(t *testing.T) {
+	P := "type P(type ) struct { x int32; y string }"
+	Box := "type Box(type a comparable()) struct { f a }"
+	Boxm := "func (x0 Box(type a comparable())) eq(type )(y a) bool { return x0.f == y }"
+	e := "Box(P()){P(){1, \"a\"}}.eq()(P(){1, \"b\"})"
+	prog := fggParseAndOkMonomGood(t, P, Box, Boxm, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if !strings.Contains(res.GetMain().String(), "false") {
+		t.Errorf("Expected false, got: " + res.GetMain().String())
+	}
+}
+
+/******************************************************************************/
+/* Unary operators */
+
+// Negation is defined for a type param if it is for the types in (the type list of) its bound
+func TestUnary001(t *testing.T) {
+	Num := "type Num(type ) interface { type int32, int64 }"
+	Box := "type Box(type a Num()) struct { f a }"
+	Boxm := "func (x0 Box(type a Num())) neg(type )() a { return -x0.f }"
+	e := "Box(int64){3}.neg()()"
+	prog := fggParseAndOkMonomGood(t, Num, Box, Boxm, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int64(-3)" {
+		t.Errorf("Expected int64(-3), got: " + res.GetMain().String())
+	}
+	fggOblitGood(t, Num, Box, Boxm, e)
+}
+
+// Obliteration: the conversion to a type param uses its rep
+func TestUnary001b(t *testing.T) {
+	Num := "type Num(type ) interface { type int32, int64 }"
+	neg := "func neg(type a Num())(x a) a { return -x + a(1) }"
+	fggOblitGood(t, Num, neg, "neg(int32)(3) == -2")
+	fggOblitGood(t, Num, neg, "int32(1) / (neg(int32)(1) + 0)") // A run-time panic
+}
+
+func TestUnary002(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	Box := "type Box(type a Any()) struct { f a }"
+	Boxm := "func (x0 Box(type a Any())) not(type )() a { return !x0.f }"
+	e := "Box(bool){true}"
+	fggParseAndOkBad(t, "operator ! not defined for type: a", Any, Box, Boxm, e)
+}
+
+/******************************************************************************/
+/* Conversions */
+
+// A type param can be converted if all the types in (the type list of) its bound can
+func TestConv001(t *testing.T) {
+	Num := "type Num(type ) interface { type int32, int64 }"
+	Box := "type Box(type a Num()) struct { f a }"
+	Boxm := "func (x0 Box(type a Num())) toFloat(type )() float64 { return float64(x0.f) }"
+	e := "Box(int32){3}.toFloat()()"
+	prog := fggParseAndOkMonomGood(t, Num, Box, Boxm, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "float64(3e+00)" {
+		t.Errorf("Expected float64(3e+00), got: " + res.GetMain().String())
+	}
+}
+
+func TestConv002(t *testing.T) {
+	MyInt := "type MyInt(type ) int64"
+	MyIntm := "func (x MyInt(type )) inc(type )() MyInt() { return x + MyInt()(1) }"
+	e := "MyInt()(int64(2)).inc()()"
+	prog := fggParseAndOkMonomGood(t, MyInt, MyIntm, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "MyInt<>(3)" { // Cf. the monomorphised type name
+		t.Errorf("Expected MyInt<>(3), got: " + res.GetMain().String())
+	}
+}
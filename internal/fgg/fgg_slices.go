@@ -0,0 +1,469 @@
+/*
+ * This file contains defs for slice values and the builtin slice operations:
+ * literals, indexing, len and append.  Cf. TSlice, in fgg_types.go.
+ */
+
+package fgg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rhu1/fgg/internal/base"
+)
+
+/* Public constructors */
+
+func NewSliceLit(u Type, es []FGGExpr) SliceLit { return SliceLit{u, es, base.Span{}} }
+func NewIndex(e FGGExpr, i FGGExpr) Index       { return Index{e, i, base.Span{}} }
+func NewLen(e FGGExpr) Len                      { return Len{e, base.Span{}} }
+func NewAppend(e FGGExpr, es []FGGExpr) Append  { return Append{e, es, base.Span{}} }
+
+// The type of len(e)
+var lenType = NewTPrimitive(INT32)
+
+/* SliceLit */
+
+// u{e1, ..., en}, where u is a slice type or a defined type whose underlying
+// type is a slice type -- the latter is parsed as a StructLit, cf. StructLit.Typing
+type SliceLit struct {
+	typ   Type
+	elems []FGGExpr
+	span  base.Span // Source position, not part of node identity
+}
+
+func (s SliceLit) GetSpan() base.Span { return s.span }
+
+var _ FGGExpr = SliceLit{}
+
+func (s SliceLit) GetType() Type       { return s.typ }
+func (s SliceLit) GetElems() []FGGExpr { return s.elems }
+
+func (s SliceLit) Subs(subs map[Variable]FGGExpr) FGGExpr {
+	es := make([]FGGExpr, len(s.elems))
+	for i := 0; i < len(s.elems); i++ {
+		es[i] = s.elems[i].Subs(subs)
+	}
+	return SliceLit{s.typ, es, s.span}
+}
+
+func (s SliceLit) TSubs(subs EtaOpen) FGGExpr {
+	es := make([]FGGExpr, len(s.elems))
+	for i := 0; i < len(s.elems); i++ {
+		es[i] = s.elems[i].TSubs(subs)
+	}
+	return SliceLit{s.typ.SubsEtaOpen(subs), es, s.span}
+}
+
+// Cf. StructLit.Eval
+func (s SliceLit) Eval(ds []Decl) (FGGExpr, string) {
+	es := make([]FGGExpr, len(s.elems))
+	done := false
+	var rule string
+	for i := 0; i < len(s.elems); i++ {
+		v := s.elems[i]
+		if !done && !v.IsValue() {
+			v, rule = v.Eval(ds)
+			done = true
+		}
+		es[i] = v
+	}
+	if !done {
+		panic("Cannot reduce: " + s.String())
+	}
+	return SliceLit{s.typ, es, s.span}, rule
+}
+
+func (s SliceLit) Typing(ds []Decl, delta Delta, gamma Gamma,
+	allowStupid bool) (Type, FGGExpr) {
+	s.typ.Ok(ds, delta)
+	u_S, ok := s.typ.Underlying(ds).(TSlice)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, s,
+			"Slice literal: "+s.typ.String()+" is not a slice type"))
+	}
+	elems := make([]FGGExpr, len(s.elems))
+	for i := 0; i < len(s.elems); i++ {
+		u, newSubtree := s.elems[i].Typing(ds, delta, gamma, allowStupid)
+		ok, coercion := u.AssignableToDelta(ds, delta, u_S.elem)
+		if !ok {
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, s,
+				"Slice element must be assignable to element type: elem="+u.String()+
+					", expected="+u_S.elem.String()))
+		}
+		elems[i] = coercion(newSubtree)
+	}
+	return s.typ, SliceLit{s.typ, elems, s.span}
+}
+
+// From base.Expr
+func (s SliceLit) IsValue() bool {
+	for _, v := range s.elems {
+		if !v.IsValue() {
+			return false
+		}
+	}
+	return true
+}
+
+func (s SliceLit) IsPanic() bool {
+	return false
+}
+
+func (s SliceLit) CanEval(ds []Decl) bool {
+	for _, v := range s.elems {
+		if v.CanEval(ds) {
+			return true
+		} else if !v.IsValue() {
+			return false
+		}
+	}
+	return false
+}
+
+func (s SliceLit) String() string {
+	var b strings.Builder
+	b.WriteString(s.typ.String())
+	b.WriteString("{")
+	writeExprs(&b, s.elems)
+	b.WriteString("}")
+	return b.String()
+}
+
+func (s SliceLit) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString(s.typ.ToGoString(ds))
+	b.WriteString("{")
+	writeToGoExprs(ds, &b, s.elems)
+	b.WriteString("}")
+	return b.String()
+}
+
+/* Index */
+
+// e_S[e_idx], where e_S is a slice or a map -- cf. mapIndex for a missing key
+type Index struct {
+	e_S   FGGExpr
+	e_idx FGGExpr
+	span  base.Span // Source position, not part of node identity
+}
+
+func (x Index) GetSpan() base.Span { return x.span }
+
+var _ FGGExpr = Index{}
+
+func (x Index) GetExpr() FGGExpr  { return x.e_S }
+func (x Index) GetIndex() FGGExpr { return x.e_idx }
+
+func (x Index) Subs(subs map[Variable]FGGExpr) FGGExpr {
+	return Index{x.e_S.Subs(subs), x.e_idx.Subs(subs), x.span}
+}
+
+func (x Index) TSubs(subs EtaOpen) FGGExpr {
+	return Index{x.e_S.TSubs(subs), x.e_idx.TSubs(subs), x.span}
+}
+
+func (x Index) Eval(ds []Decl) (FGGExpr, string) {
+	if !x.e_S.IsValue() {
+		e, rule := x.e_S.Eval(ds)
+		return Index{e, x.e_idx, x.span}, rule
+	}
+	if !x.e_idx.IsValue() {
+		e, rule := x.e_idx.Eval(ds)
+		return Index{x.e_S, e, x.span}, rule
+	}
+	if m, ok := x.e_S.(MapLit); ok {
+		v, _ := mapIndex(ds, m, x.e_idx, x)
+		if v.IsPanic() {
+			return v, "IndexPanic"
+		}
+		return v, "Index"
+	}
+	s := x.e_S.(SliceLit)
+	i := toInt64(x.e_idx.(PrimtValue).Val())
+	if i < 0 || i >= int64(len(s.elems)) { // Cf. Go run-time panic
+		msg := fmt.Sprintf("index out of range [%d] with length %d", i, len(s.elems))
+		return runtimePanic(ds, x, msg), "IndexPanic"
+	}
+	return s.elems[i], "Index"
+}
+
+func (x Index) Typing(ds []Decl, delta Delta, gamma Gamma,
+	allowStupid bool) (Type, FGGExpr) {
+	u, e_S := x.e_S.Typing(ds, delta, gamma, allowStupid)
+	switch u_S := u.Underlying(ds).(type) {
+	case TSlice:
+		u_idx, e_idx := x.e_idx.Typing(ds, delta, gamma, allowStupid)
+		checkIndex(ds, delta, x, u_idx, e_idx)
+		return u_S.elem, Index{e_S, e_idx, x.span}
+	case TMap:
+		e_idx := typeMapOperand(ds, delta, gamma, allowStupid, x, x.e_idx, u_S.key, "key", "key")
+		return u_S.elem, Index{e_S, e_idx, x.span}
+	}
+	panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, x,
+		"Cannot index non-slice/map: "+x.e_S.String()+" of type "+u.String()))
+}
+
+// An index must be of integer type, and not a negative constant
+func checkIndex(ds []Decl, delta Delta, x Index, u_idx Type, e_idx FGGExpr) {
+	if !evalPrimtPredicate(ds, delta, isInt, u_idx) {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, x,
+			"Index must be of integer type: "+x.e_idx.String()+" of type "+u_idx.String()))
+	}
+	if lit, ok := e_idx.(PrimitiveLiteral); ok && toInt64(lit.payload) < 0 {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, x,
+			"Index must not be negative: "+x.e_idx.String()))
+	}
+}
+
+// From base.Expr
+func (x Index) IsValue() bool {
+	return false
+}
+
+func (x Index) IsPanic() bool {
+	return false
+}
+
+// N.B. true for an out of range index, cf. the run-time panic in Eval
+func (x Index) CanEval(ds []Decl) bool {
+	if x.e_S.CanEval(ds) {
+		return true
+	} else if !x.e_S.IsValue() {
+		return false
+	}
+	return x.e_idx.IsValue() || x.e_idx.CanEval(ds)
+}
+
+func (x Index) String() string {
+	var b strings.Builder
+	writeIndexed(&b, x.e_S, x.e_S.String())
+	b.WriteString("[")
+	b.WriteString(x.e_idx.String())
+	b.WriteString("]")
+	return b.String()
+}
+
+func (x Index) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	writeIndexed(&b, x.e_S, x.e_S.ToGoString(ds))
+	b.WriteString("[")
+	b.WriteString(x.e_idx.ToGoString(ds))
+	b.WriteString("]")
+	return b.String()
+}
+
+// Parenthesises e_S if it is an operation or a function literal, cf. writeFunc
+func writeIndexed(b *strings.Builder, e_S FGGExpr, s string) {
+	switch e_S.(type) {
+	case UnaryOperation, BinaryOperation, Comparison, FuncLit:
+		b.WriteString("(")
+		b.WriteString(s)
+		b.WriteString(")")
+	default:
+		b.WriteString(s)
+	}
+}
+
+/* Len */
+
+// len(e_S)
+type Len struct {
+	e_S  FGGExpr
+	span base.Span // Source position, not part of node identity
+}
+
+func (l Len) GetSpan() base.Span { return l.span }
+
+var _ FGGExpr = Len{}
+
+func (l Len) GetExpr() FGGExpr { return l.e_S }
+
+func (l Len) Subs(subs map[Variable]FGGExpr) FGGExpr {
+	return Len{l.e_S.Subs(subs), l.span}
+}
+
+func (l Len) TSubs(subs EtaOpen) FGGExpr {
+	return Len{l.e_S.TSubs(subs), l.span}
+}
+
+func (l Len) Eval(ds []Decl) (FGGExpr, string) {
+	if !l.e_S.IsValue() {
+		e, rule := l.e_S.Eval(ds)
+		return Len{e, l.span}, rule
+	}
+	var n int
+	switch e_S := l.e_S.(type) {
+	case SliceLit:
+		n = len(e_S.elems)
+	case MapLit:
+		n = len(e_S.entries)
+	}
+	lit := PrimitiveLiteral{int32(n), lenType.tag, l.span}
+	return TypedPrimitiveValue{lit, lenType, l.span}, "Len"
+}
+
+func (l Len) Typing(ds []Decl, delta Delta, gamma Gamma,
+	allowStupid bool) (Type, FGGExpr) {
+	u, e_S := l.e_S.Typing(ds, delta, gamma, allowStupid)
+	switch u.Underlying(ds).(type) {
+	case TSlice, TMap:
+	default:
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, l,
+			"Invalid argument for len: "+l.e_S.String()+" of type "+u.String()))
+	}
+	return lenType, Len{e_S, l.span}
+}
+
+// From base.Expr
+func (l Len) IsValue() bool {
+	return false
+}
+
+func (l Len) IsPanic() bool {
+	return false
+}
+
+func (l Len) CanEval(ds []Decl) bool {
+	return l.e_S.IsValue() || l.e_S.CanEval(ds)
+}
+
+func (l Len) String() string {
+	return "len(" + l.e_S.String() + ")"
+}
+
+func (l Len) ToGoString(ds []Decl) string {
+	return "len(" + l.e_S.ToGoString(ds) + ")"
+}
+
+/* Append */
+
+// append(e_S, e1, ..., en) -- slices are immutable values, so the result
+// never aliases e_S
+type Append struct {
+	e_S   FGGExpr
+	elems []FGGExpr
+	span  base.Span // Source position, not part of node identity
+}
+
+func (a Append) GetSpan() base.Span { return a.span }
+
+var _ FGGExpr = Append{}
+
+func (a Append) GetExpr() FGGExpr    { return a.e_S }
+func (a Append) GetElems() []FGGExpr { return a.elems }
+
+func (a Append) Subs(subs map[Variable]FGGExpr) FGGExpr {
+	es := make([]FGGExpr, len(a.elems))
+	for i := 0; i < len(a.elems); i++ {
+		es[i] = a.elems[i].Subs(subs)
+	}
+	return Append{a.e_S.Subs(subs), es, a.span}
+}
+
+func (a Append) TSubs(subs EtaOpen) FGGExpr {
+	es := make([]FGGExpr, len(a.elems))
+	for i := 0; i < len(a.elems); i++ {
+		es[i] = a.elems[i].TSubs(subs)
+	}
+	return Append{a.e_S.TSubs(subs), es, a.span}
+}
+
+// Cf. Call.Eval
+func (a Append) Eval(ds []Decl) (FGGExpr, string) {
+	if !a.e_S.IsValue() {
+		e, rule := a.e_S.Eval(ds)
+		return Append{e, a.elems, a.span}, rule
+	}
+	es := make([]FGGExpr, len(a.elems))
+	done := false
+	var rule string
+	for i := 0; i < len(a.elems); i++ {
+		e := a.elems[i]
+		if !done && !e.IsValue() {
+			e, rule = e.Eval(ds)
+			done = true
+		}
+		es[i] = e
+	}
+	if done {
+		return Append{a.e_S, es, a.span}, rule
+	}
+	s := a.e_S.(SliceLit)
+	res := make([]FGGExpr, 0, len(s.elems)+len(a.elems))
+	res = append(res, s.elems...)
+	res = append(res, a.elems...)
+	return SliceLit{s.typ, res, a.span}, "Append"
+}
+
+// The result has the (possibly defined) type of e_S, as in Go
+func (a Append) Typing(ds []Decl, delta Delta, gamma Gamma,
+	allowStupid bool) (Type, FGGExpr) {
+	u, e_S := a.e_S.Typing(ds, delta, gamma, allowStupid)
+	u_S, ok := u.Underlying(ds).(TSlice)
+	if !ok {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, a,
+			"Invalid argument for append: "+a.e_S.String()+" of type "+u.String()))
+	}
+	elems := make([]FGGExpr, len(a.elems))
+	for i := 0; i < len(a.elems); i++ {
+		u_e, newSubtree := a.elems[i].Typing(ds, delta, gamma, allowStupid)
+		ok, coercion := u_e.AssignableToDelta(ds, delta, u_S.elem)
+		if !ok {
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, a,
+				"Slice element must be assignable to element type: elem="+u_e.String()+
+					", expected="+u_S.elem.String()))
+		}
+		elems[i] = coercion(newSubtree)
+	}
+	return u, Append{e_S, elems, a.span}
+}
+
+// From base.Expr
+func (a Append) IsValue() bool {
+	return false
+}
+
+func (a Append) IsPanic() bool {
+	return false
+}
+
+func (a Append) CanEval(ds []Decl) bool {
+	if a.e_S.CanEval(ds) {
+		return true
+	} else if !a.e_S.IsValue() {
+		return false
+	}
+	for _, v := range a.elems {
+		if v.CanEval(ds) {
+			return true
+		} else if !v.IsValue() {
+			return false
+		}
+	}
+	return true
+}
+
+func (a Append) String() string {
+	var b strings.Builder
+	b.WriteString("append(")
+	b.WriteString(a.e_S.String())
+	for _, v := range a.elems {
+		b.WriteString(", ")
+		b.WriteString(v.String())
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func (a Append) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString("append(")
+	b.WriteString(a.e_S.ToGoString(ds))
+	for _, v := range a.elems {
+		b.WriteString(", ")
+		b.WriteString(v.ToGoString(ds))
+	}
+	b.WriteString(")")
+	return b.String()
+}
@@ -0,0 +1,295 @@
+/*
+ * This file contains defs for type switches: a method body (or branch of one)
+ * that selects a case by the dynamic type of an interface value.  Cf. Assert,
+ * in fgg_exprs.go.
+ */
+
+package fgg
+
+import (
+	"strings"
+
+	"github.com/rhu1/fgg/internal/base"
+)
+
+/* Public constructors */
+
+func NewTypeCase(u Type, e FGGExpr) TypeCase { return TypeCase{u, e} }
+
+// x may be empty, i.e., no binding; e_def may be nil, i.e., no default
+// (rejected by typing); u may be nil, cf. Cond
+func NewTypeSwitch(x Name, e_I FGGExpr, cases []TypeCase, e_def FGGExpr, u Type) TypeSwitch {
+	return TypeSwitch{x, e_I, cases, e_def, u, base.Span{}}
+}
+
+/* TypeSwitch */
+
+// "case u: e_body" -- u may be an instantiated generic type, e.g., Box(T)
+type TypeCase struct {
+	u      Type
+	e_body FGGExpr
+}
+
+func (c TypeCase) GetType() Type    { return c.u }
+func (c TypeCase) GetBody() FGGExpr { return c.e_body }
+
+func (c TypeCase) String() string {
+	var b strings.Builder
+	b.WriteString("case " + c.u.String() + ": ")
+	writeBody(&b, c.e_body)
+	return b.String()
+}
+
+// A method body (or branch of one):
+// switch x := e_I.(type) { case u1: e1; ...; case un: en; default: e_def }.
+// The first case whose type the dynamic type of e_I is assignable to (cf.
+// Assert) is selected, o/w the default -- which is required (cf. Go's
+// "missing return"), so that a type switch never gets stuck, whether or not
+// the cases are exhaustive.  In each case, x has the case type; in the
+// default, the type of e_I.  typ is as for Cond.
+type TypeSwitch struct {
+	x     Name // "" for "switch e_I.(type)"
+	e_I   FGGExpr
+	cases []TypeCase
+	e_def FGGExpr // nil if no default
+	typ   Type
+	span  base.Span // Source position, not part of node identity
+}
+
+func (s TypeSwitch) GetSpan() base.Span { return s.span }
+
+var _ FGGExpr = TypeSwitch{}
+
+func (s TypeSwitch) GetVar() Name         { return s.x }
+func (s TypeSwitch) GetExpr() FGGExpr     { return s.e_I }
+func (s TypeSwitch) GetCases() []TypeCase { return s.cases }
+func (s TypeSwitch) GetDefault() FGGExpr  { return s.e_def }
+func (s TypeSwitch) GetType() Type        { return s.typ }
+
+// x is bound in each case (and the default), so is not substituted there
+func (s TypeSwitch) Subs(subs map[Variable]FGGExpr) FGGExpr {
+	subs1 := subs
+	if s.x != "" {
+		subs1 = make(map[Variable]FGGExpr)
+		for k, v := range subs {
+			subs1[k] = v
+		}
+		subs1[NewVariable(s.x)] = NewVariable(s.x)
+	}
+	cases := make([]TypeCase, len(s.cases))
+	for i, c := range s.cases {
+		cases[i] = TypeCase{c.u, c.e_body.Subs(subs1)}
+	}
+	var e_def FGGExpr
+	if s.e_def != nil {
+		e_def = s.e_def.Subs(subs1)
+	}
+	return TypeSwitch{s.x, s.e_I.Subs(subs), cases, e_def, s.typ, s.span}
+}
+
+func (s TypeSwitch) TSubs(eta EtaOpen) FGGExpr {
+	cases := make([]TypeCase, len(s.cases))
+	for i, c := range s.cases {
+		cases[i] = TypeCase{c.u.SubsEtaOpen(eta), c.e_body.TSubs(eta)}
+	}
+	var e_def FGGExpr
+	if s.e_def != nil {
+		e_def = s.e_def.TSubs(eta)
+	}
+	var u Type
+	if s.typ != nil {
+		u = s.typ.SubsEtaOpen(eta)
+	}
+	return TypeSwitch{s.x, s.e_I.TSubs(eta), cases, e_def, u, s.span}
+}
+
+// N.B. only e_I is evaluated, the cases are not
+func (s TypeSwitch) Eval(ds []Decl) (FGGExpr, string) {
+	if !s.e_I.IsValue() {
+		e, rule := s.e_I.Eval(ds)
+		return TypeSwitch{s.x, e, s.cases, s.e_def, s.typ, s.span}, rule
+	}
+	if e, ok := s.selectCase(ds); ok {
+		return s.bind(e), "TypeSwitch"
+	}
+	if s.e_def == nil {
+		panic("No matching case in type switch, and no default: " + s.String())
+	}
+	return s.bind(s.e_def), "TypeSwitchDefault"
+}
+
+// Pre: s.e_I is a value -- N.B. the cases are closed at run time, cf. Assert.Eval
+func (s TypeSwitch) selectCase(ds []Decl) (FGGExpr, bool) {
+	u := concreteType(s.e_I)
+	for _, c := range s.cases {
+		if ok, _ := u.AssignableToDelta(ds, Delta{}, c.u); ok {
+			return c.e_body, true
+		}
+	}
+	return nil, false
+}
+
+// Substitutes the value of e_I for x in e, a case (or the default)
+func (s TypeSwitch) bind(e FGGExpr) FGGExpr {
+	if s.x == "" {
+		return e
+	}
+	return e.Subs(map[Variable]FGGExpr{NewVariable(s.x): s.e_I})
+}
+
+// N.B. unlike Let, x may shadow a variable in gamma, as each case is a new
+// scope (e.g., "switch x := x.(type)")
+func (s TypeSwitch) Typing(ds []Decl, delta Delta, gamma Gamma, allowStupid bool) (Type, FGGExpr) {
+	u_I, e_I := s.e_I.Typing(ds, delta, gamma, allowStupid)
+	isI := IsIfaceLikeType(ds, u_I)
+	if !isI && !allowStupid {
+		panic(base.NewDiagnostic(base.DIAG_NOT_INTERFACE, s,
+			"Type switch expr must be an interface-like type (in a non-stupid context): found "+
+				u_I.String()))
+	}
+	if s.e_def == nil {
+		panic(base.NewDiagnostic(base.DIAG_OTHER, s,
+			"Type switch must have a default case (cf. Go's missing return): "+
+				s.String()))
+	}
+	us := make([]Type, len(s.cases)+1) // The branch types, the default last
+	es := make([]FGGExpr, len(s.cases)+1)
+	for i, c := range s.cases {
+		c.u.Ok(ds, delta)
+		// N.B. distinct cases may coincide once instantiated, e.g., "case T" and
+		// "case int32" with T=int32 -- so only checked in source (cf. monomExpr1)
+		for _, prev := range s.cases[:i] {
+			if !allowStupid && prev.u.Equals(c.u) {
+				panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, s,
+					"Duplicate case in type switch: "+c.u.String()))
+			}
+		}
+		// Cf. T-ASSERT_S
+		if isI && !IsIfaceLikeType(ds, c.u) &&
+			!ImplsDelta(ds, delta, c.u, getInterface(ds, bounds(delta, u_I))) {
+			panic(base.NewDiagnostic(base.DIAG_BAD_ASSERT, s,
+				"Type switch case must implement expr type: case="+
+					c.u.String()+", expr="+u_I.String()))
+		}
+		us[i], es[i] = c.e_body.Typing(ds, delta, s.extend(gamma, c.u), allowStupid)
+	}
+	n := len(s.cases)
+	us[n], es[n] = s.e_def.Typing(ds, delta, s.extend(gamma, u_I), allowStupid)
+
+	u_res := s.typ
+	if u_res == nil { // The join of the branch types, cf. Cond
+		u_res = joinBranches(ds, delta, us)
+		if u_res == nil {
+			strs := make([]string, len(us))
+			for i, v := range us {
+				strs[i] = v.String()
+			}
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, s,
+				"Mismatched branch types: "+strings.Join(strs, ", ")))
+		}
+	}
+	for i := range es {
+		ok, coercion := us[i].AssignableToDelta(ds, delta, u_res)
+		if !ok {
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, s,
+				"Branch must be assignable to declared return type: found="+
+					us[i].String()+", expected="+u_res.String()))
+		}
+		es[i] = coerceBody(es[i], coercion)
+	}
+	cases := make([]TypeCase, n)
+	for i, c := range s.cases {
+		cases[i] = TypeCase{c.u, es[i]}
+	}
+	return u_res, TypeSwitch{s.x, e_I, cases, es[n], s.typ, s.span}
+}
+
+// gamma with x (if any) bound to u
+func (s TypeSwitch) extend(gamma Gamma, u Type) Gamma {
+	if s.x == "" {
+		return gamma
+	}
+	gamma1 := make(Gamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	gamma1[s.x] = u
+	return gamma1
+}
+
+// The first of us to which all of us are assignable, if any, o/w nil
+func joinBranches(ds []Decl, delta Delta, us []Type) Type {
+	for _, u := range us {
+		ok := true
+		for _, u1 := range us {
+			if ok, _ = u1.AssignableToDelta(ds, delta, u); !ok {
+				break
+			}
+		}
+		if ok {
+			return u
+		}
+	}
+	return nil
+}
+
+func (s TypeSwitch) IsValue() bool {
+	return false
+}
+
+func (s TypeSwitch) IsPanic() bool {
+	return false
+}
+
+func (s TypeSwitch) CanEval(ds []Decl) bool {
+	if s.e_I.CanEval(ds) {
+		return true
+	} else if !s.e_I.IsValue() {
+		return false
+	}
+	_, ok := s.selectCase(ds)
+	return ok || s.e_def != nil
+}
+
+func (s TypeSwitch) String() string {
+	var b strings.Builder
+	s.writeHeader(&b, s.e_I.String())
+	for _, c := range s.cases {
+		b.WriteString(c.String())
+		b.WriteString("; ")
+	}
+	if s.e_def != nil {
+		b.WriteString("default: ")
+		writeBody(&b, s.e_def)
+		b.WriteString(" ")
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+func (s TypeSwitch) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	s.writeHeader(&b, s.e_I.ToGoString(ds))
+	for _, c := range s.cases {
+		b.WriteString("case " + c.u.ToGoString(ds) + ": ")
+		writeToGoBody(ds, &b, c.e_body)
+		b.WriteString("; ")
+	}
+	if s.e_def != nil {
+		b.WriteString("default: ")
+		writeToGoBody(ds, &b, s.e_def)
+		b.WriteString(" ")
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// "switch x := e_I.(type) { ", or "switch e_I.(type) { "
+func (s TypeSwitch) writeHeader(b *strings.Builder, e_I string) {
+	b.WriteString("switch ")
+	if s.x != "" {
+		b.WriteString(s.x + " := ")
+	}
+	b.WriteString(e_I)
+	b.WriteString(".(type) { ")
+}
@@ -5,11 +5,13 @@ package fgg_test // Separate package, can test "API"
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/rhu1/fgg/internal/base"
 	"github.com/rhu1/fgg/internal/base/testutils"
 	"github.com/rhu1/fgg/internal/fgg"
+	"github.com/rhu1/fgg/internal/frontend"
 	"github.com/rhu1/fgg/internal/parser"
 )
 
@@ -37,6 +39,18 @@ func fggParseAndOkBad(t *testing.T, msg string, elems ...string) base.Program {
 	return testutils.ParseAndOkBad(t, msg, &adptr, fgg.MakeFggProgram(elems...))
 }
 
+// The -test-oblit simulation check, cf. frontend.TestOblit: each step of the
+// FGG program is matched by its obliteration, up to a value (or panic)
+func fggOblitGood(t *testing.T, elems ...string) {
+	src := fgg.MakeFggProgram(elems...)
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Unexpected panic: " + fmt.Sprintf("%v", r) + "\n" + src)
+		}
+	}()
+	frontend.TestOblit(false, src, frontend.EVAL_TO_VAL)
+}
+
 // Based on testutils.EvalAndOkGood
 // Pre: parseAndOkGood
 func NomonoGood(t *testing.T, p fgg.FGGProgram) fgg.FGGProgram {
@@ -408,7 +422,7 @@ func TestEval001(t *testing.T) {
 	Bm := "func (x0 B(type a Any())) m(type b Any())(x1 b) b { return ToAny(){A(){}}.any.(b) }"
 	e := "B(A()){A(){}}.m(B(A()))(B(A()){A(){}}).f"
 	prog := fggParseAndOkMonomGood(t, Any, ToAny, A, B, Bm, e)
-	testutils.EvalAndOkBad(t, prog, "Cannot cast A() to B(A())", 3)
+	testutils.EvalToValueBad(t, prog, "interface conversion: A<> is not B<A<>>", 10)
 }
 
 /* fmt.Sprintf */
@@ -429,6 +443,427 @@ func TestEval003(t *testing.T) {
 	testutils.EvalAndOkGood(t, prog, 1)
 }
 
+// A bad import is a syntax error, located at the import
+func TestImport001(t *testing.T) {
+	src := "package main;\nimport \"os\";\ntype A(type ) struct {};\nfunc main() { _ = A(){} }"
+	var adptr parser.FGGAdaptor
+	_, errs := adptr.Parse(true, src)
+	if len(errs) != 1 {
+		t.Fatalf("Expected one syntax error, got: %v", errs)
+	}
+	d, ok := errs[0].(base.Diagnostic)
+	if !ok || d.Kind != base.DIAG_SYNTAX || d.Span.String() != "2:0-2:11" {
+		t.Errorf("Expected syntax diagnostic at 2:0-2:11, got: " + errs[0].Error())
+	}
+}
+
+/* Conditionals */
+
+// The branches are checked against the (instantiated) return type
+func TestCond001(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	A := "type A(type ) struct {}"
+	Am := "func (x0 A(type )) choose(type a Any())(b bool, x a, y a) a { if b { return x } else { return y } }"
+	e := "A(){}.choose(int32)(1 > 2, 1, 2)"
+	prog := fggParseAndOkMonomGood(t, Any, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(2)" {
+		t.Errorf("Expected int32(2), got: " + res.GetMain().String())
+	}
+	fggOblitGood(t, Any, A, Am, e)
+}
+
+// Obliteration: the branches have different struct types, so the conditional
+// has the erased declared return type
+func TestCond001b(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	A := "type A(type ) struct {}"
+	B := "type B(type ) struct {}"
+	Box := "type Box(type a Any()) struct { f a }"
+	Boxm := "func (x0 Box(type a Any())) choose(type )(n int32) Any() { if n > 0 && n != 2 { return A(){} } else { return x0 } }"
+	fggOblitGood(t, Any, A, B, Box, Boxm, "Box(B()){B(){}}.choose()(1)")
+	fggOblitGood(t, Any, A, B, Box, Boxm, "Box(B()){B(){}}.choose()(2)")
+}
+
+func TestCond002(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	A := "type A(type ) struct {}"
+	Am := "func (x0 A(type )) choose(type a Any())(b bool, x a) a { if b { return x } else { return A(){} } }"
+	e := "A(){}"
+	fggParseAndOkBad(t, "Branch must be assignable to declared return type", Any, A, Am, e)
+}
+
+/* Local bindings */
+
+func TestLet001(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	A := "type A(type ) struct {}"
+	Am := "func (x0 A(type )) id(type a Any())(x a) a { var y a = x; z := y; return z }"
+	e := "A(){}.id(int32)(3)"
+	prog := fggParseAndOkGood(t, Any, A, Am, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(3)" {
+		t.Errorf("Expected int32(3), got: " + res.GetMain().String())
+	}
+	prog = fggParseAndOkMonomGood(t, Any, A, Am, e)
+	res = testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(3)" {
+		t.Errorf("Expected int32(3), got: " + res.GetMain().String())
+	}
+}
+
+func TestLet002(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	A := "type A(type ) struct {}"
+	Am := "func (x0 A(type )) f(type a Any())(x a) a { var y a = A(){}; return y }"
+	e := "A(){}"
+	fggParseAndOkBad(t, "Definition of y must be assignable to its declared type", Any, A, Am, e)
+}
+
+/* Functions */
+
+func TestFunc001(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	id := "func id(type a Any())(x a) a { return x }"
+	twice := "func twice(type a Any())(x a) a { return id(a)(id(a)(x)) }"
+	e := "twice(int32)(3)"
+	prog := fggParseAndOkGood(t, Any, id, twice, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(3)" {
+		t.Errorf("Expected int32(3), got: " + res.GetMain().String())
+	}
+	prog = fggParseAndOkMonomGood(t, Any, id, twice, e)
+	res = testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "int32(3)" {
+		t.Errorf("Expected int32(3), got: " + res.GetMain().String())
+	}
+}
+
+func TestFunc002(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	id := "func id(type a Any())(x a) a { return x }"
+	e := "id()(3)"
+	fggParseAndOkBad(t, "Arity mismatch: type actuals", Any, id, e)
+}
+
+/* Function values */
+
+func TestFuncLit001(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	compose := "func compose(type a Any(), b Any(), c Any())(f func(a) b, g func(b) c) func(a) c { " +
+		"return func(x a) c { return g(f(x)) } }"
+	e := "compose(int32, int32, int32)(func(x int32) int32 { return x + 1 }, " +
+		"func(y int32) int32 { return y * 2 })(3)"
+	prog := fggParseAndOkGood(t, Any, compose, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "int32(8)" {
+		t.Errorf("Expected int32(8), got: " + res.GetMain().String())
+	}
+	prog = fggParseAndOkMonomGood(t, Any, compose, e)
+	res = testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "int32(8)" {
+		t.Errorf("Expected int32(8), got: " + res.GetMain().String())
+	}
+}
+
+// A method on a generic function type -- the receiver is called as a function
+func TestFuncLit002(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	A := "type A(type ) struct {}"
+	Fn := "type Fn(type a Any()) func(a) a"
+	Fnapp := "func (f Fn(type a Any())) app(type )(x a) a { return f(x) }"
+	e := "Fn(A())(func(x A()) A() { return x }).app()(A(){})"
+	prog := fggParseAndOkGood(t, Any, A, Fn, Fnapp, e)
+	res := testutils.EvalToValueGood(t, prog, 10)
+	if res.GetMain().String() != "A(){}" {
+		t.Errorf("Expected A(){}, got: " + res.GetMain().String())
+	}
+	prog = fggParseAndOkMonomGood(t, Any, A, Fn, Fnapp, e)
+	testutils.EvalToValueGood(t, prog, 10)
+}
+
+func TestFuncLit003(t *testing.T) {
+	A := "type A(type ) struct {}"
+	e := "A(){}(A(){})"
+	fggParseAndOkBad(t, "Cannot call non-function", A, e)
+}
+
+func TestFuncLit003b(t *testing.T) {
+	A := "type A(type ) struct {}"
+	B := "type B(type ) struct {}"
+	e := "func(x A()) A() { return B(){} }"
+	fggParseAndOkBad(t, "Function body must be assignable to declared return type", A, B, e)
+}
+
+// A captured variable cannot be redeclared in the body, cf. Let.Typing
+func TestFuncLit003c(t *testing.T) {
+	adder := "func adder(type )(n int32) func(int32) int32 { return func(x int32) int32 { n := x; return n } }"
+	e := "adder()(3)(4)"
+	fggParseAndOkBad(t, "Variable already declared: n", adder, e)
+}
+
+/* Slices */
+
+// A method on a generic slice type, using len, indexing and append
+func TestSlice001(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	A := "type A(type ) struct {}"
+	List := "type List(type a Any()) []a"
+	Llast := "func (xs List(type a Any())) last(type )() a { return xs[len(xs) - 1] }"
+	e := "append(List(A()){}, A(){}).last()()"
+	prog := fggParseAndOkGood(t, Any, A, List, Llast, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "A(){}" {
+		t.Errorf("Expected A(){}, got: " + res.GetMain().String())
+	}
+	prog = fggParseAndOkMonomGood(t, Any, A, List, Llast, e)
+	res = testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "A<>{}" {
+		t.Errorf("Expected A<>{}, got: " + res.GetMain().String())
+	}
+}
+
+func TestSlice002(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	A := "type A(type ) struct {}"
+	B := "type B(type ) struct {}"
+	List := "type List(type a Any()) []a"
+	e := "append(List(A()){}, B(){})"
+	fggParseAndOkBad(t, "Slice element must be assignable to element type", Any, A, B, List, e)
+}
+
+/* Maps */
+
+// A generic map type, with a key type param bounded by a type list of
+// comparable types
+func TestMap001(t *testing.T) {
+	Key := "type Key(type ) interface { type int32, string }"
+	Set := "type Set(type K Key()) map[K]bool"
+	Sadd := "func (s Set(type K Key())) add(type )(k K) Set(K) { s[k] = true; return s }"
+	Shas := "func (s Set(type K Key())) has(type )(k K) bool { v, ok := s[k]; return ok }"
+	e := "Set(int32){}.add()(2).add()(1).has()(1)"
+	prog := fggParseAndOkGood(t, Key, Set, Sadd, Shas, e)
+	res := testutils.EvalToValueGood(t, prog, 30)
+	if res.GetMain().String() != "bool(true)" {
+		t.Errorf("Expected bool(true), got: " + res.GetMain().String())
+	}
+	prog = fggParseAndOkMonomGood(t, Key, Set, Sadd, Shas, e)
+	res = testutils.EvalToValueGood(t, prog, 30)
+	if res.GetMain().String() != "bool(true)" {
+		t.Errorf("Expected bool(true), got: " + res.GetMain().String())
+	}
+}
+
+func TestMap002(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	Set := "type Set(type K Any()) map[K]bool"
+	e := "Set(int32){}"
+	fggParseAndOkBad(t, "Invalid map key type", Any, Set, e)
+}
+
+/* Type switches */
+
+// Cases on instantiated generic types, and on a type param -- which coincides
+// with the int32 case once instantiated, cf. monomExpr1
+func TestTypeSwitch001(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	Box := "type Box(type a Any()) struct { v a }"
+	get := "func get(type a Any())(x Any(), d a) a { switch y := x.(type) { " +
+		"case Box(a): return y.v; case a: return y; case int32: return d; default: return d } }"
+	e := "get(int32)(Box(int32){5}, 0) + get(int32)(7, 0) + get(int32)(Box(Any()){5}, 100)"
+	prog := fggParseAndOkGood(t, Any, Box, get, e)
+	res := testutils.EvalToValueGood(t, prog, 50)
+	if res.GetMain().String() != "int32(112)" {
+		t.Errorf("Expected int32(112), got: " + res.GetMain().String())
+	}
+	prog = fggParseAndOkMonomGood(t, Any, Box, get, e)
+	res = testutils.EvalToValueGood(t, prog, 50)
+	if res.GetMain().String() != "int32(112)" {
+		t.Errorf("Expected int32(112), got: " + res.GetMain().String())
+	}
+}
+
+func TestTypeSwitch002(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	I := "type I(type ) interface { m(type )() int32 }"
+	A := "type A(type ) struct {}"
+	f := "func f(type )(x I()) int32 { switch x.(type) { case A(): return 1; default: return 0 } }"
+	e := "f()(A(){})"
+	fggParseAndOkBad(t, "Type switch case must implement expr type", Any, I, A, f, e)
+}
+
+/* Comma-ok assertions */
+
+// The zero value of a type param is that of its instantiation -- so its
+// bound must have a type list
+func TestAssertOk001(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	Num := "type Num(type ) interface { type int32, string }"
+	Box := "type Box(type a Num()) struct { v a }"
+	get := "func get(type a Num())(x Any()) Box(a) { v, ok := x.(Box(a)); return v }"
+	e := "get(string)(Box(int32){5})"
+	prog := fggParseAndOkGood(t, Any, Num, Box, get, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "Box(string){string(\"\")}" {
+		t.Errorf("Expected Box(string){string(\"\")}, got: " + res.GetMain().String())
+	}
+	prog = fggParseAndOkMonomGood(t, Any, Num, Box, get, e)
+	res = testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "Box<string>{string(\"\")}" {
+		t.Errorf("Expected Box<string>{string(\"\")}, got: " + res.GetMain().String())
+	}
+	fggOblitGood(t, Any, Num, Box, get, e)
+	fggOblitGood(t, Any, Num, Box, get, "get(int32)(Box(int32){5})")
+}
+
+func TestAssertOk002(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	get := "func get(type a Any())(x Any()) a { v, ok := x.(a); return v }"
+	e := "get(int32)(1)"
+	fggParseAndOkBad(t, "Comma-ok assertion requires a type with a zero value", Any, get, e)
+}
+
+// The cause is named: the bound of a has no type list
+func TestAssertOk002b(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	get := "func get(type a Any())(x Any()) a { v, ok := x.(a); return v }"
+	e := "get(int32)(1)"
+	fggParseAndOkBad(t, "type param a: the type set of Any() is all types", Any, get, e)
+}
+
+// ... or a type in the type list of the bound is an interface
+func TestAssertOk002c(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	I := "type I(type ) interface { m(type )() int32 }"
+	Num := "type Num(type ) interface { type int32, I() }"
+	get := "func get(type a Num())(x Any()) a { v, ok := x.(a); return v }"
+	e := "get(int32)(1)"
+	fggParseAndOkBad(t, "with I() in the type set of Num(): I() is an interface type",
+		Any, I, Num, get, e)
+}
+
+/* Panics */
+
+func TestPanic001(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	Box := "type Box(type a Any()) struct { v a }"
+	get := "func get(type a Any())(x Any()) Box(a) { panic(x) }"
+	e := "get(int32)(Box(int32){5}).v"
+	prog := fggParseAndOkGood(t, Any, Box, get, e)
+	testutils.EvalToValueBad(t, prog, "panic(Box(int32){int32(5)})", 10)
+	prog = fggParseAndOkMonomGood(t, Any, Box, get, e)
+	testutils.EvalToValueBad(t, prog, "panic(Box<int32>{int32(5)})", 10)
+}
+
+// A failed assertion reduces to a panic (at the type of the assertion)
+func TestPanic002(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	Box := "type Box(type a Any()) struct { v a }"
+	E := "type E(type ) struct {}"
+	cast := "func cast(type a Any())(x Any()) a { return x.(a) }"
+	e := "cast(Box(int32))(E(){}).v"
+	prog := fggParseAndOkGood(t, Any, Box, E, cast, e)
+	testutils.EvalToValueBad(t, prog, "interface conversion: E() is not Box(int32)", 10)
+	prog = fggParseAndOkMonomGood(t, Any, Box, E, cast, e)
+	testutils.EvalToValueBad(t, prog, "interface conversion: E<> is not Box<int32>", 10)
+}
+
+// Comparing interface values of the same uncomparable dynamic type is a
+// run-time error
+func TestPanic003(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	eq := "func eq(type )(x Any(), y Any()) bool { return x == y }"
+	e := "eq()(func() int32 { return 1 }, func() int32 { return 1 })"
+	prog := fggParseAndOkGood(t, Any, eq, e)
+	testutils.EvalToValueBad(t, prog, "comparing uncomparable type func() int32", 10)
+	prog = fggParseAndOkMonomGood(t, Any, eq, e)
+	testutils.EvalToValueBad(t, prog, "comparing uncomparable type func() int32", 10)
+}
+
+// panic is a statement, not an expression
+func TestPanic004(t *testing.T) {
+	A := "type A(type ) struct {}"
+	Am := "func (x0 A(type )) m(type )() A() { return panic(A(){}) }"
+	e := "A(){}.m()()"
+	var adptr parser.FGGAdaptor
+	_, errs := adptr.Parse(true, fgg.MakeFggProgram(A, Am, e))
+	if len(errs) == 0 {
+		t.Fatalf("Expected syntax error")
+	}
+	if d, ok := errs[0].(base.Diagnostic); !ok || d.Kind != base.DIAG_SYNTAX {
+		t.Errorf("Expected syntax diagnostic, got: " + errs[0].Error())
+	}
+}
+
+/* Embedded fields */
+
+// A promoted field and method, of a generic embedded type
+func TestEmbed001(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	Box := "type Box(type a Any()) struct { v a }"
+	Boxget := "func (x Box(type a Any())) get(type )() a { return x.v }"
+	Wrap := "type Wrap(type a Any()) struct { Box(a); n int32 }"
+	e := "Wrap(int32){Box(int32){3}, 2}.v + Wrap(int32){Box(int32){3}, 2}.get()()"
+	prog := fggParseAndOkGood(t, Any, Box, Boxget, Wrap, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "int32(6)" {
+		t.Errorf("Expected int32(6), got: " + res.GetMain().String())
+	}
+	prog = fggParseAndOkMonomGood(t, Any, Box, Boxget, Wrap, e)
+	res = testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "int32(6)" {
+		t.Errorf("Expected int32(6), got: " + res.GetMain().String())
+	}
+}
+
+// A generic interface satisfied via promoted methods, dynamically dispatched
+func TestEmbed002(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	Box := "type Box(type a Any()) struct { v a }"
+	Boxget := "func (x Box(type a Any())) get(type )() a { return x.v }"
+	Boxpair := "func (x Box(type a Any())) pair(type b Any())(y b) b { return y }"
+	Wrap := "type Wrap(type a Any()) struct { Box(a) }"
+	Getter := "type Getter(type a Any()) interface { get(type )() a }"
+	Pairer := "type Pairer(type ) interface { pair(type b Any())(y b) b }"
+	f := "func f(type a Any())(x Getter(a)) a { return x.get()() }"
+	g := "func g(type )(x Pairer()) int32 { return x.pair(int32)(4) }"
+	e := "f(int32)(Wrap(int32){Box(int32){3}}) + g()(Wrap(bool){Box(bool){true}})"
+	prog := fggParseAndOkGood(t, Any, Box, Boxget, Boxpair, Wrap, Getter, Pairer, f, g, e)
+	res := testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "int32(7)" {
+		t.Errorf("Expected int32(7), got: " + res.GetMain().String())
+	}
+	prog = fggParseAndOkMonomGood(t, Any, Box, Boxget, Boxpair, Wrap, Getter, Pairer, f, g, e)
+	res = testutils.EvalToValueGood(t, prog, 20)
+	if res.GetMain().String() != "int32(7)" {
+		t.Errorf("Expected int32(7), got: " + res.GetMain().String())
+	}
+}
+
+func TestEmbed003(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	A := "type A(type a Any()) struct { f a }"
+	B := "type B(type ) struct { f int32 }"
+	C := "type C(type ) struct { A(bool); B() }"
+	e := "C(){A(bool){true}, B(){1}}.f"
+	fggParseAndOkBad(t, "Ambiguous selector f", Any, A, B, C, e)
+}
+
+func TestEmbed004(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	A := "type A(type a Any()) struct { a }"
+	e := "A(int32){1}"
+	fggParseAndOkBad(t, "Embedded field must be a type name", Any, A, e)
+}
+
+func TestEmbed005(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	A := "type A(type a Any()) struct { B(a) }"
+	B := "type B(type a Any()) struct { A(a) }"
+	e := "A(int32){}"
+	fggParseAndOkBad(t, "Invalid cyclic declaration", Any, A, B, e)
+}
+
 /* Nomono */
 
 func TestNomono001(t *testing.T) {
@@ -530,3 +965,97 @@ func TestNomono009(t *testing.T) {
 	prog := fggParseAndOkGood(t, Any, IA, A, ma1, ma2, B, mb1, mb2, mb3, C, mc1, D, foo, e).(fgg.FGGProgram)
 	NomonoGood(t, prog)
 }
+
+func TestNomono010(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	A := "type A(type a Any()) struct {}"
+	f := "func f(type a Any())(x a) Any() { return f(A(a))(A(a){}) }"
+	e := "f(int32)(1)"
+	prog := fggParseAndOkGood(t, Any, A, f, e).(fgg.FGGProgram)
+	NomonoBad(t, prog, "f polymorphic recursion, a -> A(a)")
+}
+
+func TestNomono011(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	A := "type A(type a Any()) struct {}"
+	f := "func f(type a Any())(x a) Any() { return g(a)(x) }"
+	g := "func g(type b Any())(y b) Any() { return A(b){} }"
+	e := "f(int32)(1)"
+	prog := fggParseAndOkGood(t, Any, A, f, g, e).(fgg.FGGProgram)
+	NomonoGood(t, prog)
+}
+
+/* Formatting */
+
+func TestFormat001(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	Num := "type Num(type ) interface { type int32, int64 }"
+	Box := "type Box(type a Any()) struct { f a }"
+	get := "func (x0 Box(type a Any())) get(type b Num())(x1 b) a { return x0.f }"
+	e := "Box(int32){1}.get(int64)(2 + 3)"
+	var adptr parser.FGGAdaptor
+	out := testutils.FormatAndReparseGood(t, &adptr,
+		fgg.MakeFggProgram(Any, Num, Box, get, e))
+	exp := "type Num(type ) interface {\n\ttype int32, int64\n};"
+	if !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat002(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	Fn := "type Fn(type a Any()) func(a) a"
+	Fnapp := "func (f Fn(type a Any())) app(type )(x a) a { return f(x) }"
+	e := "Fn(int32)(func(x int32) int32 { y := x; return y }).app()(1)"
+	var adptr parser.FGGAdaptor
+	out := testutils.FormatAndReparseGood(t, &adptr,
+		fgg.MakeFggProgram(Any, Fn, Fnapp, e))
+	exp := "_ = Fn(int32)(func(x int32) int32 { y := x; return y }).app()(1)"
+	if !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat003(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	List := "type List(type a Any()) []a"
+	Lfirst := "func (xs List(type a Any())) first(type )() a { return (xs)[0] }"
+	e := "append(List(int32){1}, len([]bool{}))"
+	var adptr parser.FGGAdaptor
+	out := testutils.FormatAndReparseGood(t, &adptr,
+		fgg.MakeFggProgram(Any, List, Lfirst, e))
+	exp := "type List(type a Any()) []a;\nfunc (xs List(type a Any())) first(type )() a {\n\treturn xs[0]\n};"
+	if !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat004(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	Ages := "type Ages(type a Any()) map[string]a"
+	Aget := "func (m Ages(type a Any())) get(type )(k string) a { v, ok := m[k]; return v }"
+	e := "Ages(int32){\"a\" : 1}.get()(\"a\")"
+	var adptr parser.FGGAdaptor
+	out := testutils.FormatAndReparseGood(t, &adptr,
+		fgg.MakeFggProgram(Any, Ages, Aget, e))
+	exp := "func (m Ages(type a Any())) get(type )(k string) a {\n\tv, ok := m[k];\n\treturn v\n};"
+	if !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+	if exp := "Ages(int32){\"a\": 1}.get()(\"a\")"; !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
+
+func TestFormat005(t *testing.T) {
+	Any := "type Any(type ) interface {}"
+	Box := "type Box(type a Any()) struct { v a }"
+	get := "func get(type a Any())(x Any(), d a) a { switch y := x.(type) { case Box(a): return y.v; default: return d } }"
+	e := "get(int32)(Box(int32){5}, 0)"
+	var adptr parser.FGGAdaptor
+	out := testutils.FormatAndReparseGood(t, &adptr, fgg.MakeFggProgram(Any, Box, get, e))
+	exp := "\tswitch y := x.(type) {\n\tcase Box(a):\n\t\treturn y.v\n\tdefault:\n\t\treturn d\n\t}\n};"
+	if !strings.Contains(out, exp) {
+		t.Errorf("Expected " + exp + ", got:\n" + out)
+	}
+}
@@ -9,11 +9,16 @@ import (
 /* Exports */
 
 func NewTParam(name Name) TParam                      { return TParam(name) }
-func NewTNamed(t Name, us []Type) TNamed              { return TNamed{t, us} }
-func NewSTypeLit(fds []FieldDecl) STypeLit            { return STypeLit{fds} }
-func NewITypeLit(specs []Spec, tlist []Type) ITypeLit { return ITypeLit{specs, tlist} }
-func NewTPrimitive(t Tag) TPrimitive                  { return TPrimitive{t} }
+func NewTNamed(t Name, us []Type) TNamed              { return TNamed{t, us, base.Span{}} }
+func NewSTypeLit(fds []FieldDecl) STypeLit            { return STypeLit{fds, base.Span{}} }
+func NewITypeLit(specs []Spec, tlist []Type) ITypeLit { return ITypeLit{specs, tlist, base.Span{}} }
+func NewTPrimitive(t Tag) TPrimitive                  { return TPrimitive{t, base.Span{}} }
 func NewUndefTPrimitive(t Tag) UndefTPrimitive        { return UndefTPrimitive{t} }
+func NewTFunc(us []Type, u Type) TFunc                { return TFunc{us, u, base.Span{}} }
+func NewTSlice(u Type) TSlice                         { return TSlice{u, base.Span{}} }
+func NewTMap(k Type, u Type) TMap                     { return TMap{k, u, base.Span{}} }
+func NewTerm(tilde bool, u Type) Term                 { return Term{tilde, u} }
+func NewUnion(ts []Term) Union                        { return Union(ts) }
 
 // Factors t0 <: t_I for every Type u0, since the test is always the same.
 // u_I has type ITypeLit to enforce that the Impls relation is only tested
@@ -21,9 +26,8 @@ func NewUndefTPrimitive(t Tag) UndefTPrimitive        { return UndefTPrimitive{t
 func ImplsDelta(ds []Decl, delta Delta, u0 Type, u_I ITypeLit) bool {
 	ms0 := methodsDelta(ds, delta, u0)
 	msI := methodsDelta(ds, delta, u_I)
-	tlist0 := tlist(ds, u0)
-	tlistI := tlist(ds, u_I)
-	return ms0.IsSupersetOf(msI) && tlist0.RepresentedBy(ds, tlistI)
+	return ms0.IsSupersetOf(msI) &&
+		typeSetDelta(ds, delta, u0).SubsetOf(ds, delta, u_I.TypeSet(ds))
 }
 
 func EqualsOrImpls(ds []Decl, delta Delta, u0 Type, u Type) bool {
@@ -74,7 +78,7 @@ func (a TParam) ImplsDelta(ds []Decl, delta Delta, u Type) bool {
 				return false
 			}
 		}
-		return true
+		return typeSetDelta(ds, delta, a).SubsetOf(ds, delta, typeSetDelta(ds, delta, u))
 	} else {
 		return false
 	}
@@ -102,7 +106,8 @@ func (a TParam) AssignableToDelta(ds []Decl, delta Delta, u Type) (bool, Coercio
 
 func (a TParam) Ok(ds []Decl, delta Delta) {
 	if _, ok := delta[a]; !ok {
-		panic("Type param " + a.String() + " unknown in context: " + delta.String())
+		panic(base.NewDiagnostic(base.DIAG_UNKNOWN_TYPE, a,
+			"Type param "+a.String()+" unknown in context: "+delta.String()))
 	}
 }
 
@@ -132,9 +137,12 @@ func (a TParam) Underlying(ds []Decl) Type {
 // Convention: t=type name (t), u=FGG type (tau)
 type TNamed struct {
 	t_name Name
-	u_args []Type // SmallPsi
+	u_args []Type    // SmallPsi
+	span   base.Span // Source position, not part of node identity
 }
 
+func (u0 TNamed) GetSpan() base.Span { return u0.span }
+
 var _ Type = TNamed{}
 var _ Spec = TNamed{}
 
@@ -147,7 +155,7 @@ func (u0 TNamed) SubsEtaOpen(eta EtaOpen) Type {
 	for i := 0; i < len(us); i++ {
 		us[i] = u0.u_args[i].SubsEtaOpen(eta)
 	}
-	return TNamed{u0.t_name, us}
+	return TNamed{u0.t_name, us, u0.span}
 }
 
 func (u0 TNamed) SubsEtaClosed(eta EtaClosed) GroundType {
@@ -156,7 +164,7 @@ func (u0 TNamed) SubsEtaClosed(eta EtaClosed) GroundType {
 	for i := 0; i < len(us); i++ {
 		us[i] = u0.u_args[i].SubsEtaClosed(eta)
 	}
-	return TNamed{u0.t_name, us}
+	return TNamed{u0.t_name, us, u0.span}
 }
 
 // u0 <: u
@@ -169,7 +177,7 @@ func (u0 TNamed) ImplsDelta(ds []Decl, delta Delta, u Type) bool {
 			u0.String() + ", expected=" + u.String())
 	case TPrimitive:
 		return false
-	case STypeLit: // or any other composite type literal, if there were more
+	case STypeLit, TFunc, TSlice, TMap: // or any other composite type literal, if there were more
 		return u0.Underlying(ds).Equals(u)
 
 	case TNamed:
@@ -181,11 +189,8 @@ func (u0 TNamed) ImplsDelta(ds []Decl, delta Delta, u Type) bool {
 			return false
 		}
 	case ITypeLit:
-		if u.HasTList() {
-			tlist := u.FlatTList(ds)
-			if !(tlist.Contains(u0) || tlist.Contains(u0.Underlying(ds))) { // todo initial version; not accounting for type sets proposal -- https://github.com/golang/go/issues/45346
-				return false
-			}
+		if !typeSetDelta(ds, delta, u0).SubsetOf(ds, delta, u.TypeSet(ds)) { // u0 may itself be a (named) interface
+			return false
 		}
 		gs := methodsDelta(ds, delta, u)   // u is a t_I
 		gs0 := methodsDelta(ds, delta, u0) // t0 may be any
@@ -205,10 +210,11 @@ func (u0 TNamed) AssignableToDelta(ds []Decl, delta Delta, u Type) (bool, Coerci
 		return true, noOpCoercion
 	}
 	// if t is not a defined type
-	if _, ok := u.(STypeLit); ok {
+	switch u.(type) {
+	case STypeLit, TFunc, TSlice, TMap:
 		if u0.Underlying(ds).Equals(u) {
 			coercion := func(expr FGGExpr) FGGExpr {
-				return Convert{u, expr}
+				return Convert{u, expr, base.Span{}}
 			}
 			return true, coercion
 		}
@@ -217,7 +223,10 @@ func (u0 TNamed) AssignableToDelta(ds []Decl, delta Delta, u Type) (bool, Coerci
 }
 
 func (u0 TNamed) Ok(ds []Decl, delta Delta) {
-	td := getTDecl(ds, u0.t_name) // Panics if type not found
+	if !isTypeName(ds, u0.t_name) {
+		panic(base.NewDiagnostic(base.DIAG_UNKNOWN_TYPE, u0, "Type not found: "+u0.t_name))
+	}
+	td := getTDecl(ds, u0.t_name)
 	Psi := td.GetBigPsi()
 	if len(Psi.tFormals) != len(u0.u_args) {
 		var b strings.Builder
@@ -225,9 +234,7 @@ func (u0 TNamed) Ok(ds []Decl, delta Delta) {
 		b.WriteString(Psi.String())
 		b.WriteString(" actuals=")
 		writeTypes(&b, u0.u_args)
-		b.WriteString("\n\t")
-		b.WriteString(u0.String())
-		panic(b.String())
+		panic(base.NewDiagnostic(base.DIAG_ARITY, u0, b.String()))
 	}
 	for _, v := range u0.u_args {
 		v.Ok(ds, delta)
@@ -240,19 +247,19 @@ func (u0 TNamed) Ok(ds []Decl, delta Delta) {
 		formal := tf.u_I.SubsEtaOpen(eta)
 
 		if !ImplsDelta(ds, delta, actual, getInterface(ds, formal)) { // formal is a \tau_I, checked by TDecl.Ok
-			panic("Type actual must implement type formal: actual=" +
-				actual.String() + " formal=" + formal.String())
+			panic(base.NewDiagnostic(base.DIAG_BAD_BOUND, u0,
+				"Type actual must implement type formal: actual="+
+					actual.String()+" formal="+formal.String()))
 		}
 	}
 }
 
 // \tau_I is a Spec, but not \tau_S -- this aspect is currently "dynamically typed"
-// From Spec
+// From Spec -- a non-interface u is a single-term union, cf. isEmbedding
 func (u TNamed) GetSigs(ds []Decl) []Sig {
 	u_I, ok := u.Underlying(ds).(ITypeLit)
 	if !ok {
-		panic("Cannot use non-interface type as a Spec: " + u.String() +
-			" is a " + reflect.TypeOf(u).String())
+		return nil
 	}
 	var res []Sig
 	for _, s := range u_I.specs {
@@ -320,12 +327,14 @@ type PrimType interface {
 /* Defined primitive types - int32, float32, string, etc. */
 
 type TPrimitive struct {
-	tag Tag
+	tag  Tag
+	span base.Span // Source position, not part of node identity
 }
 
 var _ Type = TPrimitive{}
 
-func (t0 TPrimitive) Tag() Tag { return t0.tag }
+func (t0 TPrimitive) Tag() Tag           { return t0.tag }
+func (t0 TPrimitive) GetSpan() base.Span { return t0.span }
 
 func (t0 TPrimitive) SubsEtaOpen(eta EtaOpen) Type {
 	return t0
@@ -335,8 +344,9 @@ func (t0 TPrimitive) SubsEtaClosed(eta EtaClosed) GroundType {
 	return t0
 }
 
+// N.B. a primitive type implements only itself and interfaces, cf. EqualsOrImpls
 func (t0 TPrimitive) ImplsDelta(ds []Decl, delta Delta, u Type) bool {
-	panic("Maybe delete this?")
+	return EqualsOrImpls(ds, delta, t0, u)
 }
 
 func (t0 TPrimitive) AssignableToDelta(ds []Decl, delta Delta, u Type) (bool, Coercion) {
@@ -353,7 +363,7 @@ func (t0 TPrimitive) Equals(t base.Type) bool {
 	if _, ok := u.(TPrimitive); !ok {
 		return false
 	}
-	return t0 == u.(TPrimitive)
+	return t0.tag == u.(TPrimitive).tag
 }
 
 func (t0 TPrimitive) String() string {
@@ -388,8 +398,13 @@ func (u0 UndefTPrimitive) SubsEtaClosed(eta EtaClosed) GroundType {
 	return u0
 }
 
+// An untyped constant "implements" any type that can represent it, e.g., an
+// untyped 1 and int64, or Any() -- cf. AssignableToDelta
 func (u0 UndefTPrimitive) ImplsDelta(ds []Decl, delta Delta, u Type) bool {
-	panic("implement me")
+	if isIfaceType(ds, u) {
+		return EqualsOrImpls(ds, delta, u0, u)
+	}
+	return u0.Equals(u) || u0.RepresentableBy(ds, delta, u)
 }
 
 func (u0 UndefTPrimitive) AssignableToDelta(ds []Decl, delta Delta, u Type) (bool, Coercion) {
@@ -398,7 +413,7 @@ func (u0 UndefTPrimitive) AssignableToDelta(ds []Decl, delta Delta, u Type) (boo
 	}
 	if u0.RepresentableBy(ds, delta, u) {
 		coercion := func(expr FGGExpr) FGGExpr {
-			return Convert{u, expr}
+			return Convert{u, expr, base.Span{}}
 		}
 		return true, coercion
 	}
@@ -413,11 +428,9 @@ func (u0 UndefTPrimitive) RepresentableBy(ds []Decl, delta Delta, u Type) bool {
 		constraint := bounds(delta, u)
 		return u0.RepresentableBy(ds, delta, constraint) // falls into case below
 	case ITypeLit:
-		if under.HasTList() {
-			for _, u2 := range under.FlatTList(ds) {
-				if !u0.RepresentableBy(ds, delta, u2) {
-					return false
-				}
+		for _, t := range under.TypeSet(ds).terms { // N.B. none if all types
+			if !u0.RepresentableBy(ds, delta, t.u) {
+				return false
 			}
 		}
 		return true
@@ -464,8 +477,11 @@ func (u0 UndefTPrimitive) Underlying(ds []Decl) Type {
 
 type STypeLit struct {
 	fDecls []FieldDecl
+	span   base.Span // Source position, not part of node identity
 }
 
+func (s STypeLit) GetSpan() base.Span { return s.span }
+
 var _ Type = STypeLit{}
 
 func (s STypeLit) GetFieldDecls() []FieldDecl { return s.fDecls }
@@ -475,7 +491,7 @@ func (s STypeLit) SubsEtaOpen(eta EtaOpen) Type {
 	for i, fd := range s.fDecls {
 		fds[i] = fd.SubsEtaOpen(eta)
 	}
-	return STypeLit{fds}
+	return STypeLit{fds, s.span}
 }
 
 func (s STypeLit) SubsEtaClosed(eta EtaClosed) GroundType {
@@ -483,7 +499,7 @@ func (s STypeLit) SubsEtaClosed(eta EtaClosed) GroundType {
 	for i, fd := range s.fDecls {
 		fds[i] = fd.SubsEtaClosed(eta)
 	}
-	return STypeLit{fds}
+	return STypeLit{fds, s.span}
 }
 
 func (s STypeLit) ImplsDelta(ds []Decl, delta Delta, u Type) bool {
@@ -505,7 +521,7 @@ func (s STypeLit) AssignableToDelta(ds []Decl, delta Delta, u Type) (bool, Coerc
 	}
 	if s.Equals(u.Underlying(ds)) {
 		coercion := func(expr FGGExpr) FGGExpr {
-			return Convert{u, expr}
+			return Convert{u, expr, base.Span{}}
 		}
 		return true, coercion
 	}
@@ -520,10 +536,15 @@ func (s STypeLit) Ok(ds []Decl, delta Delta) {
 	seen := make(map[Name]FieldDecl)
 	for _, v := range s.fDecls {
 		if _, ok := seen[v.field]; ok {
-			panic("Duplicate field name: " + v.field + "\n\t" + s.String())
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, s,
+				"Duplicate field name: "+v.field))
 		}
 		seen[v.field] = v
-		v.u.Ok(ds, delta)
+		if _, ok := v.u.(TNamed); v.embedded && !ok {
+			panic(base.NewDiagnostic(base.DIAG_OTHER, s,
+				"Embedded field must be a type name, not: "+v.u.String()))
+		}
+		okValueType(ds, delta, v.u)
 	}
 }
 
@@ -564,39 +585,302 @@ func (s STypeLit) Underlying(ds []Decl) Type {
 }
 
 type FieldDecl struct {
-	field Name
-	u     Type // u=tau
+	field    Name
+	u        Type      // u=tau
+	embedded bool      // An embedded field, named by its type name -- cf. selections
+	span     base.Span // Source position, not part of node identity
 }
 
+func (fd FieldDecl) GetSpan() base.Span { return fd.span }
+
 var _ FGGNode = FieldDecl{}
 
-func (fd FieldDecl) GetName() Name { return fd.field }
-func (fd FieldDecl) GetType() Type { return fd.u }
+func (fd FieldDecl) GetName() Name    { return fd.field }
+func (fd FieldDecl) GetType() Type    { return fd.u }
+func (fd FieldDecl) IsEmbedded() bool { return fd.embedded }
 
 func (fd FieldDecl) SubsEtaOpen(eta EtaOpen) FieldDecl {
-	return FieldDecl{fd.field, fd.u.SubsEtaOpen(eta)}
+	return FieldDecl{fd.field, fd.u.SubsEtaOpen(eta), fd.embedded, fd.span}
 }
 
 func (fd FieldDecl) SubsEtaClosed(eta EtaClosed) FieldDecl {
-	return FieldDecl{fd.field, fd.u.SubsEtaClosed(eta)}
+	return FieldDecl{fd.field, fd.u.SubsEtaClosed(eta), fd.embedded, fd.span}
 }
 
 func (fd FieldDecl) Equals(other FieldDecl) bool {
-	return fd.field == other.field && fd.u.Equals(other.u)
+	return fd.field == other.field && fd.u.Equals(other.u) &&
+		fd.embedded == other.embedded
 }
 
+// N.B. an embedded field is written as its type, "u" -- its name is the type name
 func (fd FieldDecl) String() string {
+	if fd.embedded {
+		return fd.u.String()
+	}
 	return fd.field + " " + fd.u.String()
 }
 
+/******************************************************************************/
+/* Function types */
+
+// func(u1, ..., un) u_ret -- the type of a function value (cf. FuncLit)
+type TFunc struct {
+	params []Type
+	u_ret  Type
+	span   base.Span // Source position, not part of node identity
+}
+
+func (f TFunc) GetSpan() base.Span { return f.span }
+
+var _ Type = TFunc{}
+
+func (f TFunc) GetParams() []Type { return f.params }
+func (f TFunc) GetReturn() Type   { return f.u_ret }
+
+func (f TFunc) SubsEtaOpen(eta EtaOpen) Type {
+	us := make([]Type, len(f.params))
+	for i, v := range f.params {
+		us[i] = v.SubsEtaOpen(eta)
+	}
+	return TFunc{us, f.u_ret.SubsEtaOpen(eta), f.span}
+}
+
+func (f TFunc) SubsEtaClosed(eta EtaClosed) GroundType {
+	us := make([]Type, len(f.params))
+	for i, v := range f.params {
+		us[i] = v.SubsEtaClosed(eta)
+	}
+	return TFunc{us, f.u_ret.SubsEtaClosed(eta), f.span}
+}
+
+// Cf. STypeLit.ImplsDelta
+func (f TFunc) ImplsDelta(ds []Decl, delta Delta, u Type) bool {
+	switch under := u.Underlying(ds).(type) {
+	case TFunc:
+		return f.Equals(under)
+	case ITypeLit:
+		return len(methods(ds, under)) == 0
+	default:
+		return false
+	}
+}
+
+// Cf. STypeLit.AssignableToDelta
+func (f TFunc) AssignableToDelta(ds []Decl, delta Delta, u Type) (bool, Coercion) {
+	if EqualsOrImpls(ds, delta, f, u) {
+		return true, noOpCoercion
+	}
+	if f.Equals(u.Underlying(ds)) {
+		coercion := func(expr FGGExpr) FGGExpr {
+			return Convert{u, expr, base.Span{}}
+		}
+		return true, coercion
+	}
+	return false, nil
+}
+
+func (f TFunc) Ok(ds []Decl, delta Delta) {
+	for _, v := range f.params {
+		okValueType(ds, delta, v)
+	}
+	okValueType(ds, delta, f.u_ret)
+}
+
+func (f TFunc) Equals(t base.Type) bool {
+	other, ok := t.(TFunc)
+	if !ok || len(f.params) != len(other.params) {
+		return false
+	}
+	for i, v := range f.params {
+		if !v.Equals(other.params[i]) {
+			return false
+		}
+	}
+	return f.u_ret.Equals(other.u_ret)
+}
+
+func (f TFunc) String() string {
+	var b strings.Builder
+	b.WriteString("func(")
+	writeTypes(&b, f.params)
+	b.WriteString(") ")
+	b.WriteString(f.u_ret.String())
+	return b.String()
+}
+
+func (f TFunc) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString("func(")
+	writeToGoTypes(ds, &b, f.params)
+	b.WriteString(") ")
+	b.WriteString(f.u_ret.ToGoString(ds))
+	return b.String()
+}
+
+func (f TFunc) Underlying(ds []Decl) Type {
+	return f
+}
+
+/******************************************************************************/
+/* Slice types */
+
+// []u_elem -- the type of a slice value (cf. SliceLit)
+type TSlice struct {
+	elem Type
+	span base.Span // Source position, not part of node identity
+}
+
+func (s TSlice) GetSpan() base.Span { return s.span }
+
+var _ Type = TSlice{}
+
+func (s TSlice) GetElem() Type { return s.elem }
+
+func (s TSlice) SubsEtaOpen(eta EtaOpen) Type {
+	return TSlice{s.elem.SubsEtaOpen(eta), s.span}
+}
+
+func (s TSlice) SubsEtaClosed(eta EtaClosed) GroundType {
+	return TSlice{s.elem.SubsEtaClosed(eta), s.span}
+}
+
+// Cf. STypeLit.ImplsDelta
+func (s TSlice) ImplsDelta(ds []Decl, delta Delta, u Type) bool {
+	switch under := u.Underlying(ds).(type) {
+	case TSlice:
+		return s.Equals(under)
+	case ITypeLit:
+		return len(methods(ds, under)) == 0
+	default:
+		return false
+	}
+}
+
+// Cf. STypeLit.AssignableToDelta
+func (s TSlice) AssignableToDelta(ds []Decl, delta Delta, u Type) (bool, Coercion) {
+	if EqualsOrImpls(ds, delta, s, u) {
+		return true, noOpCoercion
+	}
+	if s.Equals(u.Underlying(ds)) {
+		coercion := func(expr FGGExpr) FGGExpr {
+			return Convert{u, expr, base.Span{}}
+		}
+		return true, coercion
+	}
+	return false, nil
+}
+
+func (s TSlice) Ok(ds []Decl, delta Delta) {
+	okValueType(ds, delta, s.elem)
+}
+
+func (s TSlice) Equals(t base.Type) bool {
+	other, ok := t.(TSlice)
+	return ok && s.elem.Equals(other.elem)
+}
+
+func (s TSlice) String() string {
+	return "[]" + s.elem.String()
+}
+
+func (s TSlice) ToGoString(ds []Decl) string {
+	return "[]" + s.elem.ToGoString(ds)
+}
+
+func (s TSlice) Underlying(ds []Decl) Type {
+	return s
+}
+
+/******************************************************************************/
+/* Map types */
+
+// map[u_key]u_elem -- the type of a map value (cf. MapLit)
+type TMap struct {
+	key  Type
+	elem Type
+	span base.Span // Source position, not part of node identity
+}
+
+func (m TMap) GetSpan() base.Span { return m.span }
+
+var _ Type = TMap{}
+
+func (m TMap) GetKey() Type  { return m.key }
+func (m TMap) GetElem() Type { return m.elem }
+
+func (m TMap) SubsEtaOpen(eta EtaOpen) Type {
+	return TMap{m.key.SubsEtaOpen(eta), m.elem.SubsEtaOpen(eta), m.span}
+}
+
+func (m TMap) SubsEtaClosed(eta EtaClosed) GroundType {
+	return TMap{m.key.SubsEtaClosed(eta), m.elem.SubsEtaClosed(eta), m.span}
+}
+
+// Cf. TSlice.ImplsDelta
+func (m TMap) ImplsDelta(ds []Decl, delta Delta, u Type) bool {
+	switch under := u.Underlying(ds).(type) {
+	case TMap:
+		return m.Equals(under)
+	case ITypeLit:
+		return len(methods(ds, under)) == 0
+	default:
+		return false
+	}
+}
+
+// Cf. TSlice.AssignableToDelta
+func (m TMap) AssignableToDelta(ds []Decl, delta Delta, u Type) (bool, Coercion) {
+	if EqualsOrImpls(ds, delta, m, u) {
+		return true, noOpCoercion
+	}
+	if m.Equals(u.Underlying(ds)) {
+		coercion := func(expr FGGExpr) FGGExpr {
+			return Convert{u, expr, base.Span{}}
+		}
+		return true, coercion
+	}
+	return false, nil
+}
+
+// The key type must be comparable, as in Go -- e.g., a type param bounded by
+// a type list of comparable types
+func (m TMap) Ok(ds []Decl, delta Delta) {
+	okValueType(ds, delta, m.key)
+	okValueType(ds, delta, m.elem)
+	if !isComparableType(ds, delta, m.key) {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, m,
+			"Invalid map key type: "+m.key.String()))
+	}
+}
+
+func (m TMap) Equals(t base.Type) bool {
+	other, ok := t.(TMap)
+	return ok && m.key.Equals(other.key) && m.elem.Equals(other.elem)
+}
+
+func (m TMap) String() string {
+	return "map[" + m.key.String() + "]" + m.elem.String()
+}
+
+func (m TMap) ToGoString(ds []Decl) string {
+	return "map[" + m.key.ToGoString(ds) + "]" + m.elem.ToGoString(ds)
+}
+
+func (m TMap) Underlying(ds []Decl) Type {
+	return m
+}
+
 /******************************************************************************/
 /* Interface literal */
 
 type ITypeLit struct {
 	specs []Spec
 	tlist TypeList
+	span  base.Span // Source position, not part of node identity
 }
 
+func (i ITypeLit) GetSpan() base.Span { return i.span }
+
 var _ Type = ITypeLit{}
 
 func (i ITypeLit) GetSpecs() []Spec { return i.specs }
@@ -605,18 +889,27 @@ func (i ITypeLit) HasTList() bool {
 	return i.tlist != nil && len(i.tlist) > 0
 }
 
-// When a constraint embeds another constraint, the type list of
-// the final constraint is the intersection of all the type lists involved.
-// If there are multiple embedded types, intersection preserves the property
-// that any type argument must satisfy the requirements of all embedded types.
-func (i ITypeLit) FlatTList(ds []Decl) TypeList {
-	res := i.tlist // todo currently not flattening possible constraints in the type list
+// The type set of an interface is the intersection of the type sets of its
+// elements: the type list, unions, and embedded interfaces -- so any type
+// argument must satisfy the requirements of all of them.
+// Cf. https://github.com/golang/go/issues/45346
+func (i ITypeLit) TypeSet(ds []Decl) TypeSet {
+	res := allTypes
+	if i.HasTList() {
+		res = res.intersect(ds, i.tlist.typeSet(ds))
+	}
 	for _, spec := range i.specs {
-		if emb, ok := spec.(TNamed); ok {
-			emb_under := emb.Underlying(ds).(ITypeLit) // checked in ok
-			if emb_under.HasTList() {
-				res = res.intersect(emb_under.FlatTList(ds))
+		switch s := spec.(type) {
+		case TNamed:
+			if emb_under, ok := s.Underlying(ds).(ITypeLit); ok {
+				res = res.intersect(ds, emb_under.TypeSet(ds))
+			} else { // A single-term union, cf. isEmbedding
+				res = res.intersect(ds, TypeSet{false, []Term{{false, s}}, false})
 			}
+		case Union:
+			res = res.intersect(ds, TypeSet{false, s, false})
+		case comparableElem:
+			res = res.intersect(ds, TypeSet{true, nil, true})
 		}
 	}
 	return res
@@ -633,9 +926,13 @@ func (i ITypeLit) SubsEtaClosed(eta EtaClosed) GroundType {
 			specs[i] = s.SubsEtaOpen(subs)
 		case TNamed:
 			specs[i] = s.SubsEtaClosed(eta).(TNamed)
+		case Union:
+			specs[i] = s.SubsEtaOpen(eta.ToEtaOpen())
+		case comparableElem:
+			specs[i] = s
 		}
 	}
-	return ITypeLit{specs, i.tlist}
+	return ITypeLit{specs, i.tlist, i.span}
 }
 
 func (i ITypeLit) SubsEtaOpen(eta EtaOpen) Type {
@@ -646,9 +943,13 @@ func (i ITypeLit) SubsEtaOpen(eta EtaOpen) Type {
 			specs[i] = s.SubsEtaOpen(eta)
 		case TNamed:
 			specs[i] = s.SubsEtaOpen(eta).(TNamed)
+		case Union:
+			specs[i] = s.SubsEtaOpen(eta)
+		case comparableElem:
+			specs[i] = s
 		}
 	}
-	return ITypeLit{specs, i.tlist}
+	return ITypeLit{specs, i.tlist, i.span}
 }
 
 func (i ITypeLit) ImplsDelta(ds []Decl, delta Delta, u Type) bool {
@@ -678,20 +979,25 @@ func (i ITypeLit) Ok(ds []Decl, delta Delta) {
 		switch s := v.(type) {
 		case Sig:
 			if _, ok := seen_g[s.meth]; ok {
-				panic("Multiple sigs with name: " + s.meth + "\n\t" + i.String())
+				panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, i,
+					"Multiple sigs with name: "+s.meth))
 			}
 			seen_g[s.meth] = s
 			s.Ok(ds, delta)
 		case TNamed:
 			k := s.String()
 			if _, ok := seen_u[k]; ok {
-				panic("Repeat embedding of type: " + k + "\n\t" + i.String())
+				panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, i,
+					"Repeat embedding of type: "+k))
 			}
 			seen_u[k] = s
-			if !IsIfaceType(ds, s) { // CHECKME: allow embed type param?
-				panic("Embedded type must be a named interface, not: " + k + "\n\t" + i.String())
+			s.Ok(ds, delta)
+			if !isEmbedding(ds, s) { // CHECKME: allow embed type param?
+				Union{{false, s}}.Ok(ds, delta)
 			}
+		case Union:
 			s.Ok(ds, delta)
+		case comparableElem: // Only in the predeclared comparable, cf. universe
 		default:
 			panic("Unknown Spec kind: " + reflect.TypeOf(v).String() + "\n\t" +
 				i.String())
@@ -700,6 +1006,10 @@ func (i ITypeLit) Ok(ds []Decl, delta Delta) {
 	if i.HasTList() {
 		i.tlist.Ok(ds, delta)
 	}
+	if i.TypeSet(ds).IsEmpty() {
+		panic(base.NewDiagnostic(base.DIAG_BAD_TYPE_SET, i,
+			"Empty type set: "+i.String()))
+	}
 }
 
 func (i ITypeLit) Equals(t base.Type) bool {
@@ -729,6 +1039,13 @@ func specEquals(s1, s2 Spec) bool {
 		if g2, ok := s2.(Sig); ok {
 			return sigAlphaEquals(s1, g2)
 		}
+	case Union:
+		if u2, ok := s2.(Union); ok {
+			return s1.Equals(u2)
+		}
+	case comparableElem:
+		_, ok := s2.(comparableElem)
+		return ok
 	}
 	return false
 }
@@ -760,21 +1077,41 @@ func (i ITypeLit) Underlying(ds []Decl) Type {
 	return i
 }
 
+// A single named term without "~" is parsed as is, cf. FGGAdaptor.ExitUnion:
+// it is an embedded interface if u names one, o/w a single-term union
+func isEmbedding(ds []Decl, u TNamed) bool {
+	return isIfaceType(ds, u)
+}
+
+// The type of a field, param or result (or an element of one): as in Go, an
+// interface whose type set is restricted by its type elements (a union, type
+// list or comparable) is not a basic interface, and may only be used as a
+// bound, cf. TFormal
+func okValueType(ds []Decl, delta Delta, u Type) {
+	u.Ok(ds, delta)
+	if _, ok := u.(TParam); ok {
+		return
+	}
+	u_I, ok := u.Underlying(ds).(ITypeLit)
+	if !ok {
+		return
+	}
+	if u_N, ok := u.(TNamed); ok { // Cf. TypeDecl.Ok, before TypeSet recurses
+		td := getTDecl(ds, u_N.GetName())
+		checkCyclicTypeDecl(ds, td, td.GetSourceType())
+	}
+	if ts := u_I.TypeSet(ds); !ts.IsAll() || ts.IsComparable() {
+		panic(base.NewDiagnostic(base.DIAG_BAD_TYPE_SET, u,
+			"Interface contains type constraints, can only be used as a bound: "+
+				u.String()))
+	}
+}
+
 /******************************************************************************/
 /* Type lists (cf. ITypeLit) -- not a type itself, just a helper */
 
 type TypeList []Type
 
-func (tlist0 TypeList) intersect(tlist TypeList) TypeList {
-	inter := TypeList{}
-	for _, t := range tlist0 {
-		if tlist.Contains(t) {
-			inter = append(inter, t)
-		}
-	}
-	return inter
-}
-
 func (tlist0 TypeList) Contains(t Type) bool {
 	for _, t2 := range tlist0 {
 		if t2.Equals(t) {
@@ -789,46 +1126,269 @@ func (tlist0 TypeList) Ok(ds []Decl, delta Delta) {
 	for _, u := range tlist0 {
 		k := u.String()
 		if _, ok := seen_tl[k]; ok {
-			panic("Duplicate type: " + k + " in type list\n\t" + tlist0.String())
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, tlist0,
+				"Duplicate type: "+k+" in type list"))
 		}
 		seen_tl[k] = u
+		okValueType(ds, delta, u) // According to go2goplay
+	}
+}
 
-		if isIfaceType(ds, u) {
-			under := u.Underlying(ds).(ITypeLit)
-			if under.HasTList() {
-				panic("interface Contains type constraints: " + under.String()) //according to go2goplay
-			}
+// A type list (draft design) also admits the types whose underlying type is
+// listed, i.e., "type int32, S()" is the union "~int32 | S()"
+func (tlist0 TypeList) typeSet(ds []Decl) TypeSet {
+	ts := make([]Term, len(tlist0))
+	for i, u := range tlist0 {
+		ts[i] = Term{u.Underlying(ds).Equals(u), u}
+	}
+	return TypeSet{false, ts, false}
+}
+
+func (tlist0 TypeList) String() string {
+	var b strings.Builder
+	b.WriteString("type ")
+	writeTypes(&b, tlist0)
+	return b.String()
+}
+
+/******************************************************************************/
+/* Unions (cf. ITypeLit) -- a type element, e.g., "~int32 | ~int64 | S()" */
+
+// u, or ~u: all the types whose underlying type is u
+type Term struct {
+	tilde bool
+	u     Type
+}
+
+func (t Term) GetType() Type { return t.u }
+func (t Term) IsTilde() bool { return t.tilde }
+
+func (t Term) SubsEtaOpen(eta EtaOpen) Term {
+	return Term{t.tilde, t.u.SubsEtaOpen(eta)}
+}
+
+// t0 ⊆ t
+func (t0 Term) subsetOf(ds []Decl, t Term) bool {
+	if t.tilde {
+		return t0.u.Underlying(ds).Equals(t.u)
+	}
+	return !t0.tilde && t0.u.Equals(t.u)
+}
+
+// t0 ∩ t, if not empty -- terms are either disjoint or one includes the other
+func (t0 Term) intersect(ds []Decl, t Term) (Term, bool) {
+	if t0.subsetOf(ds, t) {
+		return t0, true
+	} else if t.subsetOf(ds, t0) {
+		return t, true
+	}
+	return Term{}, false
+}
+
+func (t Term) Equals(t1 Term) bool {
+	return t.tilde == t1.tilde && t.u.Equals(t1.u)
+}
+
+func (t Term) String() string {
+	if t.tilde {
+		return "~" + t.u.String()
+	}
+	return t.u.String()
+}
+
+type Union []Term
+
+var _ Spec = Union{}
+
+// A union has no methods, it only restricts the type set
+func (u Union) GetSigs(ds []Decl) []Sig { return nil }
+
+func (u Union) SubsEtaOpen(eta EtaOpen) Union {
+	res := make(Union, len(u))
+	for i, t := range u {
+		res[i] = t.SubsEtaOpen(eta)
+	}
+	return res
+}
+
+// Terms may not be interfaces (cf. TypeList.Ok) nor type params, and the type
+// of a ~ term must be its own underlying type, e.g., ~int32 but not ~MyInt
+func (u Union) Ok(ds []Decl, delta Delta) {
+	seen := make(map[string]Term) // key is t.String()
+	for _, t := range u {
+		k := t.String()
+		if _, ok := seen[k]; ok {
+			panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, u,
+				"Duplicate term: "+k+" in union"))
+		}
+		seen[k] = t
+		t.u.Ok(ds, delta)
+		if _, ok := t.u.(TParam); ok {
+			panic(base.NewDiagnostic(base.DIAG_BAD_TYPE_SET, u,
+				"Term cannot be a type parameter: "+k))
+		}
+		if isIfaceType(ds, t.u) {
+			panic(base.NewDiagnostic(base.DIAG_BAD_TYPE_SET, u,
+				"Term cannot be an interface: "+k))
+		}
+		if t.tilde && !t.u.Underlying(ds).Equals(t.u) {
+			panic(base.NewDiagnostic(base.DIAG_BAD_TYPE_SET, u,
+				"Invalid use of ~: the underlying type of "+t.u.String()+
+					" is "+t.u.Underlying(ds).String()))
 		}
-		u.Ok(ds, delta)
 	}
 }
 
-func tlist(ds []Decl, u Type) TypeList {
+func (u Union) Equals(u1 Union) bool {
+	if len(u) != len(u1) {
+		return false
+	}
+	for i, t := range u {
+		if !t.Equals(u1[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (u Union) String() string {
+	ss := make([]string, len(u))
+	for i, t := range u {
+		ss[i] = t.String()
+	}
+	return strings.Join(ss, " | ")
+}
+
+// The sole element of the predeclared comparable (cf. universe): restricts
+// the type set to the comparable types, as checked by isComparableType
+type comparableElem struct{}
+
+var _ Spec = comparableElem{}
+
+func (c comparableElem) GetSigs(ds []Decl) []Sig { return nil }
+func (c comparableElem) String() string          { return "comparable" }
+
+/******************************************************************************/
+/* Type sets -- not a type itself, just a helper */
+
+// The types admitted by the type elements of an interface (the method
+// elements are cf. methodsDelta): all types, or the union of the terms --
+// of which only the comparable ones, if comparable (cf. comparableElem)
+type TypeSet struct {
+	all        bool
+	terms      []Term
+	comparable bool
+}
+
+var allTypes = TypeSet{true, nil, false}
+
+// The type set of u: of its bound if u is a type param, the interface type
+// set if u is an interface, otherwise just u itself
+func typeSetDelta(ds []Decl, delta Delta, u Type) TypeSet {
+	switch u1 := u.(type) {
+	case TParam:
+		if u_B, ok := delta[u1]; ok {
+			return typeSetDelta(ds, delta, u_B)
+		}
+	case FreshTVar: // Cf. methodsDelta
+		return typeSetDelta(ds, delta, u1.bound)
+	}
 	if u_I, ok := u.Underlying(ds).(ITypeLit); ok {
-		return u_I.FlatTList(ds)
-	} else {
-		return TypeList{u}
+		return u_I.TypeSet(ds)
 	}
+	return TypeSet{false, []Term{{false, u}}, false}
 }
 
-func (tlist0 TypeList) RepresentedBy(ds []Decl, other TypeList) bool {
-	if len(other) == 0 {
-		return true
-	} else {
-		for _, u := range tlist0 {
-			if ! (other.Contains(u) || other.Contains(u.Underlying(ds))) {
+// N.B. all (comparable) types, if IsComparable
+func (s TypeSet) IsAll() bool        { return s.all }
+func (s TypeSet) IsComparable() bool { return s.comparable }
+func (s TypeSet) IsEmpty() bool      { return !s.all && len(s.terms) == 0 }
+
+// N.B. nil if all types
+func (s TypeSet) GetTerms() []Term { return s.terms }
+
+// s0 ⊆ s, term-wise
+func (s0 TypeSet) SubsetOf(ds []Decl, delta Delta, s TypeSet) bool {
+	if s.comparable && !s0.comparable {
+		if s0.all {
+			return false
+		}
+		for _, t0 := range s0.terms {
+			if !isComparableType(ds, delta, t0.u) {
 				return false
 			}
 		}
+	}
+	if s.all {
 		return true
+	} else if s0.all {
+		return false
+	}
+	for _, t0 := range s0.terms {
+		if !s.covers(ds, t0) {
+			return false
+		}
 	}
+	return true
 }
 
-func (tlist0 TypeList) String() string {
-	var b strings.Builder
-	b.WriteString("type ")
-	writeTypes(&b, tlist0)
-	return b.String()
+func (s TypeSet) covers(ds []Decl, t0 Term) bool {
+	for _, t := range s.terms {
+		if t0.subsetOf(ds, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s0 TypeSet) intersect(ds []Decl, s TypeSet) TypeSet {
+	comparable := s0.comparable || s.comparable
+	if s0.all {
+		return TypeSet{s.all, s.terms, comparable}.comparableOnly(ds)
+	} else if s.all {
+		return TypeSet{s0.all, s0.terms, comparable}.comparableOnly(ds)
+	}
+	res := TypeSet{false, []Term{}, comparable}
+	for _, t0 := range s0.terms {
+		for _, t := range s.terms {
+			if t1, ok := t0.intersect(ds, t); ok && !res.covers(ds, t1) {
+				res.terms = append(res.terms, t1)
+			}
+		}
+	}
+	return res.comparableOnly(ds)
+}
+
+// If comparable, drops the terms that are not, e.g., a struct with a slice
+// field -- so that IsEmpty, and the terms ranged over by evalPrimtPredicate
+// and isComparableType, agree with comparable.  A term with free type params
+// is kept: whether it is comparable depends on the type args.
+func (s TypeSet) comparableOnly(ds []Decl) TypeSet {
+	if !s.comparable || s.all {
+		return s
+	}
+	res := TypeSet{false, []Term{}, true}
+	for _, t := range s.terms {
+		if len(fv(t.u)) > 0 || isComparableType(ds, make(Delta), t.u) {
+			res.terms = append(res.terms, t)
+		}
+	}
+	return res
+}
+
+func (s TypeSet) String() string {
+	var res string
+	if s.all {
+		res = "all types"
+	} else if s.IsEmpty() {
+		return "empty type set"
+	} else {
+		res = Union(s.terms).String()
+	}
+	if s.comparable {
+		res = "comparable: " + res
+	}
+	return res
 }
 
 /******************************************************************************/
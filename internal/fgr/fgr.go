@@ -36,6 +36,8 @@ func (t0 Type) AssignableTo(ds []Decl, u base.Type) bool {
 	t_fgr := u.(Type)
 	if isStructType(ds, t_fgr) {
 		return isStructType(ds, t0) && t0 == t_fgr
+	} else if isPrimitiveType(t_fgr) {
+		return t0 == t_fgr
 	}
 
 	gs := methods(ds, t_fgr) // t is a t_I
@@ -107,8 +109,6 @@ type FGRExpr interface {
 	// CHECKME: resulting Exprs are not "parsed" from source, OK?
 	Eval(ds []Decl) (FGRExpr, string)
 
-	//IsPanic() bool  // TODO "explicit" FGR panic -- cf. underlying runtime panic
-
 	// General "nested" subexpr dropping for fgr sim -- also Select-TRep (not only SynthAsserts)
 	DropSynthAsserts(ds []Decl) FGRExpr
 }
@@ -1,6 +1,10 @@
 package fgr
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/rhu1/fgg/internal/base"
+)
 
 var _ = fmt.Errorf
 
@@ -32,6 +36,8 @@ func methods(ds []Decl, t Type) map[Name]Sig {
 				res[v.meth] = v
 			}
 		}
+	} else if isPrimitiveType(t) {
+		res[GET_REP] = NewSig(GET_REP, []ParamDecl{}, RepType) // Cf. Call.Eval
 	} else if t != RepType { // !!! Rep // Perhaps redundant if all TDecl OK checked first
 		panic("Unknown type: " + t.String())
 	}
@@ -62,5 +68,5 @@ func getTDecl(ds []Decl, t Type) TDecl {
 			return td
 		}
 	}
-	panic("Type not found: " + t)
+	panic(base.NewDiagnostic(base.DIAG_UNKNOWN_TYPE, nil, "Type not found: "+t.String()))
 }
@@ -44,47 +44,85 @@ var _ FGRNode = FGRProgram{}
 func (p FGRProgram) GetDecls() []Decl   { return p.decls } // Return a copy?
 func (p FGRProgram) GetMain() base.Expr { return p.e_main }
 
+// Panics with the first Diagnostic found, if any -- cf. Check
 func (p FGRProgram) Ok(allowStupid bool, mode base.TypingMode) (base.Type, base.Program) {
+	typ, p1, errs := p.Check(allowStupid, mode)
+	base.Diagnostics(errs).PanicIfAny()
+	return typ, p1
+}
+
+// Each decl (and main) is checked independently, so all of them are reported.
+// The returned Type is nil if main is not well typed.
+func (p FGRProgram) Check(allowStupid bool, mode base.TypingMode) (base.Type, base.Program, []base.Diagnostic) {
 	if !allowStupid { // Hack, to print the following only for "top-level" programs (not during Eval)
 		/*fmt.Println("[Warning] Type/method decl OK not fully checked yet " +
 		"(e.g., distinct field/param names, etc.)")*/
 	}
+	var errs base.Diagnostics
 	tds := make(map[Type]TDecl)
 	mds := make(map[string]MDecl) // Hack, string = string(md.recv.t) + "." + md.GetName()
 	for _, v := range p.decls {
-		switch d := v.(type) {
-		case TDecl:
-			d.Ok(p.decls) // Currently empty -- TODO: check, e.g., unique field names -- cf., above [Warning]
-			// N.B. checks also omitted from submission version
-			t := Type(d.GetName())
-			if _, ok := tds[t]; ok {
-				panic("Multiple declarations of type name: " + string(t) + "\n\t" +
-					d.String())
+		errs.Catch(v, func() {
+			switch d := v.(type) {
+			case TDecl:
+				t := Type(d.GetName())
+				if _, ok := tds[t]; ok {
+					panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, d,
+						"Multiple declarations of type name: "+string(t)))
+				}
+				tds[t] = d
+				d.Ok(p.decls) // Currently empty -- TODO: check, e.g., unique field names -- cf., above [Warning]
+				// N.B. checks also omitted from submission version
+			case MDecl:
+				n := d.GetName()
+				hash := string(d.recv.t) + "." + n
+				if _, ok := mds[hash]; ok {
+					panic(base.NewDiagnostic(base.DIAG_DUPLICATE_DECL, d,
+						"Multiple declarations for receiver "+string(d.recv.t)+
+							" of the method name: "+n))
+				}
+				mds[hash] = d
+				d.Ok(p.decls)
+			default:
+				panic("Unknown decl: " + reflect.TypeOf(v).String() + "\n\t" +
+					v.String())
 			}
-			tds[t] = d
-		case MDecl:
-			d.Ok(p.decls)
-			n := d.GetName()
-			hash := string(d.recv.t) + "." + n
-			if _, ok := mds[hash]; ok {
-				panic("Multiple declarations for receiver " + string(d.recv.t) +
-					" of the method name: " + n + "\n\t" + d.String())
-			}
-			mds[hash] = d
-		default:
-			panic("Unknown decl: " + reflect.TypeOf(v).String() + "\n\t" +
-				v.String())
-		}
+		})
 	}
 	var gamma Gamma // Empty env for main
-	return p.e_main.Typing(p.decls, gamma, allowStupid), p
+	var typ base.Type
+	errs.Catch(p.e_main, func() {
+		typ = p.e_main.Typing(p.decls, gamma, allowStupid)
+	})
+	return typ, p, errs
 }
 
 // CHECKME: resulting FGRProgram is not parsed from source, OK? -- cf. Expr.Eval
 // But doesn't affect FGRPprogam.Ok() (i.e., Expr.Typing)
 func (p FGRProgram) Eval() (base.Program, string) {
-	e, rule := p.e_main.Eval(p.decls)
-	return FGRProgram{p.decls, e.(FGRExpr)}, rule
+	if p.e_main.IsPanic() {
+		panic("Cannot reduce: " + p.e_main.String())
+	}
+	e, rule := EvalStep(p.decls, p.e_main)
+	return FGRProgram{p.decls, e}, rule
+}
+
+// EvalStep reduces e one step.  E[panic] --> panic: a raised panic unwinds
+// the eval context (cf. Panic.Eval), and is caught here -- at the type of e,
+// cf. Panic.t.  So any one-step reduction outside of FGRProgram.Eval, e.g.,
+// to look ahead, should also use EvalStep.
+func EvalStep(ds []Decl, e FGRExpr) (res FGRExpr, rule string) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(Panic); !ok {
+				panic(r)
+			}
+			res = Panic{e.Typing(ds, make(Gamma), true)}
+			rule = "Panic"
+		}
+	}()
+	e1, rule := e.Eval(ds)
+	return e1.(FGRExpr), rule
 }
 
 func (p FGRProgram) String() string {
@@ -187,8 +225,8 @@ func (md MDecl) GetBody() FGRExpr           { return md.e_body }
 
 func (md MDecl) Ok(ds []Decl) {
 	if !isStructType(ds, md.recv.t) {
-		panic("Receiver must be a struct type: not " + md.recv.t.String() +
-			"\n\t" + md.String())
+		panic(base.NewDiagnostic(base.DIAG_BAD_RECEIVER, md,
+			"Receiver must be a struct type: not "+md.recv.t.String()))
 	}
 	env := Gamma{md.recv.name: md.recv.t}
 	for _, v := range md.pDecls {
@@ -197,8 +235,9 @@ func (md MDecl) Ok(ds []Decl) {
 	allowStupid := false
 	t := md.e_body.Typing(ds, env, allowStupid)
 	if !t.AssignableTo(ds, md.t_ret) {
-		panic("Method body type must implement declared return type: found=" +
-			t.String() + ", expected=" + md.t_ret.String() + "\n\t" + md.String())
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, md,
+			"Method body type must implement declared return type: found="+
+				t.String()+", expected="+md.t_ret.String()))
 	}
 }
 
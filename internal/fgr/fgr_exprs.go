@@ -7,8 +7,10 @@ package fgr
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/rhu1/fgg/internal/base"
 	"github.com/rhu1/fgg/internal/fgg"
 	"github.com/rhu1/fgg/internal/parser"
 )
@@ -24,6 +26,10 @@ func NewCall(e FGRExpr, m Name, es []FGRExpr) Call { return Call{e, m, es} }
 func NewAssert(e FGRExpr, t Type) Assert           { return Assert{e, t} }
 func NewSynthAssert(e FGRExpr, t Type) SynthAssert { return SynthAssert{e, t} }
 
+func NewCond(cond FGRExpr, e_then FGRExpr, e_else FGRExpr, t Type) Cond {
+	return Cond{cond, e_then, e_else, t}
+}
+
 /* Variable */
 
 type Variable struct {
@@ -47,7 +53,8 @@ func (x Variable) Eval(ds []Decl) (FGRExpr, string) {
 func (x Variable) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
 	res, ok := gamma[x.name]
 	if !ok {
-		panic("Var not in env: " + x.String())
+		panic(base.NewDiagnostic(base.DIAG_UNKNOWN_VAR, x,
+			"Var not in env: "+x.String()))
 	}
 	return res
 }
@@ -61,6 +68,10 @@ func (x Variable) IsValue() bool {
 	return false
 }
 
+func (x Variable) IsPanic() bool {
+	return false
+}
+
 func (x Variable) CanEval(ds []Decl) bool {
 	return false
 }
@@ -120,16 +131,16 @@ func (s StructLit) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
 		writeExprs(&b, s.elems)
 		b.WriteString("], fields=[")
 		writeFieldDecls(&b, fs)
-		b.WriteString("]\n\t")
-		b.WriteString(s.String())
-		panic(b.String())
+		b.WriteString("]")
+		panic(base.NewDiagnostic(base.DIAG_ARITY, s, b.String()))
 	}
 	for i := 0; i < len(s.elems); i++ {
 		t := s.elems[i].Typing(ds, gamma, allowStupid)
 		u := fs[i].t
 		if !t.AssignableTo(ds, u) {
-			panic("Arg expr must implement field type: arg=" + t.String() +
-				", field=" + u.String() + "\n\t" + s.String())
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, s,
+				"Arg expr must implement field type: arg="+t.String()+
+					", field="+u.String()))
 		}
 	}
 	return s.t_S
@@ -153,6 +164,10 @@ func (s StructLit) IsValue() bool {
 	return true
 }
 
+func (s StructLit) IsPanic() bool {
+	return false
+}
+
 func (s StructLit) CanEval(ds []Decl) bool {
 	for _, v := range s.elems {
 		if v.CanEval(ds) {
@@ -230,7 +245,8 @@ func (s Select) Eval(ds []Decl) (FGRExpr, string) {
 func (s Select) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
 	t := s.e_S.Typing(ds, gamma, allowStupid)
 	if !isStructType(ds, t) {
-		panic("Illegal select on non-struct type expr: " + t)
+		panic(base.NewDiagnostic(base.DIAG_NOT_STRUCT, s,
+			"Illegal select on non-struct type expr: "+t.String()))
 	}
 	fds := fields(ds, t)
 	for _, v := range fds {
@@ -238,13 +254,14 @@ func (s Select) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
 			return v.t
 		}
 	}
-	panic("Field not found: " + s.field + " in " + t.String())
+	panic(base.NewDiagnostic(base.DIAG_UNKNOWN_FIELD, s,
+		"Field not found: "+s.field+" in "+t.String()))
 }
 
 // DropSynthAsserts from FGRExpr
 func (s Select) DropSynthAsserts(ds []Decl) FGRExpr {
 	if s.CanEval(ds) { // Cf. fast-forwarding (some overlap, intentional)
-		e2, _ := s.Eval(ds)
+		e2, _ := EvalStep(ds, s)
 		if v, ok := e2.(TRep); ok { // !!! Select-TRep cf. nomono.fgg
 			if !v.IsValue() { // CHECKME: temp assertion -- may need to (attempt to) further reduce TRep to ground
 				panic("CHECKME: Select-TRep produced non-ground TRep: " +
@@ -261,6 +278,10 @@ func (s Select) IsValue() bool {
 	return false
 }
 
+func (s Select) IsPanic() bool {
+	return false
+}
+
 func (s Select) CanEval(ds []Decl) bool {
 	if s.e_S.CanEval(ds) {
 		return true
@@ -334,6 +355,9 @@ func (c Call) Eval(ds []Decl) (FGRExpr, string) {
 		return Call{c.e_recv, c.meth, args}, rule
 	}
 	// c.e and c.args all values
+	if v, ok := c.e_recv.(PrimitiveValue); ok { // getRep is the only method of a primitive type, cf. methods
+		return TRep{Name(v.Typing(ds, make(Gamma), true)), []FGRExpr{}}, "Call"
+	}
 	s := c.e_recv.(StructLit)
 	x0, xs, e := body(ds, s.t_S, c.meth) // panics if method not found
 	subs := make(map[Variable]FGRExpr)
@@ -348,7 +372,8 @@ func (c Call) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
 	t0 := c.e_recv.Typing(ds, gamma, allowStupid)
 	var g Sig
 	if tmp, ok := methods(ds, t0)[c.meth]; !ok { // !!! submission version had "methods(m)"
-		panic("Method not found: " + c.meth + " in " + t0.String() + "\n\t" + c.String())
+		panic(base.NewDiagnostic(base.DIAG_UNKNOWN_METHOD, c,
+			"Method not found: "+c.meth+" in "+t0.String()))
 	} else {
 		g = tmp
 	}
@@ -359,13 +384,14 @@ func (c Call) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
 		b.WriteString("], params=[")
 		writeParamDecls(&b, g.pDecls)
 		b.WriteString("]")
-		panic(b.String())
+		panic(base.NewDiagnostic(base.DIAG_ARITY, c, b.String()))
 	}
 	for i := 0; i < len(c.args); i++ {
 		t := c.args[i].Typing(ds, gamma, allowStupid)
 		if !t.AssignableTo(ds, g.pDecls[i].t) {
-			panic("Arg expr type must implement param type: arg=" + t + ", param=" +
-				g.pDecls[i].t)
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+				"Arg expr type must implement param type: arg="+t.String()+
+					", param="+g.pDecls[i].t.String()))
 		}
 	}
 	return g.t_ret
@@ -385,6 +411,10 @@ func (c Call) IsValue() bool {
 	return false
 }
 
+func (c Call) IsPanic() bool {
+	return false
+}
+
 func (c Call) CanEval(ds []Decl) bool {
 	if c.e_recv.CanEval(ds) {
 		return true
@@ -398,6 +428,9 @@ func (c Call) CanEval(ds []Decl) bool {
 			return false
 		}
 	}
+	if _, ok := c.e_recv.(PrimitiveValue); ok {
+		return c.meth == GET_REP && len(c.args) == 0
+	}
 	t_S := c.e_recv.(StructLit).t_S
 	for _, d := range ds { // TODO: factor out GetMethDecl
 		if md, ok := d.(MDecl); ok &&
@@ -451,25 +484,22 @@ func (a Assert) Eval(ds []Decl) (FGRExpr, string) {
 		e, rule := a.e_I.Eval(ds)
 		return Assert{e.(FGRExpr), a.t_cast}, rule
 	}
-	t_S := a.e_I.(StructLit).t_S
-	if !isStructType(ds, t_S) {
-		panic("Non struct type found in struct lit: " + t_S.String())
-	}
-	if t_S.AssignableTo(ds, a.t_cast) {
+	if concreteType(ds, a.e_I).AssignableTo(ds, a.t_cast) {
 		return a.e_I, "Assert"
 	}
-	panic("Cannot reduce: " + a.String())
+	return Panic{a.t_cast}, "AssertPanic"
 }
 
 // Typing ...
 func (a Assert) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
 	t := a.e_I.Typing(ds, gamma, allowStupid)
-	if isStructType(ds, t) {
+	if isStructType(ds, t) || isPrimitiveType(t) { // A concrete type, cf. concreteType
 		if allowStupid {
 			return a.t_cast
 		} else {
-			panic("Expr must be an interface type (in a non-stupid context): found " +
-				t.String() + " for\n\t" + a.String())
+			panic(base.NewDiagnostic(base.DIAG_NOT_INTERFACE, a,
+				"Expr must be an interface type (in a non-stupid context): found "+
+					t.String()))
 		}
 	}
 	// t is an interface type
@@ -480,8 +510,9 @@ func (a Assert) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
 	if a.t_cast.AssignableTo(ds, t) {
 		return a.t_cast
 	}
-	panic("Struct type assertion must implement expr type: asserted=" +
-		a.t_cast.String() + ", expr=" + t.String())
+	panic(base.NewDiagnostic(base.DIAG_BAD_ASSERT, a,
+		"Struct type assertion must implement expr type: asserted="+
+			a.t_cast.String()+", expr="+t.String()))
 }
 
 func (a Assert) DropSynthAsserts(ds []Decl) FGRExpr {
@@ -493,13 +524,12 @@ func (a Assert) IsValue() bool {
 	return false
 }
 
+func (a Assert) IsPanic() bool {
+	return false
+}
+
 func (a Assert) CanEval(ds []Decl) bool {
-	if a.e_I.CanEval(ds) {
-		return true
-	} else if !a.e_I.IsValue() {
-		return false
-	}
-	return a.e_I.(StructLit).t_S.AssignableTo(ds, a.t_cast)
+	return a.e_I.IsValue() || a.e_I.CanEval(ds) // N.B. true for a failed assertion
 }
 
 func (a Assert) String() string {
@@ -543,11 +573,7 @@ func (a SynthAssert) Eval(ds []Decl) (FGRExpr, string) {
 		e, rule := a.e_I.Eval(ds)
 		return SynthAssert{e.(FGRExpr), a.t_cast}, rule
 	}
-	t_S := a.e_I.(StructLit).t_S
-	if !isStructType(ds, t_S) {
-		panic("Non struct type found in struct lit: " + t_S.String())
-	}
-	if t_S.AssignableTo(ds, a.t_cast) {
+	if concreteType(ds, a.e_I).AssignableTo(ds, a.t_cast) {
 		return a.e_I, "SynthAssert"
 	}
 	panic("Cannot reduce: " + a.String())
@@ -560,8 +586,9 @@ func (a SynthAssert) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
 		if allowStupid {
 			return a.t_cast
 		} else {
-			panic("Expr must be an interface type (in a non-stupid context): found " +
-				t.String() + " for\n\t" + a.String())
+			panic(base.NewDiagnostic(base.DIAG_NOT_INTERFACE, a,
+				"Expr must be an interface type (in a non-stupid context): found "+
+					t.String()))
 		}
 	}
 	// t is an interface type
@@ -572,8 +599,9 @@ func (a SynthAssert) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
 	if a.t_cast.AssignableTo(ds, t) {
 		return a.t_cast
 	}
-	panic("Struct type assertion must implement expr type: asserted=" +
-		a.t_cast.String() + ", expr=" + t.String())
+	panic(base.NewDiagnostic(base.DIAG_BAD_ASSERT, a,
+		"Struct type assertion must implement expr type: asserted="+
+			a.t_cast.String()+", expr="+t.String()))
 }
 
 func (a SynthAssert) DropSynthAsserts(ds []Decl) FGRExpr {
@@ -586,6 +614,10 @@ func (a SynthAssert) IsValue() bool {
 	return false
 }
 
+func (a SynthAssert) IsPanic() bool {
+	return false
+}
+
 // CanEval from base.Expr
 func (a SynthAssert) CanEval(ds []Decl) bool {
 	if a.e_I.CanEval(ds) {
@@ -593,7 +625,7 @@ func (a SynthAssert) CanEval(ds []Decl) bool {
 	} else if !a.e_I.IsValue() {
 		return false
 	}
-	return a.e_I.(StructLit).t_S.AssignableTo(ds, a.t_cast)
+	return concreteType(ds, a.e_I).AssignableTo(ds, a.t_cast)
 }
 
 func (a SynthAssert) String() string {
@@ -617,20 +649,30 @@ func (a SynthAssert) ToGoString(ds []Decl) string {
 
 /* Panic */
 
-type Panic struct{}
+// The panic of a failed rep check (cf. IfThenElse) or assertion, a run-time
+// error of a primitive operation (cf. evalPrimitive), or an obliterated FGG
+// panic(e) (cf. oblitExpr) -- there is no panic argument.  Like FG/FGG,
+// E[panic] reduces to panic, cf. EvalStep.  t is the type of the term the
+// panic stands for.
+type Panic struct {
+	t Type
+}
 
 var _ FGRExpr = Panic{}
 
+func (p Panic) GetType() Type { return p.t }
+
 func (p Panic) Subs(subs map[Variable]FGRExpr) FGRExpr {
 	return p
 }
 
 func (p Panic) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
-	panic("TODO: " + p.String()) // !!! FIXME: allow any t
+	return p.t
 }
 
+// N.B. a raised panic is a Go panic, caught (outside the eval context) by EvalStep
 func (p Panic) Eval(ds []Decl) (FGRExpr, string) {
-	panic("Cannot reduce panic.")
+	panic(p)
 }
 
 func (p Panic) DropSynthAsserts(ds []Decl) FGRExpr {
@@ -639,11 +681,15 @@ func (p Panic) DropSynthAsserts(ds []Decl) FGRExpr {
 
 // From base.Expr
 func (p Panic) IsValue() bool {
+	return false
+}
+
+func (p Panic) IsPanic() bool {
 	return true
 }
 
 func (p Panic) CanEval(ds []Decl) bool {
-	return false
+	return true
 }
 
 func (p Panic) String() string {
@@ -651,7 +697,7 @@ func (p Panic) String() string {
 }
 
 func (p Panic) ToGoString(ds []Decl) string {
-	return "panic"
+	return "panic(\"panic\")"
 }
 
 /* IfThenElse */
@@ -682,7 +728,7 @@ func (c IfThenElse) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
 			": found " + t2.String())
 	}
 	t3 := c.e3.Typing(ds, gamma, allowStupid)
-	// !!! no explicit e4 -- should always be panic? (panic typing is TODO)
+	// No explicit e4 -- always panic, at type t3
 	return t3
 }
 
@@ -696,17 +742,13 @@ func (c IfThenElse) Eval(ds []Decl) (FGRExpr, string) {
 		return IfThenElse{c.e1, e.(FGRExpr), c.e3, c.src}, rule
 	}
 
-	// TODO: refactor
-	var a parser.FGGAdaptor
-	p_fgg := a.Parse(true, c.src).(fgg.FGGProgram)
-	ds_fgg := p_fgg.GetDecls()
-
+	ds_fgg := parseFggDecls(c.src)
 	r1 := c.e1.(TRep)
 	r2 := c.e2.(TRep)
 	if r1.Reify().ImplsDelta(ds_fgg, make(fgg.Delta), r2.Reify()) {
 		return c.e3, "If-true"
 	} else {
-		return Panic{}, "If-false"
+		return Panic{c.e3.Typing(ds, make(Gamma), true)}, "If-false"
 	}
 }
 
@@ -719,6 +761,10 @@ func (c IfThenElse) IsValue() bool {
 	return false
 }
 
+func (c IfThenElse) IsPanic() bool {
+	return false
+}
+
 func (c IfThenElse) CanEval(ds []Decl) bool {
 	if c.e1.CanEval(ds) {
 		return true
@@ -730,7 +776,7 @@ func (c IfThenElse) CanEval(ds []Decl) bool {
 	} else if !c.e2.IsValue() {
 		return false
 	}
-	return c.e3.CanEval(ds)
+	return true // If-true, o/w If-false
 }
 
 func (c IfThenElse) String() string {
@@ -757,6 +803,233 @@ func (c IfThenElse) ToGoString(ds []Decl) string {
 	return b.String()
 }
 
+/* Cond */
+
+// Cond represents: if cond then e_then else e_else -- an obliterated FGG
+// conditional, cf. fgg.Cond.  t is the (erased) type of the conditional, to
+// which each branch must be assignable.
+type Cond struct {
+	cond   FGRExpr
+	e_then FGRExpr
+	e_else FGRExpr
+	t      Type
+}
+
+var _ FGRExpr = Cond{}
+
+func (c Cond) GetCond() FGRExpr { return c.cond }
+func (c Cond) GetThen() FGRExpr { return c.e_then }
+func (c Cond) GetElse() FGRExpr { return c.e_else }
+func (c Cond) GetType() Type    { return c.t }
+
+func (c Cond) Subs(subs map[Variable]FGRExpr) FGRExpr {
+	return Cond{c.cond.Subs(subs), c.e_then.Subs(subs), c.e_else.Subs(subs), c.t}
+}
+
+// The condition is checked by FGG (cf. fgg.Cond.Typing) -- here, it may be of
+// an (erased) type param type, i.e., an interface type
+func (c Cond) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
+	if t := c.cond.Typing(ds, gamma, allowStupid); isStructType(ds, t) {
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+			"Non-boolean condition: "+t.String()))
+	}
+	for _, e := range []FGRExpr{c.e_then, c.e_else} {
+		if t := e.Typing(ds, gamma, allowStupid); !t.AssignableTo(ds, c.t) {
+			panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+				"Branch must be assignable to the conditional type: found="+
+					t.String()+", expected="+c.t.String()))
+		}
+	}
+	return c.t
+}
+
+// N.B. only the condition is evaluated, the branches are not
+func (c Cond) Eval(ds []Decl) (FGRExpr, string) {
+	if !c.cond.IsValue() {
+		e, rule := c.cond.Eval(ds)
+		return Cond{e, c.e_then, c.e_else, c.t}, rule
+	}
+	if c.cond.(PrimitiveValue).v.Val().(bool) {
+		return c.e_then, "IfTrue"
+	}
+	return c.e_else, "IfFalse"
+}
+
+func (c Cond) DropSynthAsserts(ds []Decl) FGRExpr {
+	return Cond{c.cond.DropSynthAsserts(ds), c.e_then.DropSynthAsserts(ds),
+		c.e_else.DropSynthAsserts(ds), c.t}
+}
+
+// From base.Expr
+func (c Cond) IsValue() bool {
+	return false
+}
+
+func (c Cond) IsPanic() bool {
+	return false
+}
+
+func (c Cond) CanEval(ds []Decl) bool {
+	return c.cond.IsValue() || c.cond.CanEval(ds)
+}
+
+func (c Cond) String() string {
+	var b strings.Builder
+	b.WriteString("(if ")
+	b.WriteString(c.cond.String())
+	b.WriteString(" then ")
+	b.WriteString(c.e_then.String())
+	b.WriteString(" else ")
+	b.WriteString(c.e_else.String())
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c Cond) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString("(if ")
+	b.WriteString(c.cond.ToGoString(ds))
+	b.WriteString(" then ")
+	b.WriteString(c.e_then.ToGoString(ds))
+	b.WriteString(" else ")
+	b.WriteString(c.e_else.ToGoString(ds))
+	b.WriteString(")")
+	return b.String()
+}
+
+/* CommaOk */
+
+// CommaOk represents: x, x_ok := e.(r); e_body -- an obliterated FGG comma-ok
+// assertion, cf. fgg.CommaOk.  Once e is a value, a dynamic check of its rep
+// against r (cf. IfThenElse) yields the pair bound in e_body: the value and
+// true, or else the zero value of the asserted type and false.  t is the
+// (erased) asserted type.
+type CommaOk struct {
+	x      Variable
+	x_ok   Variable
+	e      FGRExpr
+	r      FGRExpr // TRep (or TmpTParam (Variable), cf. Convert)
+	t      Type
+	e_body FGRExpr
+	src    string // Original FGG source, cf. IfThenElse -- for the check, and the zero value
+}
+
+var _ FGRExpr = CommaOk{}
+
+func NewCommaOk(x Variable, x_ok Variable, e FGRExpr, r FGRExpr, t Type, e_body FGRExpr,
+	src string) CommaOk {
+	return CommaOk{x, x_ok, e, r, t, e_body, src}
+}
+
+func (c CommaOk) GetExpr() FGRExpr { return c.e }
+func (c CommaOk) GetRep() FGRExpr  { return c.r }
+func (c CommaOk) GetBody() FGRExpr { return c.e_body }
+
+// x and x_ok are bound in e_body, so are not substituted there
+func (c CommaOk) Subs(subs map[Variable]FGRExpr) FGRExpr {
+	subs1 := make(map[Variable]FGRExpr)
+	for k, v := range subs {
+		subs1[k] = v
+	}
+	subs1[c.x] = c.x
+	subs1[c.x_ok] = c.x_ok
+	return CommaOk{c.x, c.x_ok, c.e.Subs(subs), c.r.Subs(subs), c.t,
+		c.e_body.Subs(subs1), c.src}
+}
+
+func (c CommaOk) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
+	c.e.Typing(ds, gamma, allowStupid)
+	if t := c.r.Typing(ds, gamma, allowStupid); t != RepType {
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+			"Comma-ok assertion rep must be of type "+RepType.String()+": found "+t.String()))
+	}
+	gamma1 := make(Gamma)
+	for k, v := range gamma {
+		gamma1[k] = v
+	}
+	gamma1[c.x.name] = c.t
+	gamma1[c.x_ok.name] = Type(fgg.NameFromTag(fgg.BOOL))
+	return c.e_body.Typing(ds, gamma1, allowStupid)
+}
+
+func (c CommaOk) Eval(ds []Decl) (FGRExpr, string) {
+	if !c.e.IsValue() {
+		e, rule := c.e.Eval(ds)
+		return CommaOk{c.x, c.x_ok, e, c.r, c.t, c.e_body, c.src}, rule
+	}
+	if !c.r.IsValue() {
+		r, rule := c.r.Eval(ds)
+		return CommaOk{c.x, c.x_ok, c.e, r, c.t, c.e_body, c.src}, rule
+	}
+	ds_fgg := parseFggDecls(c.src)
+	u := c.r.(TRep).Reify()
+	v := c.e
+	ok := repOf(ds, c.e).Reify().ImplsDelta(ds_fgg, make(fgg.Delta), u)
+	if !ok {
+		z, _ := fgg.ZeroValue(ds_fgg, u) // Checked by FGG, cf. fgg.CommaOk.Typing
+		v = oblitExpr(ds_fgg, make(fgg.Delta), make(fgg.Gamma), z)
+	}
+	lit := fgg.NewBoolLit(strconv.FormatBool(ok))
+	subs := map[Variable]FGRExpr{
+		c.x:    v,
+		c.x_ok: PrimitiveValue{fgg.NewTypedPrimitiveValue(lit, fgg.NewTPrimitive(fgg.BOOL))}}
+	return c.e_body.Subs(subs), "CommaOkAssert"
+}
+
+func (c CommaOk) DropSynthAsserts(ds []Decl) FGRExpr {
+	return CommaOk{c.x, c.x_ok, c.e.DropSynthAsserts(ds), c.r.DropSynthAsserts(ds), c.t,
+		c.e_body.DropSynthAsserts(ds), c.src}
+}
+
+// From base.Expr
+func (c CommaOk) IsValue() bool {
+	return false
+}
+
+func (c CommaOk) IsPanic() bool {
+	return false
+}
+
+// N.B. like fgg.CommaOk, never stuck once e and r are values
+func (c CommaOk) CanEval(ds []Decl) bool {
+	if c.e.CanEval(ds) {
+		return true
+	} else if !c.e.IsValue() {
+		return false
+	}
+	return c.r.IsValue() || c.r.CanEval(ds)
+}
+
+func (c CommaOk) String() string {
+	var b strings.Builder
+	b.WriteString("let ")
+	b.WriteString(c.x.String())
+	b.WriteString(", ")
+	b.WriteString(c.x_ok.String())
+	b.WriteString("=")
+	b.WriteString(c.e.String())
+	b.WriteString(".(")
+	b.WriteString(c.r.String())
+	b.WriteString(") in ")
+	b.WriteString(c.e_body.String())
+	return b.String()
+}
+
+func (c CommaOk) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString("let ")
+	b.WriteString(c.x.ToGoString(ds))
+	b.WriteString(", ")
+	b.WriteString(c.x_ok.ToGoString(ds))
+	b.WriteString("=")
+	b.WriteString(c.e.ToGoString(ds))
+	b.WriteString(".(")
+	b.WriteString(c.r.ToGoString(ds))
+	b.WriteString(") in ")
+	b.WriteString(c.e_body.ToGoString(ds))
+	return b.String()
+}
+
 /* Let */
 
 // Let represents: let x = e1 in e2
@@ -815,6 +1088,10 @@ func (e Let) IsValue() bool {
 	return false
 }
 
+func (e Let) IsPanic() bool {
+	return false
+}
+
 // CanEval from base.Expr
 func (e Let) CanEval(ds []Decl) bool {
 	if e.e1.CanEval(ds) {
@@ -862,10 +1139,13 @@ var _ FGRExpr = TRep{}
 // GetArgs public getter
 func (r TRep) GetArgs() []FGRExpr { return r.args }
 
-func (r TRep) Reify() fgg.TNamed {
+func (r TRep) Reify() fgg.Type {
 	if !r.IsValue() {
 		panic("Cannot refiy non-ground TRep: " + r.String())
 	}
+	if isPrimitiveType(Type(r.t_name)) {
+		return fgg.NewTPrimitive(fgg.TagFromName(r.t_name))
+	}
 	us := make([]fgg.Type, len(r.args)) // All TName
 	for i := 0; i < len(us); i++ {
 		us[i] = r.args[i].(TRep).Reify() // CHECKME: guaranteed TRep?
@@ -920,6 +1200,10 @@ func (r TRep) IsValue() bool {
 	return true
 }
 
+func (r TRep) IsPanic() bool {
+	return false
+}
+
 func (r TRep) CanEval(ds []Decl) bool {
 	for _, v := range r.args {
 		if v.CanEval(ds) {
@@ -983,6 +1267,10 @@ func (tmp TmpTParam) IsValue() bool {
 	panic("Shouldn't get in here: " + tmp.String())
 }
 
+func (tmp TmpTParam) IsPanic() bool {
+	panic("Shouldn't get in here: " + tmp.String())
+}
+
 func (tmp TmpTParam) CanEval(ds []Decl) bool {
 	panic("Shouldn't get in here: " + tmp.String())
 }
@@ -997,6 +1285,46 @@ func (tmp TmpTParam) ToGoString(ds []Decl) string {
 
 /* Aux, helpers */
 
+// The decls of the original FGG source, cf. IfThenElse
+func parseFggDecls(src string) []fgg.Decl {
+	var a parser.FGGAdaptor
+	p, errs := a.Parse(true, src)
+	if len(errs) > 0 {
+		panic("Could not re-parse source: " + errs[0].Error())
+	}
+	return p.(fgg.FGGProgram).GetDecls()
+}
+
+// The rep of a value, i.e., the result of its getRep method (cf. mkGetRep):
+// the rep fields come first in a struct value, cf. oblitSTypeLit
+func repOf(ds []Decl, v FGRExpr) TRep {
+	if s, ok := v.(StructLit); ok {
+		n := 0
+		for _, fd := range fields(ds, s.t_S) {
+			if fd.t != RepType {
+				break
+			}
+			n++
+		}
+		return TRep{Name(s.t_S), s.elems[:n]}
+	}
+	return TRep{Name(concreteType(ds, v)), []FGRExpr{}}
+}
+
+// The type of a value, i.e., a struct or primitive type
+func concreteType(ds []Decl, v FGRExpr) Type {
+	switch v1 := v.(type) {
+	case StructLit:
+		if !isStructType(ds, v1.t_S) {
+			panic("Non struct type found in struct lit: " + v1.t_S.String())
+		}
+		return v1.t_S
+	case PrimitiveValue:
+		return v1.Typing(ds, make(Gamma), true)
+	}
+	panic("Not a value: " + v.String())
+}
+
 func writeExprs(b *strings.Builder, es []FGRExpr) {
 	if len(es) > 0 {
 		b.WriteString(es[0].String())
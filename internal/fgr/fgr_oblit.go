@@ -7,6 +7,7 @@ import (
 
 	//"strings"
 
+	"github.com/rhu1/fgg/internal/base"
 	"github.com/rhu1/fgg/internal/fgg"
 )
 
@@ -17,6 +18,10 @@ var _ = reflect.Append
 const GET_REP = "getRep"
 const HAS_REP = "HasRep"
 
+// Top-level functions are obliterated to methods of a dummy (empty) struct
+const FUNCS = "Funcs"
+const FUNCS_RECV = "_f"
+
 /* Export */
 
 func ToFgrTypeFromBounds(delta fgg.Delta, u fgg.Type) Type {
@@ -28,7 +33,7 @@ func ToFgrTypeFromBounds(delta fgg.Delta, u fgg.Type) Type {
  */
 
 func Obliterate(p_fgg fgg.FGGProgram) FGRProgram { // CHECKME can also subsume existing FGG-FG trans?
-	ds_fgg := p_fgg.GetDecls()
+	ds_fgg := fgg.WithUniverse(p_fgg.GetDecls()) // Predeclared any, comparable as ordinary interfaces
 
 	e_fgg := p_fgg.GetMain().(fgg.FGGExpr)
 	var delta fgg.Delta
@@ -39,28 +44,20 @@ func Obliterate(p_fgg fgg.FGGProgram) FGRProgram { // CHECKME can also subsume e
 	ds_fgr := make([]Decl, 1)                                    // There will also be an additional getRep MDecl for each t_S
 	ss_HasRep := []Spec{NewSig(GET_REP, []ParamDecl{}, RepType)} // !!! Rep type name -- TODO: factor out constants
 	ds_fgr[0] = NewITypeLit(Type(HAS_REP), ss_HasRep)            // TODO: factor out constant
+	hasFuncs := false
 	for i := 0; i < len(ds_fgg); i++ {
 		d_fgg := ds_fgg[i]
 		switch d := d_fgg.(type) {
-		//case fgg.STypeLit:
-		//	recv_getRep := NewParamDecl("x0", Type(d.GetName())) // TODO: factor out constant
-		//	t_S := d.GetName()
-		//	tfs := d.GetBigPsi().GetTFormals()
-		//	es := make([]FGRExpr, len(tfs))
-		//	for i := 0; i < len(es); i++ {
-		//		es[i] = NewSelect(NewVariable("x0"), tfs[i].GetTParam().String())
-		//	}
-		//	e_getRep := TRep{Name(t_S), es} // TODO: New constructor
-		//	getRep := NewMDecl(recv_getRep, GET_REP /*[]RepDecl{},*/, []ParamDecl{},
-		//		RepType, e_getRep) // TODO: factor out constants
-		//	ds_fgr = append(ds_fgr, oblitSTypeLit(d), getRep)
-		//case fgg.ITypeLit:
-		//	ds_fgr = append(ds_fgr, oblitITypeLit(d))
-
 		case fgg.TypeDecl:
-			ds_fgr = append(ds_fgr, oblitTDecl(ds_fgg, d))
+			ds_fgr = append(ds_fgr, oblitTDecl(ds_fgg, d)...)
 		case fgg.MethDecl:
 			ds_fgr = append(ds_fgr, oblitMDecl(ds_fgg, d))
+		case fgg.FuncDecl:
+			if !hasFuncs {
+				ds_fgr = append(ds_fgr, NewSTypeLit(Type(FUNCS), []FieldDecl{}))
+				hasFuncs = true
+			}
+			ds_fgr = append(ds_fgr, oblitFuncDecl(ds_fgg, d))
 		default:
 			panic("Unexpected Decl type " + reflect.TypeOf(d).String() + ": " +
 				d.String())
@@ -70,46 +67,73 @@ func Obliterate(p_fgg fgg.FGGProgram) FGRProgram { // CHECKME can also subsume e
 	return NewFGRProgram(ds_fgr, e_fgr)
 }
 
-func oblitTDecl(ds_fgg []Decl, d fgg.TypeDecl) TDecl {
-	return STypeLit{}
+// A struct type is obliterated together with its getRep method -- other named
+// types (e.g., func, slice or primitive) have no FGR counterpart
+func oblitTDecl(ds_fgg []Decl, d fgg.TypeDecl) []Decl {
+	switch u := d.GetSourceType().(type) {
+	case fgg.STypeLit:
+		return []Decl{oblitSTypeLit(d, u), mkGetRep(d)}
+	case fgg.ITypeLit:
+		return []Decl{oblitITypeLit(ds_fgg, d, u)}
+	default:
+		panic(base.NewDiagnostic(base.DIAG_UNSUPPORTED, d,
+			"Type not supported by obliteration: "+d.String()))
+	}
 }
 
 /* Obliterate STypeLit, ITypeLit, Sig */
 
-//func oblitSTypeLit(s fgg.STypeLit) STypeLit {
-//	t := Type(s.GetName())
-//	psi := s.GetBigPsi()
-//	tfs := psi.GetTFormals()
-//	fds_fgg := s.GetFieldDecls()
-//	fds_fgr := make([]FieldDecl, len(tfs)+len(fds_fgg))
-//	for i := 0; i < len(tfs); i++ {
-//		fds_fgr[i] = NewFieldDecl(tfs[i].GetTParam().String(), RepType)
-//	}
-//	delta := psi.ToDelta()
-//	for i := 0; i < len(fds_fgg); i++ {
-//		fd_fgg := fds_fgg[i]
-//		erased := toFgrTypeFromBounds(delta, fd_fgg.GetType())
-//		fds_fgr[len(tfs)+i] = NewFieldDecl(fd_fgg.GetName(), erased)
-//	}
-//	return NewSTypeLit(t /*rds,*/, fds_fgr)
-//}
-//
-//func oblitITypeLit(c fgg.ITypeLit) ITypeLit {
-//	t := Type(c.GetName())
-//	ss_fgg := c.GetSpecs()
-//	ss_fgr := make([]Spec, 1+len(ss_fgg))
-//	ss_fgr[0] = Type(HAS_REP) // TODO: add HasRep to decls -- and factor out constant
-//	for i := 0; i < len(ss_fgg); i++ {
-//		s_fgg := ss_fgg[i]
-//		switch s := s_fgg.(type) {
-//		case fgg.Type:
-//			panic("[TODO]: " + s.String()) // !!!
-//		case fgg.Sig:
-//			ss_fgr[i+1] = oblitSig(s)
-//		}
-//	}
-//	return NewITypeLit(t, ss_fgr)
-//}
+// A Rep field for each type formal, then the erased fields
+func oblitSTypeLit(d fgg.TypeDecl, s fgg.STypeLit) STypeLit {
+	t := Type(d.GetName())
+	psi := d.GetBigPsi()
+	tfs := psi.GetTFormals()
+	fds_fgg := s.GetFieldDecls()
+	fds_fgr := make([]FieldDecl, len(tfs)+len(fds_fgg))
+	for i := 0; i < len(tfs); i++ {
+		fds_fgr[i] = NewFieldDecl(tfs[i].GetTParam().String(), RepType)
+	}
+	delta := psi.ToDelta()
+	for i := 0; i < len(fds_fgg); i++ {
+		fd_fgg := fds_fgg[i]
+		erased := toFgrTypeFromBounds(delta, fd_fgg.GetType())
+		fds_fgr[len(tfs)+i] = NewFieldDecl(fd_fgg.GetName(), erased)
+	}
+	return NewSTypeLit(t, fds_fgr)
+}
+
+// func (x0 t_S) getRep() Rep { return t_S[[x0.a1, ..., x0.an]] }
+func mkGetRep(d fgg.TypeDecl) MDecl {
+	t_S := d.GetName()
+	recv_getRep := NewParamDecl("x0", Type(t_S)) // TODO: factor out constant
+	tfs := d.GetBigPsi().GetTFormals()
+	es := make([]FGRExpr, len(tfs))
+	for i := 0; i < len(es); i++ {
+		es[i] = NewSelect(NewVariable("x0"), tfs[i].GetTParam().String())
+	}
+	e_getRep := TRep{t_S, es} // TODO: New constructor
+	return NewMDecl(recv_getRep, GET_REP, []ParamDecl{}, RepType, e_getRep)
+}
+
+// Every interface embeds HasRep.  Type elements (type lists, unions,
+// comparable) are dropped: they only constrain type args, which are checked
+// in FGG (and are only reps in FGR)
+func oblitITypeLit(ds_fgg []Decl, d fgg.TypeDecl, c fgg.ITypeLit) ITypeLit {
+	t := Type(d.GetName())
+	ss_fgg := c.GetSpecs()
+	ss_fgr := []Spec{Type(HAS_REP)}
+	for _, s_fgg := range ss_fgg {
+		switch s := s_fgg.(type) {
+		case fgg.TNamed:
+			if fgg.IsEmbedding(ds_fgg, s) { // o/w a single-term union
+				ss_fgr = append(ss_fgr, Type(s.GetName()))
+			}
+		case fgg.Sig:
+			ss_fgr = append(ss_fgr, oblitSig(s))
+		}
+	}
+	return NewITypeLit(t, ss_fgr)
+}
 
 func oblitSig(g_fgg fgg.Sig) Sig {
 	m := g_fgg.GetMethod()
@@ -183,6 +207,33 @@ func oblitMDecl(ds_fgg []Decl, d fgg.MethDecl) MDecl {
 	return NewMDecl(recv_fgr, m /*rds,*/, pds_fgr, t_fgr, e_fgr)
 }
 
+// Cf. oblitMDecl -- the receiver is the dummy FUNCS struct
+func oblitFuncDecl(ds_fgg []Decl, d fgg.FuncDecl) MDecl {
+	recv_fgr := NewParamDecl(FUNCS_RECV, Type(FUNCS))
+	tfs := d.GetFDeclPsi().GetTFormals()
+	pds_fgg := d.GetParamDecls()
+	pds_fgr := make([]ParamDecl, len(tfs)+len(pds_fgg))
+	for i := 0; i < len(tfs); i++ {
+		pds_fgr[i] = NewParamDecl(tfs[i].GetTParam().String(), RepType)
+	}
+	delta := d.GetFDeclPsi().ToDelta()
+	subs := make(map[Variable]FGRExpr)
+	v_recv := NewVariable(FUNCS_RECV)
+	subs[v_recv] = v_recv
+	gamma := make(fgg.Gamma)
+	for i := 0; i < len(pds_fgg); i++ {
+		pd := pds_fgg[i]
+		x := pd.GetName()
+		pds_fgr[len(tfs)+i] = NewParamDecl(x, Type(HAS_REP))
+		v := NewVariable(x)
+		subs[v] = NewSynthAssert(v, toFgrTypeFromBounds(delta, pd.GetType()))
+		gamma[x] = pd.GetType()
+	}
+	e_fgr := oblitExpr(ds_fgg, delta, gamma, d.GetBody())
+	e_fgr = e_fgr.Subs(subs)
+	return NewMDecl(recv_fgr, d.GetName(), pds_fgr, Type(HAS_REP), e_fgr)
+}
+
 /* Obliterate Expr */
 
 func oblitExpr(ds_fgg []Decl, delta fgg.Delta, gamma fgg.Gamma, e_fgg fgg.FGGExpr) FGRExpr {
@@ -190,7 +241,7 @@ func oblitExpr(ds_fgg []Decl, delta fgg.Delta, gamma fgg.Gamma, e_fgg fgg.FGGExp
 	case fgg.Variable:
 		return NewVariable(e.GetName())
 	case fgg.StructLit:
-		u := e.GetNamedType()
+		u := e.GetType().(fgg.TNamed)
 		t := Type(u.GetName())
 		us := u.GetTArgs()
 		es_fgg := e.GetElems()
@@ -206,7 +257,7 @@ func oblitExpr(ds_fgg []Decl, delta fgg.Delta, gamma fgg.Gamma, e_fgg fgg.FGGExp
 		e_fgg := e.GetExpr() // Shadows original e_fgg
 		e_fgr := oblitExpr(ds_fgg, delta, gamma, e_fgg)
 		f := e.GetField()
-		u, _ := e_fgg.Typing(ds_fgg, delta, gamma, true)//.(fgg.TNamed)
+		u, _ := e_fgg.Typing(ds_fgg, delta, gamma, true) //.(fgg.TNamed)
 		fds_fgg := fgg.Fields(ds_fgg, u.(fgg.TNamed))
 		var u_f fgg.Type = nil
 		for _, fd_fgg := range fds_fgg {
@@ -256,6 +307,19 @@ func oblitExpr(ds_fgg []Decl, delta fgg.Delta, gamma fgg.Gamma, e_fgg fgg.FGGExp
 		res = NewCall(e_fgr, m, es_fgr)
 		res = NewSynthAssert(res, t_ret)
 		return res
+	case fgg.FuncCall:
+		targs := e.GetTArgs()
+		es_fgg := e.GetArgs()
+		es_fgr := make([]FGRExpr, len(targs)+len(es_fgg))
+		for i := 0; i < len(targs); i++ {
+			es_fgr[i] = mkRep_oblit(targs[i])
+		}
+		for i := 0; i < len(es_fgg); i++ {
+			es_fgr[len(targs)+i] = oblitExpr(ds_fgg, delta, gamma, es_fgg[i])
+		}
+		u_ret, _ := e.Typing(ds_fgg, delta, gamma, true)
+		recv := NewStructLit(Type(FUNCS), []FGRExpr{})
+		return NewSynthAssert(NewCall(recv, e.GetFunc(), es_fgr), toFgrTypeFromBounds(delta, u_ret))
 	case fgg.Assert:
 		x := Variable{"_x" + strconv.Itoa(nextLetIndex())}
 		eX := oblitExpr(ds_fgg, delta, gamma, e.GetExpr())
@@ -265,8 +329,94 @@ func oblitExpr(ds_fgg []Decl, delta fgg.Delta, gamma fgg.Gamma, e_fgg fgg.FGGExp
 		pFgg := fgg.NewProgram(ds_fgg, fgg.NewVariable(fgg.Name("dummy")), false)
 		cond := IfThenElse{e1, mkRep_oblit(u), e3, pFgg.String()} // TODO: New constructor
 		return Let{x, eX, cond}
+	case fgg.Let:
+		eX := oblitExpr(ds_fgg, delta, gamma, e.GetDef())
+		u := e.GetType()
+		if u == nil {
+			u, _ = e.GetDef().Typing(ds_fgg, delta, gamma, true)
+		}
+		gamma1 := make(fgg.Gamma)
+		for k, v := range gamma {
+			gamma1[k] = v
+		}
+		gamma1[e.GetVar()] = u
+		return Let{NewVariable(e.GetVar()), eX, oblitExpr(ds_fgg, delta, gamma1, e.GetBody())}
+	case fgg.Panic:
+		// An FGR panic has no argument (cf. Panic), so the panic argument is
+		// dropped -- though still evaluated first, if not already a value
+		p := Panic{toFgrTypeFromBounds(delta, e.GetType())}
+		if e.GetArg().IsValue() {
+			return p
+		}
+		x := Variable{"_x" + strconv.Itoa(nextLetIndex())}
+		return Let{x, oblitExpr(ds_fgg, delta, gamma, e.GetArg()), p}
+	case fgg.PrimitiveLiteral:
+		return NewPrimitiveValue(e)
+	case fgg.TypedPrimitiveValue:
+		return NewPrimitiveValue(e)
+	case fgg.UnaryOperation:
+		return NewUnaryOperation(oblitExpr(ds_fgg, delta, gamma, e.GetExpr()), e.GetOp())
+	case fgg.BinaryOperation:
+		left := oblitExpr(ds_fgg, delta, gamma, e.GetLeft())
+		return NewBinaryOperation(left, oblitExpr(ds_fgg, delta, gamma, e.GetRight()), e.GetOp())
+	case fgg.Comparison:
+		left := oblitExpr(ds_fgg, delta, gamma, e.GetLeft())
+		return NewBinaryOperation(left, oblitExpr(ds_fgg, delta, gamma, e.GetRight()), e.GetOp())
+	case fgg.Convert:
+		// The target is represented at run time, as for an Assert -- only
+		// primitive types, or type params (cf. fgg.validConversion)
+		u := e.GetType()
+		switch u.(type) {
+		case fgg.TPrimitive, fgg.TParam:
+			e1 := oblitExpr(ds_fgg, delta, gamma, e.GetExpr())
+			return NewConvert(toFgrTypeFromBounds(delta, u), mkRep_oblit(u), e1)
+		default:
+			panic(base.NewDiagnostic(base.DIAG_UNSUPPORTED, e,
+				"Conversion not supported by obliteration: "+e.String()))
+		}
+	case fgg.Cond:
+		u, _ := e.Typing(ds_fgg, delta, gamma, true) // The declared return type, or the join of the branches
+		cond := oblitExpr(ds_fgg, delta, gamma, e.GetCond())
+		e_then := oblitExpr(ds_fgg, delta, gamma, e.GetThen())
+		e_else := oblitExpr(ds_fgg, delta, gamma, e.GetElse())
+		return NewCond(cond, e_then, e_else, toFgrTypeFromBounds(delta, u))
+	case fgg.FuncLit, fgg.Apply:
+		// FGR has no function types (cf. toFgrTypeFromBounds)
+		panic(base.NewDiagnostic(base.DIAG_UNSUPPORTED, e_fgg,
+			"Function values not supported by obliteration: "+e_fgg.String()))
+	case fgg.SliceLit, fgg.Index, fgg.Len, fgg.Append:
+		// FGR has no slice types (cf. toFgrTypeFromBounds)
+		panic(base.NewDiagnostic(base.DIAG_UNSUPPORTED, e_fgg,
+			"Slices not supported by obliteration: "+e_fgg.String()))
+	case fgg.CommaOk:
+		a, ok := e.GetDef().(fgg.Assert)
+		if !ok {
+			panic(base.NewDiagnostic(base.DIAG_UNSUPPORTED, e_fgg,
+				"Maps not supported by obliteration: "+e_fgg.String()))
+		}
+		// Cf. Assert, but a failed rep check binds the zero value and false
+		eX := oblitExpr(ds_fgg, delta, gamma, a.GetExpr())
+		u := a.GetType()
+		gamma1 := make(fgg.Gamma)
+		for k, v := range gamma {
+			gamma1[k] = v
+		}
+		gamma1[e.GetVar()] = u
+		gamma1[e.GetOkVar()] = fgg.NewTPrimitive(fgg.BOOL)
+		e_body := oblitExpr(ds_fgg, delta, gamma1, e.GetBody())
+		pFgg := fgg.NewProgram(ds_fgg, fgg.NewVariable(fgg.Name("dummy")), false)
+		return NewCommaOk(NewVariable(e.GetVar()), NewVariable(e.GetOkVar()), eX,
+			mkRep_oblit(u), toFgrTypeFromBounds(delta, u), e_body, pFgg.String())
+	case fgg.MapLit, fgg.MapAssign:
+		panic(base.NewDiagnostic(base.DIAG_UNSUPPORTED, e_fgg,
+			"Maps not supported by obliteration: "+e_fgg.String()))
+	case fgg.TypeSwitch:
+		// FGR's IfThenElse has no else branch (a failed rep check panics), so no fall through to the next case
+		panic(base.NewDiagnostic(base.DIAG_UNSUPPORTED, e_fgg,
+			"Type switches not supported by obliteration: "+e_fgg.String()))
 	default:
-		panic("Unknown FGG Expr type: " + e_fgg.String())
+		panic(base.NewDiagnostic(base.DIAG_UNSUPPORTED, e_fgg,
+			"Expression not supported by obliteration: "+e_fgg.String()))
 	}
 }
 
@@ -274,7 +424,17 @@ func oblitExpr(ds_fgg []Decl, delta fgg.Delta, gamma fgg.Gamma, e_fgg fgg.FGGExp
 
 // i.e., "erase" -- cf. oblit
 func toFgrTypeFromBounds(delta fgg.Delta, u fgg.Type) Type {
-	return Type(fgg.Bounds(delta, u).(fgg.TNamed).GetName()) // !!! cf. STRING_TYPE hack, TParam
+	switch u_B := fgg.Bounds(delta, u).(type) {
+	case fgg.TNamed:
+		return Type(u_B.GetName())
+	case fgg.TPrimitive:
+		return Type(u_B.String())
+	case fgg.UndefTPrimitive: // The default type, cf. primitiveType
+		return Type(fgg.NameFromTag(u_B.Tag()))
+	default:
+		panic(base.NewDiagnostic(base.DIAG_UNSUPPORTED, u,
+			"Type not supported by obliteration: "+u.String()))
+	}
 }
 
 // TODO: check where dtype should be used in wrapper translation -- and add unit tests (when return type is type param, don't want the FGG type arg, which may be struct; want the FGR target decl type as the wrapper target)
@@ -283,8 +443,8 @@ func dtype(ds []Decl, delta fgg.Delta, gamma fgg.Gamma, d fgg.FGGExpr) fgg.Type
 	case fgg.Variable:
 		return gamma[e.GetName()]
 	case fgg.StructLit:
-		t_S := e.GetNamedType().GetName()
-		td := fgg.GetTDecl(ds, t_S)//.(fgg.STypeLit)
+		t_S := e.GetType().(fgg.TNamed).GetName()
+		td := fgg.GetTDecl(ds, t_S) //.(fgg.STypeLit)
 		tfs := td.GetBigPsi().GetTFormals()
 		us := make([]fgg.Type, len(tfs))
 		for i := 0; i < len(us); i++ {
@@ -305,11 +465,13 @@ func dtype(ds []Decl, delta fgg.Delta, gamma fgg.Gamma, d fgg.FGGExpr) fgg.Type
 		u := fgg.Bounds(delta, dtype(ds, delta, gamma, e.GetRecv()))
 		g := fgg.Methods(ds, u)[e.GetMethod()]
 		return g.GetReturn()
+	case fgg.FuncCall:
+		return fgg.GetFuncDecl(ds, e.GetFunc()).GetReturn()
 	case fgg.Assert:
 		return e.GetType()
 	default:
-		panic("Unknown FGG expr kind: " + reflect.TypeOf(e).String() + "\n\t" +
-			e.String())
+		panic(base.NewDiagnostic(base.DIAG_UNSUPPORTED, e,
+			"Expression not supported by obliteration: "+e.String()))
 	}
 }
 
@@ -322,12 +484,14 @@ func mkRep_oblit(u fgg.Type) FGRExpr { // Duplicated from fgr_translation
 		us := u1.GetTArgs()
 		es := make([]FGRExpr, len(us))
 		for i := 0; i < len(us); i++ {
-			es[i] = mkRep(us[i])
+			es[i] = mkRep_oblit(us[i])
 		}
 		return TRep{u1.GetName(), es}
+	case fgg.TPrimitive:
+		return TRep{u1.String(), []FGRExpr{}}
 	default:
-		panic("Unknown fgg.Type kind " + reflect.TypeOf(u).String() +
-			": " + u.String())
+		panic(base.NewDiagnostic(base.DIAG_UNSUPPORTED, u,
+			"Type not supported by obliteration: "+u.String()))
 	}
 }
 
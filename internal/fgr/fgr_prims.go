@@ -0,0 +1,376 @@
+package fgr
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/rhu1/fgg/internal/base"
+	"github.com/rhu1/fgg/internal/fgg"
+)
+
+/* "Exported" constructors for fgg (translation) */
+
+func NewPrimitiveValue(v fgg.PrimtValue) PrimitiveValue           { return PrimitiveValue{v} }
+func NewUnaryOperation(e FGRExpr, op fgg.Operator) UnaryOperation { return UnaryOperation{e, op} }
+func NewConvert(t Type, r FGRExpr, e FGRExpr) Convert             { return Convert{t, r, e} }
+
+func NewBinaryOperation(left, right FGRExpr, op fgg.Operator) BinaryOperation {
+	return BinaryOperation{left, right, op}
+}
+
+/* Primitive types */
+
+// The predeclared primitive types have no decls -- like a struct type, a
+// primitive type is a concrete type, and its only method is getRep (cf.
+// methods).  (Named primitive types are not supported, cf. oblitTDecl.)
+func isPrimitiveType(t Type) bool {
+	_, ok := fgg.NamesToTags[string(t)]
+	return ok
+}
+
+// The type of an untyped constant is its default type, cf. an untyped
+// constant assigned to an interface in Go
+func primitiveType(v fgg.PrimtValue) Type {
+	u, _ := v.Typing(nil, make(fgg.Delta), make(fgg.Gamma), true)
+	if u, ok := u.(fgg.UndefTPrimitive); ok {
+		return Type(fgg.NameFromTag(u.Tag()))
+	}
+	return Type(u.String())
+}
+
+/* PrimitiveValue */
+
+// A (typed or untyped) FGG primitive value -- FGR borrows the primitive
+// values of FGG, and evaluates primitive operations by FGG, cf. evalPrimitive
+type PrimitiveValue struct {
+	v fgg.PrimtValue
+}
+
+var _ FGRExpr = PrimitiveValue{}
+
+func (p PrimitiveValue) GetValue() fgg.PrimtValue { return p.v }
+
+func (p PrimitiveValue) Subs(subs map[Variable]FGRExpr) FGRExpr {
+	return p
+}
+
+func (p PrimitiveValue) Eval(ds []Decl) (FGRExpr, string) {
+	panic("Cannot reduce: " + p.String())
+}
+
+func (p PrimitiveValue) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
+	return primitiveType(p.v)
+}
+
+func (p PrimitiveValue) DropSynthAsserts(ds []Decl) FGRExpr {
+	return p
+}
+
+// From base.Expr
+func (p PrimitiveValue) IsValue() bool {
+	return true
+}
+
+func (p PrimitiveValue) IsPanic() bool {
+	return false
+}
+
+func (p PrimitiveValue) CanEval(ds []Decl) bool {
+	return false
+}
+
+func (p PrimitiveValue) String() string {
+	return p.v.String()
+}
+
+func (p PrimitiveValue) ToGoString(ds []Decl) string {
+	return p.v.ToGoString(ds)
+}
+
+/* UnaryOperation */
+
+// Logical not and numeric negation, cf. fgg.UnaryOperation
+type UnaryOperation struct {
+	e  FGRExpr
+	op fgg.Operator
+}
+
+var _ FGRExpr = UnaryOperation{}
+
+func (u UnaryOperation) GetExpr() FGRExpr    { return u.e }
+func (u UnaryOperation) GetOp() fgg.Operator { return u.op }
+
+func (u UnaryOperation) Subs(subs map[Variable]FGRExpr) FGRExpr {
+	return UnaryOperation{u.e.Subs(subs), u.op}
+}
+
+func (u UnaryOperation) Eval(ds []Decl) (FGRExpr, string) {
+	if !u.e.IsValue() {
+		e, rule := u.e.Eval(ds)
+		return UnaryOperation{e, u.op}, rule
+	}
+	return evalPrimitive(ds, u, fgg.NewUnaryOp(u.e.(PrimitiveValue).v, u.op))
+}
+
+// The operand is checked by FGG (cf. fgg.UnaryOperation.Typing) -- here, it
+// may be of an (erased) type param type, i.e., an interface type
+func (u UnaryOperation) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
+	t := u.e.Typing(ds, gamma, allowStupid)
+	if isStructType(ds, t) {
+		panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, u,
+			"Operator "+string(u.op)+" not defined for struct type: "+t.String()))
+	}
+	return t
+}
+
+func (u UnaryOperation) DropSynthAsserts(ds []Decl) FGRExpr {
+	return UnaryOperation{u.e.DropSynthAsserts(ds), u.op}
+}
+
+// From base.Expr
+func (u UnaryOperation) IsValue() bool {
+	return false
+}
+
+func (u UnaryOperation) IsPanic() bool {
+	return false
+}
+
+func (u UnaryOperation) CanEval(ds []Decl) bool {
+	return u.e.IsValue() || u.e.CanEval(ds)
+}
+
+func (u UnaryOperation) String() string {
+	return string(u.op) + u.e.String()
+}
+
+func (u UnaryOperation) ToGoString(ds []Decl) string {
+	return string(u.op) + u.e.ToGoString(ds)
+}
+
+/* BinaryOperation */
+
+// Arithmetic, logical and relational operations, cf. fgg.BinaryOperation and
+// fgg.Comparison -- == and != are evaluated by FGR, as the operands may be
+// struct values (cf. valueEquals), the others by FGG
+type BinaryOperation struct {
+	left, right FGRExpr
+	op          fgg.Operator
+}
+
+var _ FGRExpr = BinaryOperation{}
+
+func (b BinaryOperation) GetLeft() FGRExpr    { return b.left }
+func (b BinaryOperation) GetRight() FGRExpr   { return b.right }
+func (b BinaryOperation) GetOp() fgg.Operator { return b.op }
+
+func (b BinaryOperation) Subs(subs map[Variable]FGRExpr) FGRExpr {
+	return BinaryOperation{b.left.Subs(subs), b.right.Subs(subs), b.op}
+}
+
+func (b BinaryOperation) Eval(ds []Decl) (FGRExpr, string) {
+	if !b.left.IsValue() {
+		e, rule := b.left.Eval(ds)
+		return BinaryOperation{e, b.right, b.op}, rule
+	}
+	if !b.right.IsValue() {
+		e, rule := b.right.Eval(ds)
+		return BinaryOperation{b.left, e, b.op}, rule
+	}
+	if b.op == fgg.EQL || b.op == fgg.NEQ {
+		res := valueEquals(b.left, b.right) == (b.op == fgg.EQL)
+		return PrimitiveValue{fgg.NewBoolLit(strconv.FormatBool(res))}, fgg.OpToRule[b.op] // An untyped bool, cf. fgg.Comparison
+	}
+	left := b.left.(PrimitiveValue).v
+	right := b.right.(PrimitiveValue).v
+	return evalPrimitive(ds, b, fgg.NewBinaryOp(left, right, b.op))
+}
+
+// Cf. UnaryOperation.Typing -- the result of a comparison is a bool, else
+// the more general of the operand types
+func (b BinaryOperation) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
+	t_left := b.left.Typing(ds, gamma, allowStupid)
+	t_right := b.right.Typing(ds, gamma, allowStupid)
+	switch b.op {
+	case fgg.EQL, fgg.NEQ, fgg.GT, fgg.LT, fgg.GEQ, fgg.LEQ:
+		return Type(fgg.NameFromTag(fgg.BOOL))
+	}
+	for _, t := range []Type{t_left, t_right} {
+		if isStructType(ds, t) {
+			panic(base.NewDiagnostic(base.DIAG_BAD_OPERATION, b,
+				"Operator "+string(b.op)+" not defined for struct type: "+t.String()))
+		}
+	}
+	if t_left.AssignableTo(ds, t_right) {
+		return t_right
+	}
+	return t_left
+}
+
+func (b BinaryOperation) DropSynthAsserts(ds []Decl) FGRExpr {
+	return BinaryOperation{b.left.DropSynthAsserts(ds), b.right.DropSynthAsserts(ds), b.op}
+}
+
+// From base.Expr
+func (b BinaryOperation) IsValue() bool {
+	return false
+}
+
+func (b BinaryOperation) IsPanic() bool {
+	return false
+}
+
+func (b BinaryOperation) CanEval(ds []Decl) bool {
+	leftOk := b.left.IsValue() || b.left.CanEval(ds)
+	rightOk := b.right.IsValue() || b.right.CanEval(ds)
+	return leftOk && rightOk
+}
+
+func (b BinaryOperation) String() string {
+	var sb strings.Builder
+	sb.WriteString(b.left.String())
+	sb.WriteString(" ")
+	sb.WriteString(string(b.op))
+	sb.WriteString(" ")
+	sb.WriteString(b.right.String())
+	return sb.String()
+}
+
+func (b BinaryOperation) ToGoString(ds []Decl) string {
+	var sb strings.Builder
+	sb.WriteString(b.left.ToGoString(ds))
+	sb.WriteString(" ")
+	sb.WriteString(string(b.op))
+	sb.WriteString(" ")
+	sb.WriteString(b.right.ToGoString(ds))
+	return sb.String()
+}
+
+/* Convert */
+
+// A conversion to a primitive type, or to a type param -- i.e., to the type
+// represented by r, cf. fgg.Convert.  t is the (erased) type of the result.
+type Convert struct {
+	t Type
+	r FGRExpr // TRep, or an expr of type Rep (e.g., a Variable, for a type param)
+	e FGRExpr
+}
+
+var _ FGRExpr = Convert{}
+
+func (c Convert) GetType() Type    { return c.t }
+func (c Convert) GetRep() FGRExpr  { return c.r }
+func (c Convert) GetExpr() FGRExpr { return c.e }
+
+func (c Convert) Subs(subs map[Variable]FGRExpr) FGRExpr {
+	return Convert{c.t, c.r.Subs(subs), c.e.Subs(subs)}
+}
+
+func (c Convert) Eval(ds []Decl) (FGRExpr, string) {
+	if !c.r.IsValue() {
+		r, rule := c.r.Eval(ds)
+		return Convert{c.t, r, c.e}, rule
+	}
+	if !c.e.IsValue() {
+		e, rule := c.e.Eval(ds)
+		return Convert{c.t, c.r, e}, rule
+	}
+	v, ok := c.e.(PrimitiveValue)
+	if !ok { // A struct value converted to a type param: FGG only allows the same (underlying) type, cf. fgg.validConversion
+		return c.e, "Convert"
+	}
+	res, rule := fgg.NewConvert(c.r.(TRep).Reify(), v.v).Eval(nil) // Primitive types are predeclared, so no FGG decls needed
+	return PrimitiveValue{res.(fgg.PrimtValue)}, rule
+}
+
+// The conversion itself is checked by FGG, cf. fgg.Convert.Typing
+func (c Convert) Typing(ds []Decl, gamma Gamma, allowStupid bool) Type {
+	if t := c.r.Typing(ds, gamma, allowStupid); t != RepType {
+		panic(base.NewDiagnostic(base.DIAG_NOT_ASSIGNABLE, c,
+			"Conversion rep must be of type "+RepType.String()+": found "+t.String()))
+	}
+	c.e.Typing(ds, gamma, allowStupid)
+	return c.t
+}
+
+func (c Convert) DropSynthAsserts(ds []Decl) FGRExpr {
+	return Convert{c.t, c.r.DropSynthAsserts(ds), c.e.DropSynthAsserts(ds)}
+}
+
+// From base.Expr
+func (c Convert) IsValue() bool {
+	return false
+}
+
+func (c Convert) IsPanic() bool {
+	return false
+}
+
+func (c Convert) CanEval(ds []Decl) bool {
+	if c.r.CanEval(ds) {
+		return true
+	} else if !c.r.IsValue() {
+		return false
+	}
+	return c.e.IsValue() || c.e.CanEval(ds)
+}
+
+func (c Convert) String() string {
+	var b strings.Builder
+	b.WriteString(c.r.String())
+	b.WriteString("(")
+	b.WriteString(c.e.String())
+	b.WriteString(")")
+	return b.String()
+}
+
+func (c Convert) ToGoString(ds []Decl) string {
+	var b strings.Builder
+	b.WriteString(c.r.ToGoString(ds))
+	b.WriteString("(")
+	b.WriteString(c.e.ToGoString(ds))
+	b.WriteString(")")
+	return b.String()
+}
+
+/* Aux */
+
+// Evaluates e_fgg, the FGG counterpart of e (an operation on primitive
+// values), by one step -- an FGG run-time panic, e.g., an integer division
+// by zero, is a panic of e
+func evalPrimitive(ds []Decl, e FGRExpr, e_fgg fgg.FGGExpr) (FGRExpr, string) {
+	v, rule := e_fgg.Eval(nil) // Primitive types are predeclared, so no FGG decls needed
+	if v.IsPanic() {
+		return Panic{e.Typing(ds, make(Gamma), true)}, rule
+	}
+	return PrimitiveValue{v.(fgg.PrimtValue)}, rule
+}
+
+// Go == on values, cf. fgg.valueEquals: the same type, and equal payloads or
+// fields (including the rep fields) -- FGR has no uncomparable values
+func valueEquals(v1, v2 FGRExpr) bool {
+	switch v1 := v1.(type) {
+	case StructLit:
+		v2, ok := v2.(StructLit)
+		if !ok || v1.t_S != v2.t_S {
+			return false
+		}
+		for i := 0; i < len(v1.elems); i++ {
+			if !valueEquals(v1.elems[i], v2.elems[i]) {
+				return false
+			}
+		}
+		return true
+	case TRep:
+		v2, ok := v2.(TRep)
+		return ok && v1.String() == v2.String() // N.B. values, i.e., ground
+	case PrimitiveValue:
+		v2, ok := v2.(PrimitiveValue)
+		if !ok {
+			return false
+		}
+		eq, _ := fgg.NewBinaryOp(v1.v, v2.v, fgg.EQL).Eval(nil)
+		return eq.(fgg.PrimtValue).Val().(bool)
+	}
+	panic("Not a value: " + v1.String())
+}
@@ -24,11 +24,6 @@ const (
 	NO_EVAL     = -2 // Must be < EVAL_TO_VAL
 )
 
-var (
-	OblitEvalSteps int
-	Verbose        bool
-)
-
 /* -- Interp */
 
 type Interp interface {
@@ -42,14 +37,21 @@ type Interp interface {
 
 func parse(verbose bool, a base.Adaptor, src string, strict bool) base.Program {
 	VPrintln(verbose, "\nParsing AST:")
-	prog := a.Parse(strict, src) // AST (Program root)
+	prog, errs := a.Parse(strict, src) // AST (Program root)
+	if len(errs) > 0 {
+		for _, e := range errs {
+			fmt.Fprintln(os.Stderr, e)
+		}
+		os.Exit(1)
+	}
 	VPrintln(verbose, prog.String())
 	return prog
 }
 
-// N.B. currently FG panic comes out implicitly as an underlying run-time panic
-// CHECKME: add explicit FG panics?
-// If steps == EVAL_TO_VAL, then Eval to value
+// N.B. a panic result (cf. base.Expr.IsPanic) -- an explicit panic(v), or a
+// run-time error such as a failed assert -- ends evaluation, whether or not
+// steps remain; whereas a stuck term raises a Go panic, "Cannot reduce: ..."
+// If steps == EVAL_TO_VAL, then Eval to value (or panic)
 // Post: intrp.GetProgram() contains the Eval result; result type is returned
 func Eval(intrp Interp, steps int) base.Type {
 	if steps < NO_EVAL {
@@ -73,27 +75,31 @@ func Eval(intrp Interp, steps int) base.Type {
 	p := p_init
 	t := t_init
 
-	for i := 1; i <= steps || !done; i++ {
+	for i := 1; (i <= steps || !done) && !p.GetMain().IsPanic(); i++ {
 		p, rule = p.Eval()
 		intrp.SetProgram(p)
 		intrp.VPrintln(fmt.Sprintf("%6d: %8s %v", i, "["+rule+"]", p.GetMain()))
 		intrp.VPrint("Checking OK:") // TODO: maybe disable by default, enable by flag
 		t, p = p.Ok(allowStupid, base.CHECK)
 		intrp.VPrintln(" " + t.String())
-		if !assignableTo(ds, t, t_init) { // Check type preservation
+		if !AssignableTo(ds, t, t_init) { // Check type preservation
 			panic("Type not preserved by evaluation.")
 		}
-		if !done && p.GetMain().IsValue() { // N.B. IsValue, not CanEval -- bad asserts panics, like Go (but not actual FGG)
+		if !done && p.GetMain().IsValue() { // N.B. IsValue, not CanEval -- a panic ends the loop (cf. loop guard)
 			done = true
 		}
 	}
-	intrp.VPrintln(p.GetMain().String()) // Final result  // CHECKME: check prog.printf, for ToGoString?
+	if p.GetMain().IsPanic() {
+		intrp.VPrintln("Panicked: " + p.GetMain().String()) // Final result, but not a value
+	} else {
+		intrp.VPrintln(p.GetMain().String()) // Final result  // CHECKME: check prog.printf, for ToGoString?
+	}
 	//return p_res
 	return t
 }
 
 // Just a quick fix; avoid having casts all over the place in fg/fgg/fgr-specific code
-func assignableTo(ds []base.Decl, t0, t base.Type ) bool {
+func AssignableTo(ds []base.Decl, t0, t base.Type ) bool {
 	switch t0 := t0.(type) {
 	case fg.Type:
 		ok, _ := t0.AssignableTo(ds, t.(fg.Type))
@@ -172,7 +178,7 @@ var _ Interp = &FGGInterp{}
 func NewFGGInterp(verbose bool, src string, strict bool) *FGGInterp {
 	var a parser.FGGAdaptor
 	orig := parse(verbose, &a, src, strict).(fgg.FGGProgram)
-	renamed := renameParams(orig)
+	renamed := RenameParams(orig)
 	/*vPrintln(verbose, "\nRenamed method type params:")
 	vPrintln(verbose, renamed.String())*/
 
@@ -193,73 +199,10 @@ func (intrp *FGGInterp) Eval(steps int) base.Type {
 	return Eval(intrp, steps)
 }
 
-// Pre: (monom == true || compile != "") => -fgg is set
-// rename
-func (intrp *FGGInterp) Monom(monom bool, compile string) {
-	if !monom && compile == "" {
-		return
-	}
-
-	p_fgg := intrp.GetSource().(fgg.FGGProgram)
-
-	if ok, msg := fgg.IsMonomOK(intrp.orig); !ok { // nomonom
-		//fmt.Println("\nCannot monomorphise (nomono detected):\n\t" + msg)
-		panic("\nCannot monomorphise (nomono detected):\n\t" + msg)
-	}
-
-	p_mono := fgg.Monomorph(p_fgg)
-	if monom {
-		intrp.VPrintln("\nMonomorphising:")
-		fmt.Println(p_mono.String())
-	}
-	if compile != "" {
-		intrp.VPrintln("\nMonomorphising:")
-		out := monomOutputHack(p_mono.String())
-		if compile == "--" {
-			fmt.Println(out)
-		} else {
-			intrp.VPrintln(out)
-			intrp.VPrintln("Writing output to: " + compile)
-			bs := []byte(out)
-			err := ioutil.WriteFile(compile, bs, 0644)
-			CheckErr(err)
-		}
-	}
-}
-
-// WIP
-func (intrp *FGGInterp) Oblit(compile string) {
-	if compile == "" {
-		return
-	}
-	intrp.VPrintln("\nTranslating FGG to FG(R) using Obliteration: [Warning] WIP [Warning]")
-	p_fgr := fgr.Obliterate(intrp.GetSource().(fgg.FGGProgram))
-	out := p_fgr.String()
-	// TODO: factor out with -monomc
-	if compile == "--" {
-		fmt.Println(out)
-	} else {
-		intrp.VPrintln(out)
-		intrp.VPrintln("Writing output to: " + compile)
-		bs := []byte(out)
-		err := ioutil.WriteFile(compile, bs, 0644)
-		CheckErr(err)
-	}
-
-	// cf. interp -- TODO: factor out with others
-	p_fgr.Ok(false, base.CHECK)
-	if OblitEvalSteps > NO_EVAL {
-		/*intrp.VPrint("\nEvaluating FGR:") // eval prints a leading "\n"
-		intrp_fgr := NewFGRInterp(Verbose, p_fgr)
-		intrp_fgr.Eval(OblitEvalSteps)
-		fmt.Println(intrp_fgr.GetProgram().GetMain())*/
-		Eval(NewFGRInterp(true, p_fgr), OblitEvalSteps)
-	}
-}
-
 /* Type param renaming */
 
-func renameParams(p fgg.FGGProgram) fgg.FGGProgram {
+// Renames the method type params of each decl to β1, β2, ... (as done before checking)
+func RenameParams(p fgg.FGGProgram) fgg.FGGProgram {
 	ds := make([]base.Decl, len(p.GetDecls()))
 	for i, v := range p.GetDecls() {
 		ds[i] = renameParamsDecl(v.(fgg.Decl))
@@ -273,6 +216,8 @@ func renameParamsDecl(p fgg.Decl) fgg.Decl {
 		return renameParamsTypeDecl(d)
 	case fgg.MethDecl:
 		return renameParamsMethDecl(d)
+	case fgg.FuncDecl:
+		return d // No receiver type params to be captured
 	default:
 		panic("Unknown Decl type: " + reflect.TypeOf(d).String() +
 			"\n\t" + d.String())
@@ -292,7 +237,7 @@ func renameParamsITypeLit(c fgg.ITypeLit) fgg.ITypeLit {
 	ss := make([]fgg.Spec, len(orig))
 	for i, s1 := range orig {
 		switch s := s1.(type) {
-		case fgg.TNamed:
+		case fgg.TNamed, fgg.Union:
 			ss[i] = s
 		case fgg.Sig:
 			subs := makeParamIndexSubs(s.Psi)
@@ -335,7 +280,8 @@ func makeParamIndexSubs(Psi fgg.BigPsi) fgg.EtaOpen {
 
 /* Aux */
 
-func monomOutputHack(out string) string {
+// Makes the output of monomorphisation Go-compatible (no angle bracks, etc.)
+func MonomOutputHack(out string) string {
 	// TODO: refactor -- cf. fgg_monom, toMonomId
 	out = strings.Replace(out, ",,", "ᐨ", -1) // U+1428 Canadian Aboriginal Syllabics Final Short Horizontal Stroke
 	// U+035C Combining Double Breve Below -- CHECKME: doesn't work with ANTLR?
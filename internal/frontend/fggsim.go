@@ -67,7 +67,17 @@ func TestMonom(printf bool, verbose bool, src string, steps int) {
 			panic("Monom is value but FGG is not:\n\tfgg = " + main_fgg.String() +
 				"\n\tmonom=" + main_mono.String())
 		}
-		// Both non-values, check for stuck (e.g., bad asserts -- though panic is technically not stuck)
+		if main_fgg.IsPanic() {
+			if !main_mono.IsPanic() {
+				panic("FGG is panic but monom is not:\n\tfgg = " + main_fgg.String() +
+					"\n\tmonom=" + main_mono.String())
+			}
+			break // Both are panics
+		} else if main_mono.IsPanic() {
+			panic("Monom is panic but FGG is not:\n\tfgg = " + main_fgg.String() +
+				"\n\tmonom=" + main_mono.String())
+		}
+		// Both non-values, check for stuck (N.B. bad asserts reduce to panics, so are not stuck)
 		if main_fgg.CanEval(ds_fgg) {
 			if !main_mono.CanEval(ds_mono) {
 				panic("FGG is stuck but monom is not:\n\tfgg = " + main_fgg.String() +
@@ -78,11 +88,6 @@ func TestMonom(printf bool, verbose bool, src string, steps int) {
 				panic("Monom is stuck but FGG is not:\n\tfgg = " + main_fgg.String() +
 					"\n\tmonom=" + main_mono.String())
 			}
-			if _, ok := main_fgg.(fgg.Assert); ok {
-				if _, ok1 := main_mono.(fg.Assert); ok1 {
-					break // Both stuck on bad assert
-				}
-			}
 		}
 
 		// Repeat: horizontal arrows and right-vertical arrow
@@ -162,7 +167,17 @@ func TestOblit(verbose bool, src string, steps int) {
 			panic("Oblit is value but FGG is not:\n\tfgg = " +
 				mainFgg.String() + "\n\toblit=" + mainOblit.String())
 		}
-		// Both non-values, check for stuck (e.g., bad asserts -- though panic is technically not stuck)
+		if mainFgg.IsPanic() {
+			if !mainOblit.IsPanic() {
+				panic("FGG is panic but oblit is not:\n\tfgg = " +
+					mainFgg.String() + "\n\toblit=" + mainOblit.String())
+			}
+			break // Both are panics
+		} else if mainOblit.IsPanic() {
+			panic("Oblit is panic but FGG is not:\n\tfgg = " +
+				mainFgg.String() + "\n\toblit=" + mainOblit.String())
+		}
+		// Both non-values, check for stuck (N.B. bad asserts reduce to panics, so are not stuck)
 		if mainFgg.CanEval(dsFgg) {
 			if !mainOblit.CanEval(dsOblit) {
 				panic("FGG is stuck but monom is not:\n\tfgg = " +
@@ -173,11 +188,6 @@ func TestOblit(verbose bool, src string, steps int) {
 				panic("Oblit is stuck but FGG is not:\n\tfgg = " +
 					mainFgg.String() + "\n\toblit=" + mainOblit.String())
 			}
-			if _, ok := mainFgg.(fgg.Assert); ok {
-				if _, ok1 := mainOblit.(fg.Assert); ok1 {
-					break // Both stuck on bad assert
-				}
-			}
 		}
 
 		// Repeat: horizontal arrows and right-vertical arrow
@@ -212,7 +222,7 @@ func isFFSilent(ds []base.Decl, e base.Expr) bool {
 		return false
 	case fgr.Select:
 		if e1.CanEval(ds) {
-			e2, _ := e1.Eval(ds)
+			e2, _ := fgr.EvalStep(ds, e1)
 			// N.B. the following overlaps with DropSynthAsserts -- but keep: do FF greedily first, then do DropSynthAsserts at end (o/w may need to interleave)
 			if _, ok := e2.(fgr.TRep); ok { // !!! cf. nomono.fgg
 				return true
@@ -244,7 +254,14 @@ func isFFSilent(ds []base.Decl, e base.Expr) bool {
 		}
 		return isFFSilent(ds, e2)
 	case fgr.IfThenElse:
-		return true
+		// A failed rep check is not silent: it corresponds to a failed FGG assert
+		e2, _ := fgr.EvalStep(ds, e1)
+		_, ok := e2.(fgr.Panic)
+		return !ok
+	case fgr.Cond:
+		return isFFSilent(ds, e1.GetCond())
+	case fgr.CommaOk:
+		return isFFSilentSeq(ds, []fgr.FGRExpr{e1.GetExpr(), e1.GetRep()})
 	case fgr.Let:
 		eX := e1.GetDef()
 		if eX.IsValue() {
@@ -258,11 +275,29 @@ func isFFSilent(ds []base.Decl, e base.Expr) bool {
 			}
 		}
 		return false
-	default: // Variable, TRep
+	case fgr.UnaryOperation:
+		return isFFSilent(ds, e1.GetExpr())
+	case fgr.BinaryOperation:
+		return isFFSilentSeq(ds, []fgr.FGRExpr{e1.GetLeft(), e1.GetRight()})
+	case fgr.Convert:
+		return isFFSilentSeq(ds, []fgr.FGRExpr{e1.GetRep(), e1.GetExpr()})
+	default: // Variable, PrimitiveValue
 		return false
 	}
 }
 
+// Cf. the args of a Call: the first of es that is not a value is evaluated next
+func isFFSilentSeq(ds []base.Decl, es []fgr.FGRExpr) bool {
+	for _, v := range es {
+		if isFFSilent(ds, v) {
+			return true
+		} else if v.CanEval(ds) {
+			return false
+		}
+	}
+	return false
+}
+
 // HERE: refactor to fggsim (or fgrsim?) -- fix -test-oblit in Makefile to use fgrsim -- make mini examples -- check "non-empty typerep" cases for typerep-select dropping
 
 // TODO: factor out with testMonomStep
@@ -7,12 +7,10 @@ package parser
 import (
 	"fmt"
 	"reflect"
-	"strconv"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 
 	"github.com/rhu1/fgg/internal/base"
-	"github.com/rhu1/fgg/internal/base/testutils"
 	"github.com/rhu1/fgg/internal/fg"
 	"github.com/rhu1/fgg/internal/parser/util"
 	"github.com/rhu1/fgg/parser/fg/parser"
@@ -24,7 +22,9 @@ var _ = reflect.Append
 // Convert ANTLR generated CST to an fg.FGNode AST
 type FGAdaptor struct {
 	*parser.BaseFGListener
-	stack []fg.FGNode // Because Listener methods don't return...
+	stack    []fg.FGNode // Because Listener methods don't return...
+	comments []base.Comment
+	funcs    map[fg.Name]bool // Declared function names, cf. ExitConvert
 }
 
 var _ base.Adaptor = &FGAdaptor{}
@@ -35,33 +35,69 @@ func (a *FGAdaptor) push(n fg.FGNode) {
 
 func (a *FGAdaptor) pop() fg.FGNode {
 	if len(a.stack) < 1 {
-		panic(testutils.PARSER_PANIC_PREFIX + "Stack is empty")
+		panic(base.NewDiagnostic(base.DIAG_SYNTAX, nil, "Stack is empty")) // Located by util.Walk
 	}
 	res := a.stack[len(a.stack)-1]
 	a.stack = a.stack[:len(a.stack)-1]
 	return res
 }
 
-// strictParse means panic upon any parsing error -- o/w error recovery is attempted
-func (a *FGAdaptor) Parse(strictParse bool, input string) base.Program {
-	is := antlr.NewInputStream(input)
-	var lexer antlr.Lexer
-	if strictParse { // https://stackoverflow.com/questions/51683104/how-to-catch-minor-errors
-		lexer = FGBailLexer{parser.NewFGLexer(is)} // FIXME: not working -- e.g., incr{1}, bad token
-	} else {
-		lexer = parser.NewFGLexer(is)
+// Locates the node just pushed by the Exit method of ctx, if any.
+// N.B. ExitEveryRule is called after the rule-specific Exit method; a rule that
+// pushes nothing leaves an already located node on top, which is not overwritten.
+func (a *FGAdaptor) ExitEveryRule(ctx antlr.ParserRuleContext) {
+	if len(a.stack) < 1 {
+		return
 	}
+	top := a.stack[len(a.stack)-1]
+	if n, ok := top.(base.Spanned); ok && !n.GetSpan().IsKnown() {
+		a.stack[len(a.stack)-1] = fg.SetSpan(top, util.SpanOf(ctx))
+	}
+}
+
+// strictParse means stop at the first parser error -- o/w error recovery is attempted.
+// Lexer and parser errors, and adaptor errors such as a bad import, are
+// returned (as located base.Diagnostics), not printed; the AST is only built if
+// there are no errors.
+func (a *FGAdaptor) Parse(strictParse bool, input string) (base.Program, []error) {
+	is := antlr.NewInputStream(input)
+	lexer := parser.NewFGLexer(is)
 	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
 	p := parser.NewFGParser(stream)
-	if strictParse {
-		p.RemoveErrorListeners()
-		p.SetErrorHandler(&util.StrictErrorStrategy{})
+	errs := util.NewErrorCollector(lexer, p, strictParse)
+	var tree parser.IProgramContext
+	errs.Run(func() { tree = p.Program() })
+	if len(errs.Errors) > 0 {
+		return nil, errs.Errors
 	}
-	antlr.ParseTreeWalkerDefault.Walk(a, p.Program())
-	return a.pop().(fg.FGProgram)
+	a.funcs = funcNames(tree)
+	if err := util.Walk(a, tree); err != nil { // E.g., a bad import
+		return nil, append(errs.Errors, err)
+	}
+	a.comments = util.Comments(stream)
+	return a.pop().(fg.FGProgram), nil
 }
 
-/* "typ": #TNamed, #TPrimitive, #TypeLit_ */
+// The names of the funcDecls -- a function may be called before its decl
+func funcNames(tree parser.IProgramContext) map[fg.Name]bool {
+	res := make(map[fg.Name]bool)
+	for _, c := range tree.GetChildren() {
+		if ds, ok := c.(*parser.DeclsContext); ok {
+			for _, d := range ds.GetChildren() {
+				if fd, ok := d.(*parser.FuncDeclContext); ok {
+					res[fd.Sig().(*parser.SigContext).GetMeth().GetText()] = true
+				}
+			}
+		}
+	}
+	return res
+}
+
+func (a *FGAdaptor) GetComments() []base.Comment {
+	return a.comments
+}
+
+/* "typ": #TNamed, #TPrimitive, #TypeLit_, #TFunc, #TSlice */
 
 func (a *FGAdaptor) ExitTNamed(ctx *parser.TNamedContext) {
 	tname := fg.NewTNamed(ctx.GetName().GetText())
@@ -73,6 +109,33 @@ func (a *FGAdaptor) ExitTPrimitive(ctx *parser.TPrimitiveContext) {
 	a.push(fg.NewTPrimitive(tag))
 }
 
+// Children: 2=typs (if any)
+func (a *FGAdaptor) ExitTFunc(ctx *parser.TFuncContext) {
+	// Reverse order
+	t := a.pop().(fg.Type)
+	ts := []fg.Type{}
+	if ctx.GetChildCount() > 4 {
+		nts := (ctx.GetChild(2).GetChildCount() + 1) / 2 // e.g., t ',' t ',' t
+		ts = make([]fg.Type, nts)
+		for i := nts - 1; i >= 0; i-- {
+			ts[i] = a.pop().(fg.Type) // Adding backwards
+		}
+	}
+	a.push(fg.NewTFunc(ts, t))
+}
+
+func (a *FGAdaptor) ExitTSlice(ctx *parser.TSliceContext) {
+	t := a.pop().(fg.Type)
+	a.push(fg.NewTSlice(t))
+}
+
+func (a *FGAdaptor) ExitTMap(ctx *parser.TMapContext) {
+	// Reverse order
+	elem := a.pop().(fg.Type)
+	key := a.pop().(fg.Type)
+	a.push(fg.NewTMap(key, elem))
+}
+
 func (a *FGAdaptor) ExitTypeLit_(ctx *parser.TypeLit_Context) {
 	// do nothing -- the struct/interface literal is already at top of a.stack
 	// cf. ExitStructTypeLit
@@ -120,14 +183,18 @@ func (a *FGAdaptor) ExitProgram(ctx *parser.ProgramContext) {
 	if c3_cast, ok := c3.GetPayload().(*antlr.CommonToken); ok &&
 		c3_cast.GetText() == "import" {
 		if pkg := ctx.GetChild(4).GetPayload().(*antlr.CommonToken).GetText(); pkg != "\"fmt\"" { // TODO: refactor
-			panic(testutils.PARSER_PANIC_PREFIX + "The only allowed import is \"fmt\"; found: " + pkg)
+			span := util.TokenSpan(ctx.IMPORT().GetSymbol(), ctx.STRING_LIT().GetSymbol())
+			panic(base.Diagnostic{Kind: base.DIAG_SYNTAX,
+				Message: "The only allowed import is \"fmt\"; found: " + pkg, Span: span})
 		}
 		offset = 3
 		if cast, ok := foo.(*antlr.CommonToken); !ok || cast.GetText() != "=" { // Looking for: _ = ...
 			printf = true
 		}
 	} else if cast, ok := foo.(*antlr.CommonToken); !ok || cast.GetText() != "=" {
-		panic(testutils.PARSER_PANIC_PREFIX + "Missing \"import fmt;\".")
+		span := util.TokenSpan(ctx.FMT().GetSymbol(), ctx.PRINTF().GetSymbol())
+		panic(base.Diagnostic{Kind: base.DIAG_SYNTAX, Message: "Missing \"import fmt;\".",
+			Span: span})
 	}
 	bar := ctx.GetChild(offset + 3)                                   // Check if this child is "func", i.e., no decls
 	if _, ok := bar.GetPayload().(*antlr.BaseParserRuleContext); ok { // If "func", then *antlr.CommonToken
@@ -152,9 +219,13 @@ func (a *FGAdaptor) ExitTypeDecl(ctx *parser.TypeDeclContext) {
 /* "fieldDecls", "fieldDecl" */
 
 func (a *FGAdaptor) ExitFieldDecl(ctx *parser.FieldDeclContext) {
-	f := fg.Name(ctx.GetField().GetText())
 	//t := fg.Type(ctx.GetTyp().GetText())
 	t := a.pop().(fg.Type)
+	if ctx.GetField() == nil { // Embedded field, named by its type name
+		a.push(fg.NewEmbeddedFieldDecl(fg.Name(t.String()), t))
+		return
+	}
+	f := fg.Name(ctx.GetField().GetText())
 	a.push(fg.NewFieldDecl(f, t))
 }
 
@@ -165,9 +236,85 @@ func (a *FGAdaptor) ExitMethDecl(ctx *parser.MethDeclContext) {
 	e := a.pop().(fg.FGExpr)
 	g := a.pop().(fg.Sig)
 	recv := a.pop().(fg.ParamDecl)
+	e = fg.SetBodyType(e, g.GetReturn())
 	a.push(fg.NewMDecl(recv, g.GetMethod(), g.GetParamDecls(), g.GetReturn(), e))
 }
 
+/* "funcDecl" */
+
+// Cf. ExitMethDecl
+func (a *FGAdaptor) ExitFuncDecl(ctx *parser.FuncDeclContext) {
+	// Reverse order
+	e := a.pop().(fg.FGExpr)
+	g := a.pop().(fg.Sig)
+	e = fg.SetBodyType(e, g.GetReturn())
+	a.push(fg.NewFuncDecl(g.GetMethod(), g.GetParamDecls(), g.GetReturn(), e))
+}
+
+/* "body": "return" expr, ifElse, typeSwitch, or binding ";" body */
+
+func (a *FGAdaptor) ExitBody(ctx *parser.BodyContext) {
+	if ctx.PANIC() != nil { // "panic(e)"
+		e := a.pop().(fg.FGExpr)
+		a.push(fg.NewPanic(e, nil)) // Type set by ExitMethDecl
+		return
+	}
+	b, ok := ctx.Binding().(*parser.BindingContext)
+	if !ok {
+		return
+	}
+	// Reverse order
+	e_body := a.pop().(fg.FGExpr)
+	e_def := a.pop().(fg.FGExpr)
+	x := b.NAME(0).GetText()
+	switch b.GetChild(1).(*antlr.TerminalNodeImpl).GetText() {
+	case ",": // "x, x_ok := e"
+		a.push(fg.NewCommaOk(x, b.NAME(1).GetText(), e_def, e_body))
+		return
+	case "[": // "x[e_key] = e", e_def is the e
+		e_key := a.pop().(fg.FGExpr)
+		a.push(fg.NewMapAssign(x, e_key, e_def, e_body))
+		return
+	}
+	var t fg.Type // nil for "x := e"
+	if b.Typ() != nil {
+		t = a.pop().(fg.Type)
+	}
+	a.push(fg.NewLet(x, t, e_def, e_body))
+}
+
+func (a *FGAdaptor) ExitIfElse(ctx *parser.IfElseContext) {
+	e2 := a.pop().(fg.FGExpr)
+	e1 := a.pop().(fg.FGExpr)
+	cond := a.pop().(fg.FGExpr)
+	a.push(fg.NewCond(cond, e1, e2, nil)) // Type set by ExitMethDecl
+}
+
+func (a *FGAdaptor) ExitTypeSwitch(ctx *parser.TypeSwitchContext) {
+	// Reverse order
+	var e_def fg.FGExpr // nil if no default
+	if ctx.DEFAULT() != nil {
+		e_def = a.pop().(fg.FGExpr)
+	}
+	cases := make([]fg.TypeCase, len(ctx.AllTypeCase()))
+	for i := len(cases) - 1; i >= 0; i-- {
+		cases[i] = a.pop().(fg.TypeCase) // Adding backwards
+	}
+	e_I := a.pop().(fg.FGExpr)
+	var x fg.Name // "" if no binding
+	if ctx.NAME() != nil {
+		x = ctx.NAME().GetText()
+	}
+	a.push(fg.NewTypeSwitch(x, e_I, cases, e_def, nil)) // Type set by ExitMethDecl
+}
+
+func (a *FGAdaptor) ExitTypeCase(ctx *parser.TypeCaseContext) {
+	// Reverse order
+	e := a.pop().(fg.FGExpr)
+	t := a.pop().(fg.Type)
+	a.push(fg.NewTypeCase(t, e))
+}
+
 // Cf. ExitFieldDecl
 func (a *FGAdaptor) ExitParamDecl(ctx *parser.ParamDeclContext) {
 	x := ctx.GetVari().GetText()
@@ -192,7 +339,7 @@ func (a *FGAdaptor) ExitSig(ctx *parser.SigContext) {
 	a.push(fg.NewSig(m, pds, t))
 }
 
-/* "expr": #Variable, #StructLit, #Select, #Call, #Assert, #Sprintf */
+/* "expr": #Variable, #StructLit, #Select, #Call, #Apply, #Index, #Assert, #Sprintf, #Len, #Append, #Convert, #FuncCall, #FuncLit */
 
 func (a *FGAdaptor) ExitVariable(ctx *parser.VariableContext) {
 	id := fg.Name(ctx.GetChild(0).(*antlr.TerminalNodeImpl).GetText())
@@ -211,9 +358,30 @@ func (a *FGAdaptor) ExitStructLit(ctx *parser.StructLitContext) {
 		}
 	}
 	t := a.pop().(fg.Type)
+	if _, ok := t.(fg.TSlice); ok { // N.B. a defined slice type is resolved by typing, cf. StructLit.Typing
+		a.push(fg.NewSliceLit(t, es))
+		return
+	}
+	if _, ok := t.(fg.TMap); ok { // "map[K]V{}", cf. ExitMapLit
+		a.push(fg.NewMapLit(t, []fg.MapEntry{}))
+		return
+	}
 	a.push(fg.NewStructLit(t, es))
 }
 
+// Children: 2=entries -- each entry is a key and a value expr
+func (a *FGAdaptor) ExitMapLit(ctx *parser.MapLitContext) {
+	nes := (ctx.GetChild(2).GetChildCount() + 1) / 2 // e.g., entry ',' entry
+	es := make([]fg.MapEntry, nes)
+	for i := nes - 1; i >= 0; i-- {
+		v := a.pop().(fg.FGExpr)
+		k := a.pop().(fg.FGExpr)
+		es[i] = fg.NewMapEntry(k, v) // Adding backwards
+	}
+	t := a.pop().(fg.Type)
+	a.push(fg.NewMapLit(t, es))
+}
+
 func (a *FGAdaptor) ExitSelect(ctx *parser.SelectContext) {
 	e := a.pop().(fg.FGExpr)
 	f := fg.Name(ctx.GetChild(2).(*antlr.TerminalNodeImpl).GetText())
@@ -241,6 +409,87 @@ func (a *FGAdaptor) ExitAssert(ctx *parser.AssertContext) {
 	a.push(fg.NewAssert(e, t))
 }
 
+// E.g., int64(x), MyInt(3), S(struct{}{})
+// N.B. a call of a function with one arg, e.g., f(x), is also parsed as a Convert
+func (a *FGAdaptor) ExitConvert(ctx *parser.ConvertContext) {
+	e := a.pop().(fg.FGExpr)
+	t := a.pop().(fg.Type)
+	if t1, ok := t.(fg.TNamed); ok && a.funcs[t1.String()] {
+		a.push(fg.NewFuncCall(t1.String(), []fg.FGExpr{e}))
+		return
+	}
+	a.push(fg.NewConvert(t, e))
+}
+
+// Cf. ExitCall
+func (a *FGAdaptor) ExitFuncCall(ctx *parser.FuncCallContext) {
+	args := []fg.FGExpr{}
+	if ctx.GetChildCount() > 3 {
+		nargs := (ctx.GetChild(2).GetChildCount() + 1) / 2 // e.g., e ',' e ',' e
+		args = make([]fg.FGExpr, nargs)
+		for i := nargs - 1; i >= 0; i-- {
+			args[i] = a.pop().(fg.FGExpr) // Adding backwards
+		}
+	}
+	f := fg.Name(ctx.GetChild(0).(*antlr.TerminalNodeImpl).GetText())
+	a.push(fg.NewFuncCall(f, args))
+}
+
+// Children: 2=params (if any) -- cf. ExitSig, ExitFuncDecl
+func (a *FGAdaptor) ExitFuncLit(ctx *parser.FuncLitContext) {
+	// Reverse order
+	e := a.pop().(fg.FGExpr)
+	t := a.pop().(fg.Type)
+	pds := []fg.ParamDecl{}
+	if ctx.GetChildCount() > 7 {
+		npds := (ctx.GetChild(2).GetChildCount() + 1) / 2 // e.g., pd ',' pd ',' pd
+		pds = make([]fg.ParamDecl, npds)
+		for i := npds - 1; i >= 0; i-- {
+			pds[i] = a.pop().(fg.ParamDecl) // Adding backwards
+		}
+	}
+	e = fg.SetBodyType(e, t)
+	a.push(fg.NewFuncLit(pds, t, e, nil))
+}
+
+// Children: 0=expr, 2=exprs (if any) -- cf. ExitFuncCall
+func (a *FGAdaptor) ExitApply(ctx *parser.ApplyContext) {
+	args := []fg.FGExpr{}
+	if ctx.GetChildCount() > 3 {
+		nargs := (ctx.GetChild(2).GetChildCount() + 1) / 2 // e.g., e ',' e ',' e
+		args = make([]fg.FGExpr, nargs)
+		for i := nargs - 1; i >= 0; i-- {
+			args[i] = a.pop().(fg.FGExpr) // Adding backwards
+		}
+	}
+	e := a.pop().(fg.FGExpr)
+	a.push(fg.NewApply(e, args))
+}
+
+// Children: 0=expr, 2=expr
+func (a *FGAdaptor) ExitIndex(ctx *parser.IndexContext) {
+	// Reverse order
+	i := a.pop().(fg.FGExpr)
+	e := a.pop().(fg.FGExpr)
+	a.push(fg.NewIndex(e, i))
+}
+
+func (a *FGAdaptor) ExitLen(ctx *parser.LenContext) {
+	e := a.pop().(fg.FGExpr)
+	a.push(fg.NewLen(e))
+}
+
+// Children: 2=exprs -- the first is the slice, cf. ExitFuncCall
+func (a *FGAdaptor) ExitAppend(ctx *parser.AppendContext) {
+	nargs := (ctx.GetArgs().GetChildCount() + 1) / 2 // e.g., e ',' e ',' e
+	es := make([]fg.FGExpr, nargs-1)
+	for i := nargs - 2; i >= 0; i-- {
+		es[i] = a.pop().(fg.FGExpr) // Adding backwards
+	}
+	e := a.pop().(fg.FGExpr)
+	a.push(fg.NewAppend(e, es))
+}
+
 // TODO: check for import "fmt"
 func (a *FGAdaptor) ExitSprintf(ctx *parser.SprintfContext) {
 	var format string = ctx.GetChild(4).(*antlr.TerminalNodeImpl).GetText()
@@ -253,31 +502,14 @@ func (a *FGAdaptor) ExitSprintf(ctx *parser.SprintfContext) {
 	a.push(fg.NewSprintf(format, args))
 }
 
-/* For "strict" parsing, *lexer* errors */
+/* Primitive unary operations: #UnaryOp */
 
-type FGBailLexer struct {
-	*parser.FGLexer
-}
-
-// FIXME: not working -- e.g., incr{1}, bad token
-// Want to "override" *BaseLexer.Recover -- XXX that's not how Go works (because BaseLexer is a struct, not interface)
-func (b *FGBailLexer) Recover(re antlr.RecognitionException) {
-	message := "lex error after token " + re.GetOffendingToken().GetText() +
-		" at position " + strconv.Itoa(re.GetOffendingToken().GetStart())
-	panic(message)
+func (a *FGAdaptor) ExitUnaryOp(ctx *parser.UnaryOpContext) {
+	e := a.pop().(fg.FGExpr)
+	op := fg.Operator(ctx.GetOp().GetText())
+	a.push(fg.NewUnaryOp(e, op))
 }
 
-/*public FGBailLexer(ICharStream input) : base(input) { }
-
-public override void Recover(LexerNoViableAltException e)
-{
-	string message = string.Format("lex error after token {0} at position {1}", _lasttoken.Text, e.StartIndex);
-	BasicEnvironment.SyntaxError = message;
-	BasicEnvironment.ErrorStartIndex = e.StartIndex;
-	throw new ParseCanceledException(BasicEnvironment.SyntaxError);
-}*/
-
-
 /* Primitive binary operations: #BinaryOp */
 
 func (a *FGAdaptor) ExitBinaryOp(ctx *parser.BinaryOpContext) {
@@ -7,12 +7,10 @@ package parser
 import (
 	"fmt"
 	"reflect"
-	"strconv"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 
 	"github.com/rhu1/fgg/internal/base"
-	"github.com/rhu1/fgg/internal/base/testutils"
 	"github.com/rhu1/fgg/internal/fgg"
 	"github.com/rhu1/fgg/internal/parser/util"
 	"github.com/rhu1/fgg/parser/fgg/parser"
@@ -24,7 +22,9 @@ var _ = reflect.Append
 // Convert ANTLR generated CST to an FGNode AST
 type FGGAdaptor struct {
 	*parser.BaseFGGListener
-	stack []fgg.FGGNode // Because Listener methods don't return...
+	stack    []fgg.FGGNode // Because Listener methods don't return...
+	comments []base.Comment
+	funcs    map[fgg.Name]bool // Declared function names, cf. ExitConvert
 }
 
 var _ base.Adaptor = &FGGAdaptor{}
@@ -35,39 +35,112 @@ func (a *FGGAdaptor) push(n fgg.FGGNode) {
 
 func (a *FGGAdaptor) pop() fgg.FGGNode {
 	if len(a.stack) < 1 {
-		panic(testutils.PARSER_PANIC_PREFIX + "Stack is empty")
+		panic(base.NewDiagnostic(base.DIAG_SYNTAX, nil, "Stack is empty")) // Located by util.Walk
 	}
 	res := a.stack[len(a.stack)-1]
 	a.stack = a.stack[:len(a.stack)-1]
 	return res
 }
 
-// strictParse means panic upon any parsing error -- o/w error recovery is attempted
-func (a *FGGAdaptor) Parse(strictParse bool, input string) base.Program {
-	is := antlr.NewInputStream(input)
-	var lexer antlr.Lexer
-	if strictParse { // https://stackoverflow.com/questions/51683104/how-to-catch-minor-errors
-		lexer = FGGBailLexer{parser.NewFGGLexer(is)} // FIXME: not working -- e.g., incr{1}, bad token
-	} else {
-		lexer = parser.NewFGGLexer(is)
+// Locates the node just pushed by the Exit method of ctx, if any.
+// N.B. ExitEveryRule is called after the rule-specific Exit method; a rule that
+// pushes nothing leaves an already located node on top, which is not overwritten.
+func (a *FGGAdaptor) ExitEveryRule(ctx antlr.ParserRuleContext) {
+	if len(a.stack) < 1 {
+		return
 	}
+	top := a.stack[len(a.stack)-1]
+	if n, ok := top.(base.Spanned); ok && !n.GetSpan().IsKnown() {
+		a.stack[len(a.stack)-1] = fgg.SetSpan(top, util.SpanOf(ctx))
+	}
+}
+
+// strictParse means stop at the first parser error -- o/w error recovery is attempted.
+// Lexer and parser errors, and adaptor errors such as a bad import, are
+// returned (as located base.Diagnostics), not printed; the AST is only built if
+// there are no errors.
+func (a *FGGAdaptor) Parse(strictParse bool, input string) (base.Program, []error) {
+	is := antlr.NewInputStream(input)
+	lexer := parser.NewFGGLexer(is)
 	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
 	p := parser.NewFGGParser(stream)
-	if strictParse {
-		p.RemoveErrorListeners()
-		p.SetErrorHandler(&util.StrictErrorStrategy{})
+	errs := util.NewErrorCollector(lexer, p, strictParse)
+	var tree parser.IProgramContext
+	errs.Run(func() { tree = p.Program() })
+	if len(errs.Errors) > 0 {
+		return nil, errs.Errors
+	}
+	a.funcs = funcNamesFGG(tree)
+	if err := util.Walk(a, tree); err != nil { // E.g., a bad import
+		return nil, append(errs.Errors, err)
+	}
+	a.comments = util.Comments(stream)
+	return a.pop().(fgg.FGGProgram), nil
+}
+
+// Cf. funcNames
+func funcNamesFGG(tree parser.IProgramContext) map[fgg.Name]bool {
+	res := make(map[fgg.Name]bool)
+	for _, c := range tree.GetChildren() {
+		if ds, ok := c.(*parser.DeclsContext); ok {
+			for _, d := range ds.GetChildren() {
+				if fd, ok := d.(*parser.FuncDeclContext); ok {
+					res[fd.Sig().(*parser.SigContext).GetMeth().GetText()] = true
+				}
+			}
+		}
 	}
-	antlr.ParseTreeWalkerDefault.Walk(a, p.Program())
-	return a.pop().(fgg.FGGProgram)
+	return res
 }
 
-/* "typ": #TypeParam, #TypeName, #TPrimitive, #TypeLit_ */
+func (a *FGGAdaptor) GetComments() []base.Comment {
+	return a.comments
+}
 
+/* "typ": #TypeName, #TypeParam, #TPrimitive, #TypeLit_, #TFunc, #TSlice */
+
+// A bare "any" or "comparable" names the predeclared type (cf. fgg.universe),
+// as "any()" does, unless it is shadowed by a type formal in scope
 func (a *FGGAdaptor) ExitTypeParam(ctx *parser.TypeParamContext) {
-	b := fgg.NewTParam(ctx.GetName().GetText())
+	name := ctx.GetName().GetText()
+	if (name == fgg.ANY || name == fgg.COMPARABLE) && !isTFormalInScope(ctx, name) {
+		a.push(fgg.NewTNamed(name, []fgg.Type{}))
+		return
+	}
+	b := fgg.NewTParam(name)
 	a.push(b)
 }
 
+// Cf. ExitTypeParam -- the type formals of the enclosing type decl, method
+// decl (receiver and method type formals) or func decl, and sigs
+func isTFormalInScope(ctx antlr.Tree, name string) bool {
+	for c := ctx.GetParent(); c != nil; c = c.GetParent() {
+		var tfs []parser.ITypeFormalsContext
+		switch d := c.(type) {
+		case *parser.TypeDeclContext:
+			tfs = append(tfs, d.TypeFormals())
+		case *parser.MethDeclContext:
+			tfs = append(tfs, d.TypeFormals(), d.Sig().(*parser.SigContext).TypeFormals())
+		case *parser.FuncDeclContext:
+			tfs = append(tfs, d.Sig().(*parser.SigContext).TypeFormals())
+		case *parser.SigContext:
+			tfs = append(tfs, d.TypeFormals())
+		}
+		for _, v := range tfs {
+			fds := v.(*parser.TypeFormalsContext).TypeFDecls()
+			if fds == nil {
+				continue
+			}
+			for _, fd := range fds.(*parser.TypeFDeclsContext).AllTypeFDecl() {
+				if fd.(*parser.TypeFDeclContext).NAME().GetText() == name {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func (a *FGGAdaptor) ExitTypeName(ctx *parser.TypeNameContext) {
 	//t := fgg.Name(ctx.GetChild(0).(*antlr.TerminalNodeImpl).GetText())
 	// TODO check if changing to .GetName doesn't alter the ChildCount below
@@ -89,6 +162,33 @@ func (a *FGGAdaptor) ExitTPrimitive(ctx *parser.TPrimitiveContext) {
 	a.push(fgg.NewTPrimitive(tag))
 }
 
+// Children: 2=typs (if any)
+func (a *FGGAdaptor) ExitTFunc(ctx *parser.TFuncContext) {
+	// Reverse order
+	u := a.pop().(fgg.Type)
+	us := []fgg.Type{}
+	if ctx.GetChildCount() > 4 {
+		nus := (ctx.GetChild(2).GetChildCount() + 1) / 2 // e.g., u ',' u ',' u
+		us = make([]fgg.Type, nus)
+		for i := nus - 1; i >= 0; i-- {
+			us[i] = a.pop().(fgg.Type) // Adding backwards
+		}
+	}
+	a.push(fgg.NewTFunc(us, u))
+}
+
+func (a *FGGAdaptor) ExitTSlice(ctx *parser.TSliceContext) {
+	u := a.pop().(fgg.Type)
+	a.push(fgg.NewTSlice(u))
+}
+
+func (a *FGGAdaptor) ExitTMap(ctx *parser.TMapContext) {
+	// Reverse order
+	elem := a.pop().(fgg.Type)
+	key := a.pop().(fgg.Type)
+	a.push(fgg.NewTMap(key, elem))
+}
+
 func (a *FGGAdaptor) ExitTypeLit_(ctx *parser.TypeLit_Context) {
 	// do nothing -- the struct/interface literal is already at top of a.stack
 	// cf. ExitStructTypeLit
@@ -135,6 +235,24 @@ func (a *FGGAdaptor) ExitInterfaceTypeLit(ctx *parser.InterfaceTypeLitContext) {
 	a.push(fgg.NewITypeLit(specs, tlist))
 }
 
+/* "spec", "union", "term" */
+
+// Children: term ('|' term)* -- a single term without "~" is pushed as is,
+// e.g., an embedded interface name (cf. ITypeLit.Ok)
+func (a *FGGAdaptor) ExitUnion(ctx *parser.UnionContext) {
+	nts := (ctx.GetChildCount() + 1) / 2 // e.g., t '|' t '|' t
+	ts := make([]fgg.Term, nts)
+	for i := nts - 1; i >= 0; i-- {
+		tilde := ctx.Term(i).(*parser.TermContext).GetTilde() != nil
+		ts[i] = fgg.NewTerm(tilde, a.pop().(fgg.Type)) // Adding backwards
+	}
+	if u, ok := ts[0].GetType().(fgg.TNamed); ok && nts == 1 && !ts[0].IsTilde() {
+		a.push(u)
+	} else {
+		a.push(fgg.NewUnion(ts))
+	}
+}
+
 /* "typeFormals", "typeFDecls", "typeFDecl" */
 
 func (a *FGGAdaptor) ExitTypeFormals(ctx *parser.TypeFormalsContext) {
@@ -150,7 +268,7 @@ func (a *FGGAdaptor) ExitTypeFormals(ctx *parser.TypeFormalsContext) {
 }
 
 func (a *FGGAdaptor) ExitTypeFDecl(ctx *parser.TypeFDeclContext) {
-	u := a.pop().(fgg.Type)                                              // CHECKME: TName? (\tau_I)
+	u := a.pop().(fgg.Type)                                                 // CHECKME: TName? (\tau_I)
 	b := fgg.NewTParam(ctx.GetChild(0).(*antlr.TerminalNodeImpl).GetText()) // Not pop().(TParam) -- BNF asks for NAME
 	a.push(fgg.NewTFormal(b, u))
 }
@@ -168,14 +286,18 @@ func (a *FGGAdaptor) ExitProgram(ctx *parser.ProgramContext) {
 	if c3_cast, ok := c3.GetPayload().(*antlr.CommonToken); ok &&
 		c3_cast.GetText() == "import" {
 		if pkg := ctx.GetChild(4).GetPayload().(*antlr.CommonToken).GetText(); pkg != "\"fmt\"" { // TODO: refactor
-			panic(testutils.PARSER_PANIC_PREFIX + "The only allowed import is \"fmt\"; found: " + pkg)
+			span := util.TokenSpan(ctx.IMPORT().GetSymbol(), ctx.STRING_LIT().GetSymbol())
+			panic(base.Diagnostic{Kind: base.DIAG_SYNTAX,
+				Message: "The only allowed import is \"fmt\"; found: " + pkg, Span: span})
 		}
 		offset = 3
 		if cast, ok := foo.(*antlr.CommonToken); !ok || cast.GetText() != "=" {
 			printf = true
 		}
 	} else if cast, ok := foo.(*antlr.CommonToken); !ok || cast.GetText() != "=" {
-		panic(testutils.PARSER_PANIC_PREFIX + "Missing \"import fmt;\".")
+		span := util.TokenSpan(ctx.FMT().GetSymbol(), ctx.PRINTF().GetSymbol())
+		panic(base.Diagnostic{Kind: base.DIAG_SYNTAX, Message: "Missing \"import fmt;\".",
+			Span: span})
 	}
 	bar := ctx.GetChild(offset + 3)                                   // Check if this child is "func", i.e., no decls
 	if _, ok := bar.GetPayload().(*antlr.BaseParserRuleContext); ok { // If "func", then *antlr.CommonToken
@@ -201,9 +323,19 @@ func (a *FGGAdaptor) ExitTypeDecl(ctx *parser.TypeDeclContext) {
 /* "fieldDecls", "fieldDecl" */
 
 func (a *FGGAdaptor) ExitFieldDecl(ctx *parser.FieldDeclContext) {
-	f := fgg.Name(ctx.GetField().GetText())
 	//typ := Type(ctx.GetChild(1).GetText())
 	u := a.pop().(fgg.Type)
+	if ctx.GetField() == nil { // Embedded field, named by its type name (without type args)
+		var f fgg.Name
+		if u_N, ok := u.(fgg.TNamed); ok {
+			f = u_N.GetName()
+		} else {
+			f = fgg.Name(u.String()) // Rejected by STypeLit.Ok
+		}
+		a.push(fgg.NewEmbeddedFieldDecl(f, u))
+		return
+	}
+	f := fgg.Name(ctx.GetField().GetText())
 	a.push(fgg.NewFieldDecl(f, u))
 }
 
@@ -216,9 +348,85 @@ func (a *FGGAdaptor) ExitMethDecl(ctx *parser.MethDeclContext) {
 	psi := a.pop().(fgg.BigPsi)
 	t := fgg.Name(ctx.GetTypn().GetText())
 	recv := fgg.Name(ctx.GetRecv().GetText())
+	e = fgg.SetBodyType(e, g.GetReturn())
 	a.push(fgg.NewMDecl(recv, t, psi, g.GetMethod(), g.GetPsi(), g.GetParamDecls(), g.GetReturn(), e))
 }
 
+/* "funcDecl" */
+
+// Cf. ExitMethDecl
+func (a *FGGAdaptor) ExitFuncDecl(ctx *parser.FuncDeclContext) {
+	// Reverse order
+	e := a.pop().(fgg.FGGExpr)
+	g := a.pop().(fgg.Sig)
+	e = fgg.SetBodyType(e, g.GetReturn())
+	a.push(fgg.NewFuncDecl(g.GetMethod(), g.GetPsi(), g.GetParamDecls(), g.GetReturn(), e))
+}
+
+/* "body": "return" expr, ifElse, typeSwitch, or binding ";" body */
+
+func (a *FGGAdaptor) ExitBody(ctx *parser.BodyContext) {
+	if ctx.PANIC() != nil { // "panic(e)"
+		e := a.pop().(fgg.FGGExpr)
+		a.push(fgg.NewPanic(e, nil)) // Type set by ExitMethDecl
+		return
+	}
+	b, ok := ctx.Binding().(*parser.BindingContext)
+	if !ok {
+		return
+	}
+	// Reverse order
+	e_body := a.pop().(fgg.FGGExpr)
+	e_def := a.pop().(fgg.FGGExpr)
+	x := b.NAME(0).GetText()
+	switch b.GetChild(1).(*antlr.TerminalNodeImpl).GetText() {
+	case ",": // "x, x_ok := e"
+		a.push(fgg.NewCommaOk(x, b.NAME(1).GetText(), e_def, e_body))
+		return
+	case "[": // "x[e_key] = e", e_def is the e
+		e_key := a.pop().(fgg.FGGExpr)
+		a.push(fgg.NewMapAssign(x, e_key, e_def, e_body))
+		return
+	}
+	var t fgg.Type // nil for "x := e"
+	if b.Typ() != nil {
+		t = a.pop().(fgg.Type)
+	}
+	a.push(fgg.NewLet(x, t, e_def, e_body))
+}
+
+func (a *FGGAdaptor) ExitIfElse(ctx *parser.IfElseContext) {
+	e2 := a.pop().(fgg.FGGExpr)
+	e1 := a.pop().(fgg.FGGExpr)
+	cond := a.pop().(fgg.FGGExpr)
+	a.push(fgg.NewCond(cond, e1, e2, nil)) // Type set by ExitMethDecl
+}
+
+func (a *FGGAdaptor) ExitTypeSwitch(ctx *parser.TypeSwitchContext) {
+	// Reverse order
+	var e_def fgg.FGGExpr // nil if no default
+	if ctx.DEFAULT() != nil {
+		e_def = a.pop().(fgg.FGGExpr)
+	}
+	cases := make([]fgg.TypeCase, len(ctx.AllTypeCase()))
+	for i := len(cases) - 1; i >= 0; i-- {
+		cases[i] = a.pop().(fgg.TypeCase) // Adding backwards
+	}
+	e_I := a.pop().(fgg.FGGExpr)
+	var x fgg.Name // "" if no binding
+	if ctx.NAME() != nil {
+		x = ctx.NAME().GetText()
+	}
+	a.push(fgg.NewTypeSwitch(x, e_I, cases, e_def, nil)) // Type set by ExitMethDecl
+}
+
+func (a *FGGAdaptor) ExitTypeCase(ctx *parser.TypeCaseContext) {
+	// Reverse order
+	e := a.pop().(fgg.FGGExpr)
+	u := a.pop().(fgg.Type)
+	a.push(fgg.NewTypeCase(u, e))
+}
+
 // Cf. ExitFieldDecl
 func (a *FGGAdaptor) ExitParamDecl(ctx *parser.ParamDeclContext) {
 	x := ctx.GetVari().GetText()
@@ -244,7 +452,7 @@ func (a *FGGAdaptor) ExitSig(ctx *parser.SigContext) {
 	a.push(fgg.NewSig(m, psi, pds, t))
 }
 
-/* "expr": #Variable, #StructLit, #Select, #Call, #Assert, #Sprintf */
+/* "expr": #StructLit, #Select, #Call, #Apply, #Index, #Assert, #Sprintf, #Len, #Append, #Convert, #FuncCall, #Variable, #FuncLit */
 
 // Same as FG
 func (a *FGGAdaptor) ExitVariable(ctx *parser.VariableContext) {
@@ -263,9 +471,30 @@ func (a *FGGAdaptor) ExitStructLit(ctx *parser.StructLitContext) {
 		}
 	}
 	t := a.pop().(fgg.Type)
+	if _, ok := t.(fgg.TSlice); ok { // N.B. a defined slice type is resolved by typing, cf. StructLit.Typing
+		a.push(fgg.NewSliceLit(t, es))
+		return
+	}
+	if _, ok := t.(fgg.TMap); ok { // "map[K]V{}", cf. ExitMapLit
+		a.push(fgg.NewMapLit(t, []fgg.MapEntry{}))
+		return
+	}
 	a.push(fgg.NewStructLit(t, es))
 }
 
+// Children: 2=entries -- each entry is a key and a value expr
+func (a *FGGAdaptor) ExitMapLit(ctx *parser.MapLitContext) {
+	nes := (ctx.GetChild(2).GetChildCount() + 1) / 2 // e.g., entry ',' entry
+	es := make([]fgg.MapEntry, nes)
+	for i := nes - 1; i >= 0; i-- {
+		v := a.pop().(fgg.FGGExpr)
+		k := a.pop().(fgg.FGGExpr)
+		es[i] = fgg.NewMapEntry(k, v) // Adding backwards
+	}
+	t := a.pop().(fgg.Type)
+	a.push(fgg.NewMapLit(t, es))
+}
+
 // Same as Fg
 func (a *FGGAdaptor) ExitSelect(ctx *parser.SelectContext) {
 	e := a.pop().(fgg.FGGExpr)
@@ -303,6 +532,98 @@ func (a *FGGAdaptor) ExitAssert(ctx *parser.AssertContext) {
 	a.push(fgg.NewAssert(e, u))
 }
 
+// E.g., int64(x), MyInt()(3), a(x) -- N.B. a named type needs its (possibly empty) type args
+// N.B. a call of a function with one arg, e.g., f(int32)(x), is also parsed as a Convert
+func (a *FGGAdaptor) ExitConvert(ctx *parser.ConvertContext) {
+	e := a.pop().(fgg.FGGExpr)
+	u := a.pop().(fgg.Type)
+	if u1, ok := u.(fgg.TNamed); ok && a.funcs[u1.GetName()] {
+		a.push(fgg.NewFuncCall(u1.GetName(), u1.GetTArgs(), []fgg.FGGExpr{e}))
+		return
+	}
+	a.push(fgg.NewConvert(u, e))
+}
+
+// Cf. ExitCall
+func (a *FGGAdaptor) ExitFuncCall(ctx *parser.FuncCallContext) {
+	argCs := ctx.GetArgs()
+	args := []fgg.FGGExpr{}
+	if argCs != nil {
+		nargs := (argCs.GetChildCount() + 1) / 2 // e.g., e ',' e ',' e
+		args = make([]fgg.FGGExpr, nargs)
+		for i := nargs - 1; i >= 0; i-- {
+			args[i] = a.pop().(fgg.FGGExpr) // Adding backwards
+		}
+	}
+	targCs := ctx.GetTargs()
+	targs := []fgg.Type{}
+	if targCs != nil {
+		ntargs := (targCs.GetChildCount() + 1) / 2 // e.g., t ',' t ',' t
+		targs = make([]fgg.Type, ntargs)
+		for i := ntargs - 1; i >= 0; i-- {
+			targs[i] = a.pop().(fgg.Type) // Adding backwards
+		}
+	}
+	f := fgg.Name(ctx.GetChild(0).(*antlr.TerminalNodeImpl).GetText())
+	a.push(fgg.NewFuncCall(f, targs, args))
+}
+
+// Children: 2=params (if any) -- cf. ExitSig, ExitFuncDecl
+func (a *FGGAdaptor) ExitFuncLit(ctx *parser.FuncLitContext) {
+	// Reverse order
+	e := a.pop().(fgg.FGGExpr)
+	u := a.pop().(fgg.Type)
+	pds := []fgg.ParamDecl{}
+	if ctx.GetChildCount() > 7 {
+		npds := (ctx.GetChild(2).GetChildCount() + 1) / 2 // e.g., pd ',' pd ',' pd
+		pds = make([]fgg.ParamDecl, npds)
+		for i := npds - 1; i >= 0; i-- {
+			pds[i] = a.pop().(fgg.ParamDecl) // Adding backwards
+		}
+	}
+	e = fgg.SetBodyType(e, u)
+	a.push(fgg.NewFuncLit(pds, u, e, nil))
+}
+
+// Cf. ExitFuncCall
+func (a *FGGAdaptor) ExitApply(ctx *parser.ApplyContext) {
+	argCs := ctx.GetArgs()
+	args := []fgg.FGGExpr{}
+	if argCs != nil {
+		nargs := (argCs.GetChildCount() + 1) / 2 // e.g., e ',' e ',' e
+		args = make([]fgg.FGGExpr, nargs)
+		for i := nargs - 1; i >= 0; i-- {
+			args[i] = a.pop().(fgg.FGGExpr) // Adding backwards
+		}
+	}
+	e := a.pop().(fgg.FGGExpr)
+	a.push(fgg.NewApply(e, args))
+}
+
+// Children: 0=expr, 2=expr
+func (a *FGGAdaptor) ExitIndex(ctx *parser.IndexContext) {
+	// Reverse order
+	i := a.pop().(fgg.FGGExpr)
+	e := a.pop().(fgg.FGGExpr)
+	a.push(fgg.NewIndex(e, i))
+}
+
+func (a *FGGAdaptor) ExitLen(ctx *parser.LenContext) {
+	e := a.pop().(fgg.FGGExpr)
+	a.push(fgg.NewLen(e))
+}
+
+// Children: 2=exprs -- the first is the slice, cf. ExitApply
+func (a *FGGAdaptor) ExitAppend(ctx *parser.AppendContext) {
+	nargs := (ctx.GetArgs().GetChildCount() + 1) / 2 // e.g., e ',' e ',' e
+	es := make([]fgg.FGGExpr, nargs-1)
+	for i := nargs - 2; i >= 0; i-- {
+		es[i] = a.pop().(fgg.FGGExpr) // Adding backwards
+	}
+	e := a.pop().(fgg.FGGExpr)
+	a.push(fgg.NewAppend(e, es))
+}
+
 // TODO: check for import "fmt"
 func (a *FGGAdaptor) ExitSprintf(ctx *parser.SprintfContext) {
 	var format string = ctx.GetChild(4).(*antlr.TerminalNodeImpl).GetText()
@@ -315,18 +636,12 @@ func (a *FGGAdaptor) ExitSprintf(ctx *parser.SprintfContext) {
 	a.push(fgg.NewSprintf(format, args))
 }
 
-/* For "strict" parsing, *lexer* errors */
+/* Primitive unary operations: #UnaryOp */
 
-type FGGBailLexer struct {
-	*parser.FGGLexer
-}
-
-// FIXME: not working -- e.g., incr{1}, bad token
-// Want to "override" *BaseLexer.Recover -- XXX that's not how Go works (because BaseLexer is a struct, not interface)
-func (b *FGGBailLexer) Recover(re antlr.RecognitionException) {
-	message := "lex error after token " + re.GetOffendingToken().GetText() +
-		" at position " + strconv.Itoa(re.GetOffendingToken().GetStart())
-	panic(message)
+func (a *FGGAdaptor) ExitUnaryOp(ctx *parser.UnaryOpContext) {
+	e := a.pop().(fgg.FGGExpr)
+	op := fgg.Operator(ctx.GetOp().GetText())
+	a.push(fgg.NewUnaryOp(e, op))
 }
 
 /* Primitive binary operations: #BinaryOp */
@@ -357,4 +672,4 @@ func (a *FGGAdaptor) ExitFloatLit(ctx *parser.FloatLitContext) {
 func (a *FGGAdaptor) ExitStringLit(ctx *parser.StringLitContext) {
 	lit := ctx.GetLit().GetText()
 	a.push(fgg.NewStringLit(lit))
-}
\ No newline at end of file
+}
@@ -1,41 +1,185 @@
 package util
 
 import (
-	"errors"
-	"fmt"
-	"os"
-	"strconv"
+	"strings"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
+
+	"github.com/rhu1/fgg/internal/base"
 )
 
-/* For "strict" parsing, *parser* errors -- cf. F(G)GBailLexer */
+/* Source spans */
+
+// From the start of the first token to the end of the last token of ctx
+func SpanOf(ctx antlr.ParserRuleContext) base.Span {
+	start, stop := ctx.GetStart(), ctx.GetStop()
+	if start == nil {
+		return base.Span{}
+	}
+	if stop == nil || stop.GetTokenIndex() < start.GetTokenIndex() { // Empty rule
+		stop = start
+	}
+	return TokenSpan(start, stop)
+}
+
+// From the start of start to the end of stop
+func TokenSpan(start antlr.Token, stop antlr.Token) base.Span {
+	return base.Span{StartLine: start.GetLine(), StartCol: start.GetColumn(),
+		EndLine: stop.GetLine(), EndCol: stop.GetColumn() + len(stop.GetText())}
+}
+
+/* Comments -- the COMMENT and LINE_COMMENT tokens, on the hidden channel */
+
+// Returns the comments in stream, which should be fully parsed
+func Comments(stream *antlr.CommonTokenStream) []base.Comment {
+	var cs []base.Comment
+	for _, tok := range stream.GetAllTokens() {
+		if tok.GetChannel() != antlr.TokenHiddenChannel {
+			continue
+		}
+		text := tok.GetText()
+		span := base.Span{StartLine: tok.GetLine(), StartCol: tok.GetColumn(),
+			EndLine: tok.GetLine(), EndCol: tok.GetColumn() + len(text)}
+		if i := strings.LastIndex(text, "\n"); i >= 0 { // Multi-line block comment
+			span.EndLine += strings.Count(text, "\n")
+			span.EndCol = len(text) - i - 1
+		}
+		cs = append(cs, base.Comment{Text: text, Span: span})
+	}
+	return cs
+}
+
+/* Syntax errors -- collected as values, cf. base.Adaptor.Parse */
+
+// Collects lexer and parser errors as DIAG_SYNTAX base.Diagnostics
+// (instead of the default antlr.ConsoleErrorListener)
+type ErrorCollector struct {
+	*antlr.DefaultErrorListener
+	Errors []error
+}
+
+var _ antlr.ErrorListener = &ErrorCollector{}
+
+// Installs a new ErrorCollector on lexer and p.
+// If strict, p stops at its first error (cf. StrictErrorStrategy), else the
+// default ANTLR error recovery is attempted (so further errors may be found).
+// Lexer errors are always recovered from (by skipping the bad input).
+func NewErrorCollector(lexer antlr.Lexer, p antlr.Parser, strict bool) *ErrorCollector {
+	c := &ErrorCollector{antlr.NewDefaultErrorListener(), nil}
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(c)
+	p.RemoveErrorListeners()
+	p.AddErrorListener(c)
+	if strict {
+		p.SetErrorHandler(NewStrictErrorStrategy())
+	}
+	return c
+}
+
+func (c *ErrorCollector) SyntaxError(recognizer antlr.Recognizer,
+	offendingSymbol interface{}, line, column int, msg string,
+	e antlr.RecognitionException) {
+	prefix := "Syntax error: "
+	if _, ok := recognizer.(antlr.Lexer); ok {
+		prefix = "Lexical error: "
+	}
+	span := base.Span{StartLine: line, StartCol: column, EndLine: line,
+		EndCol: column + 1}
+	if tok, ok := offendingSymbol.(antlr.Token); ok &&
+		tok.GetTokenType() != antlr.TokenEOF {
+		span.EndCol = column + len(tok.GetText())
+	}
+	d := base.Diagnostic{Kind: base.DIAG_SYNTAX, Message: prefix + msg, Span: span}
+	c.Errors = append(c.Errors, d)
+}
+
+// Runs parse (e.g., a call to the start rule), stopping quietly on a strict bail out
+func (c *ErrorCollector) Run(parse func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(strictBailOut); !ok {
+				panic(r)
+			}
+		}
+	}()
+	parse()
+}
+
+/* Adaptor errors -- raised by the listener during the walk, cf. base.Adaptor.Parse */
+
+// Walks tree as antlr.ParseTreeWalkerDefault, but returns a base.Diagnostic
+// panic raised by the listener (e.g., a bad import) as an error -- an
+// unlocated one is located at the rule being exited.  Any other panic is
+// propagated.
+func Walk(listener antlr.ParseTreeListener, tree antlr.Tree) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			d, ok := r.(base.Diagnostic)
+			if !ok {
+				panic(r)
+			}
+			err = d
+		}
+	}()
+	walk(listener, tree)
+	return nil
+}
+
+func walk(listener antlr.ParseTreeListener, t antlr.Tree) {
+	switch tt := t.(type) {
+	case antlr.ErrorNode:
+		listener.VisitErrorNode(tt)
+	case antlr.TerminalNode:
+		listener.VisitTerminal(tt)
+	default:
+		ctx := t.(antlr.RuleNode).GetRuleContext().(antlr.ParserRuleContext)
+		listener.EnterEveryRule(ctx)
+		ctx.EnterRule(listener)
+		for i := 0; i < t.GetChildCount(); i++ {
+			walk(listener, t.GetChild(i))
+		}
+		exitRule(listener, ctx)
+	}
+}
+
+func exitRule(listener antlr.ParseTreeListener, ctx antlr.ParserRuleContext) {
+	defer func() {
+		if r := recover(); r != nil {
+			if d, ok := r.(base.Diagnostic); ok && !d.Span.IsKnown() {
+				d.Span = SpanOf(ctx)
+				panic(d)
+			}
+			panic(r)
+		}
+	}()
+	ctx.ExitRule(listener)
+	listener.ExitEveryRule(ctx)
+}
+
+/* For "strict" parsing, *parser* errors */
 
-// Cf. https://stackoverflow.com/questions/51683104/how-to-catch-minor-errors
+// Cf. antlr.BailErrorStrategy, but the error is first reported to the listeners
+// (the generated rule funcs call ReportError before Recover)
 type StrictErrorStrategy struct {
-	antlr.DefaultErrorStrategy
+	*antlr.DefaultErrorStrategy
 }
 
 var _ antlr.ErrorStrategy = &StrictErrorStrategy{}
 
+type strictBailOut struct{}
+
+func NewStrictErrorStrategy() *StrictErrorStrategy {
+	return &StrictErrorStrategy{antlr.NewDefaultErrorStrategy()}
+}
+
 func (s *StrictErrorStrategy) Recover(recognizer antlr.Parser, e antlr.RecognitionException) {
-	token := recognizer.GetCurrentToken()
-	message := "[Parser] error at line " + strconv.Itoa(token.GetLine()) +
-		", position " + strconv.Itoa(token.GetColumn()) + " right before " +
-		s.GetTokenErrorDisplay(token)
-	//panic(message) // + " " + e.GetMessage())
-	fmt.Println(errors.New(message))
-	os.Exit(1)
+	panic(strictBailOut{})
 }
 
-//ErrorStrategy.RecoverInline(Parser) Token
+// No single token insertion/deletion -- the mismatch is reported, then Recover bails out
 func (s *StrictErrorStrategy) RecoverInline(recognizer antlr.Parser) antlr.Token {
-	token := recognizer.GetCurrentToken()
-	message := "[Parser] error at line " + strconv.Itoa(token.GetLine()) +
-		", position " + strconv.Itoa(token.GetColumn()) + " right before " +
-		s.GetTokenErrorDisplay(token)
-	//panic(message) // + fmt.Sprintf("%v", antlr.NewInputMisMatchException(recognizer)))
-	fmt.Println(errors.New(message))
-	os.Exit(1)
-	return nil
+	panic(antlr.NewInputMisMatchException(recognizer))
 }
+
+// Don't attempt to recover from problems in subrules
+func (s *StrictErrorStrategy) Sync(recognizer antlr.Parser) {}
@@ -8,157 +8,515 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
-	"reflect"
-	"strconv"
+	"path/filepath"
+	"strings"
 
-	"github.com/rhu1/fgg/internal/fg"
+	"github.com/rhu1/fgg/api"
 	"github.com/rhu1/fgg/internal/frontend"
 )
 
-var _ = reflect.Append
-var _ = strconv.AppendBool
+// Exit codes, the same for every subcommand
+const (
+	EXIT_OK    = 0 // Success
+	EXIT_ERROR = 1 // The program is rejected: syntax, type or nomono error, run-time panic, failed simulation
+	EXIT_USAGE = 2 // Bad command line, or the input cannot be read/written
+)
 
-// Command line parameters/flags
-var (
-	interpFG  bool // parse FG
-	interpFGG bool // parse FGG
+type command struct {
+	name  string
+	args  string // Synopsis of the non-flag args
+	short string
+	run   func(args []string) int // Returns the exit code
+}
 
-	monom  bool   // parse FGG and monomorphise FGG source -- paper notation (angle bracks)
-	monomc string // output filename of monomorphised FGG; "--" for stdout -- Go output (no angle bracks)
-	// TODO: fix naming between "monomc", "compile" and "oblitc"
+var commands []command
 
-	oblitc         string // output filename of FGR compilation via oblit; "--" for stdout
-	oblitEvalSteps int    // TODO: A concrete FGR syntax, for oblitc to output
+func init() { // In init, as usage refers back to commands
+	commands = []command{
+		{"check", "[flags] file", "parse and type check a program", runCheck},
+		{"run", "[flags] file", "type check and evaluate a program", runRun},
+		{"monom", "[flags] file.fgg", "monomorphise an FGG program to FG", runMonom},
+		{"oblit", "[flags] file.fgg", "[WIP] obliterate an FGG program to FGR", runOblit},
+		{"sim", "[flags] file.fgg", "simulate an FGG program against its monomorphisation/obliteration", runSim},
+		{"convert", "[flags] file.fg", "convert an FG program to FGG", runConvert},
+		{"fmt", "[flags] file...", "format programs (print, or rewrite with -w)", runFmt},
+	}
+}
 
-	monomtest bool
-	oblittest bool
+func usage() {
+	fmt.Fprintf(os.Stderr, `Usage:
 
-	useInternalSrc bool   // use internal source
-	inlineSrc      string // use content of this as source
-	strictParse    bool   // use strict parsing mode
+	fgg <command> [flags] path/to/file.{fg,go,fgg}
+	fgg <command> [flags] -lang=fg|fgg -inline "package main; type ...; func main() { ... }"
 
-	evalSteps int  // number of steps to evaluate
-	verbose   bool // verbose mode
-	printf    bool // use ToGoString for output (e.g., "main." type prefix)
-)
+Commands:
 
-func init() {
-	// FG or FGG
-	flag.BoolVar(&interpFG, "fg", false,
-		"interpret input as FG (defaults to true if neither -fg/-fgg set)")
-	flag.BoolVar(&interpFGG, "fgg", false,
-		"interpret input as FGG")
-
-	// Erasure by monomorphisation -- implicitly disabled if not -fgg
-	flag.BoolVar(&monom, "monom", false,
-		"monomorphise FGG source using paper notation, i.e., angle bracks (ignored if -fgg not set)")
-	flag.StringVar(&monomc, "monomc", "", // Empty string for "false"
-		"monomorphise FGG source to (Go-compatible) FG, i.e., no angle bracks (ignored if -fgg not set)\n"+
-			"specify '--' to print to stdout")
-
-	// Erasure(?) by translation based on type reps -- FGG vs. FGR?
-	flag.StringVar(&oblitc, "oblitc", "", // Empty string for "false"
-		"[WIP] compile FGG source to FGR (ignored if -fgg not set)\n"+
-			"specify '--' to print to stdout")
-	flag.IntVar(&oblitEvalSteps, "oblit-eval", frontend.NO_EVAL,
-		" N ⇒ evaluate N (≥ 0) steps; or\n-1 ⇒ evaluate to value (or panic)")
+`)
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "\t%-8s %s\n", c.name, c.short)
+	}
+	fmt.Fprintf(os.Stderr, `
+The language is determined by the file extension (.fg or .go for FG, .fgg for
+FGG), unless given by -lang.  Use "fgg <command> -h" for the flags of a command.
 
-	flag.BoolVar(&monomtest, "test-monom", false, `Test monom correctness`)
-	flag.BoolVar(&oblittest, "test-oblit", false, `[WIP] Test oblit correctness`)
+Exit codes: %d ok; %d program rejected (syntax/type error, run-time panic, ...);
+%d usage or I/O error.
+`, EXIT_OK, EXIT_ERROR, EXIT_USAGE)
+}
 
-	// Parsing options
-	flag.BoolVar(&useInternalSrc, "internal", false,
-		`use "internal" input as source`)
-	flag.StringVar(&inlineSrc, "inline", "",
-		`-inline="[FG/FGG src]", use inline input as source`)
-	flag.BoolVar(&strictParse, "strict", true,
-		"strict parsing (default true, means don't attempt recovery on parsing errors)")
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
 
-	flag.IntVar(&evalSteps, "eval", frontend.NO_EVAL,
-		" N ⇒ evaluate N (≥ 0) steps; or\n-1 ⇒ evaluate to value (or panic)")
-	flag.BoolVar(&verbose, "v", false,
-		"enable verbose printing")
-	frontend.Verbose = verbose
-	flag.BoolVar(&printf, "printf", false,
-		"use Go style output type name prefixes")
+// Runs the subcommand named by args[0], returning the exit code
+func run(args []string) int {
+	if len(args) < 1 {
+		usage()
+		return EXIT_USAGE
+	}
+	name := args[0]
+	if name == "help" || name == "-h" || name == "-help" || name == "--help" {
+		usage()
+		return EXIT_OK
+	}
+	for _, c := range commands {
+		if c.name == name {
+			return c.run(args[1:])
+		}
+	}
+	fmt.Fprintln(os.Stderr, "Unknown command: "+name)
+	usage()
+	return EXIT_USAGE
 }
 
-var usage = func() {
-	fmt.Fprintf(os.Stderr, `Usage:
+/* Common flags */
 
-	fgg [options] -fg  path/to/file.fg
-	fgg [options] -fgg path/to/file.fgg
-	fgg [options] -internal
-	fgg [options] -inline "package main; type ...; func main() { ... }"
+// The flags for reading and parsing the input program, shared by all commands
+type input struct {
+	name    string // The command
+	lang    string // "fg", "fgg", or "" to use the file extension
+	inline  string // If not "", use this as the source (instead of a file)
+	strict  bool   // Don't attempt recovery on parsing errors
+	verbose bool
 
-Options:
+	path string // Set by read -- the file name, or "<inline>"
+	src  string // Set by read
+}
 
-`)
-	flag.PrintDefaults()
-	os.Exit(1)
+func newFlagSet(in *input, c string, args string) *flag.FlagSet {
+	in.name = c
+	fs := flag.NewFlagSet(c, flag.ContinueOnError)
+	fs.StringVar(&in.lang, "lang", "",
+		"input language, fg or fgg (default: from the file extension)")
+	fs.StringVar(&in.inline, "inline", "",
+		`-inline="[FG/FGG src]", use inline input as source (requires -lang)`)
+	fs.BoolVar(&in.strict, "strict", true,
+		"strict parsing (default true, means don't attempt recovery on parsing errors)")
+	fs.BoolVar(&in.verbose, "v", false,
+		"enable verbose printing")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n\n\tfgg %s %s\n\nFlags:\n\n", c, args)
+		fs.PrintDefaults()
+	}
+	return fs
 }
 
-func main() {
-	flag.Usage = usage
-	flag.Parse()
-	frontend.OblitEvalSteps = oblitEvalSteps
-
-	// Determine (default) mode
-	if interpFG {
-		if interpFGG { // -fg "overrules" -fgg
-			interpFGG = false
+// Returns the exit code if args cannot be parsed, or -1 to continue
+func parseFlags(fs *flag.FlagSet, args []string) int {
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return EXIT_OK
 		}
-	} else if !interpFGG {
-		interpFG = true // -fg default
+		return EXIT_USAGE
 	}
+	return -1
+}
 
-	// Determine source
+// Returns the source and language of the input, cf. fs.Args()
+func (in *input) read(fs *flag.FlagSet) (string, api.Lang, int) {
 	var src string
 	switch {
-	case useInternalSrc: // First priority
-		src = internalSrc() // FIXME: hardcoded to FG
-	case inlineSrc != "": // Second priority, i.e., -inline overrules src file
-		src = inlineSrc
-	default:
-		if flag.NArg() < 1 {
-			fmt.Fprintln(os.Stderr, "Input error: need a source .go file (or an -inline program)")
-			flag.Usage()
+	case in.inline != "":
+		if fs.NArg() > 0 {
+			return in.usageError(fs, "cannot give both -inline and a file")
 		}
-		b, err := ioutil.ReadFile(flag.Arg(0))
+		in.path = "<inline>"
+		src = in.inline
+	case fs.NArg() == 1:
+		in.path = fs.Arg(0)
+		b, err := ioutil.ReadFile(in.path)
 		if err != nil {
-			frontend.CheckErr(err)
+			fmt.Fprintln(os.Stderr, err)
+			return "", 0, EXIT_USAGE
 		}
 		src = string(b)
+	case fs.NArg() == 0:
+		return in.usageError(fs, "need a source file (or an -inline program)")
+	default:
+		return in.usageError(fs, "too many arguments: "+strings.Join(fs.Args(), " "))
+	}
+	lang, err := detectLang(in.lang, in.path)
+	if err != nil {
+		return in.usageError(fs, err.Error())
+	}
+	in.src = src
+	return src, lang, -1
+}
+
+func (in *input) usageError(fs *flag.FlagSet, msg string) (string, api.Lang, int) {
+	fmt.Fprintln(os.Stderr, "Input error: "+msg)
+	fs.Usage()
+	return "", 0, EXIT_USAGE
+}
+
+// -lang, if given, takes precedence over the file extension
+func detectLang(lang string, path string) (api.Lang, error) {
+	switch strings.ToLower(lang) {
+	case "fg":
+		return api.FG, nil
+	case "fgg":
+		return api.FGG, nil
+	case "":
+	default:
+		return 0, fmt.Errorf("unknown language %q (expected fg or fgg)", lang)
+	}
+	switch filepath.Ext(path) {
+	case ".fg", ".go":
+		return api.FG, nil
+	case ".fgg":
+		return api.FGG, nil
+	default:
+		return 0, fmt.Errorf("cannot determine the language of %s (use -lang=fg or -lang=fgg)",
+			path)
+	}
+}
+
+// Reads and parses the input, reporting any errors
+func (in *input) parse(fs *flag.FlagSet) (*api.Program, int) {
+	src, lang, code := in.read(fs)
+	if code >= 0 {
+		return nil, code
+	}
+	opts := api.ParseOptions{Strict: in.strict}
+	var p *api.Program
+	var err error
+	if lang == api.FG {
+		p, err = api.ParseFG(src, opts)
+	} else {
+		p, err = api.ParseFGG(src, opts)
+	}
+	if err != nil {
+		return nil, in.report(err)
+	}
+	in.vPrintln("Parsed " + lang.String() + ":\n" + p.String())
+	return p, -1
+}
+
+// Parses and checks the input
+func (in *input) check(fs *flag.FlagSet) (*api.Program, int) {
+	p, code := in.parse(fs)
+	if code >= 0 {
+		return nil, code
+	}
+	checked, err := api.Check(p, api.CheckOptions{})
+	if err != nil {
+		return nil, in.report(err)
+	}
+	in.vPrintln("Checked OK: " + checked.Type())
+	return checked, -1
+}
+
+// Prints err (every error, if api.Errors) to stderr, located at the input.
+// Returns EXIT_ERROR.
+func (in *input) report(err error) int {
+	if errs, ok := err.(api.Errors); ok {
+		for _, e := range errs {
+			if d, ok := e.(api.Diagnostic); ok && d.Span.IsKnown() {
+				fmt.Fprintln(os.Stderr, in.path+":"+e.Error()) // "path:line:col-line:col: msg"
+			} else {
+				fmt.Fprintln(os.Stderr, in.path+": "+e.Error())
+			}
+		}
+		return EXIT_ERROR
+	}
+	fmt.Fprintln(os.Stderr, in.path+": "+err.Error())
+	return EXIT_ERROR
+}
+
+func (in *input) vPrintln(x string) {
+	frontend.VPrintln(in.verbose, x)
+}
+
+// Writes out to the named file, or stdout if "" or "--"
+func writeOutput(in *input, file string, out string) int {
+	if file == "" || file == "--" {
+		fmt.Println(out)
+		return EXIT_OK
+	}
+	in.vPrintln("Writing output to: " + file)
+	if err := ioutil.WriteFile(file, []byte(out+"\n"), 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return EXIT_USAGE
+	}
+	return EXIT_OK
+}
+
+func requireFGG(in *input, p *api.Program) int {
+	if p.Lang() != api.FGG {
+		fmt.Fprintln(os.Stderr, "Input error: fgg "+in.name+" expects an FGG program, not "+
+			p.Lang().String())
+		return EXIT_USAGE
+	}
+	return -1
+}
+
+/* Commands */
+
+func runCheck(args []string) int {
+	var in input
+	fs := newFlagSet(&in, "check", "[flags] file")
+	if code := parseFlags(fs, args); code >= 0 {
+		return code
+	}
+	if _, code := in.check(fs); code >= 0 {
+		return code
 	}
+	return EXIT_OK
+}
+
+// The number of steps to evaluate -- as for the previous -eval flag
+func evalFlag(fs *flag.FlagSet, dflt int) *int {
+	return fs.Int("eval", dflt,
+		" N ⇒ evaluate N (≥ 0) steps; or\n-1 ⇒ evaluate to value (or panic)")
+}
 
-	// Currently hacked
-	if monomtest {
-		frontend.TestMonom(printf, verbose, src, evalSteps)
-		//testMonom(printf bool, verbose bool, src string, steps int) {
-		return // FIXME
-	} else if oblittest {
-		frontend.TestOblit(verbose, src, evalSteps)
-		//testOblit(verbose, src, evalSteps)  // TODO: "weak" oblit simulation
-		return
+// Evaluates p (and prints the result) if steps >= 0 or steps == EVAL_TO_VAL
+func evalAndPrint(in *input, p *api.Program, steps int, printf bool) int {
+	if steps < frontend.EVAL_TO_VAL {
+		fmt.Fprintf(os.Stderr, "Input error: invalid number of steps: %d\n", steps)
+		return EXIT_USAGE
 	}
+	res := p
+	if steps != 0 { // api.Eval evaluates to a value if Steps <= 0
+		opts := api.EvalOptions{Steps: steps, CheckSteps: true}
+		if in.verbose {
+			fmt.Printf("%6d: %8s %v\n", 0, "", p.Main())
+			opts.Trace = func(i int, rule string, p *api.Program) {
+				fmt.Printf("%6d: %8s %v\n", i, "["+rule+"]", p.Main())
+			}
+		}
+		var err error
+		if res, err = api.Eval(context.Background(), p, opts); err != nil {
+			return in.report(err)
+		}
+	}
+	if res.IsPanic() {
+		fmt.Fprintln(os.Stderr, in.path+": "+res.Main())
+		return EXIT_ERROR
+	}
+	if printf {
+		fmt.Println(res.MainGoString())
+	} else {
+		fmt.Println(res.Main())
+	}
+	return EXIT_OK
+}
 
-	switch { // Pre: !(interpFG && interpFGG)
-	case interpFG:
-		frontend.FGmain(verbose, src, strictParse, evalSteps, printf)
-		// monom implicitly disabled
-	case interpFGG:
-		frontend.FGGmain(verbose, src, strictParse, evalSteps, printf, monom, monomc, oblitc)
+func runRun(args []string) int {
+	var in input
+	fs := newFlagSet(&in, "run", "[flags] file")
+	steps := evalFlag(fs, frontend.EVAL_TO_VAL)
+	printf := fs.Bool("printf", false, "use Go style output type name prefixes")
+	if code := parseFlags(fs, args); code >= 0 {
+		return code
 	}
+	p, code := in.check(fs)
+	if code >= 0 {
+		return code
+	}
+	return evalAndPrint(&in, p, *steps, *printf)
 }
 
-// For convenient quick testing -- via flag "-internal"
-func internalSrc() string {
-	Any := "type Any interface {}"
-	ToAny := "type ToAny struct { any Any }"
-	e := "ToAny{1}"                        // CHECKME: `1` skipped by parser?
-	return fg.MakeFgProgram(Any, ToAny, e) // FIXME: hardcoded FG
+func runMonom(args []string) int {
+	var in input
+	fs := newFlagSet(&in, "monom", "[flags] file.fgg")
+	out := fs.String("o", "--",
+		"output file for the FG program; '--' for stdout")
+	paper := fs.Bool("paper", false,
+		"output using paper notation, i.e., angle bracks (default is Go-compatible FG)")
+	if code := parseFlags(fs, args); code >= 0 {
+		return code
+	}
+	p, code := in.check(fs)
+	if code >= 0 {
+		return code
+	}
+	if code := requireFGG(&in, p); code >= 0 {
+		return code
+	}
+	p_mono, err := api.Monomorphise(p, api.MonomOptions{Check: true})
+	if err != nil {
+		return in.report(err)
+	}
+	res := p_mono.String()
+	if !*paper {
+		res = frontend.MonomOutputHack(res)
+	}
+	return writeOutput(&in, *out, res)
+}
+
+func runOblit(args []string) int {
+	var in input
+	fs := newFlagSet(&in, "oblit", "[flags] file.fgg")
+	out := fs.String("o", "--",
+		"output file for the FGR program; '--' for stdout")
+	steps := evalFlag(fs, frontend.NO_EVAL) // TODO: A concrete FGR syntax, for the output to be run separately
+	printf := fs.Bool("printf", false, "use Go style output type name prefixes")
+	if code := parseFlags(fs, args); code >= 0 {
+		return code
+	}
+	p, code := in.check(fs)
+	if code >= 0 {
+		return code
+	}
+	if code := requireFGG(&in, p); code >= 0 {
+		return code
+	}
+	p_fgr, err := api.Obliterate(p, api.OblitOptions{Check: true})
+	if err != nil {
+		return in.report(err)
+	}
+	if code := writeOutput(&in, *out, p_fgr.String()); code != EXIT_OK {
+		return code
+	}
+	if *steps > frontend.NO_EVAL {
+		return evalAndPrint(&in, p_fgr, *steps, *printf)
+	}
+	return EXIT_OK
+}
+
+func runSim(args []string) int {
+	var in input
+	fs := newFlagSet(&in, "sim", "[flags] file.fgg")
+	oblit := fs.Bool("oblit", false,
+		"[WIP] simulate against the obliteration (default is the monomorphisation)")
+	steps := evalFlag(fs, frontend.EVAL_TO_VAL)
+	printf := fs.Bool("printf", false, "use Go style output type name prefixes")
+	if code := parseFlags(fs, args); code >= 0 {
+		return code
+	}
+	p, code := in.check(fs) // Report any errors before simulating
+	if code >= 0 {
+		return code
+	}
+	if code := requireFGG(&in, p); code >= 0 {
+		return code
+	}
+	return in.simulate(func() {
+		if *oblit {
+			frontend.TestOblit(in.verbose, in.src, *steps)
+		} else {
+			frontend.TestMonom(*printf, in.verbose, in.src, *steps)
+		}
+	})
+}
+
+// The simulation checks raise a panic on failure
+func (in *input) simulate(f func()) (code int) {
+	defer func() {
+		if r := recover(); r != nil {
+			code = in.report(fmt.Errorf("simulation failed: %v", r))
+		}
+	}()
+	f()
+	return EXIT_OK
+}
+
+func runConvert(args []string) int {
+	var in input
+	fs := newFlagSet(&in, "convert", "[flags] file.fg")
+	out := fs.String("o", "--",
+		"output file for the FGG program; '--' for stdout")
+	if code := parseFlags(fs, args); code >= 0 {
+		return code
+	}
+	p, code := in.parse(fs)
+	if code >= 0 {
+		return code
+	}
+	if p.Lang() != api.FG {
+		fmt.Fprintln(os.Stderr, "Input error: fgg convert expects an FG program, not "+
+			p.Lang().String())
+		return EXIT_USAGE
+	}
+	p_fgg, err := api.FromFG(p)
+	if err != nil {
+		return in.report(err)
+	}
+	return writeOutput(&in, *out, p_fgg.String())
+}
+
+// Each file is formatted in turn; with -w, rewritten in place (if changed)
+func runFmt(args []string) int {
+	var in input
+	fs := newFlagSet(&in, "fmt", "[flags] file...")
+	write := fs.Bool("w", false,
+		"write the result to (each) source file instead of stdout")
+	if code := parseFlags(fs, args); code >= 0 {
+		return code
+	}
+	if in.inline != "" || fs.NArg() == 0 {
+		src, lang, code := in.read(fs)
+		if code >= 0 {
+			return code
+		}
+		if *write {
+			_, _, code := in.usageError(fs, "cannot use -w with -inline")
+			return code
+		}
+		return in.format(src, lang, false)
+	}
+	res := EXIT_OK
+	for _, path := range fs.Args() {
+		in.path = path
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return EXIT_USAGE
+		}
+		lang, err := detectLang(in.lang, path)
+		if err != nil {
+			_, _, code := in.usageError(fs, err.Error())
+			return code
+		}
+		if code := in.format(string(b), lang, *write); code > res {
+			res = code
+		}
+	}
+	return res
+}
+
+func (in *input) format(src string, lang api.Lang, write bool) int {
+	out, err := api.Format(src, lang, api.ParseOptions{Strict: in.strict})
+	if err != nil {
+		return in.report(err)
+	}
+	if !write {
+		fmt.Print(out)
+		return EXIT_OK
+	}
+	if out == src {
+		return EXIT_OK
+	}
+	in.vPrintln("Rewriting: " + in.path)
+	if err := ioutil.WriteFile(in.path, []byte(out), 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return EXIT_USAGE
+	}
+	return EXIT_OK
 }
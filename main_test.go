@@ -0,0 +1,87 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rhu1/fgg/api"
+)
+
+const fgOk = "package main; type A struct {}; func main() { _ = A{} }"
+
+func TestDetectLang(t *testing.T) {
+	tests := []struct {
+		lang, path string
+		exp        api.Lang
+		ok         bool
+	}{
+		{"", "a.fg", api.FG, true},
+		{"", "a.go", api.FG, true},
+		{"", "a.fgg", api.FGG, true},
+		{"fg", "a.fgg", api.FG, true}, // -lang takes precedence
+		{"FGG", "a.fg", api.FGG, true},
+		{"fgg", "<inline>", api.FGG, true},
+		{"", "<inline>", 0, false},
+		{"", "a.txt", 0, false},
+		{"fgr", "a.fg", 0, false},
+	}
+	for _, v := range tests {
+		lang, err := detectLang(v.lang, v.path)
+		if (err == nil) != v.ok || (v.ok && lang != v.exp) {
+			t.Errorf("detectLang(%q, %q): expected %v (ok=%v), got: %v, %v",
+				v.lang, v.path, v.exp, v.ok, lang, err)
+		}
+	}
+}
+
+// -lang overrides the file extension, e.g., FG source in a .fgg file
+func TestLangFlag(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fgg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "a.fgg")
+	if err := ioutil.WriteFile(path, []byte(fgOk), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if code := run([]string{"check", "-lang=fg", path}); code != EXIT_OK {
+		t.Errorf("Expected exit code %d, got: %d", EXIT_OK, code)
+	}
+	if code := run([]string{"check", path}); code != EXIT_ERROR {
+		t.Errorf("Expected exit code %d (FG source parsed as FGG), got: %d", EXIT_ERROR, code)
+	}
+}
+
+func TestExitCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		exp  int
+	}{
+		{"no command", []string{}, EXIT_USAGE},
+		{"unknown command", []string{"compile", "a.fg"}, EXIT_USAGE},
+		{"help", []string{"help"}, EXIT_OK},
+		{"unknown flag", []string{"check", "-foo", "a.fg"}, EXIT_USAGE},
+		{"no input", []string{"check"}, EXIT_USAGE},
+		{"missing file", []string{"check", "does/not/exist.fg"}, EXIT_USAGE},
+		{"no language", []string{"check", "-inline", fgOk}, EXIT_USAGE},
+		{"ok", []string{"run", "-lang=fg", "-inline", fgOk}, EXIT_OK},
+		{"syntax error", []string{"check", "-lang=fg", "-inline",
+			"package main; type A struct {; func main() { _ = A{} }"}, EXIT_ERROR},
+		{"type error", []string{"check", "-lang=fg", "-inline",
+			"package main; type A struct {}; func main() { _ = B{} }"}, EXIT_ERROR},
+		{"run-time panic", []string{"run", "-lang=fg", "-inline",
+			"package main; type A struct {}; " +
+				"func (x0 A) div(y int32) int32 { return 1 / y }; " +
+				"func main() { _ = A{}.div(0) }"}, EXIT_ERROR},
+		{"not FGG", []string{"monom", "-lang=fg", "-inline", fgOk}, EXIT_USAGE},
+	}
+	for _, v := range tests {
+		if code := run(v.args); code != v.exp {
+			t.Errorf("%s: expected exit code %d, got: %d", v.name, v.exp, code)
+		}
+	}
+}
@@ -3,19 +3,31 @@ grammar FGG;
 
 /* Keywords */
 
+CASE: 'case';
+DEFAULT: 'default';
+ELSE: 'else';
 FUNC: 'func';
+IF: 'if';
 INTERFACE: 'interface';
 MAIN: 'main';
+MAP: 'map';
 PACKAGE: 'package';
 RETURN: 'return';
 STRUCT: 'struct';
+SWITCH: 'switch';
 TYPE: 'type';
+VAR: 'var';
 
 IMPORT: 'import';
 FMT: 'fmt';
 PRINTF: 'Printf';
 SPRINTF: 'Sprintf';
 
+// builtin functions
+LEN: 'len';
+APPEND: 'append';
+PANIC: 'panic';
+
 // base/primitive types
 TRUE      : 'true' ;
 FALSE     : 'false' ;
@@ -30,12 +42,28 @@ STRING    : 'string' ;
 // arithmetic ops
 PLUS      : '+' ;
 MINUS     : '-' ;
+TIMES     : '*' ;
+DIV       : '/' ;
+MOD       : '%' ;
+// bitwise ops
+BITAND    : '&' ;
+BITOR     : '|' ;
+BITXOR    : '^' ;
+SHL       : '<<' ;
+SHR       : '>>' ;
 // logical ops
+NOT       : '!' ;
 AND       : '&&' ;
 OR        : '||' ;
 // relational ops
+EQ        : '==' ;
+NE        : '!=' ;
 GT        : '>' ;
 LT        : '<' ;
+GE        : '>=' ;
+LE        : '<=' ;
+// type sets
+TILDE     : '~' ;
 // ...
 
 /* Tokens */
@@ -65,10 +93,13 @@ FLOAT_LIT       : DIGITS ('.' DIGIT* EXPON? | EXPON)
 // decls, used for sequences: comes out as "helper" Contexts, nodes that group up actual children
 // underneath -- makes "adapting" easier.
 
-typ        : name=NAME                              # TypeParam
-           | name=NAME '(' typs? ')'                # TypeName
+typ        : name=NAME '(' typs? ')'                # TypeName  // N.B. before TypeParam, e.g., "f(t)(e)" with Apply
+           | name=NAME                              # TypeParam
            | name=primName                          # TPrimitive
            | typeLit                                # TypeLit_
+           | FUNC '(' typs? ')' typ                 # TFunc
+           | '[' ']' elem=typ                       # TSlice
+           | MAP '[' key=typ ']' elem=typ           # TMap
            ;
 typs       : typ (',' typ)* ;
 primName   : BOOL
@@ -77,8 +108,7 @@ primName   : BOOL
            | STRING
            ;
 typeLit    : STRUCT '{' fieldDecls? '}'	            # StructTypeLit
-//	       | INTERFACE '{' (typeList ';')? specs? '}'	    # InterfaceTypeLit
-	       | INTERFACE '{' typeList? specs? '}'	    # InterfaceTypeLit
+	       | INTERFACE '{' (typeList ';'?)? specs? '}'	    # InterfaceTypeLit  // N.B. the ";" is optional, cf. ITypeLit.String
 	       ;
 typeFormals: '(' TYPE typeFDecls? ')' ; // Refactored "(...)" into here
 typeFDecls : typeFDecl (',' typeFDecl)* ;
@@ -88,32 +118,55 @@ program    : PACKAGE MAIN ';'
              decls? FUNC MAIN '(' ')' '{'
                 ( '_' '=' expr | FMT '.' PRINTF '(' '"%#v"' ',' expr ')' )
              '}' EOF ;
-decls      : ((typeDecl | methDecl) ';')+ ;
+decls      : ((typeDecl | methDecl | funcDecl) ';')+ ;
 typeDecl   : TYPE id=NAME typeFormals typ ;
-methDecl   : FUNC '(' recv = NAME typn = NAME typeFormals ')' sig '{' RETURN expr '}' ;
+methDecl   : FUNC '(' recv = NAME typn = NAME typeFormals ')' sig '{' body '}' ;
+funcDecl   : FUNC sig '{' body '}' ;
+body       : RETURN expr | PANIC '(' expr ')' | ifElse | typeSwitch | binding ';' body ;  // N.B. panic is a statement, not an expr
+binding    : NAME ':=' expr | VAR NAME typ '=' expr
+           | NAME ',' NAME ':=' expr                // Comma-ok, e.g., "v, ok := m[k]"
+           | NAME '[' expr ']' '=' expr             // Map update
+           ;
+ifElse     : IF expr '{' body '}' ELSE ('{' body '}' | ifElse) ;
+typeSwitch : SWITCH (NAME ':=')? expr '.' '(' TYPE ')' '{' typeCase* (DEFAULT ':' body)? '}' ;
+typeCase   : CASE typ ':' body ';'? ;             // N.B. the ";" is optional, cf. TypeSwitch.String
 fieldDecls : fieldDecl (';' fieldDecl)*;
-fieldDecl  : field = NAME typ;
+fieldDecl  : field = NAME typ | embedded = typ;      // N.B. an embedded field is a type name, cf. STypeLit.Ok
 typeList   : TYPE typs ;
 specs      : spec (';' spec)*;
-spec       : (sig | typ) ;
+spec       : (sig | union) ;
+union      : term ('|' term)* ;                     // N.B. a single term without "~" is an embedded type
+term       : tilde=TILDE? typ ;
 sig        : meth = NAME typeFormals '(' params? ')' typ;
 params     : paramDecl (',' paramDecl)*;
 paramDecl  : vari = NAME typ;
 expr       :
-	NAME																# Variable
-	| typ '{' exprs? '}'                                                # StructLit
-	| expr '.' NAME														# Select
+	typ '{' exprs? '}'                                                  # StructLit  // N.B. also slice literals, e.g., "[]int32{1, 2}"
+	| typ '{' entries '}'                                               # MapLit  // N.B. "u{}" is a StructLit
 	| recv = expr '.' NAME '(' targs = typs? ')' '(' args = exprs? ')'	# Call
+	| expr '.' NAME														# Select
+	| expr '(' args = exprs? ')'                                        # Apply  // N.B. Call comes first, so "(x.f)(e)" to apply a field
+	| expr '[' index = expr ']'                                         # Index
 	| expr '.' '(' typ ')'												# Assert
 	| FMT '.' SPRINTF '(' (STRING_LIT | '"%#v"') (',' | expr)* ')'		# Sprintf
-	| expr op=(PLUS | MINUS) expr                                       # BinaryOp
-	| expr op=(GT | LT) expr                                            # BinaryOp
+	| LEN '(' expr ')'                                                  # Len
+	| APPEND '(' args = exprs ')'                                       # Append
+	| typ '(' expr ')'                                                  # Convert
+	| NAME '(' targs = typs? ')' '(' args = exprs? ')'                 # FuncCall  // N.B. "f(t)(e)" is parsed as a Convert, cf. FGGAdaptor.ExitConvert
+	| NAME                                                              # Variable  // N.B. after Convert/FuncCall, e.g., "x(e)" with x a local is resolved by typing
+	| FUNC '(' params? ')' typ '{' body '}'                             # FuncLit
+	| op=(NOT | MINUS) expr                                             # UnaryOp
+	| expr op=(TIMES | DIV | MOD | SHL | SHR | BITAND) expr              # BinaryOp
+	| expr op=(PLUS | MINUS | BITOR | BITXOR) expr                      # BinaryOp
+	| expr op=(EQ | NE | GT | LT | GE | LE) expr                        # BinaryOp
 	| expr op=AND expr                                                  # BinaryOp
 	| expr op=OR expr                                                   # BinaryOp
 	| '(' expr ')'                                                      # Paren
 	| primLit                                                           # PrimaryLit
 	;
 exprs      : expr (',' expr)*;
+entries    : entry (',' entry)*;
+entry      : key = expr ':' val = expr;
 primLit    : lit=(TRUE|FALSE)                       # BoolLit
            | lit=INT_LIT                            # IntLit
            | lit=FLOAT_LIT                          # FloatLit
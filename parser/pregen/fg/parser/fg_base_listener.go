@@ -1,4 +1,4 @@
-// Code generated from parser/FG.g4 by ANTLR 4.7.2. DO NOT EDIT.
+// Code generated from parser/FG.g4 by ANTLR 4.10.1. DO NOT EDIT.
 
 package parser // FG
 
@@ -21,6 +21,66 @@ func (s *BaseFGListener) EnterEveryRule(ctx antlr.ParserRuleContext) {}
 // ExitEveryRule is called when any rule is exited.
 func (s *BaseFGListener) ExitEveryRule(ctx antlr.ParserRuleContext) {}
 
+// EnterTNamed is called when production TNamed is entered.
+func (s *BaseFGListener) EnterTNamed(ctx *TNamedContext) {}
+
+// ExitTNamed is called when production TNamed is exited.
+func (s *BaseFGListener) ExitTNamed(ctx *TNamedContext) {}
+
+// EnterTPrimitive is called when production TPrimitive is entered.
+func (s *BaseFGListener) EnterTPrimitive(ctx *TPrimitiveContext) {}
+
+// ExitTPrimitive is called when production TPrimitive is exited.
+func (s *BaseFGListener) ExitTPrimitive(ctx *TPrimitiveContext) {}
+
+// EnterTypeLit_ is called when production TypeLit_ is entered.
+func (s *BaseFGListener) EnterTypeLit_(ctx *TypeLit_Context) {}
+
+// ExitTypeLit_ is called when production TypeLit_ is exited.
+func (s *BaseFGListener) ExitTypeLit_(ctx *TypeLit_Context) {}
+
+// EnterTFunc is called when production TFunc is entered.
+func (s *BaseFGListener) EnterTFunc(ctx *TFuncContext) {}
+
+// ExitTFunc is called when production TFunc is exited.
+func (s *BaseFGListener) ExitTFunc(ctx *TFuncContext) {}
+
+// EnterTSlice is called when production TSlice is entered.
+func (s *BaseFGListener) EnterTSlice(ctx *TSliceContext) {}
+
+// ExitTSlice is called when production TSlice is exited.
+func (s *BaseFGListener) ExitTSlice(ctx *TSliceContext) {}
+
+// EnterTMap is called when production TMap is entered.
+func (s *BaseFGListener) EnterTMap(ctx *TMapContext) {}
+
+// ExitTMap is called when production TMap is exited.
+func (s *BaseFGListener) ExitTMap(ctx *TMapContext) {}
+
+// EnterTyps is called when production typs is entered.
+func (s *BaseFGListener) EnterTyps(ctx *TypsContext) {}
+
+// ExitTyps is called when production typs is exited.
+func (s *BaseFGListener) ExitTyps(ctx *TypsContext) {}
+
+// EnterPrimName is called when production primName is entered.
+func (s *BaseFGListener) EnterPrimName(ctx *PrimNameContext) {}
+
+// ExitPrimName is called when production primName is exited.
+func (s *BaseFGListener) ExitPrimName(ctx *PrimNameContext) {}
+
+// EnterStructTypeLit is called when production StructTypeLit is entered.
+func (s *BaseFGListener) EnterStructTypeLit(ctx *StructTypeLitContext) {}
+
+// ExitStructTypeLit is called when production StructTypeLit is exited.
+func (s *BaseFGListener) ExitStructTypeLit(ctx *StructTypeLitContext) {}
+
+// EnterInterfaceTypeLit is called when production InterfaceTypeLit is entered.
+func (s *BaseFGListener) EnterInterfaceTypeLit(ctx *InterfaceTypeLitContext) {}
+
+// ExitInterfaceTypeLit is called when production InterfaceTypeLit is exited.
+func (s *BaseFGListener) ExitInterfaceTypeLit(ctx *InterfaceTypeLitContext) {}
+
 // EnterProgram is called when production program is entered.
 func (s *BaseFGListener) EnterProgram(ctx *ProgramContext) {}
 
@@ -45,17 +105,41 @@ func (s *BaseFGListener) EnterMethDecl(ctx *MethDeclContext) {}
 // ExitMethDecl is called when production methDecl is exited.
 func (s *BaseFGListener) ExitMethDecl(ctx *MethDeclContext) {}
 
-// EnterStructTypeLit is called when production StructTypeLit is entered.
-func (s *BaseFGListener) EnterStructTypeLit(ctx *StructTypeLitContext) {}
+// EnterFuncDecl is called when production funcDecl is entered.
+func (s *BaseFGListener) EnterFuncDecl(ctx *FuncDeclContext) {}
 
-// ExitStructTypeLit is called when production StructTypeLit is exited.
-func (s *BaseFGListener) ExitStructTypeLit(ctx *StructTypeLitContext) {}
+// ExitFuncDecl is called when production funcDecl is exited.
+func (s *BaseFGListener) ExitFuncDecl(ctx *FuncDeclContext) {}
 
-// EnterInterfaceTypeLit is called when production InterfaceTypeLit is entered.
-func (s *BaseFGListener) EnterInterfaceTypeLit(ctx *InterfaceTypeLitContext) {}
+// EnterBody is called when production body is entered.
+func (s *BaseFGListener) EnterBody(ctx *BodyContext) {}
 
-// ExitInterfaceTypeLit is called when production InterfaceTypeLit is exited.
-func (s *BaseFGListener) ExitInterfaceTypeLit(ctx *InterfaceTypeLitContext) {}
+// ExitBody is called when production body is exited.
+func (s *BaseFGListener) ExitBody(ctx *BodyContext) {}
+
+// EnterBinding is called when production binding is entered.
+func (s *BaseFGListener) EnterBinding(ctx *BindingContext) {}
+
+// ExitBinding is called when production binding is exited.
+func (s *BaseFGListener) ExitBinding(ctx *BindingContext) {}
+
+// EnterIfElse is called when production ifElse is entered.
+func (s *BaseFGListener) EnterIfElse(ctx *IfElseContext) {}
+
+// ExitIfElse is called when production ifElse is exited.
+func (s *BaseFGListener) ExitIfElse(ctx *IfElseContext) {}
+
+// EnterTypeSwitch is called when production typeSwitch is entered.
+func (s *BaseFGListener) EnterTypeSwitch(ctx *TypeSwitchContext) {}
+
+// ExitTypeSwitch is called when production typeSwitch is exited.
+func (s *BaseFGListener) ExitTypeSwitch(ctx *TypeSwitchContext) {}
+
+// EnterTypeCase is called when production typeCase is entered.
+func (s *BaseFGListener) EnterTypeCase(ctx *TypeCaseContext) {}
+
+// ExitTypeCase is called when production typeCase is exited.
+func (s *BaseFGListener) ExitTypeCase(ctx *TypeCaseContext) {}
 
 // EnterFieldDecls is called when production fieldDecls is entered.
 func (s *BaseFGListener) EnterFieldDecls(ctx *FieldDeclsContext) {}
@@ -75,17 +159,11 @@ func (s *BaseFGListener) EnterSpecs(ctx *SpecsContext) {}
 // ExitSpecs is called when production specs is exited.
 func (s *BaseFGListener) ExitSpecs(ctx *SpecsContext) {}
 
-// EnterSigSpec is called when production SigSpec is entered.
-func (s *BaseFGListener) EnterSigSpec(ctx *SigSpecContext) {}
-
-// ExitSigSpec is called when production SigSpec is exited.
-func (s *BaseFGListener) ExitSigSpec(ctx *SigSpecContext) {}
+// EnterSpec is called when production spec is entered.
+func (s *BaseFGListener) EnterSpec(ctx *SpecContext) {}
 
-// EnterInterfaceSpec is called when production InterfaceSpec is entered.
-func (s *BaseFGListener) EnterInterfaceSpec(ctx *InterfaceSpecContext) {}
-
-// ExitInterfaceSpec is called when production InterfaceSpec is exited.
-func (s *BaseFGListener) ExitInterfaceSpec(ctx *InterfaceSpecContext) {}
+// ExitSpec is called when production spec is exited.
+func (s *BaseFGListener) ExitSpec(ctx *SpecContext) {}
 
 // EnterSig is called when production sig is entered.
 func (s *BaseFGListener) EnterSig(ctx *SigContext) {}
@@ -105,24 +183,90 @@ func (s *BaseFGListener) EnterParamDecl(ctx *ParamDeclContext) {}
 // ExitParamDecl is called when production paramDecl is exited.
 func (s *BaseFGListener) ExitParamDecl(ctx *ParamDeclContext) {}
 
+// EnterPrimaryLit is called when production PrimaryLit is entered.
+func (s *BaseFGListener) EnterPrimaryLit(ctx *PrimaryLitContext) {}
+
+// ExitPrimaryLit is called when production PrimaryLit is exited.
+func (s *BaseFGListener) ExitPrimaryLit(ctx *PrimaryLitContext) {}
+
 // EnterCall is called when production Call is entered.
 func (s *BaseFGListener) EnterCall(ctx *CallContext) {}
 
 // ExitCall is called when production Call is exited.
 func (s *BaseFGListener) ExitCall(ctx *CallContext) {}
 
+// EnterConvert is called when production Convert is entered.
+func (s *BaseFGListener) EnterConvert(ctx *ConvertContext) {}
+
+// ExitConvert is called when production Convert is exited.
+func (s *BaseFGListener) ExitConvert(ctx *ConvertContext) {}
+
 // EnterVariable is called when production Variable is entered.
 func (s *BaseFGListener) EnterVariable(ctx *VariableContext) {}
 
 // ExitVariable is called when production Variable is exited.
 func (s *BaseFGListener) ExitVariable(ctx *VariableContext) {}
 
+// EnterUnaryOp is called when production UnaryOp is entered.
+func (s *BaseFGListener) EnterUnaryOp(ctx *UnaryOpContext) {}
+
+// ExitUnaryOp is called when production UnaryOp is exited.
+func (s *BaseFGListener) ExitUnaryOp(ctx *UnaryOpContext) {}
+
+// EnterFuncLit is called when production FuncLit is entered.
+func (s *BaseFGListener) EnterFuncLit(ctx *FuncLitContext) {}
+
+// ExitFuncLit is called when production FuncLit is exited.
+func (s *BaseFGListener) ExitFuncLit(ctx *FuncLitContext) {}
+
+// EnterApply is called when production Apply is entered.
+func (s *BaseFGListener) EnterApply(ctx *ApplyContext) {}
+
+// ExitApply is called when production Apply is exited.
+func (s *BaseFGListener) ExitApply(ctx *ApplyContext) {}
+
+// EnterIndex is called when production Index is entered.
+func (s *BaseFGListener) EnterIndex(ctx *IndexContext) {}
+
+// ExitIndex is called when production Index is exited.
+func (s *BaseFGListener) ExitIndex(ctx *IndexContext) {}
+
+// EnterMapLit is called when production MapLit is entered.
+func (s *BaseFGListener) EnterMapLit(ctx *MapLitContext) {}
+
+// ExitMapLit is called when production MapLit is exited.
+func (s *BaseFGListener) ExitMapLit(ctx *MapLitContext) {}
+
+// EnterStructLit is called when production StructLit is entered.
+func (s *BaseFGListener) EnterStructLit(ctx *StructLitContext) {}
+
+// ExitStructLit is called when production StructLit is exited.
+func (s *BaseFGListener) ExitStructLit(ctx *StructLitContext) {}
+
+// EnterFuncCall is called when production FuncCall is entered.
+func (s *BaseFGListener) EnterFuncCall(ctx *FuncCallContext) {}
+
+// ExitFuncCall is called when production FuncCall is exited.
+func (s *BaseFGListener) ExitFuncCall(ctx *FuncCallContext) {}
+
+// EnterAppend is called when production Append is entered.
+func (s *BaseFGListener) EnterAppend(ctx *AppendContext) {}
+
+// ExitAppend is called when production Append is exited.
+func (s *BaseFGListener) ExitAppend(ctx *AppendContext) {}
+
 // EnterAssert is called when production Assert is entered.
 func (s *BaseFGListener) EnterAssert(ctx *AssertContext) {}
 
 // ExitAssert is called when production Assert is exited.
 func (s *BaseFGListener) ExitAssert(ctx *AssertContext) {}
 
+// EnterLen is called when production Len is entered.
+func (s *BaseFGListener) EnterLen(ctx *LenContext) {}
+
+// ExitLen is called when production Len is exited.
+func (s *BaseFGListener) ExitLen(ctx *LenContext) {}
+
 // EnterSprintf is called when production Sprintf is entered.
 func (s *BaseFGListener) EnterSprintf(ctx *SprintfContext) {}
 
@@ -135,14 +279,56 @@ func (s *BaseFGListener) EnterSelect(ctx *SelectContext) {}
 // ExitSelect is called when production Select is exited.
 func (s *BaseFGListener) ExitSelect(ctx *SelectContext) {}
 
-// EnterStructLit is called when production StructLit is entered.
-func (s *BaseFGListener) EnterStructLit(ctx *StructLitContext) {}
+// EnterParen is called when production Paren is entered.
+func (s *BaseFGListener) EnterParen(ctx *ParenContext) {}
 
-// ExitStructLit is called when production StructLit is exited.
-func (s *BaseFGListener) ExitStructLit(ctx *StructLitContext) {}
+// ExitParen is called when production Paren is exited.
+func (s *BaseFGListener) ExitParen(ctx *ParenContext) {}
+
+// EnterBinaryOp is called when production BinaryOp is entered.
+func (s *BaseFGListener) EnterBinaryOp(ctx *BinaryOpContext) {}
+
+// ExitBinaryOp is called when production BinaryOp is exited.
+func (s *BaseFGListener) ExitBinaryOp(ctx *BinaryOpContext) {}
 
 // EnterExprs is called when production exprs is entered.
 func (s *BaseFGListener) EnterExprs(ctx *ExprsContext) {}
 
 // ExitExprs is called when production exprs is exited.
 func (s *BaseFGListener) ExitExprs(ctx *ExprsContext) {}
+
+// EnterEntries is called when production entries is entered.
+func (s *BaseFGListener) EnterEntries(ctx *EntriesContext) {}
+
+// ExitEntries is called when production entries is exited.
+func (s *BaseFGListener) ExitEntries(ctx *EntriesContext) {}
+
+// EnterEntry is called when production entry is entered.
+func (s *BaseFGListener) EnterEntry(ctx *EntryContext) {}
+
+// ExitEntry is called when production entry is exited.
+func (s *BaseFGListener) ExitEntry(ctx *EntryContext) {}
+
+// EnterBoolLit is called when production BoolLit is entered.
+func (s *BaseFGListener) EnterBoolLit(ctx *BoolLitContext) {}
+
+// ExitBoolLit is called when production BoolLit is exited.
+func (s *BaseFGListener) ExitBoolLit(ctx *BoolLitContext) {}
+
+// EnterIntLit is called when production IntLit is entered.
+func (s *BaseFGListener) EnterIntLit(ctx *IntLitContext) {}
+
+// ExitIntLit is called when production IntLit is exited.
+func (s *BaseFGListener) ExitIntLit(ctx *IntLitContext) {}
+
+// EnterFloatLit is called when production FloatLit is entered.
+func (s *BaseFGListener) EnterFloatLit(ctx *FloatLitContext) {}
+
+// ExitFloatLit is called when production FloatLit is exited.
+func (s *BaseFGListener) ExitFloatLit(ctx *FloatLitContext) {}
+
+// EnterStringLit is called when production StringLit is entered.
+func (s *BaseFGListener) EnterStringLit(ctx *StringLitContext) {}
+
+// ExitStringLit is called when production StringLit is exited.
+func (s *BaseFGListener) ExitStringLit(ctx *StringLitContext) {}
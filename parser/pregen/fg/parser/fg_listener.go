@@ -1,4 +1,4 @@
-// Code generated from parser/FG.g4 by ANTLR 4.7.2. DO NOT EDIT.
+// Code generated from parser/FG.g4 by ANTLR 4.10.1. DO NOT EDIT.
 
 package parser // FG
 
@@ -8,6 +8,36 @@ import "github.com/antlr/antlr4/runtime/Go/antlr"
 type FGListener interface {
 	antlr.ParseTreeListener
 
+	// EnterTNamed is called when entering the TNamed production.
+	EnterTNamed(c *TNamedContext)
+
+	// EnterTPrimitive is called when entering the TPrimitive production.
+	EnterTPrimitive(c *TPrimitiveContext)
+
+	// EnterTypeLit_ is called when entering the TypeLit_ production.
+	EnterTypeLit_(c *TypeLit_Context)
+
+	// EnterTFunc is called when entering the TFunc production.
+	EnterTFunc(c *TFuncContext)
+
+	// EnterTSlice is called when entering the TSlice production.
+	EnterTSlice(c *TSliceContext)
+
+	// EnterTMap is called when entering the TMap production.
+	EnterTMap(c *TMapContext)
+
+	// EnterTyps is called when entering the typs production.
+	EnterTyps(c *TypsContext)
+
+	// EnterPrimName is called when entering the primName production.
+	EnterPrimName(c *PrimNameContext)
+
+	// EnterStructTypeLit is called when entering the StructTypeLit production.
+	EnterStructTypeLit(c *StructTypeLitContext)
+
+	// EnterInterfaceTypeLit is called when entering the InterfaceTypeLit production.
+	EnterInterfaceTypeLit(c *InterfaceTypeLitContext)
+
 	// EnterProgram is called when entering the program production.
 	EnterProgram(c *ProgramContext)
 
@@ -20,11 +50,23 @@ type FGListener interface {
 	// EnterMethDecl is called when entering the methDecl production.
 	EnterMethDecl(c *MethDeclContext)
 
-	// EnterStructTypeLit is called when entering the StructTypeLit production.
-	EnterStructTypeLit(c *StructTypeLitContext)
+	// EnterFuncDecl is called when entering the funcDecl production.
+	EnterFuncDecl(c *FuncDeclContext)
 
-	// EnterInterfaceTypeLit is called when entering the InterfaceTypeLit production.
-	EnterInterfaceTypeLit(c *InterfaceTypeLitContext)
+	// EnterBody is called when entering the body production.
+	EnterBody(c *BodyContext)
+
+	// EnterBinding is called when entering the binding production.
+	EnterBinding(c *BindingContext)
+
+	// EnterIfElse is called when entering the ifElse production.
+	EnterIfElse(c *IfElseContext)
+
+	// EnterTypeSwitch is called when entering the typeSwitch production.
+	EnterTypeSwitch(c *TypeSwitchContext)
+
+	// EnterTypeCase is called when entering the typeCase production.
+	EnterTypeCase(c *TypeCaseContext)
 
 	// EnterFieldDecls is called when entering the fieldDecls production.
 	EnterFieldDecls(c *FieldDeclsContext)
@@ -35,11 +77,8 @@ type FGListener interface {
 	// EnterSpecs is called when entering the specs production.
 	EnterSpecs(c *SpecsContext)
 
-	// EnterSigSpec is called when entering the SigSpec production.
-	EnterSigSpec(c *SigSpecContext)
-
-	// EnterInterfaceSpec is called when entering the InterfaceSpec production.
-	EnterInterfaceSpec(c *InterfaceSpecContext)
+	// EnterSpec is called when entering the spec production.
+	EnterSpec(c *SpecContext)
 
 	// EnterSig is called when entering the sig production.
 	EnterSig(c *SigContext)
@@ -50,27 +89,111 @@ type FGListener interface {
 	// EnterParamDecl is called when entering the paramDecl production.
 	EnterParamDecl(c *ParamDeclContext)
 
+	// EnterPrimaryLit is called when entering the PrimaryLit production.
+	EnterPrimaryLit(c *PrimaryLitContext)
+
 	// EnterCall is called when entering the Call production.
 	EnterCall(c *CallContext)
 
+	// EnterConvert is called when entering the Convert production.
+	EnterConvert(c *ConvertContext)
+
 	// EnterVariable is called when entering the Variable production.
 	EnterVariable(c *VariableContext)
 
+	// EnterUnaryOp is called when entering the UnaryOp production.
+	EnterUnaryOp(c *UnaryOpContext)
+
+	// EnterFuncLit is called when entering the FuncLit production.
+	EnterFuncLit(c *FuncLitContext)
+
+	// EnterApply is called when entering the Apply production.
+	EnterApply(c *ApplyContext)
+
+	// EnterIndex is called when entering the Index production.
+	EnterIndex(c *IndexContext)
+
+	// EnterMapLit is called when entering the MapLit production.
+	EnterMapLit(c *MapLitContext)
+
+	// EnterStructLit is called when entering the StructLit production.
+	EnterStructLit(c *StructLitContext)
+
+	// EnterFuncCall is called when entering the FuncCall production.
+	EnterFuncCall(c *FuncCallContext)
+
+	// EnterAppend is called when entering the Append production.
+	EnterAppend(c *AppendContext)
+
 	// EnterAssert is called when entering the Assert production.
 	EnterAssert(c *AssertContext)
 
+	// EnterLen is called when entering the Len production.
+	EnterLen(c *LenContext)
+
 	// EnterSprintf is called when entering the Sprintf production.
 	EnterSprintf(c *SprintfContext)
 
 	// EnterSelect is called when entering the Select production.
 	EnterSelect(c *SelectContext)
 
-	// EnterStructLit is called when entering the StructLit production.
-	EnterStructLit(c *StructLitContext)
+	// EnterParen is called when entering the Paren production.
+	EnterParen(c *ParenContext)
+
+	// EnterBinaryOp is called when entering the BinaryOp production.
+	EnterBinaryOp(c *BinaryOpContext)
 
 	// EnterExprs is called when entering the exprs production.
 	EnterExprs(c *ExprsContext)
 
+	// EnterEntries is called when entering the entries production.
+	EnterEntries(c *EntriesContext)
+
+	// EnterEntry is called when entering the entry production.
+	EnterEntry(c *EntryContext)
+
+	// EnterBoolLit is called when entering the BoolLit production.
+	EnterBoolLit(c *BoolLitContext)
+
+	// EnterIntLit is called when entering the IntLit production.
+	EnterIntLit(c *IntLitContext)
+
+	// EnterFloatLit is called when entering the FloatLit production.
+	EnterFloatLit(c *FloatLitContext)
+
+	// EnterStringLit is called when entering the StringLit production.
+	EnterStringLit(c *StringLitContext)
+
+	// ExitTNamed is called when exiting the TNamed production.
+	ExitTNamed(c *TNamedContext)
+
+	// ExitTPrimitive is called when exiting the TPrimitive production.
+	ExitTPrimitive(c *TPrimitiveContext)
+
+	// ExitTypeLit_ is called when exiting the TypeLit_ production.
+	ExitTypeLit_(c *TypeLit_Context)
+
+	// ExitTFunc is called when exiting the TFunc production.
+	ExitTFunc(c *TFuncContext)
+
+	// ExitTSlice is called when exiting the TSlice production.
+	ExitTSlice(c *TSliceContext)
+
+	// ExitTMap is called when exiting the TMap production.
+	ExitTMap(c *TMapContext)
+
+	// ExitTyps is called when exiting the typs production.
+	ExitTyps(c *TypsContext)
+
+	// ExitPrimName is called when exiting the primName production.
+	ExitPrimName(c *PrimNameContext)
+
+	// ExitStructTypeLit is called when exiting the StructTypeLit production.
+	ExitStructTypeLit(c *StructTypeLitContext)
+
+	// ExitInterfaceTypeLit is called when exiting the InterfaceTypeLit production.
+	ExitInterfaceTypeLit(c *InterfaceTypeLitContext)
+
 	// ExitProgram is called when exiting the program production.
 	ExitProgram(c *ProgramContext)
 
@@ -83,11 +206,23 @@ type FGListener interface {
 	// ExitMethDecl is called when exiting the methDecl production.
 	ExitMethDecl(c *MethDeclContext)
 
-	// ExitStructTypeLit is called when exiting the StructTypeLit production.
-	ExitStructTypeLit(c *StructTypeLitContext)
+	// ExitFuncDecl is called when exiting the funcDecl production.
+	ExitFuncDecl(c *FuncDeclContext)
 
-	// ExitInterfaceTypeLit is called when exiting the InterfaceTypeLit production.
-	ExitInterfaceTypeLit(c *InterfaceTypeLitContext)
+	// ExitBody is called when exiting the body production.
+	ExitBody(c *BodyContext)
+
+	// ExitBinding is called when exiting the binding production.
+	ExitBinding(c *BindingContext)
+
+	// ExitIfElse is called when exiting the ifElse production.
+	ExitIfElse(c *IfElseContext)
+
+	// ExitTypeSwitch is called when exiting the typeSwitch production.
+	ExitTypeSwitch(c *TypeSwitchContext)
+
+	// ExitTypeCase is called when exiting the typeCase production.
+	ExitTypeCase(c *TypeCaseContext)
 
 	// ExitFieldDecls is called when exiting the fieldDecls production.
 	ExitFieldDecls(c *FieldDeclsContext)
@@ -98,11 +233,8 @@ type FGListener interface {
 	// ExitSpecs is called when exiting the specs production.
 	ExitSpecs(c *SpecsContext)
 
-	// ExitSigSpec is called when exiting the SigSpec production.
-	ExitSigSpec(c *SigSpecContext)
-
-	// ExitInterfaceSpec is called when exiting the InterfaceSpec production.
-	ExitInterfaceSpec(c *InterfaceSpecContext)
+	// ExitSpec is called when exiting the spec production.
+	ExitSpec(c *SpecContext)
 
 	// ExitSig is called when exiting the sig production.
 	ExitSig(c *SigContext)
@@ -113,24 +245,78 @@ type FGListener interface {
 	// ExitParamDecl is called when exiting the paramDecl production.
 	ExitParamDecl(c *ParamDeclContext)
 
+	// ExitPrimaryLit is called when exiting the PrimaryLit production.
+	ExitPrimaryLit(c *PrimaryLitContext)
+
 	// ExitCall is called when exiting the Call production.
 	ExitCall(c *CallContext)
 
+	// ExitConvert is called when exiting the Convert production.
+	ExitConvert(c *ConvertContext)
+
 	// ExitVariable is called when exiting the Variable production.
 	ExitVariable(c *VariableContext)
 
+	// ExitUnaryOp is called when exiting the UnaryOp production.
+	ExitUnaryOp(c *UnaryOpContext)
+
+	// ExitFuncLit is called when exiting the FuncLit production.
+	ExitFuncLit(c *FuncLitContext)
+
+	// ExitApply is called when exiting the Apply production.
+	ExitApply(c *ApplyContext)
+
+	// ExitIndex is called when exiting the Index production.
+	ExitIndex(c *IndexContext)
+
+	// ExitMapLit is called when exiting the MapLit production.
+	ExitMapLit(c *MapLitContext)
+
+	// ExitStructLit is called when exiting the StructLit production.
+	ExitStructLit(c *StructLitContext)
+
+	// ExitFuncCall is called when exiting the FuncCall production.
+	ExitFuncCall(c *FuncCallContext)
+
+	// ExitAppend is called when exiting the Append production.
+	ExitAppend(c *AppendContext)
+
 	// ExitAssert is called when exiting the Assert production.
 	ExitAssert(c *AssertContext)
 
+	// ExitLen is called when exiting the Len production.
+	ExitLen(c *LenContext)
+
 	// ExitSprintf is called when exiting the Sprintf production.
 	ExitSprintf(c *SprintfContext)
 
 	// ExitSelect is called when exiting the Select production.
 	ExitSelect(c *SelectContext)
 
-	// ExitStructLit is called when exiting the StructLit production.
-	ExitStructLit(c *StructLitContext)
+	// ExitParen is called when exiting the Paren production.
+	ExitParen(c *ParenContext)
+
+	// ExitBinaryOp is called when exiting the BinaryOp production.
+	ExitBinaryOp(c *BinaryOpContext)
 
 	// ExitExprs is called when exiting the exprs production.
 	ExitExprs(c *ExprsContext)
+
+	// ExitEntries is called when exiting the entries production.
+	ExitEntries(c *EntriesContext)
+
+	// ExitEntry is called when exiting the entry production.
+	ExitEntry(c *EntryContext)
+
+	// ExitBoolLit is called when exiting the BoolLit production.
+	ExitBoolLit(c *BoolLitContext)
+
+	// ExitIntLit is called when exiting the IntLit production.
+	ExitIntLit(c *IntLitContext)
+
+	// ExitFloatLit is called when exiting the FloatLit production.
+	ExitFloatLit(c *FloatLitContext)
+
+	// ExitStringLit is called when exiting the StringLit production.
+	ExitStringLit(c *StringLitContext)
 }
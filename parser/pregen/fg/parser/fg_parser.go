@@ -1,145 +1,288 @@
-// Code generated from parser/FG.g4 by ANTLR 4.7.2. DO NOT EDIT.
+// Code generated from parser/FG.g4 by ANTLR 4.10.1. DO NOT EDIT.
 
 package parser // FG
 
 import (
 	"fmt"
-	"reflect"
 	"strconv"
+	"sync"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 )
 
 // Suppress unused import errors
 var _ = fmt.Printf
-var _ = reflect.Copy
 var _ = strconv.Itoa
+var _ = sync.Once{}
 
-var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 28, 196,
-	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
-	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
-	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
-	5, 2, 37, 10, 2, 3, 2, 5, 2, 40, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 5,
-	2, 59, 10, 2, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 5, 3, 66, 10, 3, 3, 3, 3, 3,
-	6, 3, 70, 10, 3, 13, 3, 14, 3, 71, 3, 4, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5,
-	3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 5, 6,
-	91, 10, 6, 3, 6, 3, 6, 3, 6, 3, 6, 5, 6, 97, 10, 6, 3, 6, 5, 6, 100, 10,
-	6, 3, 7, 3, 7, 3, 7, 7, 7, 105, 10, 7, 12, 7, 14, 7, 108, 11, 7, 3, 8,
-	3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 7, 9, 116, 10, 9, 12, 9, 14, 9, 119, 11,
-	9, 3, 10, 3, 10, 5, 10, 123, 10, 10, 3, 11, 3, 11, 3, 11, 5, 11, 128, 10,
-	11, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 7, 12, 136, 10, 12, 12, 12,
-	14, 12, 139, 11, 12, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3,
-	14, 5, 14, 149, 10, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14,
-	3, 14, 7, 14, 159, 10, 14, 12, 14, 14, 14, 162, 11, 14, 3, 14, 5, 14, 165,
-	10, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 5, 14,
-	175, 10, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 7, 14, 183, 10,
-	14, 12, 14, 14, 14, 186, 11, 14, 3, 15, 3, 15, 3, 15, 7, 15, 191, 10, 15,
-	12, 15, 14, 15, 194, 11, 15, 3, 15, 2, 3, 26, 16, 2, 4, 6, 8, 10, 12, 14,
-	16, 18, 20, 22, 24, 26, 28, 2, 3, 4, 2, 10, 10, 28, 28, 2, 204, 2, 30,
-	3, 2, 2, 2, 4, 69, 3, 2, 2, 2, 6, 73, 3, 2, 2, 2, 8, 77, 3, 2, 2, 2, 10,
-	99, 3, 2, 2, 2, 12, 101, 3, 2, 2, 2, 14, 109, 3, 2, 2, 2, 16, 112, 3, 2,
-	2, 2, 18, 122, 3, 2, 2, 2, 20, 124, 3, 2, 2, 2, 22, 132, 3, 2, 2, 2, 24,
-	140, 3, 2, 2, 2, 26, 164, 3, 2, 2, 2, 28, 187, 3, 2, 2, 2, 30, 31, 7, 16,
-	2, 2, 31, 32, 7, 15, 2, 2, 32, 36, 7, 3, 2, 2, 33, 34, 7, 20, 2, 2, 34,
-	35, 7, 28, 2, 2, 35, 37, 7, 3, 2, 2, 36, 33, 3, 2, 2, 2, 36, 37, 3, 2,
-	2, 2, 37, 39, 3, 2, 2, 2, 38, 40, 5, 4, 3, 2, 39, 38, 3, 2, 2, 2, 39, 40,
-	3, 2, 2, 2, 40, 41, 3, 2, 2, 2, 41, 42, 7, 13, 2, 2, 42, 43, 7, 15, 2,
-	2, 43, 44, 7, 4, 2, 2, 44, 45, 7, 5, 2, 2, 45, 58, 7, 6, 2, 2, 46, 47,
-	7, 7, 2, 2, 47, 48, 7, 8, 2, 2, 48, 59, 5, 26, 14, 2, 49, 50, 7, 21, 2,
-	2, 50, 51, 7, 9, 2, 2, 51, 52, 7, 22, 2, 2, 52, 53, 7, 4, 2, 2, 53, 54,
-	7, 10, 2, 2, 54, 55, 7, 11, 2, 2, 55, 56, 5, 26, 14, 2, 56, 57, 7, 5, 2,
-	2, 57, 59, 3, 2, 2, 2, 58, 46, 3, 2, 2, 2, 58, 49, 3, 2, 2, 2, 59, 60,
-	3, 2, 2, 2, 60, 61, 7, 12, 2, 2, 61, 62, 7, 2, 2, 3, 62, 3, 3, 2, 2, 2,
-	63, 66, 5, 6, 4, 2, 64, 66, 5, 8, 5, 2, 65, 63, 3, 2, 2, 2, 65, 64, 3,
-	2, 2, 2, 66, 67, 3, 2, 2, 2, 67, 68, 7, 3, 2, 2, 68, 70, 3, 2, 2, 2, 69,
-	65, 3, 2, 2, 2, 70, 71, 3, 2, 2, 2, 71, 69, 3, 2, 2, 2, 71, 72, 3, 2, 2,
-	2, 72, 5, 3, 2, 2, 2, 73, 74, 7, 19, 2, 2, 74, 75, 7, 24, 2, 2, 75, 76,
-	5, 10, 6, 2, 76, 7, 3, 2, 2, 2, 77, 78, 7, 13, 2, 2, 78, 79, 7, 4, 2, 2,
-	79, 80, 5, 24, 13, 2, 80, 81, 7, 5, 2, 2, 81, 82, 5, 20, 11, 2, 82, 83,
-	7, 6, 2, 2, 83, 84, 7, 17, 2, 2, 84, 85, 5, 26, 14, 2, 85, 86, 7, 12, 2,
-	2, 86, 9, 3, 2, 2, 2, 87, 88, 7, 18, 2, 2, 88, 90, 7, 6, 2, 2, 89, 91,
-	5, 12, 7, 2, 90, 89, 3, 2, 2, 2, 90, 91, 3, 2, 2, 2, 91, 92, 3, 2, 2, 2,
-	92, 100, 7, 12, 2, 2, 93, 94, 7, 14, 2, 2, 94, 96, 7, 6, 2, 2, 95, 97,
-	5, 16, 9, 2, 96, 95, 3, 2, 2, 2, 96, 97, 3, 2, 2, 2, 97, 98, 3, 2, 2, 2,
-	98, 100, 7, 12, 2, 2, 99, 87, 3, 2, 2, 2, 99, 93, 3, 2, 2, 2, 100, 11,
-	3, 2, 2, 2, 101, 106, 5, 14, 8, 2, 102, 103, 7, 3, 2, 2, 103, 105, 5, 14,
-	8, 2, 104, 102, 3, 2, 2, 2, 105, 108, 3, 2, 2, 2, 106, 104, 3, 2, 2, 2,
-	106, 107, 3, 2, 2, 2, 107, 13, 3, 2, 2, 2, 108, 106, 3, 2, 2, 2, 109, 110,
-	7, 24, 2, 2, 110, 111, 7, 24, 2, 2, 111, 15, 3, 2, 2, 2, 112, 117, 5, 18,
-	10, 2, 113, 114, 7, 3, 2, 2, 114, 116, 5, 18, 10, 2, 115, 113, 3, 2, 2,
-	2, 116, 119, 3, 2, 2, 2, 117, 115, 3, 2, 2, 2, 117, 118, 3, 2, 2, 2, 118,
-	17, 3, 2, 2, 2, 119, 117, 3, 2, 2, 2, 120, 123, 5, 20, 11, 2, 121, 123,
-	7, 24, 2, 2, 122, 120, 3, 2, 2, 2, 122, 121, 3, 2, 2, 2, 123, 19, 3, 2,
-	2, 2, 124, 125, 7, 24, 2, 2, 125, 127, 7, 4, 2, 2, 126, 128, 5, 22, 12,
-	2, 127, 126, 3, 2, 2, 2, 127, 128, 3, 2, 2, 2, 128, 129, 3, 2, 2, 2, 129,
-	130, 7, 5, 2, 2, 130, 131, 7, 24, 2, 2, 131, 21, 3, 2, 2, 2, 132, 137,
-	5, 24, 13, 2, 133, 134, 7, 11, 2, 2, 134, 136, 5, 24, 13, 2, 135, 133,
-	3, 2, 2, 2, 136, 139, 3, 2, 2, 2, 137, 135, 3, 2, 2, 2, 137, 138, 3, 2,
-	2, 2, 138, 23, 3, 2, 2, 2, 139, 137, 3, 2, 2, 2, 140, 141, 7, 24, 2, 2,
-	141, 142, 7, 24, 2, 2, 142, 25, 3, 2, 2, 2, 143, 144, 8, 14, 1, 2, 144,
-	165, 7, 24, 2, 2, 145, 146, 7, 24, 2, 2, 146, 148, 7, 6, 2, 2, 147, 149,
-	5, 28, 15, 2, 148, 147, 3, 2, 2, 2, 148, 149, 3, 2, 2, 2, 149, 150, 3,
-	2, 2, 2, 150, 165, 7, 12, 2, 2, 151, 152, 7, 21, 2, 2, 152, 153, 7, 9,
-	2, 2, 153, 154, 7, 23, 2, 2, 154, 155, 7, 4, 2, 2, 155, 160, 9, 2, 2, 2,
-	156, 159, 7, 11, 2, 2, 157, 159, 5, 26, 14, 2, 158, 156, 3, 2, 2, 2, 158,
-	157, 3, 2, 2, 2, 159, 162, 3, 2, 2, 2, 160, 158, 3, 2, 2, 2, 160, 161,
-	3, 2, 2, 2, 161, 163, 3, 2, 2, 2, 162, 160, 3, 2, 2, 2, 163, 165, 7, 5,
-	2, 2, 164, 143, 3, 2, 2, 2, 164, 145, 3, 2, 2, 2, 164, 151, 3, 2, 2, 2,
-	165, 184, 3, 2, 2, 2, 166, 167, 12, 6, 2, 2, 167, 168, 7, 9, 2, 2, 168,
-	183, 7, 24, 2, 2, 169, 170, 12, 5, 2, 2, 170, 171, 7, 9, 2, 2, 171, 172,
-	7, 24, 2, 2, 172, 174, 7, 4, 2, 2, 173, 175, 5, 28, 15, 2, 174, 173, 3,
-	2, 2, 2, 174, 175, 3, 2, 2, 2, 175, 176, 3, 2, 2, 2, 176, 183, 7, 5, 2,
-	2, 177, 178, 12, 4, 2, 2, 178, 179, 7, 9, 2, 2, 179, 180, 7, 4, 2, 2, 180,
-	181, 7, 24, 2, 2, 181, 183, 7, 5, 2, 2, 182, 166, 3, 2, 2, 2, 182, 169,
-	3, 2, 2, 2, 182, 177, 3, 2, 2, 2, 183, 186, 3, 2, 2, 2, 184, 182, 3, 2,
-	2, 2, 184, 185, 3, 2, 2, 2, 185, 27, 3, 2, 2, 2, 186, 184, 3, 2, 2, 2,
-	187, 192, 5, 26, 14, 2, 188, 189, 7, 11, 2, 2, 189, 191, 5, 26, 14, 2,
-	190, 188, 3, 2, 2, 2, 191, 194, 3, 2, 2, 2, 192, 190, 3, 2, 2, 2, 192,
-	193, 3, 2, 2, 2, 193, 29, 3, 2, 2, 2, 194, 192, 3, 2, 2, 2, 23, 36, 39,
-	58, 65, 71, 90, 96, 99, 106, 117, 122, 127, 137, 148, 158, 160, 164, 174,
-	182, 184, 192,
-}
-var deserializer = antlr.NewATNDeserializer(nil)
-var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
-
-var literalNames = []string{
-	"", "';'", "'('", "')'", "'{'", "'_'", "'='", "'.'", "'\"%#v\"'", "','",
-	"'}'", "'func'", "'interface'", "'main'", "'package'", "'return'", "'struct'",
-	"'type'", "'import'", "'fmt'", "'Printf'", "'Sprintf'",
-}
-var symbolicNames = []string{
-	"", "", "", "", "", "", "", "", "", "", "", "FUNC", "INTERFACE", "MAIN",
-	"PACKAGE", "RETURN", "STRUCT", "TYPE", "IMPORT", "FMT", "PRINTF", "SPRINTF",
-	"NAME", "WHITESPACE", "COMMENT", "LINE_COMMENT", "STRING",
-}
-
-var ruleNames = []string{
-	"program", "decls", "typeDecl", "methDecl", "typeLit", "fieldDecls", "fieldDecl",
-	"specs", "spec", "sig", "params", "paramDecl", "expr", "exprs",
-}
-var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
-
-func init() {
-	for index, ds := range deserializedATN.DecisionToState {
-		decisionToDFA[index] = antlr.NewDFA(ds, index)
+type FGParser struct {
+	*antlr.BaseParser
+}
+
+var fgParserStaticData struct {
+	once                   sync.Once
+	serializedATN          []int32
+	literalNames           []string
+	symbolicNames          []string
+	ruleNames              []string
+	predictionContextCache *antlr.PredictionContextCache
+	atn                    *antlr.ATN
+	decisionToDFA          []*antlr.DFA
+}
+
+func fgParserInit() {
+	staticData := &fgParserStaticData
+	staticData.literalNames = []string{
+		"", "'('", "')'", "'['", "']'", "','", "'{'", "'}'", "';'", "'_'", "'='",
+		"'.'", "'\"%#v\"'", "':='", "':'", "'case'", "'default'", "'else'",
+		"'func'", "'if'", "'interface'", "'main'", "'map'", "'package'", "'return'",
+		"'struct'", "'switch'", "'type'", "'var'", "'import'", "'fmt'", "'Printf'",
+		"'Sprintf'", "'len'", "'append'", "'panic'", "'true'", "'false'", "'bool'",
+		"'int32'", "'int64'", "'float32'", "'float64'", "'string'", "'+'", "'-'",
+		"'*'", "'/'", "'%'", "'&'", "'|'", "'^'", "'<<'", "'>>'", "'!'", "'&&'",
+		"'||'", "'=='", "'!='", "'>'", "'<'", "'>='", "'<='",
+	}
+	staticData.symbolicNames = []string{
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "CASE",
+		"DEFAULT", "ELSE", "FUNC", "IF", "INTERFACE", "MAIN", "MAP", "PACKAGE",
+		"RETURN", "STRUCT", "SWITCH", "TYPE", "VAR", "IMPORT", "FMT", "PRINTF",
+		"SPRINTF", "LEN", "APPEND", "PANIC", "TRUE", "FALSE", "BOOL", "INT32",
+		"INT64", "FLOAT32", "FLOAT64", "STRING", "PLUS", "MINUS", "TIMES", "DIV",
+		"MOD", "BITAND", "BITOR", "BITXOR", "SHL", "SHR", "NOT", "AND", "OR",
+		"EQ", "NE", "GT", "LT", "GE", "LE", "NAME", "WHITESPACE", "COMMENT",
+		"LINE_COMMENT", "STRING_LIT", "INT_LIT", "FLOAT_LIT",
+	}
+	staticData.ruleNames = []string{
+		"typ", "typs", "primName", "typeLit", "program", "decls", "typeDecl",
+		"methDecl", "funcDecl", "body", "binding", "ifElse", "typeSwitch", "typeCase",
+		"fieldDecls", "fieldDecl", "specs", "spec", "sig", "params", "paramDecl",
+		"expr", "exprs", "entries", "entry", "primLit",
+	}
+	staticData.predictionContextCache = antlr.NewPredictionContextCache()
+	staticData.serializedATN = []int32{
+		4, 1, 69, 429, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
+		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
+		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
+		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 1, 0,
+		1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 3, 0, 59, 8, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+		0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 3, 0, 72, 8, 0, 1, 1, 1, 1, 1, 1,
+		5, 1, 77, 8, 1, 10, 1, 12, 1, 80, 9, 1, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 3,
+		3, 87, 8, 3, 1, 3, 1, 3, 1, 3, 1, 3, 3, 3, 93, 8, 3, 1, 3, 3, 3, 96, 8,
+		3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 3, 4, 104, 8, 4, 1, 4, 3, 4, 107,
+		8, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4,
+		1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 3, 4, 126, 8, 4, 1, 4, 1, 4, 1, 4,
+		1, 5, 1, 5, 1, 5, 3, 5, 134, 8, 5, 1, 5, 1, 5, 4, 5, 138, 8, 5, 11, 5,
+		12, 5, 139, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7,
+		1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9,
+		1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 3, 9, 174,
+		8, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1,
+		10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10,
+		1, 10, 3, 10, 197, 8, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1,
+		11, 1, 11, 1, 11, 1, 11, 1, 11, 3, 11, 210, 8, 11, 1, 12, 1, 12, 1, 12,
+		3, 12, 215, 8, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 5,
+		12, 224, 8, 12, 10, 12, 12, 12, 227, 9, 12, 1, 12, 1, 12, 1, 12, 3, 12,
+		232, 8, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 3, 13, 241,
+		8, 13, 1, 14, 1, 14, 1, 14, 5, 14, 246, 8, 14, 10, 14, 12, 14, 249, 9,
+		14, 1, 15, 1, 15, 1, 15, 3, 15, 254, 8, 15, 1, 16, 1, 16, 1, 16, 5, 16,
+		259, 8, 16, 10, 16, 12, 16, 262, 9, 16, 1, 17, 1, 17, 3, 17, 266, 8, 17,
+		1, 18, 1, 18, 1, 18, 3, 18, 271, 8, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1,
+		19, 1, 19, 5, 19, 279, 8, 19, 10, 19, 12, 19, 282, 9, 19, 1, 20, 1, 20,
+		1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 3, 21, 291, 8, 21, 1, 21, 1, 21, 1,
+		21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21,
+		1, 21, 5, 21, 307, 8, 21, 10, 21, 12, 21, 310, 9, 21, 1, 21, 1, 21, 1,
+		21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21,
+		1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 3, 21, 331, 8, 21, 1, 21, 1,
+		21, 1, 21, 1, 21, 1, 21, 3, 21, 338, 8, 21, 1, 21, 1, 21, 1, 21, 1, 21,
+		1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 3, 21, 353,
+		8, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1,
+		21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21,
+		3, 21, 375, 8, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 3,
+		21, 384, 8, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21,
+		1, 21, 1, 21, 1, 21, 1, 21, 5, 21, 398, 8, 21, 10, 21, 12, 21, 401, 9,
+		21, 1, 22, 1, 22, 1, 22, 5, 22, 406, 8, 22, 10, 22, 12, 22, 409, 9, 22,
+		1, 23, 1, 23, 1, 23, 5, 23, 414, 8, 23, 10, 23, 12, 23, 417, 9, 23, 1,
+		24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 3, 25, 427, 8, 25,
+		1, 25, 0, 1, 42, 26, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26,
+		28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 0, 7, 1, 0, 38, 43, 2,
+		0, 12, 12, 67, 67, 2, 0, 45, 45, 54, 54, 2, 0, 46, 49, 52, 53, 2, 0, 44,
+		45, 50, 51, 1, 0, 57, 62, 1, 0, 36, 37, 469, 0, 71, 1, 0, 0, 0, 2, 73,
+		1, 0, 0, 0, 4, 81, 1, 0, 0, 0, 6, 95, 1, 0, 0, 0, 8, 97, 1, 0, 0, 0, 10,
+		137, 1, 0, 0, 0, 12, 141, 1, 0, 0, 0, 14, 145, 1, 0, 0, 0, 16, 154, 1,
+		0, 0, 0, 18, 173, 1, 0, 0, 0, 20, 196, 1, 0, 0, 0, 22, 198, 1, 0, 0, 0,
+		24, 211, 1, 0, 0, 0, 26, 235, 1, 0, 0, 0, 28, 242, 1, 0, 0, 0, 30, 253,
+		1, 0, 0, 0, 32, 255, 1, 0, 0, 0, 34, 265, 1, 0, 0, 0, 36, 267, 1, 0, 0,
+		0, 38, 275, 1, 0, 0, 0, 40, 283, 1, 0, 0, 0, 42, 352, 1, 0, 0, 0, 44, 402,
+		1, 0, 0, 0, 46, 410, 1, 0, 0, 0, 48, 418, 1, 0, 0, 0, 50, 426, 1, 0, 0,
+		0, 52, 72, 5, 63, 0, 0, 53, 72, 3, 4, 2, 0, 54, 72, 3, 6, 3, 0, 55, 56,
+		5, 18, 0, 0, 56, 58, 5, 1, 0, 0, 57, 59, 3, 2, 1, 0, 58, 57, 1, 0, 0, 0,
+		58, 59, 1, 0, 0, 0, 59, 60, 1, 0, 0, 0, 60, 61, 5, 2, 0, 0, 61, 72, 3,
+		0, 0, 0, 62, 63, 5, 3, 0, 0, 63, 64, 5, 4, 0, 0, 64, 72, 3, 0, 0, 0, 65,
+		66, 5, 22, 0, 0, 66, 67, 5, 3, 0, 0, 67, 68, 3, 0, 0, 0, 68, 69, 5, 4,
+		0, 0, 69, 70, 3, 0, 0, 0, 70, 72, 1, 0, 0, 0, 71, 52, 1, 0, 0, 0, 71, 53,
+		1, 0, 0, 0, 71, 54, 1, 0, 0, 0, 71, 55, 1, 0, 0, 0, 71, 62, 1, 0, 0, 0,
+		71, 65, 1, 0, 0, 0, 72, 1, 1, 0, 0, 0, 73, 78, 3, 0, 0, 0, 74, 75, 5, 5,
+		0, 0, 75, 77, 3, 0, 0, 0, 76, 74, 1, 0, 0, 0, 77, 80, 1, 0, 0, 0, 78, 76,
+		1, 0, 0, 0, 78, 79, 1, 0, 0, 0, 79, 3, 1, 0, 0, 0, 80, 78, 1, 0, 0, 0,
+		81, 82, 7, 0, 0, 0, 82, 5, 1, 0, 0, 0, 83, 84, 5, 25, 0, 0, 84, 86, 5,
+		6, 0, 0, 85, 87, 3, 28, 14, 0, 86, 85, 1, 0, 0, 0, 86, 87, 1, 0, 0, 0,
+		87, 88, 1, 0, 0, 0, 88, 96, 5, 7, 0, 0, 89, 90, 5, 20, 0, 0, 90, 92, 5,
+		6, 0, 0, 91, 93, 3, 32, 16, 0, 92, 91, 1, 0, 0, 0, 92, 93, 1, 0, 0, 0,
+		93, 94, 1, 0, 0, 0, 94, 96, 5, 7, 0, 0, 95, 83, 1, 0, 0, 0, 95, 89, 1,
+		0, 0, 0, 96, 7, 1, 0, 0, 0, 97, 98, 5, 23, 0, 0, 98, 99, 5, 21, 0, 0, 99,
+		103, 5, 8, 0, 0, 100, 101, 5, 29, 0, 0, 101, 102, 5, 67, 0, 0, 102, 104,
+		5, 8, 0, 0, 103, 100, 1, 0, 0, 0, 103, 104, 1, 0, 0, 0, 104, 106, 1, 0,
+		0, 0, 105, 107, 3, 10, 5, 0, 106, 105, 1, 0, 0, 0, 106, 107, 1, 0, 0, 0,
+		107, 108, 1, 0, 0, 0, 108, 109, 5, 18, 0, 0, 109, 110, 5, 21, 0, 0, 110,
+		111, 5, 1, 0, 0, 111, 112, 5, 2, 0, 0, 112, 125, 5, 6, 0, 0, 113, 114,
+		5, 9, 0, 0, 114, 115, 5, 10, 0, 0, 115, 126, 3, 42, 21, 0, 116, 117, 5,
+		30, 0, 0, 117, 118, 5, 11, 0, 0, 118, 119, 5, 31, 0, 0, 119, 120, 5, 1,
+		0, 0, 120, 121, 5, 12, 0, 0, 121, 122, 5, 5, 0, 0, 122, 123, 3, 42, 21,
+		0, 123, 124, 5, 2, 0, 0, 124, 126, 1, 0, 0, 0, 125, 113, 1, 0, 0, 0, 125,
+		116, 1, 0, 0, 0, 126, 127, 1, 0, 0, 0, 127, 128, 5, 7, 0, 0, 128, 129,
+		5, 0, 0, 1, 129, 9, 1, 0, 0, 0, 130, 134, 3, 12, 6, 0, 131, 134, 3, 14,
+		7, 0, 132, 134, 3, 16, 8, 0, 133, 130, 1, 0, 0, 0, 133, 131, 1, 0, 0, 0,
+		133, 132, 1, 0, 0, 0, 134, 135, 1, 0, 0, 0, 135, 136, 5, 8, 0, 0, 136,
+		138, 1, 0, 0, 0, 137, 133, 1, 0, 0, 0, 138, 139, 1, 0, 0, 0, 139, 137,
+		1, 0, 0, 0, 139, 140, 1, 0, 0, 0, 140, 11, 1, 0, 0, 0, 141, 142, 5, 27,
+		0, 0, 142, 143, 5, 63, 0, 0, 143, 144, 3, 0, 0, 0, 144, 13, 1, 0, 0, 0,
+		145, 146, 5, 18, 0, 0, 146, 147, 5, 1, 0, 0, 147, 148, 3, 40, 20, 0, 148,
+		149, 5, 2, 0, 0, 149, 150, 3, 36, 18, 0, 150, 151, 5, 6, 0, 0, 151, 152,
+		3, 18, 9, 0, 152, 153, 5, 7, 0, 0, 153, 15, 1, 0, 0, 0, 154, 155, 5, 18,
+		0, 0, 155, 156, 3, 36, 18, 0, 156, 157, 5, 6, 0, 0, 157, 158, 3, 18, 9,
+		0, 158, 159, 5, 7, 0, 0, 159, 17, 1, 0, 0, 0, 160, 161, 5, 24, 0, 0, 161,
+		174, 3, 42, 21, 0, 162, 163, 5, 35, 0, 0, 163, 164, 5, 1, 0, 0, 164, 165,
+		3, 42, 21, 0, 165, 166, 5, 2, 0, 0, 166, 174, 1, 0, 0, 0, 167, 174, 3,
+		22, 11, 0, 168, 174, 3, 24, 12, 0, 169, 170, 3, 20, 10, 0, 170, 171, 5,
+		8, 0, 0, 171, 172, 3, 18, 9, 0, 172, 174, 1, 0, 0, 0, 173, 160, 1, 0, 0,
+		0, 173, 162, 1, 0, 0, 0, 173, 167, 1, 0, 0, 0, 173, 168, 1, 0, 0, 0, 173,
+		169, 1, 0, 0, 0, 174, 19, 1, 0, 0, 0, 175, 176, 5, 63, 0, 0, 176, 177,
+		5, 13, 0, 0, 177, 197, 3, 42, 21, 0, 178, 179, 5, 28, 0, 0, 179, 180, 5,
+		63, 0, 0, 180, 181, 3, 0, 0, 0, 181, 182, 5, 10, 0, 0, 182, 183, 3, 42,
+		21, 0, 183, 197, 1, 0, 0, 0, 184, 185, 5, 63, 0, 0, 185, 186, 5, 5, 0,
+		0, 186, 187, 5, 63, 0, 0, 187, 188, 5, 13, 0, 0, 188, 197, 3, 42, 21, 0,
+		189, 190, 5, 63, 0, 0, 190, 191, 5, 3, 0, 0, 191, 192, 3, 42, 21, 0, 192,
+		193, 5, 4, 0, 0, 193, 194, 5, 10, 0, 0, 194, 195, 3, 42, 21, 0, 195, 197,
+		1, 0, 0, 0, 196, 175, 1, 0, 0, 0, 196, 178, 1, 0, 0, 0, 196, 184, 1, 0,
+		0, 0, 196, 189, 1, 0, 0, 0, 197, 21, 1, 0, 0, 0, 198, 199, 5, 19, 0, 0,
+		199, 200, 3, 42, 21, 0, 200, 201, 5, 6, 0, 0, 201, 202, 3, 18, 9, 0, 202,
+		203, 5, 7, 0, 0, 203, 209, 5, 17, 0, 0, 204, 205, 5, 6, 0, 0, 205, 206,
+		3, 18, 9, 0, 206, 207, 5, 7, 0, 0, 207, 210, 1, 0, 0, 0, 208, 210, 3, 22,
+		11, 0, 209, 204, 1, 0, 0, 0, 209, 208, 1, 0, 0, 0, 210, 23, 1, 0, 0, 0,
+		211, 214, 5, 26, 0, 0, 212, 213, 5, 63, 0, 0, 213, 215, 5, 13, 0, 0, 214,
+		212, 1, 0, 0, 0, 214, 215, 1, 0, 0, 0, 215, 216, 1, 0, 0, 0, 216, 217,
+		3, 42, 21, 0, 217, 218, 5, 11, 0, 0, 218, 219, 5, 1, 0, 0, 219, 220, 5,
+		27, 0, 0, 220, 221, 5, 2, 0, 0, 221, 225, 5, 6, 0, 0, 222, 224, 3, 26,
+		13, 0, 223, 222, 1, 0, 0, 0, 224, 227, 1, 0, 0, 0, 225, 223, 1, 0, 0, 0,
+		225, 226, 1, 0, 0, 0, 226, 231, 1, 0, 0, 0, 227, 225, 1, 0, 0, 0, 228,
+		229, 5, 16, 0, 0, 229, 230, 5, 14, 0, 0, 230, 232, 3, 18, 9, 0, 231, 228,
+		1, 0, 0, 0, 231, 232, 1, 0, 0, 0, 232, 233, 1, 0, 0, 0, 233, 234, 5, 7,
+		0, 0, 234, 25, 1, 0, 0, 0, 235, 236, 5, 15, 0, 0, 236, 237, 3, 0, 0, 0,
+		237, 238, 5, 14, 0, 0, 238, 240, 3, 18, 9, 0, 239, 241, 5, 8, 0, 0, 240,
+		239, 1, 0, 0, 0, 240, 241, 1, 0, 0, 0, 241, 27, 1, 0, 0, 0, 242, 247, 3,
+		30, 15, 0, 243, 244, 5, 8, 0, 0, 244, 246, 3, 30, 15, 0, 245, 243, 1, 0,
+		0, 0, 246, 249, 1, 0, 0, 0, 247, 245, 1, 0, 0, 0, 247, 248, 1, 0, 0, 0,
+		248, 29, 1, 0, 0, 0, 249, 247, 1, 0, 0, 0, 250, 251, 5, 63, 0, 0, 251,
+		254, 3, 0, 0, 0, 252, 254, 3, 0, 0, 0, 253, 250, 1, 0, 0, 0, 253, 252,
+		1, 0, 0, 0, 254, 31, 1, 0, 0, 0, 255, 260, 3, 34, 17, 0, 256, 257, 5, 8,
+		0, 0, 257, 259, 3, 34, 17, 0, 258, 256, 1, 0, 0, 0, 259, 262, 1, 0, 0,
+		0, 260, 258, 1, 0, 0, 0, 260, 261, 1, 0, 0, 0, 261, 33, 1, 0, 0, 0, 262,
+		260, 1, 0, 0, 0, 263, 266, 3, 36, 18, 0, 264, 266, 3, 0, 0, 0, 265, 263,
+		1, 0, 0, 0, 265, 264, 1, 0, 0, 0, 266, 35, 1, 0, 0, 0, 267, 268, 5, 63,
+		0, 0, 268, 270, 5, 1, 0, 0, 269, 271, 3, 38, 19, 0, 270, 269, 1, 0, 0,
+		0, 270, 271, 1, 0, 0, 0, 271, 272, 1, 0, 0, 0, 272, 273, 5, 2, 0, 0, 273,
+		274, 3, 0, 0, 0, 274, 37, 1, 0, 0, 0, 275, 280, 3, 40, 20, 0, 276, 277,
+		5, 5, 0, 0, 277, 279, 3, 40, 20, 0, 278, 276, 1, 0, 0, 0, 279, 282, 1,
+		0, 0, 0, 280, 278, 1, 0, 0, 0, 280, 281, 1, 0, 0, 0, 281, 39, 1, 0, 0,
+		0, 282, 280, 1, 0, 0, 0, 283, 284, 5, 63, 0, 0, 284, 285, 3, 0, 0, 0, 285,
+		41, 1, 0, 0, 0, 286, 287, 6, 21, -1, 0, 287, 288, 3, 0, 0, 0, 288, 290,
+		5, 6, 0, 0, 289, 291, 3, 44, 22, 0, 290, 289, 1, 0, 0, 0, 290, 291, 1,
+		0, 0, 0, 291, 292, 1, 0, 0, 0, 292, 293, 5, 7, 0, 0, 293, 353, 1, 0, 0,
+		0, 294, 295, 3, 0, 0, 0, 295, 296, 5, 6, 0, 0, 296, 297, 3, 46, 23, 0,
+		297, 298, 5, 7, 0, 0, 298, 353, 1, 0, 0, 0, 299, 300, 5, 30, 0, 0, 300,
+		301, 5, 11, 0, 0, 301, 302, 5, 32, 0, 0, 302, 303, 5, 1, 0, 0, 303, 308,
+		7, 1, 0, 0, 304, 307, 5, 5, 0, 0, 305, 307, 3, 42, 21, 0, 306, 304, 1,
+		0, 0, 0, 306, 305, 1, 0, 0, 0, 307, 310, 1, 0, 0, 0, 308, 306, 1, 0, 0,
+		0, 308, 309, 1, 0, 0, 0, 309, 311, 1, 0, 0, 0, 310, 308, 1, 0, 0, 0, 311,
+		353, 5, 2, 0, 0, 312, 313, 5, 33, 0, 0, 313, 314, 5, 1, 0, 0, 314, 315,
+		3, 42, 21, 0, 315, 316, 5, 2, 0, 0, 316, 353, 1, 0, 0, 0, 317, 318, 5,
+		34, 0, 0, 318, 319, 5, 1, 0, 0, 319, 320, 3, 44, 22, 0, 320, 321, 5, 2,
+		0, 0, 321, 353, 1, 0, 0, 0, 322, 323, 3, 0, 0, 0, 323, 324, 5, 1, 0, 0,
+		324, 325, 3, 42, 21, 0, 325, 326, 5, 2, 0, 0, 326, 353, 1, 0, 0, 0, 327,
+		328, 5, 63, 0, 0, 328, 330, 5, 1, 0, 0, 329, 331, 3, 44, 22, 0, 330, 329,
+		1, 0, 0, 0, 330, 331, 1, 0, 0, 0, 331, 332, 1, 0, 0, 0, 332, 353, 5, 2,
+		0, 0, 333, 353, 5, 63, 0, 0, 334, 335, 5, 18, 0, 0, 335, 337, 5, 1, 0,
+		0, 336, 338, 3, 38, 19, 0, 337, 336, 1, 0, 0, 0, 337, 338, 1, 0, 0, 0,
+		338, 339, 1, 0, 0, 0, 339, 340, 5, 2, 0, 0, 340, 341, 3, 0, 0, 0, 341,
+		342, 5, 6, 0, 0, 342, 343, 3, 18, 9, 0, 343, 344, 5, 7, 0, 0, 344, 353,
+		1, 0, 0, 0, 345, 346, 7, 2, 0, 0, 346, 353, 3, 42, 21, 8, 347, 348, 5,
+		1, 0, 0, 348, 349, 3, 42, 21, 0, 349, 350, 5, 2, 0, 0, 350, 353, 1, 0,
+		0, 0, 351, 353, 3, 50, 25, 0, 352, 286, 1, 0, 0, 0, 352, 294, 1, 0, 0,
+		0, 352, 299, 1, 0, 0, 0, 352, 312, 1, 0, 0, 0, 352, 317, 1, 0, 0, 0, 352,
+		322, 1, 0, 0, 0, 352, 327, 1, 0, 0, 0, 352, 333, 1, 0, 0, 0, 352, 334,
+		1, 0, 0, 0, 352, 345, 1, 0, 0, 0, 352, 347, 1, 0, 0, 0, 352, 351, 1, 0,
+		0, 0, 353, 399, 1, 0, 0, 0, 354, 355, 10, 7, 0, 0, 355, 356, 7, 3, 0, 0,
+		356, 398, 3, 42, 21, 8, 357, 358, 10, 6, 0, 0, 358, 359, 7, 4, 0, 0, 359,
+		398, 3, 42, 21, 7, 360, 361, 10, 5, 0, 0, 361, 362, 7, 5, 0, 0, 362, 398,
+		3, 42, 21, 6, 363, 364, 10, 4, 0, 0, 364, 365, 5, 55, 0, 0, 365, 398, 3,
+		42, 21, 5, 366, 367, 10, 3, 0, 0, 367, 368, 5, 56, 0, 0, 368, 398, 3, 42,
+		21, 4, 369, 370, 10, 20, 0, 0, 370, 371, 5, 11, 0, 0, 371, 372, 5, 63,
+		0, 0, 372, 374, 5, 1, 0, 0, 373, 375, 3, 44, 22, 0, 374, 373, 1, 0, 0,
+		0, 374, 375, 1, 0, 0, 0, 375, 376, 1, 0, 0, 0, 376, 398, 5, 2, 0, 0, 377,
+		378, 10, 19, 0, 0, 378, 379, 5, 11, 0, 0, 379, 398, 5, 63, 0, 0, 380, 381,
+		10, 18, 0, 0, 381, 383, 5, 1, 0, 0, 382, 384, 3, 44, 22, 0, 383, 382, 1,
+		0, 0, 0, 383, 384, 1, 0, 0, 0, 384, 385, 1, 0, 0, 0, 385, 398, 5, 2, 0,
+		0, 386, 387, 10, 17, 0, 0, 387, 388, 5, 3, 0, 0, 388, 389, 3, 42, 21, 0,
+		389, 390, 5, 4, 0, 0, 390, 398, 1, 0, 0, 0, 391, 392, 10, 16, 0, 0, 392,
+		393, 5, 11, 0, 0, 393, 394, 5, 1, 0, 0, 394, 395, 3, 0, 0, 0, 395, 396,
+		5, 2, 0, 0, 396, 398, 1, 0, 0, 0, 397, 354, 1, 0, 0, 0, 397, 357, 1, 0,
+		0, 0, 397, 360, 1, 0, 0, 0, 397, 363, 1, 0, 0, 0, 397, 366, 1, 0, 0, 0,
+		397, 369, 1, 0, 0, 0, 397, 377, 1, 0, 0, 0, 397, 380, 1, 0, 0, 0, 397,
+		386, 1, 0, 0, 0, 397, 391, 1, 0, 0, 0, 398, 401, 1, 0, 0, 0, 399, 397,
+		1, 0, 0, 0, 399, 400, 1, 0, 0, 0, 400, 43, 1, 0, 0, 0, 401, 399, 1, 0,
+		0, 0, 402, 407, 3, 42, 21, 0, 403, 404, 5, 5, 0, 0, 404, 406, 3, 42, 21,
+		0, 405, 403, 1, 0, 0, 0, 406, 409, 1, 0, 0, 0, 407, 405, 1, 0, 0, 0, 407,
+		408, 1, 0, 0, 0, 408, 45, 1, 0, 0, 0, 409, 407, 1, 0, 0, 0, 410, 415, 3,
+		48, 24, 0, 411, 412, 5, 5, 0, 0, 412, 414, 3, 48, 24, 0, 413, 411, 1, 0,
+		0, 0, 414, 417, 1, 0, 0, 0, 415, 413, 1, 0, 0, 0, 415, 416, 1, 0, 0, 0,
+		416, 47, 1, 0, 0, 0, 417, 415, 1, 0, 0, 0, 418, 419, 3, 42, 21, 0, 419,
+		420, 5, 14, 0, 0, 420, 421, 3, 42, 21, 0, 421, 49, 1, 0, 0, 0, 422, 427,
+		7, 6, 0, 0, 423, 427, 5, 68, 0, 0, 424, 427, 5, 69, 0, 0, 425, 427, 5,
+		67, 0, 0, 426, 422, 1, 0, 0, 0, 426, 423, 1, 0, 0, 0, 426, 424, 1, 0, 0,
+		0, 426, 425, 1, 0, 0, 0, 427, 51, 1, 0, 0, 0, 37, 58, 71, 78, 86, 92, 95,
+		103, 106, 125, 133, 139, 173, 196, 209, 214, 225, 231, 240, 247, 253, 260,
+		265, 270, 280, 290, 306, 308, 330, 337, 352, 374, 383, 397, 399, 407, 415,
+		426,
+	}
+	deserializer := antlr.NewATNDeserializer(nil)
+	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
+	atn := staticData.atn
+	staticData.decisionToDFA = make([]*antlr.DFA, len(atn.DecisionToState))
+	decisionToDFA := staticData.decisionToDFA
+	for index, state := range atn.DecisionToState {
+		decisionToDFA[index] = antlr.NewDFA(state, index)
 	}
 }
 
-type FGParser struct {
-	*antlr.BaseParser
+// FGParserInit initializes any static state used to implement FGParser. By default the
+// static state used to implement the parser is lazily initialized during the first call to
+// NewFGParser(). You can call this function if you wish to initialize the static state ahead
+// of time.
+func FGParserInit() {
+	staticData := &fgParserStaticData
+	staticData.once.Do(fgParserInit)
 }
 
+// NewFGParser produces a new parser instance for the optional input antlr.TokenStream.
 func NewFGParser(input antlr.TokenStream) *FGParser {
+	FGParserInit()
 	this := new(FGParser)
-
 	this.BaseParser = antlr.NewBaseParser(input)
-
-	this.Interpreter = antlr.NewParserATNSimulator(this, deserializedATN, decisionToDFA, antlr.NewPredictionContextCache())
-	this.RuleNames = ruleNames
-	this.LiteralNames = literalNames
-	this.SymbolicNames = symbolicNames
+	staticData := &fgParserStaticData
+	this.Interpreter = antlr.NewParserATNSimulator(this, staticData.atn, staticData.decisionToDFA, staticData.predictionContextCache)
+	this.RuleNames = staticData.ruleNames
+	this.LiteralNames = staticData.literalNames
+	this.SymbolicNames = staticData.symbolicNames
 	this.GrammarFileName = "FG.g4"
 
 	return this
@@ -158,413 +301,492 @@ const (
 	FGParserT__7         = 8
 	FGParserT__8         = 9
 	FGParserT__9         = 10
-	FGParserFUNC         = 11
-	FGParserINTERFACE    = 12
-	FGParserMAIN         = 13
-	FGParserPACKAGE      = 14
-	FGParserRETURN       = 15
-	FGParserSTRUCT       = 16
-	FGParserTYPE         = 17
-	FGParserIMPORT       = 18
-	FGParserFMT          = 19
-	FGParserPRINTF       = 20
-	FGParserSPRINTF      = 21
-	FGParserNAME         = 22
-	FGParserWHITESPACE   = 23
-	FGParserCOMMENT      = 24
-	FGParserLINE_COMMENT = 25
-	FGParserSTRING       = 26
+	FGParserT__10        = 11
+	FGParserT__11        = 12
+	FGParserT__12        = 13
+	FGParserT__13        = 14
+	FGParserCASE         = 15
+	FGParserDEFAULT      = 16
+	FGParserELSE         = 17
+	FGParserFUNC         = 18
+	FGParserIF           = 19
+	FGParserINTERFACE    = 20
+	FGParserMAIN         = 21
+	FGParserMAP          = 22
+	FGParserPACKAGE      = 23
+	FGParserRETURN       = 24
+	FGParserSTRUCT       = 25
+	FGParserSWITCH       = 26
+	FGParserTYPE         = 27
+	FGParserVAR          = 28
+	FGParserIMPORT       = 29
+	FGParserFMT          = 30
+	FGParserPRINTF       = 31
+	FGParserSPRINTF      = 32
+	FGParserLEN          = 33
+	FGParserAPPEND       = 34
+	FGParserPANIC        = 35
+	FGParserTRUE         = 36
+	FGParserFALSE        = 37
+	FGParserBOOL         = 38
+	FGParserINT32        = 39
+	FGParserINT64        = 40
+	FGParserFLOAT32      = 41
+	FGParserFLOAT64      = 42
+	FGParserSTRING       = 43
+	FGParserPLUS         = 44
+	FGParserMINUS        = 45
+	FGParserTIMES        = 46
+	FGParserDIV          = 47
+	FGParserMOD          = 48
+	FGParserBITAND       = 49
+	FGParserBITOR        = 50
+	FGParserBITXOR       = 51
+	FGParserSHL          = 52
+	FGParserSHR          = 53
+	FGParserNOT          = 54
+	FGParserAND          = 55
+	FGParserOR           = 56
+	FGParserEQ           = 57
+	FGParserNE           = 58
+	FGParserGT           = 59
+	FGParserLT           = 60
+	FGParserGE           = 61
+	FGParserLE           = 62
+	FGParserNAME         = 63
+	FGParserWHITESPACE   = 64
+	FGParserCOMMENT      = 65
+	FGParserLINE_COMMENT = 66
+	FGParserSTRING_LIT   = 67
+	FGParserINT_LIT      = 68
+	FGParserFLOAT_LIT    = 69
 )
 
 // FGParser rules.
 const (
-	FGParserRULE_program    = 0
-	FGParserRULE_decls      = 1
-	FGParserRULE_typeDecl   = 2
-	FGParserRULE_methDecl   = 3
-	FGParserRULE_typeLit    = 4
-	FGParserRULE_fieldDecls = 5
-	FGParserRULE_fieldDecl  = 6
-	FGParserRULE_specs      = 7
-	FGParserRULE_spec       = 8
-	FGParserRULE_sig        = 9
-	FGParserRULE_params     = 10
-	FGParserRULE_paramDecl  = 11
-	FGParserRULE_expr       = 12
-	FGParserRULE_exprs      = 13
+	FGParserRULE_typ        = 0
+	FGParserRULE_typs       = 1
+	FGParserRULE_primName   = 2
+	FGParserRULE_typeLit    = 3
+	FGParserRULE_program    = 4
+	FGParserRULE_decls      = 5
+	FGParserRULE_typeDecl   = 6
+	FGParserRULE_methDecl   = 7
+	FGParserRULE_funcDecl   = 8
+	FGParserRULE_body       = 9
+	FGParserRULE_binding    = 10
+	FGParserRULE_ifElse     = 11
+	FGParserRULE_typeSwitch = 12
+	FGParserRULE_typeCase   = 13
+	FGParserRULE_fieldDecls = 14
+	FGParserRULE_fieldDecl  = 15
+	FGParserRULE_specs      = 16
+	FGParserRULE_spec       = 17
+	FGParserRULE_sig        = 18
+	FGParserRULE_params     = 19
+	FGParserRULE_paramDecl  = 20
+	FGParserRULE_expr       = 21
+	FGParserRULE_exprs      = 22
+	FGParserRULE_entries    = 23
+	FGParserRULE_entry      = 24
+	FGParserRULE_primLit    = 25
 )
 
-// IProgramContext is an interface to support dynamic dispatch.
-type IProgramContext interface {
+// ITypContext is an interface to support dynamic dispatch.
+type ITypContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsProgramContext differentiates from other interfaces.
-	IsProgramContext()
+	// IsTypContext differentiates from other interfaces.
+	IsTypContext()
 }
 
-type ProgramContext struct {
+type TypContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyProgramContext() *ProgramContext {
-	var p = new(ProgramContext)
+func NewEmptyTypContext() *TypContext {
+	var p = new(TypContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGParserRULE_program
+	p.RuleIndex = FGParserRULE_typ
 	return p
 }
 
-func (*ProgramContext) IsProgramContext() {}
+func (*TypContext) IsTypContext() {}
 
-func NewProgramContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ProgramContext {
-	var p = new(ProgramContext)
+func NewTypContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypContext {
+	var p = new(TypContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGParserRULE_program
+	p.RuleIndex = FGParserRULE_typ
 
 	return p
 }
 
-func (s *ProgramContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ProgramContext) PACKAGE() antlr.TerminalNode {
-	return s.GetToken(FGParserPACKAGE, 0)
+func (s *TypContext) CopyFrom(ctx *TypContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
 }
 
-func (s *ProgramContext) AllMAIN() []antlr.TerminalNode {
-	return s.GetTokens(FGParserMAIN)
+func (s *TypContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *ProgramContext) MAIN(i int) antlr.TerminalNode {
-	return s.GetToken(FGParserMAIN, i)
+func (s *TypContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ProgramContext) FUNC() antlr.TerminalNode {
-	return s.GetToken(FGParserFUNC, 0)
+type TypeLit_Context struct {
+	*TypContext
 }
 
-func (s *ProgramContext) EOF() antlr.TerminalNode {
-	return s.GetToken(FGParserEOF, 0)
+func NewTypeLit_Context(parser antlr.Parser, ctx antlr.ParserRuleContext) *TypeLit_Context {
+	var p = new(TypeLit_Context)
+
+	p.TypContext = NewEmptyTypContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypContext))
+
+	return p
 }
 
-func (s *ProgramContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *TypeLit_Context) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeLit_Context) TypeLit() ITypeLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
-}
-
-func (s *ProgramContext) FMT() antlr.TerminalNode {
-	return s.GetToken(FGParserFMT, 0)
+	return t.(ITypeLitContext)
 }
 
-func (s *ProgramContext) PRINTF() antlr.TerminalNode {
-	return s.GetToken(FGParserPRINTF, 0)
+func (s *TypeLit_Context) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterTypeLit_(s)
+	}
 }
 
-func (s *ProgramContext) IMPORT() antlr.TerminalNode {
-	return s.GetToken(FGParserIMPORT, 0)
+func (s *TypeLit_Context) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitTypeLit_(s)
+	}
 }
 
-func (s *ProgramContext) STRING() antlr.TerminalNode {
-	return s.GetToken(FGParserSTRING, 0)
+type TSliceContext struct {
+	*TypContext
+	elem ITypContext
 }
 
-func (s *ProgramContext) Decls() IDeclsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDeclsContext)(nil)).Elem(), 0)
+func NewTSliceContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TSliceContext {
+	var p = new(TSliceContext)
 
-	if t == nil {
-		return nil
-	}
+	p.TypContext = NewEmptyTypContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypContext))
 
-	return t.(IDeclsContext)
+	return p
 }
 
-func (s *ProgramContext) GetRuleContext() antlr.RuleContext {
+func (s *TSliceContext) GetElem() ITypContext { return s.elem }
+
+func (s *TSliceContext) SetElem(v ITypContext) { s.elem = v }
+
+func (s *TSliceContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ProgramContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *TSliceContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
 }
 
-func (s *ProgramContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TSliceContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterProgram(s)
+		listenerT.EnterTSlice(s)
 	}
 }
 
-func (s *ProgramContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TSliceContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitProgram(s)
+		listenerT.ExitTSlice(s)
 	}
 }
 
-func (p *FGParser) Program() (localctx IProgramContext) {
-	localctx = NewProgramContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 0, FGParserRULE_program)
-	var _la int
+type TFuncContext struct {
+	*TypContext
+}
 
-	defer func() {
-		p.ExitRule()
-	}()
+func NewTFuncContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TFuncContext {
+	var p = new(TFuncContext)
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
+	p.TypContext = NewEmptyTypContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypContext))
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(28)
-		p.Match(FGParserPACKAGE)
-	}
-	{
-		p.SetState(29)
-		p.Match(FGParserMAIN)
-	}
-	{
-		p.SetState(30)
-		p.Match(FGParserT__0)
-	}
-	p.SetState(34)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+	return p
+}
 
-	if _la == FGParserIMPORT {
-		{
-			p.SetState(31)
-			p.Match(FGParserIMPORT)
-		}
-		{
-			p.SetState(32)
-			p.Match(FGParserSTRING)
-		}
-		{
-			p.SetState(33)
-			p.Match(FGParserT__0)
-		}
+func (s *TFuncContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	}
-	p.SetState(37)
-	p.GetErrorHandler().Sync(p)
+func (s *TFuncContext) FUNC() antlr.TerminalNode {
+	return s.GetToken(FGParserFUNC, 0)
+}
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(36)
-			p.Decls()
+func (s *TFuncContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
-
-	}
-	{
-		p.SetState(39)
-		p.Match(FGParserFUNC)
-	}
-	{
-		p.SetState(40)
-		p.Match(FGParserMAIN)
-	}
-	{
-		p.SetState(41)
-		p.Match(FGParserT__1)
-	}
-	{
-		p.SetState(42)
-		p.Match(FGParserT__2)
 	}
-	{
-		p.SetState(43)
-		p.Match(FGParserT__3)
+
+	if t == nil {
+		return nil
 	}
-	p.SetState(56)
-	p.GetErrorHandler().Sync(p)
 
-	switch p.GetTokenStream().LA(1) {
-	case FGParserT__4:
-		{
-			p.SetState(44)
-			p.Match(FGParserT__4)
-		}
-		{
-			p.SetState(45)
-			p.Match(FGParserT__5)
-		}
-		{
-			p.SetState(46)
-			p.expr(0)
-		}
+	return t.(ITypContext)
+}
 
-	case FGParserFMT:
-		{
-			p.SetState(47)
-			p.Match(FGParserFMT)
-		}
-		{
-			p.SetState(48)
-			p.Match(FGParserT__6)
-		}
-		{
-			p.SetState(49)
-			p.Match(FGParserPRINTF)
-		}
-		{
-			p.SetState(50)
-			p.Match(FGParserT__1)
-		}
-		{
-			p.SetState(51)
-			p.Match(FGParserT__7)
-		}
-		{
-			p.SetState(52)
-			p.Match(FGParserT__8)
-		}
-		{
-			p.SetState(53)
-			p.expr(0)
-		}
-		{
-			p.SetState(54)
-			p.Match(FGParserT__2)
+func (s *TFuncContext) Typs() ITypsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
+	}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	if t == nil {
+		return nil
 	}
-	{
-		p.SetState(58)
-		p.Match(FGParserT__9)
+
+	return t.(ITypsContext)
+}
+
+func (s *TFuncContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterTFunc(s)
 	}
-	{
-		p.SetState(59)
-		p.Match(FGParserEOF)
+}
+
+func (s *TFuncContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitTFunc(s)
 	}
+}
 
-	return localctx
+type TNamedContext struct {
+	*TypContext
+	name antlr.Token
 }
 
-// IDeclsContext is an interface to support dynamic dispatch.
-type IDeclsContext interface {
-	antlr.ParserRuleContext
+func NewTNamedContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TNamedContext {
+	var p = new(TNamedContext)
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+	p.TypContext = NewEmptyTypContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypContext))
 
-	// IsDeclsContext differentiates from other interfaces.
-	IsDeclsContext()
+	return p
 }
 
-type DeclsContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *TNamedContext) GetName() antlr.Token { return s.name }
+
+func (s *TNamedContext) SetName(v antlr.Token) { s.name = v }
+
+func (s *TNamedContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func NewEmptyDeclsContext() *DeclsContext {
-	var p = new(DeclsContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGParserRULE_decls
-	return p
+func (s *TNamedContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGParserNAME, 0)
 }
 
-func (*DeclsContext) IsDeclsContext() {}
+func (s *TNamedContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterTNamed(s)
+	}
+}
 
-func NewDeclsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeclsContext {
-	var p = new(DeclsContext)
+func (s *TNamedContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitTNamed(s)
+	}
+}
 
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+type TPrimitiveContext struct {
+	*TypContext
+	name IPrimNameContext
+}
+
+func NewTPrimitiveContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TPrimitiveContext {
+	var p = new(TPrimitiveContext)
 
+	p.TypContext = NewEmptyTypContext()
 	p.parser = parser
-	p.RuleIndex = FGParserRULE_decls
+	p.CopyFrom(ctx.(*TypContext))
 
 	return p
 }
 
-func (s *DeclsContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *DeclsContext) AllTypeDecl() []ITypeDeclContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITypeDeclContext)(nil)).Elem())
-	var tst = make([]ITypeDeclContext, len(ts))
+func (s *TPrimitiveContext) GetName() IPrimNameContext { return s.name }
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(ITypeDeclContext)
-		}
-	}
+func (s *TPrimitiveContext) SetName(v IPrimNameContext) { s.name = v }
 
-	return tst
+func (s *TPrimitiveContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *DeclsContext) TypeDecl(i int) ITypeDeclContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeDeclContext)(nil)).Elem(), i)
+func (s *TPrimitiveContext) PrimName() IPrimNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrimNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITypeDeclContext)
+	return t.(IPrimNameContext)
 }
 
-func (s *DeclsContext) AllMethDecl() []IMethDeclContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMethDeclContext)(nil)).Elem())
-	var tst = make([]IMethDeclContext, len(ts))
+func (s *TPrimitiveContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterTPrimitive(s)
+	}
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IMethDeclContext)
-		}
+func (s *TPrimitiveContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitTPrimitive(s)
 	}
+}
 
-	return tst
+type TMapContext struct {
+	*TypContext
+	key  ITypContext
+	elem ITypContext
 }
 
-func (s *DeclsContext) MethDecl(i int) IMethDeclContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMethDeclContext)(nil)).Elem(), i)
+func NewTMapContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TMapContext {
+	var p = new(TMapContext)
 
-	if t == nil {
-		return nil
-	}
+	p.TypContext = NewEmptyTypContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypContext))
 
-	return t.(IMethDeclContext)
+	return p
 }
 
-func (s *DeclsContext) GetRuleContext() antlr.RuleContext {
+func (s *TMapContext) GetKey() ITypContext { return s.key }
+
+func (s *TMapContext) GetElem() ITypContext { return s.elem }
+
+func (s *TMapContext) SetKey(v ITypContext) { s.key = v }
+
+func (s *TMapContext) SetElem(v ITypContext) { s.elem = v }
+
+func (s *TMapContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DeclsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *TMapContext) MAP() antlr.TerminalNode {
+	return s.GetToken(FGParserMAP, 0)
 }
 
-func (s *DeclsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TMapContext) AllTyp() []ITypContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITypContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypContext); ok {
+			tst[i] = t.(ITypContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TMapContext) Typ(i int) ITypContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *TMapContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterDecls(s)
+		listenerT.EnterTMap(s)
 	}
 }
 
-func (s *DeclsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TMapContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitDecls(s)
+		listenerT.ExitTMap(s)
 	}
 }
 
-func (p *FGParser) Decls() (localctx IDeclsContext) {
-	localctx = NewDeclsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 2, FGParserRULE_decls)
+func (p *FGParser) Typ() (localctx ITypContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 0, FGParserRULE_typ)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -582,130 +804,233 @@ func (p *FGParser) Decls() (localctx IDeclsContext) {
 		}
 	}()
 
-	var _alt int
-
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(67)
+	p.SetState(71)
 	p.GetErrorHandler().Sync(p)
-	_alt = 1
-	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		switch _alt {
-		case 1:
-			p.SetState(63)
-			p.GetErrorHandler().Sync(p)
 
-			switch p.GetTokenStream().LA(1) {
-			case FGParserTYPE:
-				{
-					p.SetState(61)
-					p.TypeDecl()
-				}
+	switch p.GetTokenStream().LA(1) {
+	case FGParserNAME:
+		localctx = NewTNamedContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(52)
 
-			case FGParserFUNC:
-				{
-					p.SetState(62)
-					p.MethDecl()
-				}
+			var _m = p.Match(FGParserNAME)
 
-			default:
-				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			}
+			localctx.(*TNamedContext).name = _m
+		}
+
+	case FGParserBOOL, FGParserINT32, FGParserINT64, FGParserFLOAT32, FGParserFLOAT64, FGParserSTRING:
+		localctx = NewTPrimitiveContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(53)
+
+			var _x = p.PrimName()
+
+			localctx.(*TPrimitiveContext).name = _x
+		}
+
+	case FGParserINTERFACE, FGParserSTRUCT:
+		localctx = NewTypeLit_Context(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(54)
+			p.TypeLit()
+		}
+
+	case FGParserFUNC:
+		localctx = NewTFuncContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(55)
+			p.Match(FGParserFUNC)
+		}
+		{
+			p.SetState(56)
+			p.Match(FGParserT__0)
+		}
+		p.SetState(58)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGParserT__2)|(1<<FGParserFUNC)|(1<<FGParserINTERFACE)|(1<<FGParserMAP)|(1<<FGParserSTRUCT))) != 0) || (((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(FGParserBOOL-38))|(1<<(FGParserINT32-38))|(1<<(FGParserINT64-38))|(1<<(FGParserFLOAT32-38))|(1<<(FGParserFLOAT64-38))|(1<<(FGParserSTRING-38))|(1<<(FGParserNAME-38)))) != 0) {
 			{
-				p.SetState(65)
-				p.Match(FGParserT__0)
+				p.SetState(57)
+				p.Typs()
 			}
 
-		default:
-			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+		{
+			p.SetState(60)
+			p.Match(FGParserT__1)
+		}
+		{
+			p.SetState(61)
+			p.Typ()
 		}
 
-		p.SetState(69)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext())
+	case FGParserT__2:
+		localctx = NewTSliceContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(62)
+			p.Match(FGParserT__2)
+		}
+		{
+			p.SetState(63)
+			p.Match(FGParserT__3)
+		}
+		{
+			p.SetState(64)
+
+			var _x = p.Typ()
+
+			localctx.(*TSliceContext).elem = _x
+		}
+
+	case FGParserMAP:
+		localctx = NewTMapContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(65)
+			p.Match(FGParserMAP)
+		}
+		{
+			p.SetState(66)
+			p.Match(FGParserT__2)
+		}
+		{
+			p.SetState(67)
+
+			var _x = p.Typ()
+
+			localctx.(*TMapContext).key = _x
+		}
+		{
+			p.SetState(68)
+			p.Match(FGParserT__3)
+		}
+		{
+			p.SetState(69)
+
+			var _x = p.Typ()
+
+			localctx.(*TMapContext).elem = _x
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// ITypeDeclContext is an interface to support dynamic dispatch.
-type ITypeDeclContext interface {
+// ITypsContext is an interface to support dynamic dispatch.
+type ITypsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeDeclContext differentiates from other interfaces.
-	IsTypeDeclContext()
+	// IsTypsContext differentiates from other interfaces.
+	IsTypsContext()
 }
 
-type TypeDeclContext struct {
+type TypsContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeDeclContext() *TypeDeclContext {
-	var p = new(TypeDeclContext)
+func NewEmptyTypsContext() *TypsContext {
+	var p = new(TypsContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGParserRULE_typeDecl
+	p.RuleIndex = FGParserRULE_typs
 	return p
 }
 
-func (*TypeDeclContext) IsTypeDeclContext() {}
+func (*TypsContext) IsTypsContext() {}
 
-func NewTypeDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeDeclContext {
-	var p = new(TypeDeclContext)
+func NewTypsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypsContext {
+	var p = new(TypsContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGParserRULE_typeDecl
+	p.RuleIndex = FGParserRULE_typs
 
 	return p
 }
 
-func (s *TypeDeclContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeDeclContext) TYPE() antlr.TerminalNode {
-	return s.GetToken(FGParserTYPE, 0)
-}
+func (s *TypsContext) AllTyp() []ITypContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypContext); ok {
+			len++
+		}
+	}
 
-func (s *TypeDeclContext) NAME() antlr.TerminalNode {
-	return s.GetToken(FGParserNAME, 0)
+	tst := make([]ITypContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypContext); ok {
+			tst[i] = t.(ITypContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *TypeDeclContext) TypeLit() ITypeLitContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeLitContext)(nil)).Elem(), 0)
+func (s *TypsContext) Typ(i int) ITypContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITypeLitContext)
+	return t.(ITypContext)
 }
 
-func (s *TypeDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *TypsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterTypeDecl(s)
+		listenerT.EnterTyps(s)
 	}
 }
 
-func (s *TypeDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitTypeDecl(s)
+		listenerT.ExitTyps(s)
 	}
 }
 
-func (p *FGParser) TypeDecl() (localctx ITypeDeclContext) {
-	localctx = NewTypeDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 4, FGParserRULE_typeDecl)
+func (p *FGParser) Typs() (localctx ITypsContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 2, FGParserRULE_typs)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -724,121 +1049,121 @@ func (p *FGParser) TypeDecl() (localctx ITypeDeclContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(71)
-		p.Match(FGParserTYPE)
-	}
-	{
-		p.SetState(72)
-		p.Match(FGParserNAME)
-	}
 	{
 		p.SetState(73)
-		p.TypeLit()
+		p.Typ()
+	}
+	p.SetState(78)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FGParserT__4 {
+		{
+			p.SetState(74)
+			p.Match(FGParserT__4)
+		}
+		{
+			p.SetState(75)
+			p.Typ()
+		}
+
+		p.SetState(80)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// IMethDeclContext is an interface to support dynamic dispatch.
-type IMethDeclContext interface {
+// IPrimNameContext is an interface to support dynamic dispatch.
+type IPrimNameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsMethDeclContext differentiates from other interfaces.
-	IsMethDeclContext()
+	// IsPrimNameContext differentiates from other interfaces.
+	IsPrimNameContext()
 }
 
-type MethDeclContext struct {
+type PrimNameContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMethDeclContext() *MethDeclContext {
-	var p = new(MethDeclContext)
+func NewEmptyPrimNameContext() *PrimNameContext {
+	var p = new(PrimNameContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGParserRULE_methDecl
+	p.RuleIndex = FGParserRULE_primName
 	return p
 }
 
-func (*MethDeclContext) IsMethDeclContext() {}
+func (*PrimNameContext) IsPrimNameContext() {}
 
-func NewMethDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MethDeclContext {
-	var p = new(MethDeclContext)
+func NewPrimNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimNameContext {
+	var p = new(PrimNameContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGParserRULE_methDecl
+	p.RuleIndex = FGParserRULE_primName
 
 	return p
 }
 
-func (s *MethDeclContext) GetParser() antlr.Parser { return s.parser }
+func (s *PrimNameContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MethDeclContext) FUNC() antlr.TerminalNode {
-	return s.GetToken(FGParserFUNC, 0)
+func (s *PrimNameContext) BOOL() antlr.TerminalNode {
+	return s.GetToken(FGParserBOOL, 0)
 }
 
-func (s *MethDeclContext) ParamDecl() IParamDeclContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParamDeclContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IParamDeclContext)
+func (s *PrimNameContext) INT32() antlr.TerminalNode {
+	return s.GetToken(FGParserINT32, 0)
 }
 
-func (s *MethDeclContext) Sig() ISigContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISigContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISigContext)
+func (s *PrimNameContext) INT64() antlr.TerminalNode {
+	return s.GetToken(FGParserINT64, 0)
 }
 
-func (s *MethDeclContext) RETURN() antlr.TerminalNode {
-	return s.GetToken(FGParserRETURN, 0)
+func (s *PrimNameContext) FLOAT32() antlr.TerminalNode {
+	return s.GetToken(FGParserFLOAT32, 0)
 }
 
-func (s *MethDeclContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+func (s *PrimNameContext) FLOAT64() antlr.TerminalNode {
+	return s.GetToken(FGParserFLOAT64, 0)
+}
 
-	return t.(IExprContext)
+func (s *PrimNameContext) STRING() antlr.TerminalNode {
+	return s.GetToken(FGParserSTRING, 0)
 }
 
-func (s *MethDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *PrimNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MethDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PrimNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MethDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrimNameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterMethDecl(s)
+		listenerT.EnterPrimName(s)
 	}
 }
 
-func (s *MethDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrimNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitMethDecl(s)
+		listenerT.ExitPrimName(s)
 	}
 }
 
-func (p *FGParser) MethDecl() (localctx IMethDeclContext) {
-	localctx = NewMethDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 6, FGParserRULE_methDecl)
+func (p *FGParser) PrimName() (localctx IPrimNameContext) {
+	this := p
+	_ = this
+
+	localctx = NewPrimNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 4, FGParserRULE_primName)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -857,41 +1182,16 @@ func (p *FGParser) MethDecl() (localctx IMethDeclContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(75)
-		p.Match(FGParserFUNC)
-	}
-	{
-		p.SetState(76)
-		p.Match(FGParserT__1)
-	}
-	{
-		p.SetState(77)
-		p.ParamDecl()
-	}
-	{
-		p.SetState(78)
-		p.Match(FGParserT__2)
-	}
-	{
-		p.SetState(79)
-		p.Sig()
-	}
-	{
-		p.SetState(80)
-		p.Match(FGParserT__3)
-	}
 	{
 		p.SetState(81)
-		p.Match(FGParserRETURN)
-	}
-	{
-		p.SetState(82)
-		p.expr(0)
-	}
-	{
-		p.SetState(83)
-		p.Match(FGParserT__9)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(FGParserBOOL-38))|(1<<(FGParserINT32-38))|(1<<(FGParserINT64-38))|(1<<(FGParserFLOAT32-38))|(1<<(FGParserFLOAT64-38))|(1<<(FGParserSTRING-38)))) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
 	}
 
 	return localctx
@@ -970,7 +1270,13 @@ func (s *StructTypeLitContext) STRUCT() antlr.TerminalNode {
 }
 
 func (s *StructTypeLitContext) FieldDecls() IFieldDeclsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFieldDeclsContext)(nil)).Elem(), 0)
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldDeclsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
@@ -1014,7 +1320,13 @@ func (s *InterfaceTypeLitContext) INTERFACE() antlr.TerminalNode {
 }
 
 func (s *InterfaceTypeLitContext) Specs() ISpecsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISpecsContext)(nil)).Elem(), 0)
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISpecsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
@@ -1036,8 +1348,11 @@ func (s *InterfaceTypeLitContext) ExitRule(listener antlr.ParseTreeListener) {
 }
 
 func (p *FGParser) TypeLit() (localctx ITypeLitContext) {
+	this := p
+	_ = this
+
 	localctx = NewTypeLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, FGParserRULE_typeLit)
+	p.EnterRule(localctx, 6, FGParserRULE_typeLit)
 	var _la int
 
 	defer func() {
@@ -1056,7 +1371,7 @@ func (p *FGParser) TypeLit() (localctx ITypeLitContext) {
 		}
 	}()
 
-	p.SetState(97)
+	p.SetState(95)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
@@ -1064,54 +1379,54 @@ func (p *FGParser) TypeLit() (localctx ITypeLitContext) {
 		localctx = NewStructTypeLitContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(85)
+			p.SetState(83)
 			p.Match(FGParserSTRUCT)
 		}
 		{
-			p.SetState(86)
-			p.Match(FGParserT__3)
+			p.SetState(84)
+			p.Match(FGParserT__5)
 		}
-		p.SetState(88)
+		p.SetState(86)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == FGParserNAME {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGParserT__2)|(1<<FGParserFUNC)|(1<<FGParserINTERFACE)|(1<<FGParserMAP)|(1<<FGParserSTRUCT))) != 0) || (((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(FGParserBOOL-38))|(1<<(FGParserINT32-38))|(1<<(FGParserINT64-38))|(1<<(FGParserFLOAT32-38))|(1<<(FGParserFLOAT64-38))|(1<<(FGParserSTRING-38))|(1<<(FGParserNAME-38)))) != 0) {
 			{
-				p.SetState(87)
+				p.SetState(85)
 				p.FieldDecls()
 			}
 
 		}
 		{
-			p.SetState(90)
-			p.Match(FGParserT__9)
+			p.SetState(88)
+			p.Match(FGParserT__6)
 		}
 
 	case FGParserINTERFACE:
 		localctx = NewInterfaceTypeLitContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(91)
+			p.SetState(89)
 			p.Match(FGParserINTERFACE)
 		}
 		{
-			p.SetState(92)
-			p.Match(FGParserT__3)
+			p.SetState(90)
+			p.Match(FGParserT__5)
 		}
-		p.SetState(94)
+		p.SetState(92)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == FGParserNAME {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGParserT__2)|(1<<FGParserFUNC)|(1<<FGParserINTERFACE)|(1<<FGParserMAP)|(1<<FGParserSTRUCT))) != 0) || (((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(FGParserBOOL-38))|(1<<(FGParserINT32-38))|(1<<(FGParserINT64-38))|(1<<(FGParserFLOAT32-38))|(1<<(FGParserFLOAT64-38))|(1<<(FGParserSTRING-38))|(1<<(FGParserNAME-38)))) != 0) {
 			{
-				p.SetState(93)
+				p.SetState(91)
 				p.Specs()
 			}
 
 		}
 		{
-			p.SetState(96)
-			p.Match(FGParserT__9)
+			p.SetState(94)
+			p.Match(FGParserT__6)
 		}
 
 	default:
@@ -1121,90 +1436,138 @@ func (p *FGParser) TypeLit() (localctx ITypeLitContext) {
 	return localctx
 }
 
-// IFieldDeclsContext is an interface to support dynamic dispatch.
-type IFieldDeclsContext interface {
+// IProgramContext is an interface to support dynamic dispatch.
+type IProgramContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFieldDeclsContext differentiates from other interfaces.
-	IsFieldDeclsContext()
+	// IsProgramContext differentiates from other interfaces.
+	IsProgramContext()
 }
 
-type FieldDeclsContext struct {
+type ProgramContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFieldDeclsContext() *FieldDeclsContext {
-	var p = new(FieldDeclsContext)
+func NewEmptyProgramContext() *ProgramContext {
+	var p = new(ProgramContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGParserRULE_fieldDecls
+	p.RuleIndex = FGParserRULE_program
 	return p
 }
 
-func (*FieldDeclsContext) IsFieldDeclsContext() {}
+func (*ProgramContext) IsProgramContext() {}
 
-func NewFieldDeclsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldDeclsContext {
-	var p = new(FieldDeclsContext)
+func NewProgramContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ProgramContext {
+	var p = new(ProgramContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGParserRULE_fieldDecls
+	p.RuleIndex = FGParserRULE_program
 
 	return p
 }
 
-func (s *FieldDeclsContext) GetParser() antlr.Parser { return s.parser }
+func (s *ProgramContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FieldDeclsContext) AllFieldDecl() []IFieldDeclContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IFieldDeclContext)(nil)).Elem())
-	var tst = make([]IFieldDeclContext, len(ts))
+func (s *ProgramContext) PACKAGE() antlr.TerminalNode {
+	return s.GetToken(FGParserPACKAGE, 0)
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IFieldDeclContext)
+func (s *ProgramContext) AllMAIN() []antlr.TerminalNode {
+	return s.GetTokens(FGParserMAIN)
+}
+
+func (s *ProgramContext) MAIN(i int) antlr.TerminalNode {
+	return s.GetToken(FGParserMAIN, i)
+}
+
+func (s *ProgramContext) FUNC() antlr.TerminalNode {
+	return s.GetToken(FGParserFUNC, 0)
+}
+
+func (s *ProgramContext) EOF() antlr.TerminalNode {
+	return s.GetToken(FGParserEOF, 0)
+}
+
+func (s *ProgramContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	return tst
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
 }
 
-func (s *FieldDeclsContext) FieldDecl(i int) IFieldDeclContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFieldDeclContext)(nil)).Elem(), i)
+func (s *ProgramContext) FMT() antlr.TerminalNode {
+	return s.GetToken(FGParserFMT, 0)
+}
+
+func (s *ProgramContext) PRINTF() antlr.TerminalNode {
+	return s.GetToken(FGParserPRINTF, 0)
+}
+
+func (s *ProgramContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(FGParserIMPORT, 0)
+}
+
+func (s *ProgramContext) STRING_LIT() antlr.TerminalNode {
+	return s.GetToken(FGParserSTRING_LIT, 0)
+}
+
+func (s *ProgramContext) Decls() IDeclsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDeclsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IFieldDeclContext)
+	return t.(IDeclsContext)
 }
 
-func (s *FieldDeclsContext) GetRuleContext() antlr.RuleContext {
+func (s *ProgramContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FieldDeclsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ProgramContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FieldDeclsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ProgramContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterFieldDecls(s)
+		listenerT.EnterProgram(s)
 	}
 }
 
-func (s *FieldDeclsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ProgramContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitFieldDecls(s)
+		listenerT.ExitProgram(s)
 	}
 }
 
-func (p *FGParser) FieldDecls() (localctx IFieldDeclsContext) {
-	localctx = NewFieldDeclsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, FGParserRULE_fieldDecls)
+func (p *FGParser) Program() (localctx IProgramContext) {
+	this := p
+	_ = this
+
+	localctx = NewProgramContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 8, FGParserRULE_program)
 	var _la int
 
 	defer func() {
@@ -1224,123 +1587,321 @@ func (p *FGParser) FieldDecls() (localctx IFieldDeclsContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(97)
+		p.Match(FGParserPACKAGE)
+	}
+	{
+		p.SetState(98)
+		p.Match(FGParserMAIN)
+	}
 	{
 		p.SetState(99)
-		p.FieldDecl()
+		p.Match(FGParserT__7)
 	}
-	p.SetState(104)
+	p.SetState(103)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == FGParserT__0 {
+	if _la == FGParserIMPORT {
 		{
 			p.SetState(100)
-			p.Match(FGParserT__0)
+			p.Match(FGParserIMPORT)
 		}
 		{
 			p.SetState(101)
-			p.FieldDecl()
+			p.Match(FGParserSTRING_LIT)
+		}
+		{
+			p.SetState(102)
+			p.Match(FGParserT__7)
 		}
 
-		p.SetState(106)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(106)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(105)
+			p.Decls()
+		}
+
+	}
+	{
+		p.SetState(108)
+		p.Match(FGParserFUNC)
+	}
+	{
+		p.SetState(109)
+		p.Match(FGParserMAIN)
+	}
+	{
+		p.SetState(110)
+		p.Match(FGParserT__0)
+	}
+	{
+		p.SetState(111)
+		p.Match(FGParserT__1)
+	}
+	{
+		p.SetState(112)
+		p.Match(FGParserT__5)
+	}
+	p.SetState(125)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case FGParserT__8:
+		{
+			p.SetState(113)
+			p.Match(FGParserT__8)
+		}
+		{
+			p.SetState(114)
+			p.Match(FGParserT__9)
+		}
+		{
+			p.SetState(115)
+			p.expr(0)
+		}
+
+	case FGParserFMT:
+		{
+			p.SetState(116)
+			p.Match(FGParserFMT)
+		}
+		{
+			p.SetState(117)
+			p.Match(FGParserT__10)
+		}
+		{
+			p.SetState(118)
+			p.Match(FGParserPRINTF)
+		}
+		{
+			p.SetState(119)
+			p.Match(FGParserT__0)
+		}
+		{
+			p.SetState(120)
+			p.Match(FGParserT__11)
+		}
+		{
+			p.SetState(121)
+			p.Match(FGParserT__4)
+		}
+		{
+			p.SetState(122)
+			p.expr(0)
+		}
+		{
+			p.SetState(123)
+			p.Match(FGParserT__1)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(127)
+		p.Match(FGParserT__6)
+	}
+	{
+		p.SetState(128)
+		p.Match(FGParserEOF)
 	}
 
 	return localctx
 }
 
-// IFieldDeclContext is an interface to support dynamic dispatch.
-type IFieldDeclContext interface {
+// IDeclsContext is an interface to support dynamic dispatch.
+type IDeclsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetField returns the field token.
-	GetField() antlr.Token
-
-	// GetTyp returns the typ token.
-	GetTyp() antlr.Token
-
-	// SetField sets the field token.
-	SetField(antlr.Token)
-
-	// SetTyp sets the typ token.
-	SetTyp(antlr.Token)
-
-	// IsFieldDeclContext differentiates from other interfaces.
-	IsFieldDeclContext()
+	// IsDeclsContext differentiates from other interfaces.
+	IsDeclsContext()
 }
 
-type FieldDeclContext struct {
+type DeclsContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
-	field  antlr.Token
-	typ    antlr.Token
 }
 
-func NewEmptyFieldDeclContext() *FieldDeclContext {
-	var p = new(FieldDeclContext)
+func NewEmptyDeclsContext() *DeclsContext {
+	var p = new(DeclsContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGParserRULE_fieldDecl
+	p.RuleIndex = FGParserRULE_decls
 	return p
 }
 
-func (*FieldDeclContext) IsFieldDeclContext() {}
+func (*DeclsContext) IsDeclsContext() {}
 
-func NewFieldDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldDeclContext {
-	var p = new(FieldDeclContext)
+func NewDeclsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeclsContext {
+	var p = new(DeclsContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGParserRULE_fieldDecl
+	p.RuleIndex = FGParserRULE_decls
 
 	return p
 }
 
-func (s *FieldDeclContext) GetParser() antlr.Parser { return s.parser }
+func (s *DeclsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FieldDeclContext) GetField() antlr.Token { return s.field }
+func (s *DeclsContext) AllTypeDecl() []ITypeDeclContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypeDeclContext); ok {
+			len++
+		}
+	}
 
-func (s *FieldDeclContext) GetTyp() antlr.Token { return s.typ }
+	tst := make([]ITypeDeclContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeDeclContext); ok {
+			tst[i] = t.(ITypeDeclContext)
+			i++
+		}
+	}
 
-func (s *FieldDeclContext) SetField(v antlr.Token) { s.field = v }
+	return tst
+}
+
+func (s *DeclsContext) TypeDecl(i int) ITypeDeclContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeDeclContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
-func (s *FieldDeclContext) SetTyp(v antlr.Token) { s.typ = v }
+	if t == nil {
+		return nil
+	}
 
-func (s *FieldDeclContext) AllNAME() []antlr.TerminalNode {
-	return s.GetTokens(FGParserNAME)
+	return t.(ITypeDeclContext)
 }
 
-func (s *FieldDeclContext) NAME(i int) antlr.TerminalNode {
-	return s.GetToken(FGParserNAME, i)
+func (s *DeclsContext) AllMethDecl() []IMethDeclContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMethDeclContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMethDeclContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMethDeclContext); ok {
+			tst[i] = t.(IMethDeclContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *FieldDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *DeclsContext) MethDecl(i int) IMethDeclContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMethDeclContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMethDeclContext)
+}
+
+func (s *DeclsContext) AllFuncDecl() []IFuncDeclContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFuncDeclContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFuncDeclContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFuncDeclContext); ok {
+			tst[i] = t.(IFuncDeclContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *DeclsContext) FuncDecl(i int) IFuncDeclContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFuncDeclContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFuncDeclContext)
+}
+
+func (s *DeclsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FieldDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DeclsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FieldDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DeclsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterFieldDecl(s)
+		listenerT.EnterDecls(s)
 	}
 }
 
-func (s *FieldDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DeclsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitFieldDecl(s)
+		listenerT.ExitDecls(s)
 	}
 }
 
-func (p *FGParser) FieldDecl() (localctx IFieldDeclContext) {
-	localctx = NewFieldDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, FGParserRULE_fieldDecl)
+func (p *FGParser) Decls() (localctx IDeclsContext) {
+	this := p
+	_ = this
+
+	localctx = NewDeclsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 10, FGParserRULE_decls)
 
 	defer func() {
 		p.ExitRule()
@@ -1358,110 +1919,153 @@ func (p *FGParser) FieldDecl() (localctx IFieldDeclContext) {
 		}
 	}()
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(107)
+	p.SetState(137)
+	p.GetErrorHandler().Sync(p)
+	_alt = 1
+	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		switch _alt {
+		case 1:
+			p.SetState(133)
+			p.GetErrorHandler().Sync(p)
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext()) {
+			case 1:
+				{
+					p.SetState(130)
+					p.TypeDecl()
+				}
 
-		var _m = p.Match(FGParserNAME)
+			case 2:
+				{
+					p.SetState(131)
+					p.MethDecl()
+				}
 
-		localctx.(*FieldDeclContext).field = _m
-	}
-	{
-		p.SetState(108)
+			case 3:
+				{
+					p.SetState(132)
+					p.FuncDecl()
+				}
 
-		var _m = p.Match(FGParserNAME)
+			}
+			{
+				p.SetState(135)
+				p.Match(FGParserT__7)
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
 
-		localctx.(*FieldDeclContext).typ = _m
+		p.SetState(139)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext())
 	}
 
 	return localctx
 }
 
-// ISpecsContext is an interface to support dynamic dispatch.
-type ISpecsContext interface {
+// ITypeDeclContext is an interface to support dynamic dispatch.
+type ITypeDeclContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSpecsContext differentiates from other interfaces.
-	IsSpecsContext()
+	// GetId returns the id token.
+	GetId() antlr.Token
+
+	// SetId sets the id token.
+	SetId(antlr.Token)
+
+	// IsTypeDeclContext differentiates from other interfaces.
+	IsTypeDeclContext()
 }
 
-type SpecsContext struct {
+type TypeDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
+	id     antlr.Token
 }
 
-func NewEmptySpecsContext() *SpecsContext {
-	var p = new(SpecsContext)
+func NewEmptyTypeDeclContext() *TypeDeclContext {
+	var p = new(TypeDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGParserRULE_specs
+	p.RuleIndex = FGParserRULE_typeDecl
 	return p
 }
 
-func (*SpecsContext) IsSpecsContext() {}
+func (*TypeDeclContext) IsTypeDeclContext() {}
 
-func NewSpecsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpecsContext {
-	var p = new(SpecsContext)
+func NewTypeDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeDeclContext {
+	var p = new(TypeDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGParserRULE_specs
+	p.RuleIndex = FGParserRULE_typeDecl
 
 	return p
 }
 
-func (s *SpecsContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeDeclContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SpecsContext) AllSpec() []ISpecContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISpecContext)(nil)).Elem())
-	var tst = make([]ISpecContext, len(ts))
+func (s *TypeDeclContext) GetId() antlr.Token { return s.id }
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(ISpecContext)
-		}
-	}
+func (s *TypeDeclContext) SetId(v antlr.Token) { s.id = v }
 
-	return tst
+func (s *TypeDeclContext) TYPE() antlr.TerminalNode {
+	return s.GetToken(FGParserTYPE, 0)
 }
 
-func (s *SpecsContext) Spec(i int) ISpecContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISpecContext)(nil)).Elem(), i)
+func (s *TypeDeclContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISpecContext)
+	return t.(ITypContext)
 }
 
-func (s *SpecsContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeDeclContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGParserNAME, 0)
+}
+
+func (s *TypeDeclContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SpecsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SpecsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterSpecs(s)
+		listenerT.EnterTypeDecl(s)
 	}
 }
 
-func (s *SpecsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitSpecs(s)
+		listenerT.ExitTypeDecl(s)
 	}
 }
 
-func (p *FGParser) Specs() (localctx ISpecsContext) {
-	localctx = NewSpecsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, FGParserRULE_specs)
-	var _la int
+func (p *FGParser) TypeDecl() (localctx ITypeDeclContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 12, FGParserRULE_typeDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -1481,158 +2085,140 @@ func (p *FGParser) Specs() (localctx ISpecsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(110)
-		p.Spec()
+		p.SetState(141)
+		p.Match(FGParserTYPE)
 	}
-	p.SetState(115)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+	{
+		p.SetState(142)
 
-	for _la == FGParserT__0 {
-		{
-			p.SetState(111)
-			p.Match(FGParserT__0)
-		}
-		{
-			p.SetState(112)
-			p.Spec()
-		}
+		var _m = p.Match(FGParserNAME)
 
-		p.SetState(117)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		localctx.(*TypeDeclContext).id = _m
+	}
+	{
+		p.SetState(143)
+		p.Typ()
 	}
 
 	return localctx
 }
 
-// ISpecContext is an interface to support dynamic dispatch.
-type ISpecContext interface {
+// IMethDeclContext is an interface to support dynamic dispatch.
+type IMethDeclContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSpecContext differentiates from other interfaces.
-	IsSpecContext()
+	// IsMethDeclContext differentiates from other interfaces.
+	IsMethDeclContext()
 }
 
-type SpecContext struct {
+type MethDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySpecContext() *SpecContext {
-	var p = new(SpecContext)
+func NewEmptyMethDeclContext() *MethDeclContext {
+	var p = new(MethDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGParserRULE_spec
+	p.RuleIndex = FGParserRULE_methDecl
 	return p
 }
 
-func (*SpecContext) IsSpecContext() {}
+func (*MethDeclContext) IsMethDeclContext() {}
 
-func NewSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpecContext {
-	var p = new(SpecContext)
+func NewMethDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MethDeclContext {
+	var p = new(MethDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGParserRULE_spec
+	p.RuleIndex = FGParserRULE_methDecl
 
 	return p
 }
 
-func (s *SpecContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SpecContext) CopyFrom(ctx *SpecContext) {
-	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
-}
-
-func (s *SpecContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *SpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+func (s *MethDeclContext) GetParser() antlr.Parser { return s.parser }
 
-type InterfaceSpecContext struct {
-	*SpecContext
+func (s *MethDeclContext) FUNC() antlr.TerminalNode {
+	return s.GetToken(FGParserFUNC, 0)
 }
 
-func NewInterfaceSpecContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InterfaceSpecContext {
-	var p = new(InterfaceSpecContext)
-
-	p.SpecContext = NewEmptySpecContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*SpecContext))
-
-	return p
-}
+func (s *MethDeclContext) ParamDecl() IParamDeclContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IParamDeclContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *InterfaceSpecContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *InterfaceSpecContext) NAME() antlr.TerminalNode {
-	return s.GetToken(FGParserNAME, 0)
+	return t.(IParamDeclContext)
 }
 
-func (s *InterfaceSpecContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterInterfaceSpec(s)
+func (s *MethDeclContext) Sig() ISigContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISigContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
 	}
-}
 
-func (s *InterfaceSpecContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitInterfaceSpec(s)
+	if t == nil {
+		return nil
 	}
-}
 
-type SigSpecContext struct {
-	*SpecContext
+	return t.(ISigContext)
 }
 
-func NewSigSpecContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SigSpecContext {
-	var p = new(SigSpecContext)
+func (s *MethDeclContext) Body() IBodyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-	p.SpecContext = NewEmptySpecContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*SpecContext))
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(IBodyContext)
 }
 
-func (s *SigSpecContext) GetRuleContext() antlr.RuleContext {
+func (s *MethDeclContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SigSpecContext) Sig() ISigContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISigContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISigContext)
+func (s *MethDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SigSpecContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MethDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterSigSpec(s)
+		listenerT.EnterMethDecl(s)
 	}
 }
 
-func (s *SigSpecContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MethDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitSigSpec(s)
+		listenerT.ExitMethDecl(s)
 	}
 }
 
-func (p *FGParser) Spec() (localctx ISpecContext) {
-	localctx = NewSpecContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, FGParserRULE_spec)
+func (p *FGParser) MethDecl() (localctx IMethDeclContext) {
+	this := p
+	_ = this
+
+	localctx = NewMethDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, FGParserRULE_methDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -1650,132 +2236,143 @@ func (p *FGParser) Spec() (localctx ISpecContext) {
 		}
 	}()
 
-	p.SetState(120)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext()) {
-	case 1:
-		localctx = NewSigSpecContext(p, localctx)
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(118)
-			p.Sig()
-		}
-
-	case 2:
-		localctx = NewInterfaceSpecContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(119)
-			p.Match(FGParserNAME)
-		}
-
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(145)
+		p.Match(FGParserFUNC)
+	}
+	{
+		p.SetState(146)
+		p.Match(FGParserT__0)
+	}
+	{
+		p.SetState(147)
+		p.ParamDecl()
+	}
+	{
+		p.SetState(148)
+		p.Match(FGParserT__1)
+	}
+	{
+		p.SetState(149)
+		p.Sig()
+	}
+	{
+		p.SetState(150)
+		p.Match(FGParserT__5)
+	}
+	{
+		p.SetState(151)
+		p.Body()
+	}
+	{
+		p.SetState(152)
+		p.Match(FGParserT__6)
 	}
 
 	return localctx
 }
 
-// ISigContext is an interface to support dynamic dispatch.
-type ISigContext interface {
+// IFuncDeclContext is an interface to support dynamic dispatch.
+type IFuncDeclContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetMeth returns the meth token.
-	GetMeth() antlr.Token
-
-	// GetRet returns the ret token.
-	GetRet() antlr.Token
-
-	// SetMeth sets the meth token.
-	SetMeth(antlr.Token)
-
-	// SetRet sets the ret token.
-	SetRet(antlr.Token)
-
-	// IsSigContext differentiates from other interfaces.
-	IsSigContext()
+	// IsFuncDeclContext differentiates from other interfaces.
+	IsFuncDeclContext()
 }
 
-type SigContext struct {
+type FuncDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
-	meth   antlr.Token
-	ret    antlr.Token
 }
 
-func NewEmptySigContext() *SigContext {
-	var p = new(SigContext)
+func NewEmptyFuncDeclContext() *FuncDeclContext {
+	var p = new(FuncDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGParserRULE_sig
+	p.RuleIndex = FGParserRULE_funcDecl
 	return p
 }
 
-func (*SigContext) IsSigContext() {}
+func (*FuncDeclContext) IsFuncDeclContext() {}
 
-func NewSigContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SigContext {
-	var p = new(SigContext)
+func NewFuncDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FuncDeclContext {
+	var p = new(FuncDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGParserRULE_sig
+	p.RuleIndex = FGParserRULE_funcDecl
 
 	return p
 }
 
-func (s *SigContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SigContext) GetMeth() antlr.Token { return s.meth }
-
-func (s *SigContext) GetRet() antlr.Token { return s.ret }
+func (s *FuncDeclContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SigContext) SetMeth(v antlr.Token) { s.meth = v }
+func (s *FuncDeclContext) FUNC() antlr.TerminalNode {
+	return s.GetToken(FGParserFUNC, 0)
+}
 
-func (s *SigContext) SetRet(v antlr.Token) { s.ret = v }
+func (s *FuncDeclContext) Sig() ISigContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISigContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *SigContext) AllNAME() []antlr.TerminalNode {
-	return s.GetTokens(FGParserNAME)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *SigContext) NAME(i int) antlr.TerminalNode {
-	return s.GetToken(FGParserNAME, i)
+	return t.(ISigContext)
 }
 
-func (s *SigContext) Params() IParamsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParamsContext)(nil)).Elem(), 0)
+func (s *FuncDeclContext) Body() IBodyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IParamsContext)
+	return t.(IBodyContext)
 }
 
-func (s *SigContext) GetRuleContext() antlr.RuleContext {
+func (s *FuncDeclContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SigContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FuncDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SigContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FuncDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterSig(s)
+		listenerT.EnterFuncDecl(s)
 	}
 }
 
-func (s *SigContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FuncDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitSig(s)
+		listenerT.ExitFuncDecl(s)
 	}
 }
 
-func (p *FGParser) Sig() (localctx ISigContext) {
-	localctx = NewSigContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, FGParserRULE_sig)
-	var _la int
+func (p *FGParser) FuncDecl() (localctx IFuncDeclContext) {
+	this := p
+	_ = this
+
+	localctx = NewFuncDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, FGParserRULE_funcDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -1795,127 +2392,181 @@ func (p *FGParser) Sig() (localctx ISigContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(122)
-
-		var _m = p.Match(FGParserNAME)
-
-		localctx.(*SigContext).meth = _m
+		p.SetState(154)
+		p.Match(FGParserFUNC)
 	}
 	{
-		p.SetState(123)
-		p.Match(FGParserT__1)
+		p.SetState(155)
+		p.Sig()
 	}
-	p.SetState(125)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == FGParserNAME {
-		{
-			p.SetState(124)
-			p.Params()
-		}
-
+	{
+		p.SetState(156)
+		p.Match(FGParserT__5)
 	}
 	{
-		p.SetState(127)
-		p.Match(FGParserT__2)
+		p.SetState(157)
+		p.Body()
 	}
 	{
-		p.SetState(128)
-
-		var _m = p.Match(FGParserNAME)
-
-		localctx.(*SigContext).ret = _m
+		p.SetState(158)
+		p.Match(FGParserT__6)
 	}
 
 	return localctx
 }
 
-// IParamsContext is an interface to support dynamic dispatch.
-type IParamsContext interface {
+// IBodyContext is an interface to support dynamic dispatch.
+type IBodyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsParamsContext differentiates from other interfaces.
-	IsParamsContext()
+	// IsBodyContext differentiates from other interfaces.
+	IsBodyContext()
 }
 
-type ParamsContext struct {
+type BodyContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyParamsContext() *ParamsContext {
-	var p = new(ParamsContext)
+func NewEmptyBodyContext() *BodyContext {
+	var p = new(BodyContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGParserRULE_params
+	p.RuleIndex = FGParserRULE_body
 	return p
 }
 
-func (*ParamsContext) IsParamsContext() {}
+func (*BodyContext) IsBodyContext() {}
 
-func NewParamsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParamsContext {
-	var p = new(ParamsContext)
+func NewBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BodyContext {
+	var p = new(BodyContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGParserRULE_params
+	p.RuleIndex = FGParserRULE_body
 
 	return p
 }
 
-func (s *ParamsContext) GetParser() antlr.Parser { return s.parser }
+func (s *BodyContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ParamsContext) AllParamDecl() []IParamDeclContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IParamDeclContext)(nil)).Elem())
-	var tst = make([]IParamDeclContext, len(ts))
+func (s *BodyContext) RETURN() antlr.TerminalNode {
+	return s.GetToken(FGParserRETURN, 0)
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IParamDeclContext)
+func (s *BodyContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	return tst
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
 }
 
-func (s *ParamsContext) ParamDecl(i int) IParamDeclContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParamDeclContext)(nil)).Elem(), i)
+func (s *BodyContext) PANIC() antlr.TerminalNode {
+	return s.GetToken(FGParserPANIC, 0)
+}
+
+func (s *BodyContext) IfElse() IIfElseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIfElseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IParamDeclContext)
+	return t.(IIfElseContext)
 }
 
-func (s *ParamsContext) GetRuleContext() antlr.RuleContext {
+func (s *BodyContext) TypeSwitch() ITypeSwitchContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeSwitchContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSwitchContext)
+}
+
+func (s *BodyContext) Binding() IBindingContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBindingContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBindingContext)
+}
+
+func (s *BodyContext) Body() IBodyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBodyContext)
+}
+
+func (s *BodyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ParamsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *BodyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ParamsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BodyContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterParams(s)
+		listenerT.EnterBody(s)
 	}
 }
 
-func (s *ParamsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BodyContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitParams(s)
+		listenerT.ExitBody(s)
 	}
 }
 
-func (p *FGParser) Params() (localctx IParamsContext) {
-	localctx = NewParamsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, FGParserRULE_params)
-	var _la int
+func (p *FGParser) Body() (localctx IBodyContext) {
+	this := p
+	_ = this
+
+	localctx = NewBodyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, FGParserRULE_body)
 
 	defer func() {
 		p.ExitRule()
@@ -1933,124 +2584,209 @@ func (p *FGParser) Params() (localctx IParamsContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(130)
-		p.ParamDecl()
-	}
-	p.SetState(135)
+	p.SetState(173)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == FGParserT__8 {
+	switch p.GetTokenStream().LA(1) {
+	case FGParserRETURN:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(131)
-			p.Match(FGParserT__8)
+			p.SetState(160)
+			p.Match(FGParserRETURN)
 		}
 		{
-			p.SetState(132)
-			p.ParamDecl()
+			p.SetState(161)
+			p.expr(0)
 		}
 
-		p.SetState(137)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	case FGParserPANIC:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(162)
+			p.Match(FGParserPANIC)
+		}
+		{
+			p.SetState(163)
+			p.Match(FGParserT__0)
+		}
+		{
+			p.SetState(164)
+			p.expr(0)
+		}
+		{
+			p.SetState(165)
+			p.Match(FGParserT__1)
+		}
+
+	case FGParserIF:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(167)
+			p.IfElse()
+		}
+
+	case FGParserSWITCH:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(168)
+			p.TypeSwitch()
+		}
+
+	case FGParserVAR, FGParserNAME:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(169)
+			p.Binding()
+		}
+		{
+			p.SetState(170)
+			p.Match(FGParserT__7)
+		}
+		{
+			p.SetState(171)
+			p.Body()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IParamDeclContext is an interface to support dynamic dispatch.
-type IParamDeclContext interface {
+// IBindingContext is an interface to support dynamic dispatch.
+type IBindingContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetVari returns the vari token.
-	GetVari() antlr.Token
-
-	// GetTyp returns the typ token.
-	GetTyp() antlr.Token
-
-	// SetVari sets the vari token.
-	SetVari(antlr.Token)
-
-	// SetTyp sets the typ token.
-	SetTyp(antlr.Token)
-
-	// IsParamDeclContext differentiates from other interfaces.
-	IsParamDeclContext()
+	// IsBindingContext differentiates from other interfaces.
+	IsBindingContext()
 }
 
-type ParamDeclContext struct {
+type BindingContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
-	vari   antlr.Token
-	typ    antlr.Token
 }
 
-func NewEmptyParamDeclContext() *ParamDeclContext {
-	var p = new(ParamDeclContext)
+func NewEmptyBindingContext() *BindingContext {
+	var p = new(BindingContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGParserRULE_paramDecl
+	p.RuleIndex = FGParserRULE_binding
 	return p
 }
 
-func (*ParamDeclContext) IsParamDeclContext() {}
+func (*BindingContext) IsBindingContext() {}
 
-func NewParamDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParamDeclContext {
-	var p = new(ParamDeclContext)
+func NewBindingContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BindingContext {
+	var p = new(BindingContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGParserRULE_paramDecl
+	p.RuleIndex = FGParserRULE_binding
 
 	return p
 }
 
-func (s *ParamDeclContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ParamDeclContext) GetVari() antlr.Token { return s.vari }
-
-func (s *ParamDeclContext) GetTyp() antlr.Token { return s.typ }
-
-func (s *ParamDeclContext) SetVari(v antlr.Token) { s.vari = v }
-
-func (s *ParamDeclContext) SetTyp(v antlr.Token) { s.typ = v }
+func (s *BindingContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ParamDeclContext) AllNAME() []antlr.TerminalNode {
+func (s *BindingContext) AllNAME() []antlr.TerminalNode {
 	return s.GetTokens(FGParserNAME)
 }
 
-func (s *ParamDeclContext) NAME(i int) antlr.TerminalNode {
+func (s *BindingContext) NAME(i int) antlr.TerminalNode {
 	return s.GetToken(FGParserNAME, i)
 }
 
-func (s *ParamDeclContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *BindingContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
 
-func (s *ParamDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *BindingContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *BindingContext) VAR() antlr.TerminalNode {
+	return s.GetToken(FGParserVAR, 0)
+}
+
+func (s *BindingContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *BindingContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BindingContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ParamDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BindingContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterParamDecl(s)
+		listenerT.EnterBinding(s)
 	}
 }
 
-func (s *ParamDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BindingContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitParamDecl(s)
+		listenerT.ExitBinding(s)
 	}
 }
 
-func (p *FGParser) ParamDecl() (localctx IParamDeclContext) {
-	localctx = NewParamDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, FGParserRULE_paramDecl)
+func (p *FGParser) Binding() (localctx IBindingContext) {
+	this := p
+	_ = this
+
+	localctx = NewBindingContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, FGParserRULE_binding)
 
 	defer func() {
 		p.ExitRule()
@@ -2068,109 +2804,152 @@ func (p *FGParser) ParamDecl() (localctx IParamDeclContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(138)
+	p.SetState(196)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 12, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(175)
+			p.Match(FGParserNAME)
+		}
+		{
+			p.SetState(176)
+			p.Match(FGParserT__12)
+		}
+		{
+			p.SetState(177)
+			p.expr(0)
+		}
 
-		var _m = p.Match(FGParserNAME)
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(178)
+			p.Match(FGParserVAR)
+		}
+		{
+			p.SetState(179)
+			p.Match(FGParserNAME)
+		}
+		{
+			p.SetState(180)
+			p.Typ()
+		}
+		{
+			p.SetState(181)
+			p.Match(FGParserT__9)
+		}
+		{
+			p.SetState(182)
+			p.expr(0)
+		}
 
-		localctx.(*ParamDeclContext).vari = _m
-	}
-	{
-		p.SetState(139)
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(184)
+			p.Match(FGParserNAME)
+		}
+		{
+			p.SetState(185)
+			p.Match(FGParserT__4)
+		}
+		{
+			p.SetState(186)
+			p.Match(FGParserNAME)
+		}
+		{
+			p.SetState(187)
+			p.Match(FGParserT__12)
+		}
+		{
+			p.SetState(188)
+			p.expr(0)
+		}
 
-		var _m = p.Match(FGParserNAME)
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(189)
+			p.Match(FGParserNAME)
+		}
+		{
+			p.SetState(190)
+			p.Match(FGParserT__2)
+		}
+		{
+			p.SetState(191)
+			p.expr(0)
+		}
+		{
+			p.SetState(192)
+			p.Match(FGParserT__3)
+		}
+		{
+			p.SetState(193)
+			p.Match(FGParserT__9)
+		}
+		{
+			p.SetState(194)
+			p.expr(0)
+		}
 
-		localctx.(*ParamDeclContext).typ = _m
 	}
 
 	return localctx
 }
 
-// IExprContext is an interface to support dynamic dispatch.
-type IExprContext interface {
+// IIfElseContext is an interface to support dynamic dispatch.
+type IIfElseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExprContext differentiates from other interfaces.
-	IsExprContext()
+	// IsIfElseContext differentiates from other interfaces.
+	IsIfElseContext()
 }
 
-type ExprContext struct {
+type IfElseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExprContext() *ExprContext {
-	var p = new(ExprContext)
+func NewEmptyIfElseContext() *IfElseContext {
+	var p = new(IfElseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGParserRULE_expr
+	p.RuleIndex = FGParserRULE_ifElse
 	return p
 }
 
-func (*ExprContext) IsExprContext() {}
+func (*IfElseContext) IsIfElseContext() {}
 
-func NewExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprContext {
-	var p = new(ExprContext)
+func NewIfElseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IfElseContext {
+	var p = new(IfElseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGParserRULE_expr
-
-	return p
-}
-
-func (s *ExprContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ExprContext) CopyFrom(ctx *ExprContext) {
-	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
-}
-
-func (s *ExprContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *ExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-type CallContext struct {
-	*ExprContext
-	recv IExprContext
-	args IExprsContext
-}
-
-func NewCallContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CallContext {
-	var p = new(CallContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	p.RuleIndex = FGParserRULE_ifElse
 
 	return p
 }
 
-func (s *CallContext) GetRecv() IExprContext { return s.recv }
-
-func (s *CallContext) GetArgs() IExprsContext { return s.args }
-
-func (s *CallContext) SetRecv(v IExprContext) { s.recv = v }
-
-func (s *CallContext) SetArgs(v IExprsContext) { s.args = v }
-
-func (s *CallContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *IfElseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CallContext) NAME() antlr.TerminalNode {
-	return s.GetToken(FGParserNAME, 0)
+func (s *IfElseContext) IF() antlr.TerminalNode {
+	return s.GetToken(FGParserIF, 0)
 }
 
-func (s *CallContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *IfElseContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
@@ -2179,191 +2958,216 @@ func (s *CallContext) Expr() IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *CallContext) Exprs() IExprsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprsContext)(nil)).Elem(), 0)
+func (s *IfElseContext) AllBody() []IBodyContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IBodyContext); ok {
+			len++
+		}
+	}
 
-	if t == nil {
-		return nil
+	tst := make([]IBodyContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IBodyContext); ok {
+			tst[i] = t.(IBodyContext)
+			i++
+		}
 	}
 
-	return t.(IExprsContext)
+	return tst
 }
 
-func (s *CallContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterCall(s)
+func (s *IfElseContext) Body(i int) IBodyContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
 	}
-}
 
-func (s *CallContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitCall(s)
+	if t == nil {
+		return nil
 	}
-}
-
-type VariableContext struct {
-	*ExprContext
-}
-
-func NewVariableContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *VariableContext {
-	var p = new(VariableContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
-
-	return p
-}
 
-func (s *VariableContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return t.(IBodyContext)
 }
 
-func (s *VariableContext) NAME() antlr.TerminalNode {
-	return s.GetToken(FGParserNAME, 0)
+func (s *IfElseContext) ELSE() antlr.TerminalNode {
+	return s.GetToken(FGParserELSE, 0)
 }
 
-func (s *VariableContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterVariable(s)
+func (s *IfElseContext) IfElse() IIfElseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIfElseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
 	}
-}
 
-func (s *VariableContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitVariable(s)
+	if t == nil {
+		return nil
 	}
-}
-
-type AssertContext struct {
-	*ExprContext
-}
-
-func NewAssertContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AssertContext {
-	var p = new(AssertContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
 
-	return p
+	return t.(IIfElseContext)
 }
 
-func (s *AssertContext) GetRuleContext() antlr.RuleContext {
+func (s *IfElseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AssertContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExprContext)
-}
-
-func (s *AssertContext) NAME() antlr.TerminalNode {
-	return s.GetToken(FGParserNAME, 0)
+func (s *IfElseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AssertContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IfElseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterAssert(s)
+		listenerT.EnterIfElse(s)
 	}
 }
 
-func (s *AssertContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IfElseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitAssert(s)
+		listenerT.ExitIfElse(s)
 	}
 }
 
-type SprintfContext struct {
-	*ExprContext
-}
+func (p *FGParser) IfElse() (localctx IIfElseContext) {
+	this := p
+	_ = this
 
-func NewSprintfContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SprintfContext {
-	var p = new(SprintfContext)
+	localctx = NewIfElseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, FGParserRULE_ifElse)
 
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	defer func() {
+		p.ExitRule()
+	}()
 
-	return p
-}
-
-func (s *SprintfContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *SprintfContext) FMT() antlr.TerminalNode {
-	return s.GetToken(FGParserFMT, 0)
-}
-
-func (s *SprintfContext) SPRINTF() antlr.TerminalNode {
-	return s.GetToken(FGParserSPRINTF, 0)
-}
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-func (s *SprintfContext) STRING() antlr.TerminalNode {
-	return s.GetToken(FGParserSTRING, 0)
-}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(198)
+		p.Match(FGParserIF)
+	}
+	{
+		p.SetState(199)
+		p.expr(0)
+	}
+	{
+		p.SetState(200)
+		p.Match(FGParserT__5)
+	}
+	{
+		p.SetState(201)
+		p.Body()
+	}
+	{
+		p.SetState(202)
+		p.Match(FGParserT__6)
+	}
+	{
+		p.SetState(203)
+		p.Match(FGParserELSE)
+	}
+	p.SetState(209)
+	p.GetErrorHandler().Sync(p)
 
-func (s *SprintfContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
+	switch p.GetTokenStream().LA(1) {
+	case FGParserT__5:
+		{
+			p.SetState(204)
+			p.Match(FGParserT__5)
+		}
+		{
+			p.SetState(205)
+			p.Body()
+		}
+		{
+			p.SetState(206)
+			p.Match(FGParserT__6)
+		}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExprContext)
+	case FGParserIF:
+		{
+			p.SetState(208)
+			p.IfElse()
 		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
-	return tst
+	return localctx
 }
 
-func (s *SprintfContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+// ITypeSwitchContext is an interface to support dynamic dispatch.
+type ITypeSwitchContext interface {
+	antlr.ParserRuleContext
 
-	if t == nil {
-		return nil
-	}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	return t.(IExprContext)
+	// IsTypeSwitchContext differentiates from other interfaces.
+	IsTypeSwitchContext()
 }
 
-func (s *SprintfContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterSprintf(s)
-	}
+type TypeSwitchContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *SprintfContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitSprintf(s)
-	}
+func NewEmptyTypeSwitchContext() *TypeSwitchContext {
+	var p = new(TypeSwitchContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGParserRULE_typeSwitch
+	return p
 }
 
-type SelectContext struct {
-	*ExprContext
-}
+func (*TypeSwitchContext) IsTypeSwitchContext() {}
 
-func NewSelectContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SelectContext {
-	var p = new(SelectContext)
+func NewTypeSwitchContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSwitchContext {
+	var p = new(TypeSwitchContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	p.RuleIndex = FGParserRULE_typeSwitch
 
 	return p
 }
 
-func (s *SelectContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *TypeSwitchContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeSwitchContext) SWITCH() antlr.TerminalNode {
+	return s.GetToken(FGParserSWITCH, 0)
 }
 
-func (s *SelectContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *TypeSwitchContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
@@ -2372,82 +3176,105 @@ func (s *SelectContext) Expr() IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *SelectContext) NAME() antlr.TerminalNode {
+func (s *TypeSwitchContext) TYPE() antlr.TerminalNode {
+	return s.GetToken(FGParserTYPE, 0)
+}
+
+func (s *TypeSwitchContext) NAME() antlr.TerminalNode {
 	return s.GetToken(FGParserNAME, 0)
 }
 
-func (s *SelectContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterSelect(s)
+func (s *TypeSwitchContext) AllTypeCase() []ITypeCaseContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypeCaseContext); ok {
+			len++
+		}
 	}
-}
 
-func (s *SelectContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitSelect(s)
+	tst := make([]ITypeCaseContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeCaseContext); ok {
+			tst[i] = t.(ITypeCaseContext)
+			i++
+		}
 	}
-}
 
-type StructLitContext struct {
-	*ExprContext
+	return tst
 }
 
-func NewStructLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StructLitContext {
-	var p = new(StructLitContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+func (s *TypeSwitchContext) TypeCase(i int) ITypeCaseContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeCaseContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
-	return p
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *StructLitContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return t.(ITypeCaseContext)
 }
 
-func (s *StructLitContext) NAME() antlr.TerminalNode {
-	return s.GetToken(FGParserNAME, 0)
+func (s *TypeSwitchContext) DEFAULT() antlr.TerminalNode {
+	return s.GetToken(FGParserDEFAULT, 0)
 }
 
-func (s *StructLitContext) Exprs() IExprsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprsContext)(nil)).Elem(), 0)
+func (s *TypeSwitchContext) Body() IBodyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprsContext)
+	return t.(IBodyContext)
 }
 
-func (s *StructLitContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeSwitchContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeSwitchContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeSwitchContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterStructLit(s)
+		listenerT.EnterTypeSwitch(s)
 	}
 }
 
-func (s *StructLitContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeSwitchContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitStructLit(s)
+		listenerT.ExitTypeSwitch(s)
 	}
 }
 
-func (p *FGParser) Expr() (localctx IExprContext) {
-	return p.expr(0)
-}
+func (p *FGParser) TypeSwitch() (localctx ITypeSwitchContext) {
+	this := p
+	_ = this
 
-func (p *FGParser) expr(_p int) (localctx IExprContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
-	_parentState := p.GetState()
-	localctx = NewExprContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IExprContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 24
-	p.EnterRecursionRule(localctx, 24, FGParserRULE_expr, _p)
+	localctx = NewTypeSwitchContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, FGParserRULE_typeSwitch)
 	var _la int
 
 	defer func() {
-		p.UnrollRecursionContexts(_parentctx)
+		p.ExitRule()
 	}()
 
 	defer func() {
@@ -2462,308 +3289,3825 @@ func (p *FGParser) expr(_p int) (localctx IExprContext) {
 		}
 	}()
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(162)
+	{
+		p.SetState(211)
+		p.Match(FGParserSWITCH)
+	}
+	p.SetState(214)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext()) {
-	case 1:
-		localctx = NewVariableContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
 
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 14, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(142)
-			p.Match(FGParserNAME)
-		}
-
-	case 2:
-		localctx = NewStructLitContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-		{
-			p.SetState(143)
+			p.SetState(212)
 			p.Match(FGParserNAME)
 		}
 		{
-			p.SetState(144)
-			p.Match(FGParserT__3)
+			p.SetState(213)
+			p.Match(FGParserT__12)
 		}
-		p.SetState(146)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
 
-		if _la == FGParserFMT || _la == FGParserNAME {
-			{
-				p.SetState(145)
-				p.Exprs()
-			}
+	}
+	{
+		p.SetState(216)
+		p.expr(0)
+	}
+	{
+		p.SetState(217)
+		p.Match(FGParserT__10)
+	}
+	{
+		p.SetState(218)
+		p.Match(FGParserT__0)
+	}
+	{
+		p.SetState(219)
+		p.Match(FGParserTYPE)
+	}
+	{
+		p.SetState(220)
+		p.Match(FGParserT__1)
+	}
+	{
+		p.SetState(221)
+		p.Match(FGParserT__5)
+	}
+	p.SetState(225)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-		}
+	for _la == FGParserCASE {
 		{
-			p.SetState(148)
-			p.Match(FGParserT__9)
+			p.SetState(222)
+			p.TypeCase()
 		}
 
-	case 3:
-		localctx = NewSprintfContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-		{
-			p.SetState(149)
-			p.Match(FGParserFMT)
-		}
+		p.SetState(227)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(231)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FGParserDEFAULT {
 		{
-			p.SetState(150)
-			p.Match(FGParserT__6)
+			p.SetState(228)
+			p.Match(FGParserDEFAULT)
 		}
 		{
-			p.SetState(151)
-			p.Match(FGParserSPRINTF)
+			p.SetState(229)
+			p.Match(FGParserT__13)
 		}
 		{
-			p.SetState(152)
-			p.Match(FGParserT__1)
+			p.SetState(230)
+			p.Body()
 		}
-		{
-			p.SetState(153)
-			_la = p.GetTokenStream().LA(1)
 
-			if !(_la == FGParserT__7 || _la == FGParserSTRING) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
-			}
-		}
-		p.SetState(158)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(233)
+		p.Match(FGParserT__6)
+	}
 
-		for ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGParserT__8)|(1<<FGParserFMT)|(1<<FGParserNAME))) != 0 {
-			p.SetState(156)
-			p.GetErrorHandler().Sync(p)
+	return localctx
+}
 
-			switch p.GetTokenStream().LA(1) {
-			case FGParserT__8:
-				{
-					p.SetState(154)
-					p.Match(FGParserT__8)
-				}
+// ITypeCaseContext is an interface to support dynamic dispatch.
+type ITypeCaseContext interface {
+	antlr.ParserRuleContext
 
-			case FGParserFMT, FGParserNAME:
-				{
-					p.SetState(155)
-					p.expr(0)
-				}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-			default:
-				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			}
+	// IsTypeCaseContext differentiates from other interfaces.
+	IsTypeCaseContext()
+}
 
-			p.SetState(160)
-			p.GetErrorHandler().Sync(p)
-			_la = p.GetTokenStream().LA(1)
-		}
-		{
-			p.SetState(161)
-			p.Match(FGParserT__2)
-		}
+type TypeCaseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-	}
-	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(182)
-	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext())
+func NewEmptyTypeCaseContext() *TypeCaseContext {
+	var p = new(TypeCaseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGParserRULE_typeCase
+	return p
+}
+
+func (*TypeCaseContext) IsTypeCaseContext() {}
+
+func NewTypeCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeCaseContext {
+	var p = new(TypeCaseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGParserRULE_typeCase
+
+	return p
+}
+
+func (s *TypeCaseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeCaseContext) CASE() antlr.TerminalNode {
+	return s.GetToken(FGParserCASE, 0)
+}
+
+func (s *TypeCaseContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *TypeCaseContext) Body() IBodyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBodyContext)
+}
+
+func (s *TypeCaseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeCaseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterTypeCase(s)
+	}
+}
+
+func (s *TypeCaseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitTypeCase(s)
+	}
+}
+
+func (p *FGParser) TypeCase() (localctx ITypeCaseContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeCaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, FGParserRULE_typeCase)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(235)
+		p.Match(FGParserCASE)
+	}
+	{
+		p.SetState(236)
+		p.Typ()
+	}
+	{
+		p.SetState(237)
+		p.Match(FGParserT__13)
+	}
+	{
+		p.SetState(238)
+		p.Body()
+	}
+	p.SetState(240)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FGParserT__7 {
+		{
+			p.SetState(239)
+			p.Match(FGParserT__7)
+		}
+
+	}
+
+	return localctx
+}
+
+// IFieldDeclsContext is an interface to support dynamic dispatch.
+type IFieldDeclsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFieldDeclsContext differentiates from other interfaces.
+	IsFieldDeclsContext()
+}
+
+type FieldDeclsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFieldDeclsContext() *FieldDeclsContext {
+	var p = new(FieldDeclsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGParserRULE_fieldDecls
+	return p
+}
+
+func (*FieldDeclsContext) IsFieldDeclsContext() {}
+
+func NewFieldDeclsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldDeclsContext {
+	var p = new(FieldDeclsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGParserRULE_fieldDecls
+
+	return p
+}
+
+func (s *FieldDeclsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FieldDeclsContext) AllFieldDecl() []IFieldDeclContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFieldDeclContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFieldDeclContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFieldDeclContext); ok {
+			tst[i] = t.(IFieldDeclContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FieldDeclsContext) FieldDecl(i int) IFieldDeclContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldDeclContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldDeclContext)
+}
+
+func (s *FieldDeclsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FieldDeclsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FieldDeclsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterFieldDecls(s)
+	}
+}
+
+func (s *FieldDeclsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitFieldDecls(s)
+	}
+}
+
+func (p *FGParser) FieldDecls() (localctx IFieldDeclsContext) {
+	this := p
+	_ = this
+
+	localctx = NewFieldDeclsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, FGParserRULE_fieldDecls)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(242)
+		p.FieldDecl()
+	}
+	p.SetState(247)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FGParserT__7 {
+		{
+			p.SetState(243)
+			p.Match(FGParserT__7)
+		}
+		{
+			p.SetState(244)
+			p.FieldDecl()
+		}
+
+		p.SetState(249)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IFieldDeclContext is an interface to support dynamic dispatch.
+type IFieldDeclContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetField returns the field token.
+	GetField() antlr.Token
+
+	// SetField sets the field token.
+	SetField(antlr.Token)
+
+	// GetEmbedded returns the embedded rule contexts.
+	GetEmbedded() ITypContext
+
+	// SetEmbedded sets the embedded rule contexts.
+	SetEmbedded(ITypContext)
+
+	// IsFieldDeclContext differentiates from other interfaces.
+	IsFieldDeclContext()
+}
+
+type FieldDeclContext struct {
+	*antlr.BaseParserRuleContext
+	parser   antlr.Parser
+	field    antlr.Token
+	embedded ITypContext
+}
+
+func NewEmptyFieldDeclContext() *FieldDeclContext {
+	var p = new(FieldDeclContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGParserRULE_fieldDecl
+	return p
+}
+
+func (*FieldDeclContext) IsFieldDeclContext() {}
+
+func NewFieldDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldDeclContext {
+	var p = new(FieldDeclContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGParserRULE_fieldDecl
+
+	return p
+}
+
+func (s *FieldDeclContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FieldDeclContext) GetField() antlr.Token { return s.field }
+
+func (s *FieldDeclContext) SetField(v antlr.Token) { s.field = v }
+
+func (s *FieldDeclContext) GetEmbedded() ITypContext { return s.embedded }
+
+func (s *FieldDeclContext) SetEmbedded(v ITypContext) { s.embedded = v }
+
+func (s *FieldDeclContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *FieldDeclContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGParserNAME, 0)
+}
+
+func (s *FieldDeclContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FieldDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FieldDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterFieldDecl(s)
+	}
+}
+
+func (s *FieldDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitFieldDecl(s)
+	}
+}
+
+func (p *FGParser) FieldDecl() (localctx IFieldDeclContext) {
+	this := p
+	_ = this
+
+	localctx = NewFieldDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, FGParserRULE_fieldDecl)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(253)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(250)
+
+			var _m = p.Match(FGParserNAME)
+
+			localctx.(*FieldDeclContext).field = _m
+		}
+		{
+			p.SetState(251)
+			p.Typ()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(252)
+
+			var _x = p.Typ()
+
+			localctx.(*FieldDeclContext).embedded = _x
+		}
+
+	}
+
+	return localctx
+}
+
+// ISpecsContext is an interface to support dynamic dispatch.
+type ISpecsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSpecsContext differentiates from other interfaces.
+	IsSpecsContext()
+}
+
+type SpecsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySpecsContext() *SpecsContext {
+	var p = new(SpecsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGParserRULE_specs
+	return p
+}
+
+func (*SpecsContext) IsSpecsContext() {}
+
+func NewSpecsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpecsContext {
+	var p = new(SpecsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGParserRULE_specs
+
+	return p
+}
+
+func (s *SpecsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SpecsContext) AllSpec() []ISpecContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISpecContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISpecContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISpecContext); ok {
+			tst[i] = t.(ISpecContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SpecsContext) Spec(i int) ISpecContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISpecContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISpecContext)
+}
+
+func (s *SpecsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SpecsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SpecsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterSpecs(s)
+	}
+}
+
+func (s *SpecsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitSpecs(s)
+	}
+}
+
+func (p *FGParser) Specs() (localctx ISpecsContext) {
+	this := p
+	_ = this
+
+	localctx = NewSpecsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, FGParserRULE_specs)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(255)
+		p.Spec()
+	}
+	p.SetState(260)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FGParserT__7 {
+		{
+			p.SetState(256)
+			p.Match(FGParserT__7)
+		}
+		{
+			p.SetState(257)
+			p.Spec()
+		}
+
+		p.SetState(262)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// ISpecContext is an interface to support dynamic dispatch.
+type ISpecContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSpecContext differentiates from other interfaces.
+	IsSpecContext()
+}
+
+type SpecContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySpecContext() *SpecContext {
+	var p = new(SpecContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGParserRULE_spec
+	return p
+}
+
+func (*SpecContext) IsSpecContext() {}
+
+func NewSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpecContext {
+	var p = new(SpecContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGParserRULE_spec
+
+	return p
+}
+
+func (s *SpecContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SpecContext) Sig() ISigContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISigContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISigContext)
+}
+
+func (s *SpecContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *SpecContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SpecContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterSpec(s)
+	}
+}
+
+func (s *SpecContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitSpec(s)
+	}
+}
+
+func (p *FGParser) Spec() (localctx ISpecContext) {
+	this := p
+	_ = this
+
+	localctx = NewSpecContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, FGParserRULE_spec)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(265)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(263)
+			p.Sig()
+		}
+
+	case 2:
+		{
+			p.SetState(264)
+			p.Typ()
+		}
+
+	}
+
+	return localctx
+}
+
+// ISigContext is an interface to support dynamic dispatch.
+type ISigContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetMeth returns the meth token.
+	GetMeth() antlr.Token
+
+	// SetMeth sets the meth token.
+	SetMeth(antlr.Token)
+
+	// IsSigContext differentiates from other interfaces.
+	IsSigContext()
+}
+
+type SigContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+	meth   antlr.Token
+}
+
+func NewEmptySigContext() *SigContext {
+	var p = new(SigContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGParserRULE_sig
+	return p
+}
+
+func (*SigContext) IsSigContext() {}
+
+func NewSigContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SigContext {
+	var p = new(SigContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGParserRULE_sig
+
+	return p
+}
+
+func (s *SigContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SigContext) GetMeth() antlr.Token { return s.meth }
+
+func (s *SigContext) SetMeth(v antlr.Token) { s.meth = v }
+
+func (s *SigContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *SigContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGParserNAME, 0)
+}
+
+func (s *SigContext) Params() IParamsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IParamsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParamsContext)
+}
+
+func (s *SigContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SigContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SigContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterSig(s)
+	}
+}
+
+func (s *SigContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitSig(s)
+	}
+}
+
+func (p *FGParser) Sig() (localctx ISigContext) {
+	this := p
+	_ = this
+
+	localctx = NewSigContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, FGParserRULE_sig)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(267)
+
+		var _m = p.Match(FGParserNAME)
+
+		localctx.(*SigContext).meth = _m
+	}
+	{
+		p.SetState(268)
+		p.Match(FGParserT__0)
+	}
+	p.SetState(270)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FGParserNAME {
+		{
+			p.SetState(269)
+			p.Params()
+		}
+
+	}
+	{
+		p.SetState(272)
+		p.Match(FGParserT__1)
+	}
+	{
+		p.SetState(273)
+		p.Typ()
+	}
+
+	return localctx
+}
+
+// IParamsContext is an interface to support dynamic dispatch.
+type IParamsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsParamsContext differentiates from other interfaces.
+	IsParamsContext()
+}
+
+type ParamsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyParamsContext() *ParamsContext {
+	var p = new(ParamsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGParserRULE_params
+	return p
+}
+
+func (*ParamsContext) IsParamsContext() {}
+
+func NewParamsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParamsContext {
+	var p = new(ParamsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGParserRULE_params
+
+	return p
+}
+
+func (s *ParamsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ParamsContext) AllParamDecl() []IParamDeclContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IParamDeclContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IParamDeclContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IParamDeclContext); ok {
+			tst[i] = t.(IParamDeclContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ParamsContext) ParamDecl(i int) IParamDeclContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IParamDeclContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParamDeclContext)
+}
+
+func (s *ParamsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParamsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ParamsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterParams(s)
+	}
+}
+
+func (s *ParamsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitParams(s)
+	}
+}
+
+func (p *FGParser) Params() (localctx IParamsContext) {
+	this := p
+	_ = this
+
+	localctx = NewParamsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, FGParserRULE_params)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(275)
+		p.ParamDecl()
+	}
+	p.SetState(280)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FGParserT__4 {
+		{
+			p.SetState(276)
+			p.Match(FGParserT__4)
+		}
+		{
+			p.SetState(277)
+			p.ParamDecl()
+		}
+
+		p.SetState(282)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IParamDeclContext is an interface to support dynamic dispatch.
+type IParamDeclContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetVari returns the vari token.
+	GetVari() antlr.Token
+
+	// SetVari sets the vari token.
+	SetVari(antlr.Token)
+
+	// IsParamDeclContext differentiates from other interfaces.
+	IsParamDeclContext()
+}
+
+type ParamDeclContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+	vari   antlr.Token
+}
+
+func NewEmptyParamDeclContext() *ParamDeclContext {
+	var p = new(ParamDeclContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGParserRULE_paramDecl
+	return p
+}
+
+func (*ParamDeclContext) IsParamDeclContext() {}
+
+func NewParamDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParamDeclContext {
+	var p = new(ParamDeclContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGParserRULE_paramDecl
+
+	return p
+}
+
+func (s *ParamDeclContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ParamDeclContext) GetVari() antlr.Token { return s.vari }
+
+func (s *ParamDeclContext) SetVari(v antlr.Token) { s.vari = v }
+
+func (s *ParamDeclContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *ParamDeclContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGParserNAME, 0)
+}
+
+func (s *ParamDeclContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParamDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ParamDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterParamDecl(s)
+	}
+}
+
+func (s *ParamDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitParamDecl(s)
+	}
+}
+
+func (p *FGParser) ParamDecl() (localctx IParamDeclContext) {
+	this := p
+	_ = this
+
+	localctx = NewParamDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, FGParserRULE_paramDecl)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(283)
+
+		var _m = p.Match(FGParserNAME)
+
+		localctx.(*ParamDeclContext).vari = _m
+	}
+	{
+		p.SetState(284)
+		p.Typ()
+	}
+
+	return localctx
+}
+
+// IExprContext is an interface to support dynamic dispatch.
+type IExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExprContext differentiates from other interfaces.
+	IsExprContext()
+}
+
+type ExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExprContext() *ExprContext {
+	var p = new(ExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGParserRULE_expr
+	return p
+}
+
+func (*ExprContext) IsExprContext() {}
+
+func NewExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprContext {
+	var p = new(ExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGParserRULE_expr
+
+	return p
+}
+
+func (s *ExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExprContext) CopyFrom(ctx *ExprContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *ExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type PrimaryLitContext struct {
+	*ExprContext
+}
+
+func NewPrimaryLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PrimaryLitContext {
+	var p = new(PrimaryLitContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *PrimaryLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrimaryLitContext) PrimLit() IPrimLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrimLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrimLitContext)
+}
+
+func (s *PrimaryLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterPrimaryLit(s)
+	}
+}
+
+func (s *PrimaryLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitPrimaryLit(s)
+	}
+}
+
+type CallContext struct {
+	*ExprContext
+	recv IExprContext
+	args IExprsContext
+}
+
+func NewCallContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CallContext {
+	var p = new(CallContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *CallContext) GetRecv() IExprContext { return s.recv }
+
+func (s *CallContext) GetArgs() IExprsContext { return s.args }
+
+func (s *CallContext) SetRecv(v IExprContext) { s.recv = v }
+
+func (s *CallContext) SetArgs(v IExprsContext) { s.args = v }
+
+func (s *CallContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CallContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGParserNAME, 0)
+}
+
+func (s *CallContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *CallContext) Exprs() IExprsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprsContext)
+}
+
+func (s *CallContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterCall(s)
+	}
+}
+
+func (s *CallContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitCall(s)
+	}
+}
+
+type ConvertContext struct {
+	*ExprContext
+}
+
+func NewConvertContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ConvertContext {
+	var p = new(ConvertContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *ConvertContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ConvertContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *ConvertContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ConvertContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterConvert(s)
+	}
+}
+
+func (s *ConvertContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitConvert(s)
+	}
+}
+
+type VariableContext struct {
+	*ExprContext
+}
+
+func NewVariableContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *VariableContext {
+	var p = new(VariableContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *VariableContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *VariableContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGParserNAME, 0)
+}
+
+func (s *VariableContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterVariable(s)
+	}
+}
+
+func (s *VariableContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitVariable(s)
+	}
+}
+
+type UnaryOpContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewUnaryOpContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *UnaryOpContext {
+	var p = new(UnaryOpContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *UnaryOpContext) GetOp() antlr.Token { return s.op }
+
+func (s *UnaryOpContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *UnaryOpContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UnaryOpContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *UnaryOpContext) NOT() antlr.TerminalNode {
+	return s.GetToken(FGParserNOT, 0)
+}
+
+func (s *UnaryOpContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(FGParserMINUS, 0)
+}
+
+func (s *UnaryOpContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterUnaryOp(s)
+	}
+}
+
+func (s *UnaryOpContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitUnaryOp(s)
+	}
+}
+
+type FuncLitContext struct {
+	*ExprContext
+}
+
+func NewFuncLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FuncLitContext {
+	var p = new(FuncLitContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *FuncLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FuncLitContext) FUNC() antlr.TerminalNode {
+	return s.GetToken(FGParserFUNC, 0)
+}
+
+func (s *FuncLitContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *FuncLitContext) Body() IBodyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBodyContext)
+}
+
+func (s *FuncLitContext) Params() IParamsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IParamsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParamsContext)
+}
+
+func (s *FuncLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterFuncLit(s)
+	}
+}
+
+func (s *FuncLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitFuncLit(s)
+	}
+}
+
+type ApplyContext struct {
+	*ExprContext
+	args IExprsContext
+}
+
+func NewApplyContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ApplyContext {
+	var p = new(ApplyContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *ApplyContext) GetArgs() IExprsContext { return s.args }
+
+func (s *ApplyContext) SetArgs(v IExprsContext) { s.args = v }
+
+func (s *ApplyContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ApplyContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ApplyContext) Exprs() IExprsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprsContext)
+}
+
+func (s *ApplyContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterApply(s)
+	}
+}
+
+func (s *ApplyContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitApply(s)
+	}
+}
+
+type IndexContext struct {
+	*ExprContext
+	index IExprContext
+}
+
+func NewIndexContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IndexContext {
+	var p = new(IndexContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *IndexContext) GetIndex() IExprContext { return s.index }
+
+func (s *IndexContext) SetIndex(v IExprContext) { s.index = v }
+
+func (s *IndexContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IndexContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *IndexContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *IndexContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterIndex(s)
+	}
+}
+
+func (s *IndexContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitIndex(s)
+	}
+}
+
+type MapLitContext struct {
+	*ExprContext
+}
+
+func NewMapLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MapLitContext {
+	var p = new(MapLitContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *MapLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MapLitContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *MapLitContext) Entries() IEntriesContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEntriesContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEntriesContext)
+}
+
+func (s *MapLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterMapLit(s)
+	}
+}
+
+func (s *MapLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitMapLit(s)
+	}
+}
+
+type StructLitContext struct {
+	*ExprContext
+}
+
+func NewStructLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StructLitContext {
+	var p = new(StructLitContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *StructLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StructLitContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *StructLitContext) Exprs() IExprsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprsContext)
+}
+
+func (s *StructLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterStructLit(s)
+	}
+}
+
+func (s *StructLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitStructLit(s)
+	}
+}
+
+type FuncCallContext struct {
+	*ExprContext
+}
+
+func NewFuncCallContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FuncCallContext {
+	var p = new(FuncCallContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *FuncCallContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FuncCallContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGParserNAME, 0)
+}
+
+func (s *FuncCallContext) Exprs() IExprsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprsContext)
+}
+
+func (s *FuncCallContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterFuncCall(s)
+	}
+}
+
+func (s *FuncCallContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitFuncCall(s)
+	}
+}
+
+type AppendContext struct {
+	*ExprContext
+	args IExprsContext
+}
+
+func NewAppendContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AppendContext {
+	var p = new(AppendContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *AppendContext) GetArgs() IExprsContext { return s.args }
+
+func (s *AppendContext) SetArgs(v IExprsContext) { s.args = v }
+
+func (s *AppendContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AppendContext) APPEND() antlr.TerminalNode {
+	return s.GetToken(FGParserAPPEND, 0)
+}
+
+func (s *AppendContext) Exprs() IExprsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprsContext)
+}
+
+func (s *AppendContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterAppend(s)
+	}
+}
+
+func (s *AppendContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitAppend(s)
+	}
+}
+
+type AssertContext struct {
+	*ExprContext
+}
+
+func NewAssertContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AssertContext {
+	var p = new(AssertContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *AssertContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AssertContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *AssertContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *AssertContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterAssert(s)
+	}
+}
+
+func (s *AssertContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitAssert(s)
+	}
+}
+
+type LenContext struct {
+	*ExprContext
+}
+
+func NewLenContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LenContext {
+	var p = new(LenContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *LenContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LenContext) LEN() antlr.TerminalNode {
+	return s.GetToken(FGParserLEN, 0)
+}
+
+func (s *LenContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *LenContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterLen(s)
+	}
+}
+
+func (s *LenContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitLen(s)
+	}
+}
+
+type SprintfContext struct {
+	*ExprContext
+}
+
+func NewSprintfContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SprintfContext {
+	var p = new(SprintfContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *SprintfContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SprintfContext) FMT() antlr.TerminalNode {
+	return s.GetToken(FGParserFMT, 0)
+}
+
+func (s *SprintfContext) SPRINTF() antlr.TerminalNode {
+	return s.GetToken(FGParserSPRINTF, 0)
+}
+
+func (s *SprintfContext) STRING_LIT() antlr.TerminalNode {
+	return s.GetToken(FGParserSTRING_LIT, 0)
+}
+
+func (s *SprintfContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SprintfContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *SprintfContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterSprintf(s)
+	}
+}
+
+func (s *SprintfContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitSprintf(s)
+	}
+}
+
+type SelectContext struct {
+	*ExprContext
+}
+
+func NewSelectContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SelectContext {
+	var p = new(SelectContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *SelectContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *SelectContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGParserNAME, 0)
+}
+
+func (s *SelectContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterSelect(s)
+	}
+}
+
+func (s *SelectContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitSelect(s)
+	}
+}
+
+type ParenContext struct {
+	*ExprContext
+}
+
+func NewParenContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParenContext {
+	var p = new(ParenContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *ParenContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParenContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ParenContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterParen(s)
+	}
+}
+
+func (s *ParenContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitParen(s)
+	}
+}
+
+type BinaryOpContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewBinaryOpContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BinaryOpContext {
+	var p = new(BinaryOpContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *BinaryOpContext) GetOp() antlr.Token { return s.op }
+
+func (s *BinaryOpContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *BinaryOpContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BinaryOpContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *BinaryOpContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *BinaryOpContext) TIMES() antlr.TerminalNode {
+	return s.GetToken(FGParserTIMES, 0)
+}
+
+func (s *BinaryOpContext) DIV() antlr.TerminalNode {
+	return s.GetToken(FGParserDIV, 0)
+}
+
+func (s *BinaryOpContext) MOD() antlr.TerminalNode {
+	return s.GetToken(FGParserMOD, 0)
+}
+
+func (s *BinaryOpContext) SHL() antlr.TerminalNode {
+	return s.GetToken(FGParserSHL, 0)
+}
+
+func (s *BinaryOpContext) SHR() antlr.TerminalNode {
+	return s.GetToken(FGParserSHR, 0)
+}
+
+func (s *BinaryOpContext) BITAND() antlr.TerminalNode {
+	return s.GetToken(FGParserBITAND, 0)
+}
+
+func (s *BinaryOpContext) PLUS() antlr.TerminalNode {
+	return s.GetToken(FGParserPLUS, 0)
+}
+
+func (s *BinaryOpContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(FGParserMINUS, 0)
+}
+
+func (s *BinaryOpContext) BITOR() antlr.TerminalNode {
+	return s.GetToken(FGParserBITOR, 0)
+}
+
+func (s *BinaryOpContext) BITXOR() antlr.TerminalNode {
+	return s.GetToken(FGParserBITXOR, 0)
+}
+
+func (s *BinaryOpContext) EQ() antlr.TerminalNode {
+	return s.GetToken(FGParserEQ, 0)
+}
+
+func (s *BinaryOpContext) NE() antlr.TerminalNode {
+	return s.GetToken(FGParserNE, 0)
+}
+
+func (s *BinaryOpContext) GT() antlr.TerminalNode {
+	return s.GetToken(FGParserGT, 0)
+}
+
+func (s *BinaryOpContext) LT() antlr.TerminalNode {
+	return s.GetToken(FGParserLT, 0)
+}
+
+func (s *BinaryOpContext) GE() antlr.TerminalNode {
+	return s.GetToken(FGParserGE, 0)
+}
+
+func (s *BinaryOpContext) LE() antlr.TerminalNode {
+	return s.GetToken(FGParserLE, 0)
+}
+
+func (s *BinaryOpContext) AND() antlr.TerminalNode {
+	return s.GetToken(FGParserAND, 0)
+}
+
+func (s *BinaryOpContext) OR() antlr.TerminalNode {
+	return s.GetToken(FGParserOR, 0)
+}
+
+func (s *BinaryOpContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterBinaryOp(s)
+	}
+}
+
+func (s *BinaryOpContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitBinaryOp(s)
+	}
+}
+
+func (p *FGParser) Expr() (localctx IExprContext) {
+	return p.expr(0)
+}
+
+func (p *FGParser) expr(_p int) (localctx IExprContext) {
+	this := p
+	_ = this
+
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+	_parentState := p.GetState()
+	localctx = NewExprContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExprContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 42
+	p.EnterRecursionRule(localctx, 42, FGParserRULE_expr, _p)
+	var _la int
+
+	defer func() {
+		p.UnrollRecursionContexts(_parentctx)
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(352)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 29, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewStructLitContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+
+		{
+			p.SetState(287)
+			p.Typ()
+		}
+		{
+			p.SetState(288)
+			p.Match(FGParserT__5)
+		}
+		p.SetState(290)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGParserT__0)|(1<<FGParserT__2)|(1<<FGParserFUNC)|(1<<FGParserINTERFACE)|(1<<FGParserMAP)|(1<<FGParserSTRUCT)|(1<<FGParserFMT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(FGParserLEN-33))|(1<<(FGParserAPPEND-33))|(1<<(FGParserTRUE-33))|(1<<(FGParserFALSE-33))|(1<<(FGParserBOOL-33))|(1<<(FGParserINT32-33))|(1<<(FGParserINT64-33))|(1<<(FGParserFLOAT32-33))|(1<<(FGParserFLOAT64-33))|(1<<(FGParserSTRING-33))|(1<<(FGParserMINUS-33))|(1<<(FGParserNOT-33))|(1<<(FGParserNAME-33)))) != 0) || (((_la-67)&-(0x1f+1)) == 0 && ((1<<uint((_la-67)))&((1<<(FGParserSTRING_LIT-67))|(1<<(FGParserINT_LIT-67))|(1<<(FGParserFLOAT_LIT-67)))) != 0) {
+			{
+				p.SetState(289)
+				p.Exprs()
+			}
+
+		}
+		{
+			p.SetState(292)
+			p.Match(FGParserT__6)
+		}
+
+	case 2:
+		localctx = NewMapLitContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(294)
+			p.Typ()
+		}
+		{
+			p.SetState(295)
+			p.Match(FGParserT__5)
+		}
+		{
+			p.SetState(296)
+			p.Entries()
+		}
+		{
+			p.SetState(297)
+			p.Match(FGParserT__6)
+		}
+
+	case 3:
+		localctx = NewSprintfContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(299)
+			p.Match(FGParserFMT)
+		}
+		{
+			p.SetState(300)
+			p.Match(FGParserT__10)
+		}
+		{
+			p.SetState(301)
+			p.Match(FGParserSPRINTF)
+		}
+		{
+			p.SetState(302)
+			p.Match(FGParserT__0)
+		}
+		{
+			p.SetState(303)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == FGParserT__11 || _la == FGParserSTRING_LIT) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		p.SetState(308)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGParserT__0)|(1<<FGParserT__2)|(1<<FGParserT__4)|(1<<FGParserFUNC)|(1<<FGParserINTERFACE)|(1<<FGParserMAP)|(1<<FGParserSTRUCT)|(1<<FGParserFMT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(FGParserLEN-33))|(1<<(FGParserAPPEND-33))|(1<<(FGParserTRUE-33))|(1<<(FGParserFALSE-33))|(1<<(FGParserBOOL-33))|(1<<(FGParserINT32-33))|(1<<(FGParserINT64-33))|(1<<(FGParserFLOAT32-33))|(1<<(FGParserFLOAT64-33))|(1<<(FGParserSTRING-33))|(1<<(FGParserMINUS-33))|(1<<(FGParserNOT-33))|(1<<(FGParserNAME-33)))) != 0) || (((_la-67)&-(0x1f+1)) == 0 && ((1<<uint((_la-67)))&((1<<(FGParserSTRING_LIT-67))|(1<<(FGParserINT_LIT-67))|(1<<(FGParserFLOAT_LIT-67)))) != 0) {
+			p.SetState(306)
+			p.GetErrorHandler().Sync(p)
+
+			switch p.GetTokenStream().LA(1) {
+			case FGParserT__4:
+				{
+					p.SetState(304)
+					p.Match(FGParserT__4)
+				}
+
+			case FGParserT__0, FGParserT__2, FGParserFUNC, FGParserINTERFACE, FGParserMAP, FGParserSTRUCT, FGParserFMT, FGParserLEN, FGParserAPPEND, FGParserTRUE, FGParserFALSE, FGParserBOOL, FGParserINT32, FGParserINT64, FGParserFLOAT32, FGParserFLOAT64, FGParserSTRING, FGParserMINUS, FGParserNOT, FGParserNAME, FGParserSTRING_LIT, FGParserINT_LIT, FGParserFLOAT_LIT:
+				{
+					p.SetState(305)
+					p.expr(0)
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+			p.SetState(310)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(311)
+			p.Match(FGParserT__1)
+		}
+
+	case 4:
+		localctx = NewLenContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(312)
+			p.Match(FGParserLEN)
+		}
+		{
+			p.SetState(313)
+			p.Match(FGParserT__0)
+		}
+		{
+			p.SetState(314)
+			p.expr(0)
+		}
+		{
+			p.SetState(315)
+			p.Match(FGParserT__1)
+		}
+
+	case 5:
+		localctx = NewAppendContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(317)
+			p.Match(FGParserAPPEND)
+		}
+		{
+			p.SetState(318)
+			p.Match(FGParserT__0)
+		}
+		{
+			p.SetState(319)
+
+			var _x = p.Exprs()
+
+			localctx.(*AppendContext).args = _x
+		}
+		{
+			p.SetState(320)
+			p.Match(FGParserT__1)
+		}
+
+	case 6:
+		localctx = NewConvertContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(322)
+			p.Typ()
+		}
+		{
+			p.SetState(323)
+			p.Match(FGParserT__0)
+		}
+		{
+			p.SetState(324)
+			p.expr(0)
+		}
+		{
+			p.SetState(325)
+			p.Match(FGParserT__1)
+		}
+
+	case 7:
+		localctx = NewFuncCallContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(327)
+			p.Match(FGParserNAME)
+		}
+		{
+			p.SetState(328)
+			p.Match(FGParserT__0)
+		}
+		p.SetState(330)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGParserT__0)|(1<<FGParserT__2)|(1<<FGParserFUNC)|(1<<FGParserINTERFACE)|(1<<FGParserMAP)|(1<<FGParserSTRUCT)|(1<<FGParserFMT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(FGParserLEN-33))|(1<<(FGParserAPPEND-33))|(1<<(FGParserTRUE-33))|(1<<(FGParserFALSE-33))|(1<<(FGParserBOOL-33))|(1<<(FGParserINT32-33))|(1<<(FGParserINT64-33))|(1<<(FGParserFLOAT32-33))|(1<<(FGParserFLOAT64-33))|(1<<(FGParserSTRING-33))|(1<<(FGParserMINUS-33))|(1<<(FGParserNOT-33))|(1<<(FGParserNAME-33)))) != 0) || (((_la-67)&-(0x1f+1)) == 0 && ((1<<uint((_la-67)))&((1<<(FGParserSTRING_LIT-67))|(1<<(FGParserINT_LIT-67))|(1<<(FGParserFLOAT_LIT-67)))) != 0) {
+			{
+				p.SetState(329)
+				p.Exprs()
+			}
+
+		}
+		{
+			p.SetState(332)
+			p.Match(FGParserT__1)
+		}
+
+	case 8:
+		localctx = NewVariableContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(333)
+			p.Match(FGParserNAME)
+		}
+
+	case 9:
+		localctx = NewFuncLitContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(334)
+			p.Match(FGParserFUNC)
+		}
+		{
+			p.SetState(335)
+			p.Match(FGParserT__0)
+		}
+		p.SetState(337)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == FGParserNAME {
+			{
+				p.SetState(336)
+				p.Params()
+			}
+
+		}
+		{
+			p.SetState(339)
+			p.Match(FGParserT__1)
+		}
+		{
+			p.SetState(340)
+			p.Typ()
+		}
+		{
+			p.SetState(341)
+			p.Match(FGParserT__5)
+		}
+		{
+			p.SetState(342)
+			p.Body()
+		}
+		{
+			p.SetState(343)
+			p.Match(FGParserT__6)
+		}
+
+	case 10:
+		localctx = NewUnaryOpContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(345)
+
+			var _lt = p.GetTokenStream().LT(1)
+
+			localctx.(*UnaryOpContext).op = _lt
+
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == FGParserMINUS || _la == FGParserNOT) {
+				var _ri = p.GetErrorHandler().RecoverInline(p)
+
+				localctx.(*UnaryOpContext).op = _ri
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(346)
+			p.expr(8)
+		}
+
+	case 11:
+		localctx = NewParenContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(347)
+			p.Match(FGParserT__0)
+		}
+		{
+			p.SetState(348)
+			p.expr(0)
+		}
+		{
+			p.SetState(349)
+			p.Match(FGParserT__1)
+		}
+
+	case 12:
+		localctx = NewPrimaryLitContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(351)
+			p.PrimLit()
+		}
+
+	}
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(399)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			p.SetState(397)
+			p.GetErrorHandler().Sync(p)
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewBinaryOpContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGParserRULE_expr)
+				p.SetState(354)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+				}
+				{
+					p.SetState(355)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*BinaryOpContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(((_la-46)&-(0x1f+1)) == 0 && ((1<<uint((_la-46)))&((1<<(FGParserTIMES-46))|(1<<(FGParserDIV-46))|(1<<(FGParserMOD-46))|(1<<(FGParserBITAND-46))|(1<<(FGParserSHL-46))|(1<<(FGParserSHR-46)))) != 0) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*BinaryOpContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(356)
+					p.expr(8)
+				}
+
+			case 2:
+				localctx = NewBinaryOpContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGParserRULE_expr)
+				p.SetState(357)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+				}
+				{
+					p.SetState(358)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*BinaryOpContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(((_la-44)&-(0x1f+1)) == 0 && ((1<<uint((_la-44)))&((1<<(FGParserPLUS-44))|(1<<(FGParserMINUS-44))|(1<<(FGParserBITOR-44))|(1<<(FGParserBITXOR-44)))) != 0) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*BinaryOpContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(359)
+					p.expr(7)
+				}
+
+			case 3:
+				localctx = NewBinaryOpContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGParserRULE_expr)
+				p.SetState(360)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+				}
+				{
+					p.SetState(361)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*BinaryOpContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(FGParserEQ-57))|(1<<(FGParserNE-57))|(1<<(FGParserGT-57))|(1<<(FGParserLT-57))|(1<<(FGParserGE-57))|(1<<(FGParserLE-57)))) != 0) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*BinaryOpContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(362)
+					p.expr(6)
+				}
+
+			case 4:
+				localctx = NewBinaryOpContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGParserRULE_expr)
+				p.SetState(363)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+				}
+				{
+					p.SetState(364)
+
+					var _m = p.Match(FGParserAND)
+
+					localctx.(*BinaryOpContext).op = _m
+				}
+				{
+					p.SetState(365)
+					p.expr(5)
+				}
+
+			case 5:
+				localctx = NewBinaryOpContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGParserRULE_expr)
+				p.SetState(366)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+				}
+				{
+					p.SetState(367)
+
+					var _m = p.Match(FGParserOR)
+
+					localctx.(*BinaryOpContext).op = _m
+				}
+				{
+					p.SetState(368)
+					p.expr(4)
+				}
+
+			case 6:
+				localctx = NewCallContext(p, NewExprContext(p, _parentctx, _parentState))
+				localctx.(*CallContext).recv = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, FGParserRULE_expr)
+				p.SetState(369)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 20)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 20)", ""))
+				}
+				{
+					p.SetState(370)
+					p.Match(FGParserT__10)
+				}
+				{
+					p.SetState(371)
+					p.Match(FGParserNAME)
+				}
+				{
+					p.SetState(372)
+					p.Match(FGParserT__0)
+				}
+				p.SetState(374)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGParserT__0)|(1<<FGParserT__2)|(1<<FGParserFUNC)|(1<<FGParserINTERFACE)|(1<<FGParserMAP)|(1<<FGParserSTRUCT)|(1<<FGParserFMT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(FGParserLEN-33))|(1<<(FGParserAPPEND-33))|(1<<(FGParserTRUE-33))|(1<<(FGParserFALSE-33))|(1<<(FGParserBOOL-33))|(1<<(FGParserINT32-33))|(1<<(FGParserINT64-33))|(1<<(FGParserFLOAT32-33))|(1<<(FGParserFLOAT64-33))|(1<<(FGParserSTRING-33))|(1<<(FGParserMINUS-33))|(1<<(FGParserNOT-33))|(1<<(FGParserNAME-33)))) != 0) || (((_la-67)&-(0x1f+1)) == 0 && ((1<<uint((_la-67)))&((1<<(FGParserSTRING_LIT-67))|(1<<(FGParserINT_LIT-67))|(1<<(FGParserFLOAT_LIT-67)))) != 0) {
+					{
+						p.SetState(373)
+
+						var _x = p.Exprs()
+
+						localctx.(*CallContext).args = _x
+					}
+
+				}
+				{
+					p.SetState(376)
+					p.Match(FGParserT__1)
+				}
+
+			case 7:
+				localctx = NewSelectContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGParserRULE_expr)
+				p.SetState(377)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
+				}
+				{
+					p.SetState(378)
+					p.Match(FGParserT__10)
+				}
+				{
+					p.SetState(379)
+					p.Match(FGParserNAME)
+				}
+
+			case 8:
+				localctx = NewApplyContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGParserRULE_expr)
+				p.SetState(380)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
+				}
+				{
+					p.SetState(381)
+					p.Match(FGParserT__0)
+				}
+				p.SetState(383)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGParserT__0)|(1<<FGParserT__2)|(1<<FGParserFUNC)|(1<<FGParserINTERFACE)|(1<<FGParserMAP)|(1<<FGParserSTRUCT)|(1<<FGParserFMT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(FGParserLEN-33))|(1<<(FGParserAPPEND-33))|(1<<(FGParserTRUE-33))|(1<<(FGParserFALSE-33))|(1<<(FGParserBOOL-33))|(1<<(FGParserINT32-33))|(1<<(FGParserINT64-33))|(1<<(FGParserFLOAT32-33))|(1<<(FGParserFLOAT64-33))|(1<<(FGParserSTRING-33))|(1<<(FGParserMINUS-33))|(1<<(FGParserNOT-33))|(1<<(FGParserNAME-33)))) != 0) || (((_la-67)&-(0x1f+1)) == 0 && ((1<<uint((_la-67)))&((1<<(FGParserSTRING_LIT-67))|(1<<(FGParserINT_LIT-67))|(1<<(FGParserFLOAT_LIT-67)))) != 0) {
+					{
+						p.SetState(382)
+
+						var _x = p.Exprs()
+
+						localctx.(*ApplyContext).args = _x
+					}
+
+				}
+				{
+					p.SetState(385)
+					p.Match(FGParserT__1)
+				}
+
+			case 9:
+				localctx = NewIndexContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGParserRULE_expr)
+				p.SetState(386)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
+				}
+				{
+					p.SetState(387)
+					p.Match(FGParserT__2)
+				}
+				{
+					p.SetState(388)
+
+					var _x = p.expr(0)
+
+					localctx.(*IndexContext).index = _x
+				}
+				{
+					p.SetState(389)
+					p.Match(FGParserT__3)
+				}
+
+			case 10:
+				localctx = NewAssertContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGParserRULE_expr)
+				p.SetState(391)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
+				}
+				{
+					p.SetState(392)
+					p.Match(FGParserT__10)
+				}
+				{
+					p.SetState(393)
+					p.Match(FGParserT__0)
+				}
+				{
+					p.SetState(394)
+					p.Typ()
+				}
+				{
+					p.SetState(395)
+					p.Match(FGParserT__1)
+				}
+
+			}
+
+		}
+		p.SetState(401)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// IExprsContext is an interface to support dynamic dispatch.
+type IExprsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExprsContext differentiates from other interfaces.
+	IsExprsContext()
+}
+
+type ExprsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			if p.GetParseListeners() != nil {
-				p.TriggerExitRuleEvent()
+func NewEmptyExprsContext() *ExprsContext {
+	var p = new(ExprsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGParserRULE_exprs
+	return p
+}
+
+func (*ExprsContext) IsExprsContext() {}
+
+func NewExprsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprsContext {
+	var p = new(ExprsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGParserRULE_exprs
+
+	return p
+}
+
+func (s *ExprsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExprsContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ExprsContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
 			}
-			_prevctx = localctx
-			p.SetState(180)
-			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 18, p.GetParserRuleContext()) {
-			case 1:
-				localctx = NewSelectContext(p, NewExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, FGParserRULE_expr)
-				p.SetState(164)
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ExprsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExprsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExprsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterExprs(s)
+	}
+}
+
+func (s *ExprsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitExprs(s)
+	}
+}
+
+func (p *FGParser) Exprs() (localctx IExprsContext) {
+	this := p
+	_ = this
+
+	localctx = NewExprsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, FGParserRULE_exprs)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(402)
+		p.expr(0)
+	}
+	p.SetState(407)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FGParserT__4 {
+		{
+			p.SetState(403)
+			p.Match(FGParserT__4)
+		}
+		{
+			p.SetState(404)
+			p.expr(0)
+		}
+
+		p.SetState(409)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IEntriesContext is an interface to support dynamic dispatch.
+type IEntriesContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsEntriesContext differentiates from other interfaces.
+	IsEntriesContext()
+}
+
+type EntriesContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyEntriesContext() *EntriesContext {
+	var p = new(EntriesContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGParserRULE_entries
+	return p
+}
+
+func (*EntriesContext) IsEntriesContext() {}
+
+func NewEntriesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EntriesContext {
+	var p = new(EntriesContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGParserRULE_entries
+
+	return p
+}
+
+func (s *EntriesContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *EntriesContext) AllEntry() []IEntryContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IEntryContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IEntryContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IEntryContext); ok {
+			tst[i] = t.(IEntryContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *EntriesContext) Entry(i int) IEntryContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEntryContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEntryContext)
+}
+
+func (s *EntriesContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EntriesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *EntriesContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterEntries(s)
+	}
+}
+
+func (s *EntriesContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitEntries(s)
+	}
+}
+
+func (p *FGParser) Entries() (localctx IEntriesContext) {
+	this := p
+	_ = this
+
+	localctx = NewEntriesContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, FGParserRULE_entries)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(410)
+		p.Entry()
+	}
+	p.SetState(415)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FGParserT__4 {
+		{
+			p.SetState(411)
+			p.Match(FGParserT__4)
+		}
+		{
+			p.SetState(412)
+			p.Entry()
+		}
+
+		p.SetState(417)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IEntryContext is an interface to support dynamic dispatch.
+type IEntryContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetKey returns the key rule contexts.
+	GetKey() IExprContext
+
+	// GetVal returns the val rule contexts.
+	GetVal() IExprContext
+
+	// SetKey sets the key rule contexts.
+	SetKey(IExprContext)
+
+	// SetVal sets the val rule contexts.
+	SetVal(IExprContext)
+
+	// IsEntryContext differentiates from other interfaces.
+	IsEntryContext()
+}
+
+type EntryContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+	key    IExprContext
+	val    IExprContext
+}
+
+func NewEmptyEntryContext() *EntryContext {
+	var p = new(EntryContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGParserRULE_entry
+	return p
+}
+
+func (*EntryContext) IsEntryContext() {}
+
+func NewEntryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EntryContext {
+	var p = new(EntryContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGParserRULE_entry
+
+	return p
+}
+
+func (s *EntryContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *EntryContext) GetKey() IExprContext { return s.key }
+
+func (s *EntryContext) GetVal() IExprContext { return s.val }
+
+func (s *EntryContext) SetKey(v IExprContext) { s.key = v }
+
+func (s *EntryContext) SetVal(v IExprContext) { s.val = v }
+
+func (s *EntryContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
-				}
-				{
-					p.SetState(165)
-					p.Match(FGParserT__6)
-				}
-				{
-					p.SetState(166)
-					p.Match(FGParserNAME)
-				}
+func (s *EntryContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
-			case 2:
-				localctx = NewCallContext(p, NewExprContext(p, _parentctx, _parentState))
-				localctx.(*CallContext).recv = _prevctx
+	if t == nil {
+		return nil
+	}
 
-				p.PushNewRecursionContext(localctx, _startState, FGParserRULE_expr)
-				p.SetState(167)
+	return t.(IExprContext)
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
-				}
-				{
-					p.SetState(168)
-					p.Match(FGParserT__6)
-				}
-				{
-					p.SetState(169)
-					p.Match(FGParserNAME)
-				}
-				{
-					p.SetState(170)
-					p.Match(FGParserT__1)
-				}
-				p.SetState(172)
-				p.GetErrorHandler().Sync(p)
-				_la = p.GetTokenStream().LA(1)
+func (s *EntryContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-				if _la == FGParserFMT || _la == FGParserNAME {
-					{
-						p.SetState(171)
+func (s *EntryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-						var _x = p.Exprs()
+func (s *EntryContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterEntry(s)
+	}
+}
 
-						localctx.(*CallContext).args = _x
-					}
+func (s *EntryContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitEntry(s)
+	}
+}
 
-				}
-				{
-					p.SetState(174)
-					p.Match(FGParserT__2)
-				}
+func (p *FGParser) Entry() (localctx IEntryContext) {
+	this := p
+	_ = this
 
-			case 3:
-				localctx = NewAssertContext(p, NewExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, FGParserRULE_expr)
-				p.SetState(175)
+	localctx = NewEntryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, FGParserRULE_entry)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
-				}
-				{
-					p.SetState(176)
-					p.Match(FGParserT__6)
-				}
-				{
-					p.SetState(177)
-					p.Match(FGParserT__1)
-				}
-				{
-					p.SetState(178)
-					p.Match(FGParserNAME)
-				}
-				{
-					p.SetState(179)
-					p.Match(FGParserT__2)
-				}
+	defer func() {
+		p.ExitRule()
+	}()
 
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
 			}
-
 		}
-		p.SetState(184)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext())
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(418)
+
+		var _x = p.expr(0)
+
+		localctx.(*EntryContext).key = _x
+	}
+	{
+		p.SetState(419)
+		p.Match(FGParserT__13)
+	}
+	{
+		p.SetState(420)
+
+		var _x = p.expr(0)
+
+		localctx.(*EntryContext).val = _x
 	}
 
 	return localctx
 }
 
-// IExprsContext is an interface to support dynamic dispatch.
-type IExprsContext interface {
+// IPrimLitContext is an interface to support dynamic dispatch.
+type IPrimLitContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExprsContext differentiates from other interfaces.
-	IsExprsContext()
+	// IsPrimLitContext differentiates from other interfaces.
+	IsPrimLitContext()
 }
 
-type ExprsContext struct {
+type PrimLitContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExprsContext() *ExprsContext {
-	var p = new(ExprsContext)
+func NewEmptyPrimLitContext() *PrimLitContext {
+	var p = new(PrimLitContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGParserRULE_exprs
+	p.RuleIndex = FGParserRULE_primLit
 	return p
 }
 
-func (*ExprsContext) IsExprsContext() {}
+func (*PrimLitContext) IsPrimLitContext() {}
 
-func NewExprsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprsContext {
-	var p = new(ExprsContext)
+func NewPrimLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimLitContext {
+	var p = new(PrimLitContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGParserRULE_exprs
+	p.RuleIndex = FGParserRULE_primLit
 
 	return p
 }
 
-func (s *ExprsContext) GetParser() antlr.Parser { return s.parser }
+func (s *PrimLitContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExprsContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
+func (s *PrimLitContext) CopyFrom(ctx *PrimLitContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExprContext)
-		}
+func (s *PrimLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrimLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type FloatLitContext struct {
+	*PrimLitContext
+	lit antlr.Token
+}
+
+func NewFloatLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FloatLitContext {
+	var p = new(FloatLitContext)
+
+	p.PrimLitContext = NewEmptyPrimLitContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*PrimLitContext))
+
+	return p
+}
+
+func (s *FloatLitContext) GetLit() antlr.Token { return s.lit }
+
+func (s *FloatLitContext) SetLit(v antlr.Token) { s.lit = v }
+
+func (s *FloatLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FloatLitContext) FLOAT_LIT() antlr.TerminalNode {
+	return s.GetToken(FGParserFLOAT_LIT, 0)
+}
+
+func (s *FloatLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterFloatLit(s)
 	}
+}
 
-	return tst
+func (s *FloatLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitFloatLit(s)
+	}
 }
 
-func (s *ExprsContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+type IntLitContext struct {
+	*PrimLitContext
+	lit antlr.Token
+}
 
-	if t == nil {
-		return nil
+func NewIntLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IntLitContext {
+	var p = new(IntLitContext)
+
+	p.PrimLitContext = NewEmptyPrimLitContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*PrimLitContext))
+
+	return p
+}
+
+func (s *IntLitContext) GetLit() antlr.Token { return s.lit }
+
+func (s *IntLitContext) SetLit(v antlr.Token) { s.lit = v }
+
+func (s *IntLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IntLitContext) INT_LIT() antlr.TerminalNode {
+	return s.GetToken(FGParserINT_LIT, 0)
+}
+
+func (s *IntLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterIntLit(s)
 	}
+}
 
-	return t.(IExprContext)
+func (s *IntLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitIntLit(s)
+	}
 }
 
-func (s *ExprsContext) GetRuleContext() antlr.RuleContext {
+type BoolLitContext struct {
+	*PrimLitContext
+	lit antlr.Token
+}
+
+func NewBoolLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BoolLitContext {
+	var p = new(BoolLitContext)
+
+	p.PrimLitContext = NewEmptyPrimLitContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*PrimLitContext))
+
+	return p
+}
+
+func (s *BoolLitContext) GetLit() antlr.Token { return s.lit }
+
+func (s *BoolLitContext) SetLit(v antlr.Token) { s.lit = v }
+
+func (s *BoolLitContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExprsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *BoolLitContext) TRUE() antlr.TerminalNode {
+	return s.GetToken(FGParserTRUE, 0)
 }
 
-func (s *ExprsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BoolLitContext) FALSE() antlr.TerminalNode {
+	return s.GetToken(FGParserFALSE, 0)
+}
+
+func (s *BoolLitContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.EnterExprs(s)
+		listenerT.EnterBoolLit(s)
 	}
 }
 
-func (s *ExprsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BoolLitContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGListener); ok {
-		listenerT.ExitExprs(s)
+		listenerT.ExitBoolLit(s)
 	}
 }
 
-func (p *FGParser) Exprs() (localctx IExprsContext) {
-	localctx = NewExprsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, FGParserRULE_exprs)
+type StringLitContext struct {
+	*PrimLitContext
+	lit antlr.Token
+}
+
+func NewStringLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StringLitContext {
+	var p = new(StringLitContext)
+
+	p.PrimLitContext = NewEmptyPrimLitContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*PrimLitContext))
+
+	return p
+}
+
+func (s *StringLitContext) GetLit() antlr.Token { return s.lit }
+
+func (s *StringLitContext) SetLit(v antlr.Token) { s.lit = v }
+
+func (s *StringLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StringLitContext) STRING_LIT() antlr.TerminalNode {
+	return s.GetToken(FGParserSTRING_LIT, 0)
+}
+
+func (s *StringLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.EnterStringLit(s)
+	}
+}
+
+func (s *StringLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGListener); ok {
+		listenerT.ExitStringLit(s)
+	}
+}
+
+func (p *FGParser) PrimLit() (localctx IPrimLitContext) {
+	this := p
+	_ = this
+
+	localctx = NewPrimLitContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, FGParserRULE_primLit)
 	var _la int
 
 	defer func() {
@@ -2782,28 +7126,67 @@ func (p *FGParser) Exprs() (localctx IExprsContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(185)
-		p.expr(0)
-	}
-	p.SetState(190)
+	p.SetState(426)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == FGParserT__8 {
+	switch p.GetTokenStream().LA(1) {
+	case FGParserTRUE, FGParserFALSE:
+		localctx = NewBoolLitContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(186)
-			p.Match(FGParserT__8)
+			p.SetState(422)
+
+			var _lt = p.GetTokenStream().LT(1)
+
+			localctx.(*BoolLitContext).lit = _lt
+
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == FGParserTRUE || _la == FGParserFALSE) {
+				var _ri = p.GetErrorHandler().RecoverInline(p)
+
+				localctx.(*BoolLitContext).lit = _ri
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	case FGParserINT_LIT:
+		localctx = NewIntLitContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(423)
+
+			var _m = p.Match(FGParserINT_LIT)
+
+			localctx.(*IntLitContext).lit = _m
 		}
+
+	case FGParserFLOAT_LIT:
+		localctx = NewFloatLitContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(187)
-			p.expr(0)
+			p.SetState(424)
+
+			var _m = p.Match(FGParserFLOAT_LIT)
+
+			localctx.(*FloatLitContext).lit = _m
 		}
 
-		p.SetState(192)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	case FGParserSTRING_LIT:
+		localctx = NewStringLitContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(425)
+
+			var _m = p.Match(FGParserSTRING_LIT)
+
+			localctx.(*StringLitContext).lit = _m
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -2811,7 +7194,7 @@ func (p *FGParser) Exprs() (localctx IExprsContext) {
 
 func (p *FGParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 12:
+	case 21:
 		var t *ExprContext = nil
 		if localctx != nil {
 			t = localctx.(*ExprContext)
@@ -2824,15 +7207,39 @@ func (p *FGParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int)
 }
 
 func (p *FGParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	this := p
+	_ = this
+
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 4)
+		return p.Precpred(p.GetParserRuleContext(), 7)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 3)
+		return p.Precpred(p.GetParserRuleContext(), 6)
 
 	case 2:
-		return p.Precpred(p.GetParserRuleContext(), 2)
+		return p.Precpred(p.GetParserRuleContext(), 5)
+
+	case 3:
+		return p.Precpred(p.GetParserRuleContext(), 4)
+
+	case 4:
+		return p.Precpred(p.GetParserRuleContext(), 3)
+
+	case 5:
+		return p.Precpred(p.GetParserRuleContext(), 20)
+
+	case 6:
+		return p.Precpred(p.GetParserRuleContext(), 19)
+
+	case 7:
+		return p.Precpred(p.GetParserRuleContext(), 18)
+
+	case 8:
+		return p.Precpred(p.GetParserRuleContext(), 17)
+
+	case 9:
+		return p.Precpred(p.GetParserRuleContext(), 16)
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
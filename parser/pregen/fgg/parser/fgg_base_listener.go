@@ -1,4 +1,4 @@
-// Code generated from parser/FGG.g4 by ANTLR 4.7.2. DO NOT EDIT.
+// Code generated from parser/FGG.g4 by ANTLR 4.10.1. DO NOT EDIT.
 
 package parser // FGG
 
@@ -21,17 +21,47 @@ func (s *BaseFGGListener) EnterEveryRule(ctx antlr.ParserRuleContext) {}
 // ExitEveryRule is called when any rule is exited.
 func (s *BaseFGGListener) ExitEveryRule(ctx antlr.ParserRuleContext) {}
 
+// EnterTypeName is called when production TypeName is entered.
+func (s *BaseFGGListener) EnterTypeName(ctx *TypeNameContext) {}
+
+// ExitTypeName is called when production TypeName is exited.
+func (s *BaseFGGListener) ExitTypeName(ctx *TypeNameContext) {}
+
 // EnterTypeParam is called when production TypeParam is entered.
 func (s *BaseFGGListener) EnterTypeParam(ctx *TypeParamContext) {}
 
 // ExitTypeParam is called when production TypeParam is exited.
 func (s *BaseFGGListener) ExitTypeParam(ctx *TypeParamContext) {}
 
-// EnterTypeName is called when production TypeName is entered.
-func (s *BaseFGGListener) EnterTypeName(ctx *TypeNameContext) {}
+// EnterTPrimitive is called when production TPrimitive is entered.
+func (s *BaseFGGListener) EnterTPrimitive(ctx *TPrimitiveContext) {}
 
-// ExitTypeName is called when production TypeName is exited.
-func (s *BaseFGGListener) ExitTypeName(ctx *TypeNameContext) {}
+// ExitTPrimitive is called when production TPrimitive is exited.
+func (s *BaseFGGListener) ExitTPrimitive(ctx *TPrimitiveContext) {}
+
+// EnterTypeLit_ is called when production TypeLit_ is entered.
+func (s *BaseFGGListener) EnterTypeLit_(ctx *TypeLit_Context) {}
+
+// ExitTypeLit_ is called when production TypeLit_ is exited.
+func (s *BaseFGGListener) ExitTypeLit_(ctx *TypeLit_Context) {}
+
+// EnterTFunc is called when production TFunc is entered.
+func (s *BaseFGGListener) EnterTFunc(ctx *TFuncContext) {}
+
+// ExitTFunc is called when production TFunc is exited.
+func (s *BaseFGGListener) ExitTFunc(ctx *TFuncContext) {}
+
+// EnterTSlice is called when production TSlice is entered.
+func (s *BaseFGGListener) EnterTSlice(ctx *TSliceContext) {}
+
+// ExitTSlice is called when production TSlice is exited.
+func (s *BaseFGGListener) ExitTSlice(ctx *TSliceContext) {}
+
+// EnterTMap is called when production TMap is entered.
+func (s *BaseFGGListener) EnterTMap(ctx *TMapContext) {}
+
+// ExitTMap is called when production TMap is exited.
+func (s *BaseFGGListener) ExitTMap(ctx *TMapContext) {}
 
 // EnterTyps is called when production typs is entered.
 func (s *BaseFGGListener) EnterTyps(ctx *TypsContext) {}
@@ -39,6 +69,24 @@ func (s *BaseFGGListener) EnterTyps(ctx *TypsContext) {}
 // ExitTyps is called when production typs is exited.
 func (s *BaseFGGListener) ExitTyps(ctx *TypsContext) {}
 
+// EnterPrimName is called when production primName is entered.
+func (s *BaseFGGListener) EnterPrimName(ctx *PrimNameContext) {}
+
+// ExitPrimName is called when production primName is exited.
+func (s *BaseFGGListener) ExitPrimName(ctx *PrimNameContext) {}
+
+// EnterStructTypeLit is called when production StructTypeLit is entered.
+func (s *BaseFGGListener) EnterStructTypeLit(ctx *StructTypeLitContext) {}
+
+// ExitStructTypeLit is called when production StructTypeLit is exited.
+func (s *BaseFGGListener) ExitStructTypeLit(ctx *StructTypeLitContext) {}
+
+// EnterInterfaceTypeLit is called when production InterfaceTypeLit is entered.
+func (s *BaseFGGListener) EnterInterfaceTypeLit(ctx *InterfaceTypeLitContext) {}
+
+// ExitInterfaceTypeLit is called when production InterfaceTypeLit is exited.
+func (s *BaseFGGListener) ExitInterfaceTypeLit(ctx *InterfaceTypeLitContext) {}
+
 // EnterTypeFormals is called when production typeFormals is entered.
 func (s *BaseFGGListener) EnterTypeFormals(ctx *TypeFormalsContext) {}
 
@@ -81,17 +129,41 @@ func (s *BaseFGGListener) EnterMethDecl(ctx *MethDeclContext) {}
 // ExitMethDecl is called when production methDecl is exited.
 func (s *BaseFGGListener) ExitMethDecl(ctx *MethDeclContext) {}
 
-// EnterStructTypeLit is called when production StructTypeLit is entered.
-func (s *BaseFGGListener) EnterStructTypeLit(ctx *StructTypeLitContext) {}
+// EnterFuncDecl is called when production funcDecl is entered.
+func (s *BaseFGGListener) EnterFuncDecl(ctx *FuncDeclContext) {}
 
-// ExitStructTypeLit is called when production StructTypeLit is exited.
-func (s *BaseFGGListener) ExitStructTypeLit(ctx *StructTypeLitContext) {}
+// ExitFuncDecl is called when production funcDecl is exited.
+func (s *BaseFGGListener) ExitFuncDecl(ctx *FuncDeclContext) {}
 
-// EnterInterfaceTypeLit is called when production InterfaceTypeLit is entered.
-func (s *BaseFGGListener) EnterInterfaceTypeLit(ctx *InterfaceTypeLitContext) {}
+// EnterBody is called when production body is entered.
+func (s *BaseFGGListener) EnterBody(ctx *BodyContext) {}
 
-// ExitInterfaceTypeLit is called when production InterfaceTypeLit is exited.
-func (s *BaseFGGListener) ExitInterfaceTypeLit(ctx *InterfaceTypeLitContext) {}
+// ExitBody is called when production body is exited.
+func (s *BaseFGGListener) ExitBody(ctx *BodyContext) {}
+
+// EnterBinding is called when production binding is entered.
+func (s *BaseFGGListener) EnterBinding(ctx *BindingContext) {}
+
+// ExitBinding is called when production binding is exited.
+func (s *BaseFGGListener) ExitBinding(ctx *BindingContext) {}
+
+// EnterIfElse is called when production ifElse is entered.
+func (s *BaseFGGListener) EnterIfElse(ctx *IfElseContext) {}
+
+// ExitIfElse is called when production ifElse is exited.
+func (s *BaseFGGListener) ExitIfElse(ctx *IfElseContext) {}
+
+// EnterTypeSwitch is called when production typeSwitch is entered.
+func (s *BaseFGGListener) EnterTypeSwitch(ctx *TypeSwitchContext) {}
+
+// ExitTypeSwitch is called when production typeSwitch is exited.
+func (s *BaseFGGListener) ExitTypeSwitch(ctx *TypeSwitchContext) {}
+
+// EnterTypeCase is called when production typeCase is entered.
+func (s *BaseFGGListener) EnterTypeCase(ctx *TypeCaseContext) {}
+
+// ExitTypeCase is called when production typeCase is exited.
+func (s *BaseFGGListener) ExitTypeCase(ctx *TypeCaseContext) {}
 
 // EnterFieldDecls is called when production fieldDecls is entered.
 func (s *BaseFGGListener) EnterFieldDecls(ctx *FieldDeclsContext) {}
@@ -105,23 +177,35 @@ func (s *BaseFGGListener) EnterFieldDecl(ctx *FieldDeclContext) {}
 // ExitFieldDecl is called when production fieldDecl is exited.
 func (s *BaseFGGListener) ExitFieldDecl(ctx *FieldDeclContext) {}
 
+// EnterTypeList is called when production typeList is entered.
+func (s *BaseFGGListener) EnterTypeList(ctx *TypeListContext) {}
+
+// ExitTypeList is called when production typeList is exited.
+func (s *BaseFGGListener) ExitTypeList(ctx *TypeListContext) {}
+
 // EnterSpecs is called when production specs is entered.
 func (s *BaseFGGListener) EnterSpecs(ctx *SpecsContext) {}
 
 // ExitSpecs is called when production specs is exited.
 func (s *BaseFGGListener) ExitSpecs(ctx *SpecsContext) {}
 
-// EnterSigSpec is called when production SigSpec is entered.
-func (s *BaseFGGListener) EnterSigSpec(ctx *SigSpecContext) {}
+// EnterSpec is called when production spec is entered.
+func (s *BaseFGGListener) EnterSpec(ctx *SpecContext) {}
+
+// ExitSpec is called when production spec is exited.
+func (s *BaseFGGListener) ExitSpec(ctx *SpecContext) {}
 
-// ExitSigSpec is called when production SigSpec is exited.
-func (s *BaseFGGListener) ExitSigSpec(ctx *SigSpecContext) {}
+// EnterUnion is called when production union is entered.
+func (s *BaseFGGListener) EnterUnion(ctx *UnionContext) {}
 
-// EnterInterfaceSpec is called when production InterfaceSpec is entered.
-func (s *BaseFGGListener) EnterInterfaceSpec(ctx *InterfaceSpecContext) {}
+// ExitUnion is called when production union is exited.
+func (s *BaseFGGListener) ExitUnion(ctx *UnionContext) {}
 
-// ExitInterfaceSpec is called when production InterfaceSpec is exited.
-func (s *BaseFGGListener) ExitInterfaceSpec(ctx *InterfaceSpecContext) {}
+// EnterTerm is called when production term is entered.
+func (s *BaseFGGListener) EnterTerm(ctx *TermContext) {}
+
+// ExitTerm is called when production term is exited.
+func (s *BaseFGGListener) ExitTerm(ctx *TermContext) {}
 
 // EnterSig is called when production sig is entered.
 func (s *BaseFGGListener) EnterSig(ctx *SigContext) {}
@@ -141,24 +225,90 @@ func (s *BaseFGGListener) EnterParamDecl(ctx *ParamDeclContext) {}
 // ExitParamDecl is called when production paramDecl is exited.
 func (s *BaseFGGListener) ExitParamDecl(ctx *ParamDeclContext) {}
 
+// EnterPrimaryLit is called when production PrimaryLit is entered.
+func (s *BaseFGGListener) EnterPrimaryLit(ctx *PrimaryLitContext) {}
+
+// ExitPrimaryLit is called when production PrimaryLit is exited.
+func (s *BaseFGGListener) ExitPrimaryLit(ctx *PrimaryLitContext) {}
+
 // EnterCall is called when production Call is entered.
 func (s *BaseFGGListener) EnterCall(ctx *CallContext) {}
 
 // ExitCall is called when production Call is exited.
 func (s *BaseFGGListener) ExitCall(ctx *CallContext) {}
 
+// EnterConvert is called when production Convert is entered.
+func (s *BaseFGGListener) EnterConvert(ctx *ConvertContext) {}
+
+// ExitConvert is called when production Convert is exited.
+func (s *BaseFGGListener) ExitConvert(ctx *ConvertContext) {}
+
 // EnterVariable is called when production Variable is entered.
 func (s *BaseFGGListener) EnterVariable(ctx *VariableContext) {}
 
 // ExitVariable is called when production Variable is exited.
 func (s *BaseFGGListener) ExitVariable(ctx *VariableContext) {}
 
+// EnterUnaryOp is called when production UnaryOp is entered.
+func (s *BaseFGGListener) EnterUnaryOp(ctx *UnaryOpContext) {}
+
+// ExitUnaryOp is called when production UnaryOp is exited.
+func (s *BaseFGGListener) ExitUnaryOp(ctx *UnaryOpContext) {}
+
+// EnterFuncLit is called when production FuncLit is entered.
+func (s *BaseFGGListener) EnterFuncLit(ctx *FuncLitContext) {}
+
+// ExitFuncLit is called when production FuncLit is exited.
+func (s *BaseFGGListener) ExitFuncLit(ctx *FuncLitContext) {}
+
+// EnterApply is called when production Apply is entered.
+func (s *BaseFGGListener) EnterApply(ctx *ApplyContext) {}
+
+// ExitApply is called when production Apply is exited.
+func (s *BaseFGGListener) ExitApply(ctx *ApplyContext) {}
+
+// EnterIndex is called when production Index is entered.
+func (s *BaseFGGListener) EnterIndex(ctx *IndexContext) {}
+
+// ExitIndex is called when production Index is exited.
+func (s *BaseFGGListener) ExitIndex(ctx *IndexContext) {}
+
+// EnterMapLit is called when production MapLit is entered.
+func (s *BaseFGGListener) EnterMapLit(ctx *MapLitContext) {}
+
+// ExitMapLit is called when production MapLit is exited.
+func (s *BaseFGGListener) ExitMapLit(ctx *MapLitContext) {}
+
+// EnterStructLit is called when production StructLit is entered.
+func (s *BaseFGGListener) EnterStructLit(ctx *StructLitContext) {}
+
+// ExitStructLit is called when production StructLit is exited.
+func (s *BaseFGGListener) ExitStructLit(ctx *StructLitContext) {}
+
+// EnterFuncCall is called when production FuncCall is entered.
+func (s *BaseFGGListener) EnterFuncCall(ctx *FuncCallContext) {}
+
+// ExitFuncCall is called when production FuncCall is exited.
+func (s *BaseFGGListener) ExitFuncCall(ctx *FuncCallContext) {}
+
+// EnterAppend is called when production Append is entered.
+func (s *BaseFGGListener) EnterAppend(ctx *AppendContext) {}
+
+// ExitAppend is called when production Append is exited.
+func (s *BaseFGGListener) ExitAppend(ctx *AppendContext) {}
+
 // EnterAssert is called when production Assert is entered.
 func (s *BaseFGGListener) EnterAssert(ctx *AssertContext) {}
 
 // ExitAssert is called when production Assert is exited.
 func (s *BaseFGGListener) ExitAssert(ctx *AssertContext) {}
 
+// EnterLen is called when production Len is entered.
+func (s *BaseFGGListener) EnterLen(ctx *LenContext) {}
+
+// ExitLen is called when production Len is exited.
+func (s *BaseFGGListener) ExitLen(ctx *LenContext) {}
+
 // EnterSprintf is called when production Sprintf is entered.
 func (s *BaseFGGListener) EnterSprintf(ctx *SprintfContext) {}
 
@@ -171,14 +321,56 @@ func (s *BaseFGGListener) EnterSelect(ctx *SelectContext) {}
 // ExitSelect is called when production Select is exited.
 func (s *BaseFGGListener) ExitSelect(ctx *SelectContext) {}
 
-// EnterStructLit is called when production StructLit is entered.
-func (s *BaseFGGListener) EnterStructLit(ctx *StructLitContext) {}
+// EnterParen is called when production Paren is entered.
+func (s *BaseFGGListener) EnterParen(ctx *ParenContext) {}
 
-// ExitStructLit is called when production StructLit is exited.
-func (s *BaseFGGListener) ExitStructLit(ctx *StructLitContext) {}
+// ExitParen is called when production Paren is exited.
+func (s *BaseFGGListener) ExitParen(ctx *ParenContext) {}
+
+// EnterBinaryOp is called when production BinaryOp is entered.
+func (s *BaseFGGListener) EnterBinaryOp(ctx *BinaryOpContext) {}
+
+// ExitBinaryOp is called when production BinaryOp is exited.
+func (s *BaseFGGListener) ExitBinaryOp(ctx *BinaryOpContext) {}
 
 // EnterExprs is called when production exprs is entered.
 func (s *BaseFGGListener) EnterExprs(ctx *ExprsContext) {}
 
 // ExitExprs is called when production exprs is exited.
 func (s *BaseFGGListener) ExitExprs(ctx *ExprsContext) {}
+
+// EnterEntries is called when production entries is entered.
+func (s *BaseFGGListener) EnterEntries(ctx *EntriesContext) {}
+
+// ExitEntries is called when production entries is exited.
+func (s *BaseFGGListener) ExitEntries(ctx *EntriesContext) {}
+
+// EnterEntry is called when production entry is entered.
+func (s *BaseFGGListener) EnterEntry(ctx *EntryContext) {}
+
+// ExitEntry is called when production entry is exited.
+func (s *BaseFGGListener) ExitEntry(ctx *EntryContext) {}
+
+// EnterBoolLit is called when production BoolLit is entered.
+func (s *BaseFGGListener) EnterBoolLit(ctx *BoolLitContext) {}
+
+// ExitBoolLit is called when production BoolLit is exited.
+func (s *BaseFGGListener) ExitBoolLit(ctx *BoolLitContext) {}
+
+// EnterIntLit is called when production IntLit is entered.
+func (s *BaseFGGListener) EnterIntLit(ctx *IntLitContext) {}
+
+// ExitIntLit is called when production IntLit is exited.
+func (s *BaseFGGListener) ExitIntLit(ctx *IntLitContext) {}
+
+// EnterFloatLit is called when production FloatLit is entered.
+func (s *BaseFGGListener) EnterFloatLit(ctx *FloatLitContext) {}
+
+// ExitFloatLit is called when production FloatLit is exited.
+func (s *BaseFGGListener) ExitFloatLit(ctx *FloatLitContext) {}
+
+// EnterStringLit is called when production StringLit is entered.
+func (s *BaseFGGListener) EnterStringLit(ctx *StringLitContext) {}
+
+// ExitStringLit is called when production StringLit is exited.
+func (s *BaseFGGListener) ExitStringLit(ctx *StringLitContext) {}
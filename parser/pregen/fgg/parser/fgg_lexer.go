@@ -1,9 +1,10 @@
-// Code generated from parser/FGG.g4 by ANTLR 4.7.2. DO NOT EDIT.
+// Code generated from parser/FGG.g4 by ANTLR 4.10.1. DO NOT EDIT.
 
 package parser
 
 import (
 	"fmt"
+	"sync"
 	"unicode"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
@@ -11,136 +12,9 @@ import (
 
 // Suppress unused import error
 var _ = fmt.Printf
+var _ = sync.Once{}
 var _ = unicode.IsLetter
 
-var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 28, 216,
-	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
-	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
-	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
-	18, 9, 18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23,
-	9, 23, 4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9,
-	28, 4, 29, 9, 29, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5, 3, 6,
-	3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3,
-	10, 3, 10, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13,
-	3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3,
-	14, 3, 14, 3, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15,
-	3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3,
-	17, 3, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19,
-	3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 21, 3,
-	21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22,
-	3, 22, 3, 22, 3, 22, 3, 23, 5, 23, 158, 10, 23, 3, 24, 3, 24, 3, 25, 3,
-	25, 5, 25, 164, 10, 25, 3, 25, 3, 25, 3, 25, 7, 25, 169, 10, 25, 12, 25,
-	14, 25, 172, 11, 25, 3, 26, 6, 26, 175, 10, 26, 13, 26, 14, 26, 176, 3,
-	26, 3, 26, 3, 27, 3, 27, 3, 27, 3, 27, 7, 27, 185, 10, 27, 12, 27, 14,
-	27, 188, 11, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28,
-	3, 28, 7, 28, 199, 10, 28, 12, 28, 14, 28, 202, 11, 28, 3, 28, 3, 28, 3,
-	29, 3, 29, 3, 29, 3, 29, 7, 29, 210, 10, 29, 12, 29, 14, 29, 213, 11, 29,
-	3, 29, 3, 29, 3, 186, 2, 30, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15,
-	9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33,
-	18, 35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 2, 47, 2, 49, 24, 51, 25,
-	53, 26, 55, 27, 57, 28, 3, 2, 6, 5, 2, 67, 92, 99, 124, 947, 948, 5, 2,
-	11, 12, 15, 15, 34, 34, 4, 2, 12, 12, 15, 15, 8, 2, 34, 34, 37, 37, 39,
-	39, 42, 43, 45, 48, 97, 97, 2, 223, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2,
-	2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2,
-	2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2,
-	2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3,
-	2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37,
-	3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2,
-	49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2,
-	2, 57, 3, 2, 2, 2, 3, 59, 3, 2, 2, 2, 5, 61, 3, 2, 2, 2, 7, 63, 3, 2, 2,
-	2, 9, 65, 3, 2, 2, 2, 11, 67, 3, 2, 2, 2, 13, 69, 3, 2, 2, 2, 15, 71, 3,
-	2, 2, 2, 17, 73, 3, 2, 2, 2, 19, 75, 3, 2, 2, 2, 21, 81, 3, 2, 2, 2, 23,
-	83, 3, 2, 2, 2, 25, 88, 3, 2, 2, 2, 27, 98, 3, 2, 2, 2, 29, 103, 3, 2,
-	2, 2, 31, 111, 3, 2, 2, 2, 33, 118, 3, 2, 2, 2, 35, 125, 3, 2, 2, 2, 37,
-	130, 3, 2, 2, 2, 39, 137, 3, 2, 2, 2, 41, 141, 3, 2, 2, 2, 43, 148, 3,
-	2, 2, 2, 45, 157, 3, 2, 2, 2, 47, 159, 3, 2, 2, 2, 49, 163, 3, 2, 2, 2,
-	51, 174, 3, 2, 2, 2, 53, 180, 3, 2, 2, 2, 55, 194, 3, 2, 2, 2, 57, 205,
-	3, 2, 2, 2, 59, 60, 7, 42, 2, 2, 60, 4, 3, 2, 2, 2, 61, 62, 7, 43, 2, 2,
-	62, 6, 3, 2, 2, 2, 63, 64, 7, 46, 2, 2, 64, 8, 3, 2, 2, 2, 65, 66, 7, 61,
-	2, 2, 66, 10, 3, 2, 2, 2, 67, 68, 7, 125, 2, 2, 68, 12, 3, 2, 2, 2, 69,
-	70, 7, 97, 2, 2, 70, 14, 3, 2, 2, 2, 71, 72, 7, 63, 2, 2, 72, 16, 3, 2,
-	2, 2, 73, 74, 7, 48, 2, 2, 74, 18, 3, 2, 2, 2, 75, 76, 7, 36, 2, 2, 76,
-	77, 7, 39, 2, 2, 77, 78, 7, 37, 2, 2, 78, 79, 7, 120, 2, 2, 79, 80, 7,
-	36, 2, 2, 80, 20, 3, 2, 2, 2, 81, 82, 7, 127, 2, 2, 82, 22, 3, 2, 2, 2,
-	83, 84, 7, 104, 2, 2, 84, 85, 7, 119, 2, 2, 85, 86, 7, 112, 2, 2, 86, 87,
-	7, 101, 2, 2, 87, 24, 3, 2, 2, 2, 88, 89, 7, 107, 2, 2, 89, 90, 7, 112,
-	2, 2, 90, 91, 7, 118, 2, 2, 91, 92, 7, 103, 2, 2, 92, 93, 7, 116, 2, 2,
-	93, 94, 7, 104, 2, 2, 94, 95, 7, 99, 2, 2, 95, 96, 7, 101, 2, 2, 96, 97,
-	7, 103, 2, 2, 97, 26, 3, 2, 2, 2, 98, 99, 7, 111, 2, 2, 99, 100, 7, 99,
-	2, 2, 100, 101, 7, 107, 2, 2, 101, 102, 7, 112, 2, 2, 102, 28, 3, 2, 2,
-	2, 103, 104, 7, 114, 2, 2, 104, 105, 7, 99, 2, 2, 105, 106, 7, 101, 2,
-	2, 106, 107, 7, 109, 2, 2, 107, 108, 7, 99, 2, 2, 108, 109, 7, 105, 2,
-	2, 109, 110, 7, 103, 2, 2, 110, 30, 3, 2, 2, 2, 111, 112, 7, 116, 2, 2,
-	112, 113, 7, 103, 2, 2, 113, 114, 7, 118, 2, 2, 114, 115, 7, 119, 2, 2,
-	115, 116, 7, 116, 2, 2, 116, 117, 7, 112, 2, 2, 117, 32, 3, 2, 2, 2, 118,
-	119, 7, 117, 2, 2, 119, 120, 7, 118, 2, 2, 120, 121, 7, 116, 2, 2, 121,
-	122, 7, 119, 2, 2, 122, 123, 7, 101, 2, 2, 123, 124, 7, 118, 2, 2, 124,
-	34, 3, 2, 2, 2, 125, 126, 7, 118, 2, 2, 126, 127, 7, 123, 2, 2, 127, 128,
-	7, 114, 2, 2, 128, 129, 7, 103, 2, 2, 129, 36, 3, 2, 2, 2, 130, 131, 7,
-	107, 2, 2, 131, 132, 7, 111, 2, 2, 132, 133, 7, 114, 2, 2, 133, 134, 7,
-	113, 2, 2, 134, 135, 7, 116, 2, 2, 135, 136, 7, 118, 2, 2, 136, 38, 3,
-	2, 2, 2, 137, 138, 7, 104, 2, 2, 138, 139, 7, 111, 2, 2, 139, 140, 7, 118,
-	2, 2, 140, 40, 3, 2, 2, 2, 141, 142, 7, 82, 2, 2, 142, 143, 7, 116, 2,
-	2, 143, 144, 7, 107, 2, 2, 144, 145, 7, 112, 2, 2, 145, 146, 7, 118, 2,
-	2, 146, 147, 7, 104, 2, 2, 147, 42, 3, 2, 2, 2, 148, 149, 7, 85, 2, 2,
-	149, 150, 7, 114, 2, 2, 150, 151, 7, 116, 2, 2, 151, 152, 7, 107, 2, 2,
-	152, 153, 7, 112, 2, 2, 153, 154, 7, 118, 2, 2, 154, 155, 7, 104, 2, 2,
-	155, 44, 3, 2, 2, 2, 156, 158, 9, 2, 2, 2, 157, 156, 3, 2, 2, 2, 158, 46,
-	3, 2, 2, 2, 159, 160, 4, 50, 59, 2, 160, 48, 3, 2, 2, 2, 161, 164, 5, 45,
-	23, 2, 162, 164, 7, 97, 2, 2, 163, 161, 3, 2, 2, 2, 163, 162, 3, 2, 2,
-	2, 164, 170, 3, 2, 2, 2, 165, 169, 5, 45, 23, 2, 166, 169, 7, 97, 2, 2,
-	167, 169, 5, 47, 24, 2, 168, 165, 3, 2, 2, 2, 168, 166, 3, 2, 2, 2, 168,
-	167, 3, 2, 2, 2, 169, 172, 3, 2, 2, 2, 170, 168, 3, 2, 2, 2, 170, 171,
-	3, 2, 2, 2, 171, 50, 3, 2, 2, 2, 172, 170, 3, 2, 2, 2, 173, 175, 9, 3,
-	2, 2, 174, 173, 3, 2, 2, 2, 175, 176, 3, 2, 2, 2, 176, 174, 3, 2, 2, 2,
-	176, 177, 3, 2, 2, 2, 177, 178, 3, 2, 2, 2, 178, 179, 8, 26, 2, 2, 179,
-	52, 3, 2, 2, 2, 180, 181, 7, 49, 2, 2, 181, 182, 7, 44, 2, 2, 182, 186,
-	3, 2, 2, 2, 183, 185, 11, 2, 2, 2, 184, 183, 3, 2, 2, 2, 185, 188, 3, 2,
-	2, 2, 186, 187, 3, 2, 2, 2, 186, 184, 3, 2, 2, 2, 187, 189, 3, 2, 2, 2,
-	188, 186, 3, 2, 2, 2, 189, 190, 7, 44, 2, 2, 190, 191, 7, 49, 2, 2, 191,
-	192, 3, 2, 2, 2, 192, 193, 8, 27, 3, 2, 193, 54, 3, 2, 2, 2, 194, 195,
-	7, 49, 2, 2, 195, 196, 7, 49, 2, 2, 196, 200, 3, 2, 2, 2, 197, 199, 10,
-	4, 2, 2, 198, 197, 3, 2, 2, 2, 199, 202, 3, 2, 2, 2, 200, 198, 3, 2, 2,
-	2, 200, 201, 3, 2, 2, 2, 201, 203, 3, 2, 2, 2, 202, 200, 3, 2, 2, 2, 203,
-	204, 8, 28, 3, 2, 204, 56, 3, 2, 2, 2, 205, 211, 7, 36, 2, 2, 206, 210,
-	5, 45, 23, 2, 207, 210, 5, 47, 24, 2, 208, 210, 9, 5, 2, 2, 209, 206, 3,
-	2, 2, 2, 209, 207, 3, 2, 2, 2, 209, 208, 3, 2, 2, 2, 210, 213, 3, 2, 2,
-	2, 211, 209, 3, 2, 2, 2, 211, 212, 3, 2, 2, 2, 212, 214, 3, 2, 2, 2, 213,
-	211, 3, 2, 2, 2, 214, 215, 7, 36, 2, 2, 215, 58, 3, 2, 2, 2, 12, 2, 157,
-	163, 168, 170, 176, 186, 200, 209, 211, 4, 8, 2, 2, 2, 3, 2,
-}
-
-var lexerDeserializer = antlr.NewATNDeserializer(nil)
-var lexerAtn = lexerDeserializer.DeserializeFromUInt16(serializedLexerAtn)
-
-var lexerChannelNames = []string{
-	"DEFAULT_TOKEN_CHANNEL", "HIDDEN",
-}
-
-var lexerModeNames = []string{
-	"DEFAULT_MODE",
-}
-
-var lexerLiteralNames = []string{
-	"", "'('", "')'", "','", "';'", "'{'", "'_'", "'='", "'.'", "'\"%#v\"'",
-	"'}'", "'func'", "'interface'", "'main'", "'package'", "'return'", "'struct'",
-	"'type'", "'import'", "'fmt'", "'Printf'", "'Sprintf'",
-}
-
-var lexerSymbolicNames = []string{
-	"", "", "", "", "", "", "", "", "", "", "", "FUNC", "INTERFACE", "MAIN",
-	"PACKAGE", "RETURN", "STRUCT", "TYPE", "IMPORT", "FMT", "PRINTF", "SPRINTF",
-	"NAME", "WHITESPACE", "COMMENT", "LINE_COMMENT", "STRING",
-}
-
-var lexerRuleNames = []string{
-	"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "T__8",
-	"T__9", "FUNC", "INTERFACE", "MAIN", "PACKAGE", "RETURN", "STRUCT", "TYPE",
-	"IMPORT", "FMT", "PRINTF", "SPRINTF", "LETTER", "DIGIT", "NAME", "WHITESPACE",
-	"COMMENT", "LINE_COMMENT", "STRING",
-}
-
 type FGGLexer struct {
 	*antlr.BaseLexer
 	channelNames []string
@@ -148,26 +22,313 @@ type FGGLexer struct {
 	// TODO: EOF string
 }
 
-var lexerDecisionToDFA = make([]*antlr.DFA, len(lexerAtn.DecisionToState))
+var fgglexerLexerStaticData struct {
+	once                   sync.Once
+	serializedATN          []int32
+	channelNames           []string
+	modeNames              []string
+	literalNames           []string
+	symbolicNames          []string
+	ruleNames              []string
+	predictionContextCache *antlr.PredictionContextCache
+	atn                    *antlr.ATN
+	decisionToDFA          []*antlr.DFA
+}
 
-func init() {
-	for index, ds := range lexerAtn.DecisionToState {
-		lexerDecisionToDFA[index] = antlr.NewDFA(ds, index)
+func fgglexerLexerInit() {
+	staticData := &fgglexerLexerStaticData
+	staticData.channelNames = []string{
+		"DEFAULT_TOKEN_CHANNEL", "HIDDEN",
+	}
+	staticData.modeNames = []string{
+		"DEFAULT_MODE",
+	}
+	staticData.literalNames = []string{
+		"", "'('", "')'", "'['", "']'", "','", "'{'", "'}'", "';'", "'_'", "'='",
+		"'.'", "'\"%#v\"'", "':='", "':'", "'case'", "'default'", "'else'",
+		"'func'", "'if'", "'interface'", "'main'", "'map'", "'package'", "'return'",
+		"'struct'", "'switch'", "'type'", "'var'", "'import'", "'fmt'", "'Printf'",
+		"'Sprintf'", "'len'", "'append'", "'panic'", "'true'", "'false'", "'bool'",
+		"'int32'", "'int64'", "'float32'", "'float64'", "'string'", "'+'", "'-'",
+		"'*'", "'/'", "'%'", "'&'", "'|'", "'^'", "'<<'", "'>>'", "'!'", "'&&'",
+		"'||'", "'=='", "'!='", "'>'", "'<'", "'>='", "'<='", "'~'",
+	}
+	staticData.symbolicNames = []string{
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "CASE",
+		"DEFAULT", "ELSE", "FUNC", "IF", "INTERFACE", "MAIN", "MAP", "PACKAGE",
+		"RETURN", "STRUCT", "SWITCH", "TYPE", "VAR", "IMPORT", "FMT", "PRINTF",
+		"SPRINTF", "LEN", "APPEND", "PANIC", "TRUE", "FALSE", "BOOL", "INT32",
+		"INT64", "FLOAT32", "FLOAT64", "STRING", "PLUS", "MINUS", "TIMES", "DIV",
+		"MOD", "BITAND", "BITOR", "BITXOR", "SHL", "SHR", "NOT", "AND", "OR",
+		"EQ", "NE", "GT", "LT", "GE", "LE", "TILDE", "NAME", "WHITESPACE", "COMMENT",
+		"LINE_COMMENT", "STRING_LIT", "INT_LIT", "FLOAT_LIT",
+	}
+	staticData.ruleNames = []string{
+		"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "T__8",
+		"T__9", "T__10", "T__11", "T__12", "T__13", "CASE", "DEFAULT", "ELSE",
+		"FUNC", "IF", "INTERFACE", "MAIN", "MAP", "PACKAGE", "RETURN", "STRUCT",
+		"SWITCH", "TYPE", "VAR", "IMPORT", "FMT", "PRINTF", "SPRINTF", "LEN",
+		"APPEND", "PANIC", "TRUE", "FALSE", "BOOL", "INT32", "INT64", "FLOAT32",
+		"FLOAT64", "STRING", "PLUS", "MINUS", "TIMES", "DIV", "MOD", "BITAND",
+		"BITOR", "BITXOR", "SHL", "SHR", "NOT", "AND", "OR", "EQ", "NE", "GT",
+		"LT", "GE", "LE", "TILDE", "LETTER", "DIGIT", "NAME", "WHITESPACE",
+		"COMMENT", "LINE_COMMENT", "STRING_LIT", "DIGITS", "EXPON", "INT_LIT",
+		"FLOAT_LIT",
+	}
+	staticData.predictionContextCache = antlr.NewPredictionContextCache()
+	staticData.serializedATN = []int32{
+		4, 0, 70, 501, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
+		4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2,
+		10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15,
+		7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7,
+		20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25,
+		2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2,
+		31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36,
+		7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7,
+		41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46,
+		2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2,
+		52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57,
+		7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7,
+		62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67,
+		2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2,
+		73, 7, 73, 1, 0, 1, 0, 1, 1, 1, 1, 1, 2, 1, 2, 1, 3, 1, 3, 1, 4, 1, 4,
+		1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 8, 1, 8, 1, 9, 1, 9, 1, 10, 1, 10,
+		1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 13, 1,
+		13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15,
+		1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1,
+		17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19,
+		1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1,
+		21, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22,
+		1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1,
+		24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25,
+		1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1,
+		28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 29,
+		1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1,
+		31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33,
+		1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1,
+		34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36,
+		1, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1,
+		38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40,
+		1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1,
+		41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 43,
+		1, 43, 1, 44, 1, 44, 1, 45, 1, 45, 1, 46, 1, 46, 1, 47, 1, 47, 1, 48, 1,
+		48, 1, 49, 1, 49, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52,
+		1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 56, 1, 56, 1,
+		56, 1, 57, 1, 57, 1, 57, 1, 58, 1, 58, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60,
+		1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 63, 3, 63, 409, 8, 63, 1, 64, 1,
+		64, 1, 65, 1, 65, 3, 65, 415, 8, 65, 1, 65, 1, 65, 1, 65, 5, 65, 420, 8,
+		65, 10, 65, 12, 65, 423, 9, 65, 1, 66, 4, 66, 426, 8, 66, 11, 66, 12, 66,
+		427, 1, 66, 1, 66, 1, 67, 1, 67, 1, 67, 1, 67, 5, 67, 436, 8, 67, 10, 67,
+		12, 67, 439, 9, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 68, 1, 68, 1,
+		68, 1, 68, 5, 68, 450, 8, 68, 10, 68, 12, 68, 453, 9, 68, 1, 68, 1, 68,
+		1, 69, 1, 69, 1, 69, 1, 69, 5, 69, 461, 8, 69, 10, 69, 12, 69, 464, 9,
+		69, 1, 69, 1, 69, 1, 70, 4, 70, 469, 8, 70, 11, 70, 12, 70, 470, 1, 71,
+		1, 71, 3, 71, 475, 8, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1, 73, 1, 73, 1,
+		73, 5, 73, 484, 8, 73, 10, 73, 12, 73, 487, 9, 73, 1, 73, 3, 73, 490, 8,
+		73, 1, 73, 3, 73, 493, 8, 73, 1, 73, 1, 73, 1, 73, 3, 73, 498, 8, 73, 3,
+		73, 500, 8, 73, 1, 437, 0, 74, 1, 1, 3, 2, 5, 3, 7, 4, 9, 5, 11, 6, 13,
+		7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25, 13, 27, 14, 29, 15, 31, 16,
+		33, 17, 35, 18, 37, 19, 39, 20, 41, 21, 43, 22, 45, 23, 47, 24, 49, 25,
+		51, 26, 53, 27, 55, 28, 57, 29, 59, 30, 61, 31, 63, 32, 65, 33, 67, 34,
+		69, 35, 71, 36, 73, 37, 75, 38, 77, 39, 79, 40, 81, 41, 83, 42, 85, 43,
+		87, 44, 89, 45, 91, 46, 93, 47, 95, 48, 97, 49, 99, 50, 101, 51, 103, 52,
+		105, 53, 107, 54, 109, 55, 111, 56, 113, 57, 115, 58, 117, 59, 119, 60,
+		121, 61, 123, 62, 125, 63, 127, 0, 129, 0, 131, 64, 133, 65, 135, 66, 137,
+		67, 139, 68, 141, 0, 143, 0, 145, 69, 147, 70, 1, 0, 6, 3, 0, 65, 90, 97,
+		122, 945, 946, 3, 0, 9, 10, 13, 13, 32, 32, 2, 0, 10, 10, 13, 13, 6, 0,
+		32, 32, 35, 35, 37, 37, 40, 41, 43, 46, 95, 95, 2, 0, 69, 69, 101, 101,
+		2, 0, 43, 43, 45, 45, 513, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1,
+		0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0, 13,
+		1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0,
+		21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0,
+		0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0,
+		0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0,
+		0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1,
+		0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59,
+		1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0,
+		67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0,
+		0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0,
+		0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0,
+		0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1,
+		0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0,
+		105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1, 0,
+		0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119,
+		1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1, 0, 0, 0,
+		0, 131, 1, 0, 0, 0, 0, 133, 1, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1,
+		0, 0, 0, 0, 139, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0, 147, 1, 0, 0, 0, 1,
+		149, 1, 0, 0, 0, 3, 151, 1, 0, 0, 0, 5, 153, 1, 0, 0, 0, 7, 155, 1, 0,
+		0, 0, 9, 157, 1, 0, 0, 0, 11, 159, 1, 0, 0, 0, 13, 161, 1, 0, 0, 0, 15,
+		163, 1, 0, 0, 0, 17, 165, 1, 0, 0, 0, 19, 167, 1, 0, 0, 0, 21, 169, 1,
+		0, 0, 0, 23, 171, 1, 0, 0, 0, 25, 177, 1, 0, 0, 0, 27, 180, 1, 0, 0, 0,
+		29, 182, 1, 0, 0, 0, 31, 187, 1, 0, 0, 0, 33, 195, 1, 0, 0, 0, 35, 200,
+		1, 0, 0, 0, 37, 205, 1, 0, 0, 0, 39, 208, 1, 0, 0, 0, 41, 218, 1, 0, 0,
+		0, 43, 223, 1, 0, 0, 0, 45, 227, 1, 0, 0, 0, 47, 235, 1, 0, 0, 0, 49, 242,
+		1, 0, 0, 0, 51, 249, 1, 0, 0, 0, 53, 256, 1, 0, 0, 0, 55, 261, 1, 0, 0,
+		0, 57, 265, 1, 0, 0, 0, 59, 272, 1, 0, 0, 0, 61, 276, 1, 0, 0, 0, 63, 283,
+		1, 0, 0, 0, 65, 291, 1, 0, 0, 0, 67, 295, 1, 0, 0, 0, 69, 302, 1, 0, 0,
+		0, 71, 308, 1, 0, 0, 0, 73, 313, 1, 0, 0, 0, 75, 319, 1, 0, 0, 0, 77, 324,
+		1, 0, 0, 0, 79, 330, 1, 0, 0, 0, 81, 336, 1, 0, 0, 0, 83, 344, 1, 0, 0,
+		0, 85, 352, 1, 0, 0, 0, 87, 359, 1, 0, 0, 0, 89, 361, 1, 0, 0, 0, 91, 363,
+		1, 0, 0, 0, 93, 365, 1, 0, 0, 0, 95, 367, 1, 0, 0, 0, 97, 369, 1, 0, 0,
+		0, 99, 371, 1, 0, 0, 0, 101, 373, 1, 0, 0, 0, 103, 375, 1, 0, 0, 0, 105,
+		378, 1, 0, 0, 0, 107, 381, 1, 0, 0, 0, 109, 383, 1, 0, 0, 0, 111, 386,
+		1, 0, 0, 0, 113, 389, 1, 0, 0, 0, 115, 392, 1, 0, 0, 0, 117, 395, 1, 0,
+		0, 0, 119, 397, 1, 0, 0, 0, 121, 399, 1, 0, 0, 0, 123, 402, 1, 0, 0, 0,
+		125, 405, 1, 0, 0, 0, 127, 408, 1, 0, 0, 0, 129, 410, 1, 0, 0, 0, 131,
+		414, 1, 0, 0, 0, 133, 425, 1, 0, 0, 0, 135, 431, 1, 0, 0, 0, 137, 445,
+		1, 0, 0, 0, 139, 456, 1, 0, 0, 0, 141, 468, 1, 0, 0, 0, 143, 472, 1, 0,
+		0, 0, 145, 478, 1, 0, 0, 0, 147, 499, 1, 0, 0, 0, 149, 150, 5, 40, 0, 0,
+		150, 2, 1, 0, 0, 0, 151, 152, 5, 41, 0, 0, 152, 4, 1, 0, 0, 0, 153, 154,
+		5, 91, 0, 0, 154, 6, 1, 0, 0, 0, 155, 156, 5, 93, 0, 0, 156, 8, 1, 0, 0,
+		0, 157, 158, 5, 44, 0, 0, 158, 10, 1, 0, 0, 0, 159, 160, 5, 123, 0, 0,
+		160, 12, 1, 0, 0, 0, 161, 162, 5, 125, 0, 0, 162, 14, 1, 0, 0, 0, 163,
+		164, 5, 59, 0, 0, 164, 16, 1, 0, 0, 0, 165, 166, 5, 95, 0, 0, 166, 18,
+		1, 0, 0, 0, 167, 168, 5, 61, 0, 0, 168, 20, 1, 0, 0, 0, 169, 170, 5, 46,
+		0, 0, 170, 22, 1, 0, 0, 0, 171, 172, 5, 34, 0, 0, 172, 173, 5, 37, 0, 0,
+		173, 174, 5, 35, 0, 0, 174, 175, 5, 118, 0, 0, 175, 176, 5, 34, 0, 0, 176,
+		24, 1, 0, 0, 0, 177, 178, 5, 58, 0, 0, 178, 179, 5, 61, 0, 0, 179, 26,
+		1, 0, 0, 0, 180, 181, 5, 58, 0, 0, 181, 28, 1, 0, 0, 0, 182, 183, 5, 99,
+		0, 0, 183, 184, 5, 97, 0, 0, 184, 185, 5, 115, 0, 0, 185, 186, 5, 101,
+		0, 0, 186, 30, 1, 0, 0, 0, 187, 188, 5, 100, 0, 0, 188, 189, 5, 101, 0,
+		0, 189, 190, 5, 102, 0, 0, 190, 191, 5, 97, 0, 0, 191, 192, 5, 117, 0,
+		0, 192, 193, 5, 108, 0, 0, 193, 194, 5, 116, 0, 0, 194, 32, 1, 0, 0, 0,
+		195, 196, 5, 101, 0, 0, 196, 197, 5, 108, 0, 0, 197, 198, 5, 115, 0, 0,
+		198, 199, 5, 101, 0, 0, 199, 34, 1, 0, 0, 0, 200, 201, 5, 102, 0, 0, 201,
+		202, 5, 117, 0, 0, 202, 203, 5, 110, 0, 0, 203, 204, 5, 99, 0, 0, 204,
+		36, 1, 0, 0, 0, 205, 206, 5, 105, 0, 0, 206, 207, 5, 102, 0, 0, 207, 38,
+		1, 0, 0, 0, 208, 209, 5, 105, 0, 0, 209, 210, 5, 110, 0, 0, 210, 211, 5,
+		116, 0, 0, 211, 212, 5, 101, 0, 0, 212, 213, 5, 114, 0, 0, 213, 214, 5,
+		102, 0, 0, 214, 215, 5, 97, 0, 0, 215, 216, 5, 99, 0, 0, 216, 217, 5, 101,
+		0, 0, 217, 40, 1, 0, 0, 0, 218, 219, 5, 109, 0, 0, 219, 220, 5, 97, 0,
+		0, 220, 221, 5, 105, 0, 0, 221, 222, 5, 110, 0, 0, 222, 42, 1, 0, 0, 0,
+		223, 224, 5, 109, 0, 0, 224, 225, 5, 97, 0, 0, 225, 226, 5, 112, 0, 0,
+		226, 44, 1, 0, 0, 0, 227, 228, 5, 112, 0, 0, 228, 229, 5, 97, 0, 0, 229,
+		230, 5, 99, 0, 0, 230, 231, 5, 107, 0, 0, 231, 232, 5, 97, 0, 0, 232, 233,
+		5, 103, 0, 0, 233, 234, 5, 101, 0, 0, 234, 46, 1, 0, 0, 0, 235, 236, 5,
+		114, 0, 0, 236, 237, 5, 101, 0, 0, 237, 238, 5, 116, 0, 0, 238, 239, 5,
+		117, 0, 0, 239, 240, 5, 114, 0, 0, 240, 241, 5, 110, 0, 0, 241, 48, 1,
+		0, 0, 0, 242, 243, 5, 115, 0, 0, 243, 244, 5, 116, 0, 0, 244, 245, 5, 114,
+		0, 0, 245, 246, 5, 117, 0, 0, 246, 247, 5, 99, 0, 0, 247, 248, 5, 116,
+		0, 0, 248, 50, 1, 0, 0, 0, 249, 250, 5, 115, 0, 0, 250, 251, 5, 119, 0,
+		0, 251, 252, 5, 105, 0, 0, 252, 253, 5, 116, 0, 0, 253, 254, 5, 99, 0,
+		0, 254, 255, 5, 104, 0, 0, 255, 52, 1, 0, 0, 0, 256, 257, 5, 116, 0, 0,
+		257, 258, 5, 121, 0, 0, 258, 259, 5, 112, 0, 0, 259, 260, 5, 101, 0, 0,
+		260, 54, 1, 0, 0, 0, 261, 262, 5, 118, 0, 0, 262, 263, 5, 97, 0, 0, 263,
+		264, 5, 114, 0, 0, 264, 56, 1, 0, 0, 0, 265, 266, 5, 105, 0, 0, 266, 267,
+		5, 109, 0, 0, 267, 268, 5, 112, 0, 0, 268, 269, 5, 111, 0, 0, 269, 270,
+		5, 114, 0, 0, 270, 271, 5, 116, 0, 0, 271, 58, 1, 0, 0, 0, 272, 273, 5,
+		102, 0, 0, 273, 274, 5, 109, 0, 0, 274, 275, 5, 116, 0, 0, 275, 60, 1,
+		0, 0, 0, 276, 277, 5, 80, 0, 0, 277, 278, 5, 114, 0, 0, 278, 279, 5, 105,
+		0, 0, 279, 280, 5, 110, 0, 0, 280, 281, 5, 116, 0, 0, 281, 282, 5, 102,
+		0, 0, 282, 62, 1, 0, 0, 0, 283, 284, 5, 83, 0, 0, 284, 285, 5, 112, 0,
+		0, 285, 286, 5, 114, 0, 0, 286, 287, 5, 105, 0, 0, 287, 288, 5, 110, 0,
+		0, 288, 289, 5, 116, 0, 0, 289, 290, 5, 102, 0, 0, 290, 64, 1, 0, 0, 0,
+		291, 292, 5, 108, 0, 0, 292, 293, 5, 101, 0, 0, 293, 294, 5, 110, 0, 0,
+		294, 66, 1, 0, 0, 0, 295, 296, 5, 97, 0, 0, 296, 297, 5, 112, 0, 0, 297,
+		298, 5, 112, 0, 0, 298, 299, 5, 101, 0, 0, 299, 300, 5, 110, 0, 0, 300,
+		301, 5, 100, 0, 0, 301, 68, 1, 0, 0, 0, 302, 303, 5, 112, 0, 0, 303, 304,
+		5, 97, 0, 0, 304, 305, 5, 110, 0, 0, 305, 306, 5, 105, 0, 0, 306, 307,
+		5, 99, 0, 0, 307, 70, 1, 0, 0, 0, 308, 309, 5, 116, 0, 0, 309, 310, 5,
+		114, 0, 0, 310, 311, 5, 117, 0, 0, 311, 312, 5, 101, 0, 0, 312, 72, 1,
+		0, 0, 0, 313, 314, 5, 102, 0, 0, 314, 315, 5, 97, 0, 0, 315, 316, 5, 108,
+		0, 0, 316, 317, 5, 115, 0, 0, 317, 318, 5, 101, 0, 0, 318, 74, 1, 0, 0,
+		0, 319, 320, 5, 98, 0, 0, 320, 321, 5, 111, 0, 0, 321, 322, 5, 111, 0,
+		0, 322, 323, 5, 108, 0, 0, 323, 76, 1, 0, 0, 0, 324, 325, 5, 105, 0, 0,
+		325, 326, 5, 110, 0, 0, 326, 327, 5, 116, 0, 0, 327, 328, 5, 51, 0, 0,
+		328, 329, 5, 50, 0, 0, 329, 78, 1, 0, 0, 0, 330, 331, 5, 105, 0, 0, 331,
+		332, 5, 110, 0, 0, 332, 333, 5, 116, 0, 0, 333, 334, 5, 54, 0, 0, 334,
+		335, 5, 52, 0, 0, 335, 80, 1, 0, 0, 0, 336, 337, 5, 102, 0, 0, 337, 338,
+		5, 108, 0, 0, 338, 339, 5, 111, 0, 0, 339, 340, 5, 97, 0, 0, 340, 341,
+		5, 116, 0, 0, 341, 342, 5, 51, 0, 0, 342, 343, 5, 50, 0, 0, 343, 82, 1,
+		0, 0, 0, 344, 345, 5, 102, 0, 0, 345, 346, 5, 108, 0, 0, 346, 347, 5, 111,
+		0, 0, 347, 348, 5, 97, 0, 0, 348, 349, 5, 116, 0, 0, 349, 350, 5, 54, 0,
+		0, 350, 351, 5, 52, 0, 0, 351, 84, 1, 0, 0, 0, 352, 353, 5, 115, 0, 0,
+		353, 354, 5, 116, 0, 0, 354, 355, 5, 114, 0, 0, 355, 356, 5, 105, 0, 0,
+		356, 357, 5, 110, 0, 0, 357, 358, 5, 103, 0, 0, 358, 86, 1, 0, 0, 0, 359,
+		360, 5, 43, 0, 0, 360, 88, 1, 0, 0, 0, 361, 362, 5, 45, 0, 0, 362, 90,
+		1, 0, 0, 0, 363, 364, 5, 42, 0, 0, 364, 92, 1, 0, 0, 0, 365, 366, 5, 47,
+		0, 0, 366, 94, 1, 0, 0, 0, 367, 368, 5, 37, 0, 0, 368, 96, 1, 0, 0, 0,
+		369, 370, 5, 38, 0, 0, 370, 98, 1, 0, 0, 0, 371, 372, 5, 124, 0, 0, 372,
+		100, 1, 0, 0, 0, 373, 374, 5, 94, 0, 0, 374, 102, 1, 0, 0, 0, 375, 376,
+		5, 60, 0, 0, 376, 377, 5, 60, 0, 0, 377, 104, 1, 0, 0, 0, 378, 379, 5,
+		62, 0, 0, 379, 380, 5, 62, 0, 0, 380, 106, 1, 0, 0, 0, 381, 382, 5, 33,
+		0, 0, 382, 108, 1, 0, 0, 0, 383, 384, 5, 38, 0, 0, 384, 385, 5, 38, 0,
+		0, 385, 110, 1, 0, 0, 0, 386, 387, 5, 124, 0, 0, 387, 388, 5, 124, 0, 0,
+		388, 112, 1, 0, 0, 0, 389, 390, 5, 61, 0, 0, 390, 391, 5, 61, 0, 0, 391,
+		114, 1, 0, 0, 0, 392, 393, 5, 33, 0, 0, 393, 394, 5, 61, 0, 0, 394, 116,
+		1, 0, 0, 0, 395, 396, 5, 62, 0, 0, 396, 118, 1, 0, 0, 0, 397, 398, 5, 60,
+		0, 0, 398, 120, 1, 0, 0, 0, 399, 400, 5, 62, 0, 0, 400, 401, 5, 61, 0,
+		0, 401, 122, 1, 0, 0, 0, 402, 403, 5, 60, 0, 0, 403, 404, 5, 61, 0, 0,
+		404, 124, 1, 0, 0, 0, 405, 406, 5, 126, 0, 0, 406, 126, 1, 0, 0, 0, 407,
+		409, 7, 0, 0, 0, 408, 407, 1, 0, 0, 0, 409, 128, 1, 0, 0, 0, 410, 411,
+		2, 48, 57, 0, 411, 130, 1, 0, 0, 0, 412, 415, 3, 127, 63, 0, 413, 415,
+		5, 95, 0, 0, 414, 412, 1, 0, 0, 0, 414, 413, 1, 0, 0, 0, 415, 421, 1, 0,
+		0, 0, 416, 420, 3, 127, 63, 0, 417, 420, 5, 95, 0, 0, 418, 420, 3, 129,
+		64, 0, 419, 416, 1, 0, 0, 0, 419, 417, 1, 0, 0, 0, 419, 418, 1, 0, 0, 0,
+		420, 423, 1, 0, 0, 0, 421, 419, 1, 0, 0, 0, 421, 422, 1, 0, 0, 0, 422,
+		132, 1, 0, 0, 0, 423, 421, 1, 0, 0, 0, 424, 426, 7, 1, 0, 0, 425, 424,
+		1, 0, 0, 0, 426, 427, 1, 0, 0, 0, 427, 425, 1, 0, 0, 0, 427, 428, 1, 0,
+		0, 0, 428, 429, 1, 0, 0, 0, 429, 430, 6, 66, 0, 0, 430, 134, 1, 0, 0, 0,
+		431, 432, 5, 47, 0, 0, 432, 433, 5, 42, 0, 0, 433, 437, 1, 0, 0, 0, 434,
+		436, 9, 0, 0, 0, 435, 434, 1, 0, 0, 0, 436, 439, 1, 0, 0, 0, 437, 438,
+		1, 0, 0, 0, 437, 435, 1, 0, 0, 0, 438, 440, 1, 0, 0, 0, 439, 437, 1, 0,
+		0, 0, 440, 441, 5, 42, 0, 0, 441, 442, 5, 47, 0, 0, 442, 443, 1, 0, 0,
+		0, 443, 444, 6, 67, 1, 0, 444, 136, 1, 0, 0, 0, 445, 446, 5, 47, 0, 0,
+		446, 447, 5, 47, 0, 0, 447, 451, 1, 0, 0, 0, 448, 450, 8, 2, 0, 0, 449,
+		448, 1, 0, 0, 0, 450, 453, 1, 0, 0, 0, 451, 449, 1, 0, 0, 0, 451, 452,
+		1, 0, 0, 0, 452, 454, 1, 0, 0, 0, 453, 451, 1, 0, 0, 0, 454, 455, 6, 68,
+		1, 0, 455, 138, 1, 0, 0, 0, 456, 462, 5, 34, 0, 0, 457, 461, 3, 127, 63,
+		0, 458, 461, 3, 129, 64, 0, 459, 461, 7, 3, 0, 0, 460, 457, 1, 0, 0, 0,
+		460, 458, 1, 0, 0, 0, 460, 459, 1, 0, 0, 0, 461, 464, 1, 0, 0, 0, 462,
+		460, 1, 0, 0, 0, 462, 463, 1, 0, 0, 0, 463, 465, 1, 0, 0, 0, 464, 462,
+		1, 0, 0, 0, 465, 466, 5, 34, 0, 0, 466, 140, 1, 0, 0, 0, 467, 469, 3, 129,
+		64, 0, 468, 467, 1, 0, 0, 0, 469, 470, 1, 0, 0, 0, 470, 468, 1, 0, 0, 0,
+		470, 471, 1, 0, 0, 0, 471, 142, 1, 0, 0, 0, 472, 474, 7, 4, 0, 0, 473,
+		475, 7, 5, 0, 0, 474, 473, 1, 0, 0, 0, 474, 475, 1, 0, 0, 0, 475, 476,
+		1, 0, 0, 0, 476, 477, 3, 141, 70, 0, 477, 144, 1, 0, 0, 0, 478, 479, 3,
+		141, 70, 0, 479, 146, 1, 0, 0, 0, 480, 492, 3, 141, 70, 0, 481, 485, 5,
+		46, 0, 0, 482, 484, 3, 129, 64, 0, 483, 482, 1, 0, 0, 0, 484, 487, 1, 0,
+		0, 0, 485, 483, 1, 0, 0, 0, 485, 486, 1, 0, 0, 0, 486, 489, 1, 0, 0, 0,
+		487, 485, 1, 0, 0, 0, 488, 490, 3, 143, 71, 0, 489, 488, 1, 0, 0, 0, 489,
+		490, 1, 0, 0, 0, 490, 493, 1, 0, 0, 0, 491, 493, 3, 143, 71, 0, 492, 481,
+		1, 0, 0, 0, 492, 491, 1, 0, 0, 0, 493, 500, 1, 0, 0, 0, 494, 495, 5, 46,
+		0, 0, 495, 497, 3, 141, 70, 0, 496, 498, 3, 143, 71, 0, 497, 496, 1, 0,
+		0, 0, 497, 498, 1, 0, 0, 0, 498, 500, 1, 0, 0, 0, 499, 480, 1, 0, 0, 0,
+		499, 494, 1, 0, 0, 0, 500, 148, 1, 0, 0, 0, 17, 0, 408, 414, 419, 421,
+		427, 437, 451, 460, 462, 470, 474, 485, 489, 492, 497, 499, 2, 6, 0, 0,
+		0, 1, 0,
+	}
+	deserializer := antlr.NewATNDeserializer(nil)
+	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
+	atn := staticData.atn
+	staticData.decisionToDFA = make([]*antlr.DFA, len(atn.DecisionToState))
+	decisionToDFA := staticData.decisionToDFA
+	for index, state := range atn.DecisionToState {
+		decisionToDFA[index] = antlr.NewDFA(state, index)
 	}
 }
 
-func NewFGGLexer(input antlr.CharStream) *FGGLexer {
+// FGGLexerInit initializes any static state used to implement FGGLexer. By default the
+// static state used to implement the lexer is lazily initialized during the first call to
+// NewFGGLexer(). You can call this function if you wish to initialize the static state ahead
+// of time.
+func FGGLexerInit() {
+	staticData := &fgglexerLexerStaticData
+	staticData.once.Do(fgglexerLexerInit)
+}
 
+// NewFGGLexer produces a new lexer instance for the optional input antlr.CharStream.
+func NewFGGLexer(input antlr.CharStream) *FGGLexer {
+	FGGLexerInit()
 	l := new(FGGLexer)
-
 	l.BaseLexer = antlr.NewBaseLexer(input)
-	l.Interpreter = antlr.NewLexerATNSimulator(l, lexerAtn, lexerDecisionToDFA, antlr.NewPredictionContextCache())
-
-	l.channelNames = lexerChannelNames
-	l.modeNames = lexerModeNames
-	l.RuleNames = lexerRuleNames
-	l.LiteralNames = lexerLiteralNames
-	l.SymbolicNames = lexerSymbolicNames
+	staticData := &fgglexerLexerStaticData
+	l.Interpreter = antlr.NewLexerATNSimulator(l, staticData.atn, staticData.decisionToDFA, staticData.predictionContextCache)
+	l.channelNames = staticData.channelNames
+	l.modeNames = staticData.modeNames
+	l.RuleNames = staticData.ruleNames
+	l.LiteralNames = staticData.literalNames
+	l.SymbolicNames = staticData.symbolicNames
 	l.GrammarFileName = "FGG.g4"
 	// TODO: l.EOF = antlr.TokenEOF
 
@@ -186,20 +347,64 @@ const (
 	FGGLexerT__7         = 8
 	FGGLexerT__8         = 9
 	FGGLexerT__9         = 10
-	FGGLexerFUNC         = 11
-	FGGLexerINTERFACE    = 12
-	FGGLexerMAIN         = 13
-	FGGLexerPACKAGE      = 14
-	FGGLexerRETURN       = 15
-	FGGLexerSTRUCT       = 16
-	FGGLexerTYPE         = 17
-	FGGLexerIMPORT       = 18
-	FGGLexerFMT          = 19
-	FGGLexerPRINTF       = 20
-	FGGLexerSPRINTF      = 21
-	FGGLexerNAME         = 22
-	FGGLexerWHITESPACE   = 23
-	FGGLexerCOMMENT      = 24
-	FGGLexerLINE_COMMENT = 25
-	FGGLexerSTRING       = 26
+	FGGLexerT__10        = 11
+	FGGLexerT__11        = 12
+	FGGLexerT__12        = 13
+	FGGLexerT__13        = 14
+	FGGLexerCASE         = 15
+	FGGLexerDEFAULT      = 16
+	FGGLexerELSE         = 17
+	FGGLexerFUNC         = 18
+	FGGLexerIF           = 19
+	FGGLexerINTERFACE    = 20
+	FGGLexerMAIN         = 21
+	FGGLexerMAP          = 22
+	FGGLexerPACKAGE      = 23
+	FGGLexerRETURN       = 24
+	FGGLexerSTRUCT       = 25
+	FGGLexerSWITCH       = 26
+	FGGLexerTYPE         = 27
+	FGGLexerVAR          = 28
+	FGGLexerIMPORT       = 29
+	FGGLexerFMT          = 30
+	FGGLexerPRINTF       = 31
+	FGGLexerSPRINTF      = 32
+	FGGLexerLEN          = 33
+	FGGLexerAPPEND       = 34
+	FGGLexerPANIC        = 35
+	FGGLexerTRUE         = 36
+	FGGLexerFALSE        = 37
+	FGGLexerBOOL         = 38
+	FGGLexerINT32        = 39
+	FGGLexerINT64        = 40
+	FGGLexerFLOAT32      = 41
+	FGGLexerFLOAT64      = 42
+	FGGLexerSTRING       = 43
+	FGGLexerPLUS         = 44
+	FGGLexerMINUS        = 45
+	FGGLexerTIMES        = 46
+	FGGLexerDIV          = 47
+	FGGLexerMOD          = 48
+	FGGLexerBITAND       = 49
+	FGGLexerBITOR        = 50
+	FGGLexerBITXOR       = 51
+	FGGLexerSHL          = 52
+	FGGLexerSHR          = 53
+	FGGLexerNOT          = 54
+	FGGLexerAND          = 55
+	FGGLexerOR           = 56
+	FGGLexerEQ           = 57
+	FGGLexerNE           = 58
+	FGGLexerGT           = 59
+	FGGLexerLT           = 60
+	FGGLexerGE           = 61
+	FGGLexerLE           = 62
+	FGGLexerTILDE        = 63
+	FGGLexerNAME         = 64
+	FGGLexerWHITESPACE   = 65
+	FGGLexerCOMMENT      = 66
+	FGGLexerLINE_COMMENT = 67
+	FGGLexerSTRING_LIT   = 68
+	FGGLexerINT_LIT      = 69
+	FGGLexerFLOAT_LIT    = 70
 )
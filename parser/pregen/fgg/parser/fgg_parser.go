@@ -1,170 +1,321 @@
-// Code generated from parser/FGG.g4 by ANTLR 4.7.2. DO NOT EDIT.
+// Code generated from parser/FGG.g4 by ANTLR 4.10.1. DO NOT EDIT.
 
 package parser // FGG
 
 import (
 	"fmt"
-	"reflect"
 	"strconv"
+	"sync"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 )
 
 // Suppress unused import errors
 var _ = fmt.Printf
-var _ = reflect.Copy
 var _ = strconv.Itoa
-
-var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 28, 252,
-	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
-	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
-	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
-	18, 4, 19, 9, 19, 4, 20, 9, 20, 3, 2, 3, 2, 3, 2, 3, 2, 5, 2, 45, 10, 2,
-	3, 2, 5, 2, 48, 10, 2, 3, 3, 3, 3, 3, 3, 7, 3, 53, 10, 3, 12, 3, 14, 3,
-	56, 11, 3, 3, 4, 3, 4, 3, 4, 5, 4, 61, 10, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3,
-	5, 7, 5, 68, 10, 5, 12, 5, 14, 5, 71, 11, 5, 3, 6, 3, 6, 3, 6, 3, 7, 3,
-	7, 3, 7, 3, 7, 3, 7, 3, 7, 5, 7, 82, 10, 7, 3, 7, 5, 7, 85, 10, 7, 3, 7,
-	3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7,
-	3, 7, 3, 7, 3, 7, 3, 7, 5, 7, 104, 10, 7, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8,
-	5, 8, 111, 10, 8, 3, 8, 3, 8, 6, 8, 115, 10, 8, 13, 8, 14, 8, 116, 3, 9,
-	3, 9, 3, 9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10,
-	3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 5, 11, 139, 10,
-	11, 3, 11, 3, 11, 3, 11, 3, 11, 5, 11, 145, 10, 11, 3, 11, 5, 11, 148,
-	10, 11, 3, 12, 3, 12, 3, 12, 7, 12, 153, 10, 12, 12, 12, 14, 12, 156, 11,
-	12, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 7, 14, 164, 10, 14, 12, 14,
-	14, 14, 167, 11, 14, 3, 15, 3, 15, 5, 15, 171, 10, 15, 3, 16, 3, 16, 3,
-	16, 3, 16, 5, 16, 177, 10, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17,
-	7, 17, 185, 10, 17, 12, 17, 14, 17, 188, 11, 17, 3, 18, 3, 18, 3, 18, 3,
-	19, 3, 19, 3, 19, 3, 19, 3, 19, 5, 19, 198, 10, 19, 3, 19, 3, 19, 3, 19,
-	3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 7, 19, 209, 10, 19, 12, 19, 14,
-	19, 212, 11, 19, 3, 19, 5, 19, 215, 10, 19, 3, 19, 3, 19, 3, 19, 3, 19,
-	3, 19, 3, 19, 3, 19, 3, 19, 5, 19, 225, 10, 19, 3, 19, 3, 19, 3, 19, 5,
-	19, 230, 10, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 7, 19,
-	239, 10, 19, 12, 19, 14, 19, 242, 11, 19, 3, 20, 3, 20, 3, 20, 7, 20, 247,
-	10, 20, 12, 20, 14, 20, 250, 11, 20, 3, 20, 2, 3, 36, 21, 2, 4, 6, 8, 10,
-	12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 2, 3, 4, 2, 11,
-	11, 28, 28, 2, 261, 2, 47, 3, 2, 2, 2, 4, 49, 3, 2, 2, 2, 6, 57, 3, 2,
-	2, 2, 8, 64, 3, 2, 2, 2, 10, 72, 3, 2, 2, 2, 12, 75, 3, 2, 2, 2, 14, 114,
-	3, 2, 2, 2, 16, 118, 3, 2, 2, 2, 18, 123, 3, 2, 2, 2, 20, 147, 3, 2, 2,
-	2, 22, 149, 3, 2, 2, 2, 24, 157, 3, 2, 2, 2, 26, 160, 3, 2, 2, 2, 28, 170,
-	3, 2, 2, 2, 30, 172, 3, 2, 2, 2, 32, 181, 3, 2, 2, 2, 34, 189, 3, 2, 2,
-	2, 36, 214, 3, 2, 2, 2, 38, 243, 3, 2, 2, 2, 40, 48, 7, 24, 2, 2, 41, 42,
-	7, 24, 2, 2, 42, 44, 7, 3, 2, 2, 43, 45, 5, 4, 3, 2, 44, 43, 3, 2, 2, 2,
-	44, 45, 3, 2, 2, 2, 45, 46, 3, 2, 2, 2, 46, 48, 7, 4, 2, 2, 47, 40, 3,
-	2, 2, 2, 47, 41, 3, 2, 2, 2, 48, 3, 3, 2, 2, 2, 49, 54, 5, 2, 2, 2, 50,
-	51, 7, 5, 2, 2, 51, 53, 5, 2, 2, 2, 52, 50, 3, 2, 2, 2, 53, 56, 3, 2, 2,
-	2, 54, 52, 3, 2, 2, 2, 54, 55, 3, 2, 2, 2, 55, 5, 3, 2, 2, 2, 56, 54, 3,
-	2, 2, 2, 57, 58, 7, 3, 2, 2, 58, 60, 7, 19, 2, 2, 59, 61, 5, 8, 5, 2, 60,
-	59, 3, 2, 2, 2, 60, 61, 3, 2, 2, 2, 61, 62, 3, 2, 2, 2, 62, 63, 7, 4, 2,
-	2, 63, 7, 3, 2, 2, 2, 64, 69, 5, 10, 6, 2, 65, 66, 7, 5, 2, 2, 66, 68,
-	5, 10, 6, 2, 67, 65, 3, 2, 2, 2, 68, 71, 3, 2, 2, 2, 69, 67, 3, 2, 2, 2,
-	69, 70, 3, 2, 2, 2, 70, 9, 3, 2, 2, 2, 71, 69, 3, 2, 2, 2, 72, 73, 7, 24,
-	2, 2, 73, 74, 5, 2, 2, 2, 74, 11, 3, 2, 2, 2, 75, 76, 7, 16, 2, 2, 76,
-	77, 7, 15, 2, 2, 77, 81, 7, 6, 2, 2, 78, 79, 7, 20, 2, 2, 79, 80, 7, 28,
-	2, 2, 80, 82, 7, 6, 2, 2, 81, 78, 3, 2, 2, 2, 81, 82, 3, 2, 2, 2, 82, 84,
-	3, 2, 2, 2, 83, 85, 5, 14, 8, 2, 84, 83, 3, 2, 2, 2, 84, 85, 3, 2, 2, 2,
-	85, 86, 3, 2, 2, 2, 86, 87, 7, 13, 2, 2, 87, 88, 7, 15, 2, 2, 88, 89, 7,
-	3, 2, 2, 89, 90, 7, 4, 2, 2, 90, 103, 7, 7, 2, 2, 91, 92, 7, 8, 2, 2, 92,
-	93, 7, 9, 2, 2, 93, 104, 5, 36, 19, 2, 94, 95, 7, 21, 2, 2, 95, 96, 7,
-	10, 2, 2, 96, 97, 7, 22, 2, 2, 97, 98, 7, 3, 2, 2, 98, 99, 7, 11, 2, 2,
-	99, 100, 7, 5, 2, 2, 100, 101, 5, 36, 19, 2, 101, 102, 7, 4, 2, 2, 102,
-	104, 3, 2, 2, 2, 103, 91, 3, 2, 2, 2, 103, 94, 3, 2, 2, 2, 104, 105, 3,
-	2, 2, 2, 105, 106, 7, 12, 2, 2, 106, 107, 7, 2, 2, 3, 107, 13, 3, 2, 2,
-	2, 108, 111, 5, 16, 9, 2, 109, 111, 5, 18, 10, 2, 110, 108, 3, 2, 2, 2,
-	110, 109, 3, 2, 2, 2, 111, 112, 3, 2, 2, 2, 112, 113, 7, 6, 2, 2, 113,
-	115, 3, 2, 2, 2, 114, 110, 3, 2, 2, 2, 115, 116, 3, 2, 2, 2, 116, 114,
-	3, 2, 2, 2, 116, 117, 3, 2, 2, 2, 117, 15, 3, 2, 2, 2, 118, 119, 7, 19,
-	2, 2, 119, 120, 7, 24, 2, 2, 120, 121, 5, 6, 4, 2, 121, 122, 5, 20, 11,
-	2, 122, 17, 3, 2, 2, 2, 123, 124, 7, 13, 2, 2, 124, 125, 7, 3, 2, 2, 125,
-	126, 7, 24, 2, 2, 126, 127, 7, 24, 2, 2, 127, 128, 5, 6, 4, 2, 128, 129,
-	7, 4, 2, 2, 129, 130, 5, 30, 16, 2, 130, 131, 7, 7, 2, 2, 131, 132, 7,
-	17, 2, 2, 132, 133, 5, 36, 19, 2, 133, 134, 7, 12, 2, 2, 134, 19, 3, 2,
-	2, 2, 135, 136, 7, 18, 2, 2, 136, 138, 7, 7, 2, 2, 137, 139, 5, 22, 12,
-	2, 138, 137, 3, 2, 2, 2, 138, 139, 3, 2, 2, 2, 139, 140, 3, 2, 2, 2, 140,
-	148, 7, 12, 2, 2, 141, 142, 7, 14, 2, 2, 142, 144, 7, 7, 2, 2, 143, 145,
-	5, 26, 14, 2, 144, 143, 3, 2, 2, 2, 144, 145, 3, 2, 2, 2, 145, 146, 3,
-	2, 2, 2, 146, 148, 7, 12, 2, 2, 147, 135, 3, 2, 2, 2, 147, 141, 3, 2, 2,
-	2, 148, 21, 3, 2, 2, 2, 149, 154, 5, 24, 13, 2, 150, 151, 7, 6, 2, 2, 151,
-	153, 5, 24, 13, 2, 152, 150, 3, 2, 2, 2, 153, 156, 3, 2, 2, 2, 154, 152,
-	3, 2, 2, 2, 154, 155, 3, 2, 2, 2, 155, 23, 3, 2, 2, 2, 156, 154, 3, 2,
-	2, 2, 157, 158, 7, 24, 2, 2, 158, 159, 5, 2, 2, 2, 159, 25, 3, 2, 2, 2,
-	160, 165, 5, 28, 15, 2, 161, 162, 7, 6, 2, 2, 162, 164, 5, 28, 15, 2, 163,
-	161, 3, 2, 2, 2, 164, 167, 3, 2, 2, 2, 165, 163, 3, 2, 2, 2, 165, 166,
-	3, 2, 2, 2, 166, 27, 3, 2, 2, 2, 167, 165, 3, 2, 2, 2, 168, 171, 5, 30,
-	16, 2, 169, 171, 5, 2, 2, 2, 170, 168, 3, 2, 2, 2, 170, 169, 3, 2, 2, 2,
-	171, 29, 3, 2, 2, 2, 172, 173, 7, 24, 2, 2, 173, 174, 5, 6, 4, 2, 174,
-	176, 7, 3, 2, 2, 175, 177, 5, 32, 17, 2, 176, 175, 3, 2, 2, 2, 176, 177,
-	3, 2, 2, 2, 177, 178, 3, 2, 2, 2, 178, 179, 7, 4, 2, 2, 179, 180, 5, 2,
-	2, 2, 180, 31, 3, 2, 2, 2, 181, 186, 5, 34, 18, 2, 182, 183, 7, 5, 2, 2,
-	183, 185, 5, 34, 18, 2, 184, 182, 3, 2, 2, 2, 185, 188, 3, 2, 2, 2, 186,
-	184, 3, 2, 2, 2, 186, 187, 3, 2, 2, 2, 187, 33, 3, 2, 2, 2, 188, 186, 3,
-	2, 2, 2, 189, 190, 7, 24, 2, 2, 190, 191, 5, 2, 2, 2, 191, 35, 3, 2, 2,
-	2, 192, 193, 8, 19, 1, 2, 193, 215, 7, 24, 2, 2, 194, 195, 5, 2, 2, 2,
-	195, 197, 7, 7, 2, 2, 196, 198, 5, 38, 20, 2, 197, 196, 3, 2, 2, 2, 197,
-	198, 3, 2, 2, 2, 198, 199, 3, 2, 2, 2, 199, 200, 7, 12, 2, 2, 200, 215,
-	3, 2, 2, 2, 201, 202, 7, 21, 2, 2, 202, 203, 7, 10, 2, 2, 203, 204, 7,
-	23, 2, 2, 204, 205, 7, 3, 2, 2, 205, 210, 9, 2, 2, 2, 206, 209, 7, 5, 2,
-	2, 207, 209, 5, 36, 19, 2, 208, 206, 3, 2, 2, 2, 208, 207, 3, 2, 2, 2,
-	209, 212, 3, 2, 2, 2, 210, 208, 3, 2, 2, 2, 210, 211, 3, 2, 2, 2, 211,
-	213, 3, 2, 2, 2, 212, 210, 3, 2, 2, 2, 213, 215, 7, 4, 2, 2, 214, 192,
-	3, 2, 2, 2, 214, 194, 3, 2, 2, 2, 214, 201, 3, 2, 2, 2, 215, 240, 3, 2,
-	2, 2, 216, 217, 12, 6, 2, 2, 217, 218, 7, 10, 2, 2, 218, 239, 7, 24, 2,
-	2, 219, 220, 12, 5, 2, 2, 220, 221, 7, 10, 2, 2, 221, 222, 7, 24, 2, 2,
-	222, 224, 7, 3, 2, 2, 223, 225, 5, 4, 3, 2, 224, 223, 3, 2, 2, 2, 224,
-	225, 3, 2, 2, 2, 225, 226, 3, 2, 2, 2, 226, 227, 7, 4, 2, 2, 227, 229,
-	7, 3, 2, 2, 228, 230, 5, 38, 20, 2, 229, 228, 3, 2, 2, 2, 229, 230, 3,
-	2, 2, 2, 230, 231, 3, 2, 2, 2, 231, 239, 7, 4, 2, 2, 232, 233, 12, 4, 2,
-	2, 233, 234, 7, 10, 2, 2, 234, 235, 7, 3, 2, 2, 235, 236, 5, 2, 2, 2, 236,
-	237, 7, 4, 2, 2, 237, 239, 3, 2, 2, 2, 238, 216, 3, 2, 2, 2, 238, 219,
-	3, 2, 2, 2, 238, 232, 3, 2, 2, 2, 239, 242, 3, 2, 2, 2, 240, 238, 3, 2,
-	2, 2, 240, 241, 3, 2, 2, 2, 241, 37, 3, 2, 2, 2, 242, 240, 3, 2, 2, 2,
-	243, 248, 5, 36, 19, 2, 244, 245, 7, 5, 2, 2, 245, 247, 5, 36, 19, 2, 246,
-	244, 3, 2, 2, 2, 247, 250, 3, 2, 2, 2, 248, 246, 3, 2, 2, 2, 248, 249,
-	3, 2, 2, 2, 249, 39, 3, 2, 2, 2, 250, 248, 3, 2, 2, 2, 29, 44, 47, 54,
-	60, 69, 81, 84, 103, 110, 116, 138, 144, 147, 154, 165, 170, 176, 186,
-	197, 208, 210, 214, 224, 229, 238, 240, 248,
-}
-var deserializer = antlr.NewATNDeserializer(nil)
-var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
-
-var literalNames = []string{
-	"", "'('", "')'", "','", "';'", "'{'", "'_'", "'='", "'.'", "'\"%#v\"'",
-	"'}'", "'func'", "'interface'", "'main'", "'package'", "'return'", "'struct'",
-	"'type'", "'import'", "'fmt'", "'Printf'", "'Sprintf'",
-}
-var symbolicNames = []string{
-	"", "", "", "", "", "", "", "", "", "", "", "FUNC", "INTERFACE", "MAIN",
-	"PACKAGE", "RETURN", "STRUCT", "TYPE", "IMPORT", "FMT", "PRINTF", "SPRINTF",
-	"NAME", "WHITESPACE", "COMMENT", "LINE_COMMENT", "STRING",
-}
-
-var ruleNames = []string{
-	"typ", "typs", "typeFormals", "typeFDecls", "typeFDecl", "program", "decls",
-	"typeDecl", "methDecl", "typeLit", "fieldDecls", "fieldDecl", "specs",
-	"spec", "sig", "params", "paramDecl", "expr", "exprs",
-}
-var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
-
-func init() {
-	for index, ds := range deserializedATN.DecisionToState {
-		decisionToDFA[index] = antlr.NewDFA(ds, index)
-	}
-}
+var _ = sync.Once{}
 
 type FGGParser struct {
 	*antlr.BaseParser
 }
 
+var fggParserStaticData struct {
+	once                   sync.Once
+	serializedATN          []int32
+	literalNames           []string
+	symbolicNames          []string
+	ruleNames              []string
+	predictionContextCache *antlr.PredictionContextCache
+	atn                    *antlr.ATN
+	decisionToDFA          []*antlr.DFA
+}
+
+func fggParserInit() {
+	staticData := &fggParserStaticData
+	staticData.literalNames = []string{
+		"", "'('", "')'", "'['", "']'", "','", "'{'", "'}'", "';'", "'_'", "'='",
+		"'.'", "'\"%#v\"'", "':='", "':'", "'case'", "'default'", "'else'",
+		"'func'", "'if'", "'interface'", "'main'", "'map'", "'package'", "'return'",
+		"'struct'", "'switch'", "'type'", "'var'", "'import'", "'fmt'", "'Printf'",
+		"'Sprintf'", "'len'", "'append'", "'panic'", "'true'", "'false'", "'bool'",
+		"'int32'", "'int64'", "'float32'", "'float64'", "'string'", "'+'", "'-'",
+		"'*'", "'/'", "'%'", "'&'", "'|'", "'^'", "'<<'", "'>>'", "'!'", "'&&'",
+		"'||'", "'=='", "'!='", "'>'", "'<'", "'>='", "'<='", "'~'",
+	}
+	staticData.symbolicNames = []string{
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "CASE",
+		"DEFAULT", "ELSE", "FUNC", "IF", "INTERFACE", "MAIN", "MAP", "PACKAGE",
+		"RETURN", "STRUCT", "SWITCH", "TYPE", "VAR", "IMPORT", "FMT", "PRINTF",
+		"SPRINTF", "LEN", "APPEND", "PANIC", "TRUE", "FALSE", "BOOL", "INT32",
+		"INT64", "FLOAT32", "FLOAT64", "STRING", "PLUS", "MINUS", "TIMES", "DIV",
+		"MOD", "BITAND", "BITOR", "BITXOR", "SHL", "SHR", "NOT", "AND", "OR",
+		"EQ", "NE", "GT", "LT", "GE", "LE", "TILDE", "NAME", "WHITESPACE", "COMMENT",
+		"LINE_COMMENT", "STRING_LIT", "INT_LIT", "FLOAT_LIT",
+	}
+	staticData.ruleNames = []string{
+		"typ", "typs", "primName", "typeLit", "typeFormals", "typeFDecls", "typeFDecl",
+		"program", "decls", "typeDecl", "methDecl", "funcDecl", "body", "binding",
+		"ifElse", "typeSwitch", "typeCase", "fieldDecls", "fieldDecl", "typeList",
+		"specs", "spec", "union", "term", "sig", "params", "paramDecl", "expr",
+		"exprs", "entries", "entry", "primLit",
+	}
+	staticData.predictionContextCache = antlr.NewPredictionContextCache()
+	staticData.serializedATN = []int32{
+		4, 1, 70, 501, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
+		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
+		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
+		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
+		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
+		31, 1, 0, 1, 0, 1, 0, 3, 0, 68, 8, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+		0, 1, 0, 3, 0, 77, 8, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0,
+		1, 0, 1, 0, 1, 0, 3, 0, 90, 8, 0, 1, 1, 1, 1, 1, 1, 5, 1, 95, 8, 1, 10,
+		1, 12, 1, 98, 9, 1, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 3, 3, 105, 8, 3, 1, 3,
+		1, 3, 1, 3, 1, 3, 1, 3, 3, 3, 112, 8, 3, 3, 3, 114, 8, 3, 1, 3, 3, 3, 117,
+		8, 3, 1, 3, 3, 3, 120, 8, 3, 1, 4, 1, 4, 1, 4, 3, 4, 125, 8, 4, 1, 4, 1,
+		4, 1, 5, 1, 5, 1, 5, 5, 5, 132, 8, 5, 10, 5, 12, 5, 135, 9, 5, 1, 6, 1,
+		6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 3, 7, 146, 8, 7, 1, 7, 3,
+		7, 149, 8, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1,
+		7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 3, 7, 168, 8, 7, 1, 7, 1,
+		7, 1, 7, 1, 8, 1, 8, 1, 8, 3, 8, 176, 8, 8, 1, 8, 1, 8, 4, 8, 180, 8, 8,
+		11, 8, 12, 8, 181, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1,
+		10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11,
+		1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1,
+		12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 3, 12, 219, 8, 12, 1, 13, 1, 13,
+		1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1,
+		13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 3, 13, 242,
+		8, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1,
+		14, 1, 14, 3, 14, 255, 8, 14, 1, 15, 1, 15, 1, 15, 3, 15, 260, 8, 15, 1,
+		15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 5, 15, 269, 8, 15, 10, 15,
+		12, 15, 272, 9, 15, 1, 15, 1, 15, 1, 15, 3, 15, 277, 8, 15, 1, 15, 1, 15,
+		1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 286, 8, 16, 1, 17, 1, 17, 1,
+		17, 5, 17, 291, 8, 17, 10, 17, 12, 17, 294, 9, 17, 1, 18, 1, 18, 1, 18,
+		3, 18, 299, 8, 18, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 5, 20, 307,
+		8, 20, 10, 20, 12, 20, 310, 9, 20, 1, 21, 1, 21, 3, 21, 314, 8, 21, 1,
+		22, 1, 22, 1, 22, 5, 22, 319, 8, 22, 10, 22, 12, 22, 322, 9, 22, 1, 23,
+		3, 23, 325, 8, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 3, 24, 333,
+		8, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 5, 25, 341, 8, 25, 10,
+		25, 12, 25, 344, 9, 25, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27,
+		3, 27, 353, 8, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1,
+		27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 5, 27, 369, 8, 27, 10, 27,
+		12, 27, 372, 9, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1,
+		27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27,
+		1, 27, 3, 27, 393, 8, 27, 1, 27, 1, 27, 1, 27, 3, 27, 398, 8, 27, 1, 27,
+		1, 27, 1, 27, 1, 27, 1, 27, 3, 27, 405, 8, 27, 1, 27, 1, 27, 1, 27, 1,
+		27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27,
+		420, 8, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1,
+		27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27,
+		1, 27, 3, 27, 442, 8, 27, 1, 27, 1, 27, 1, 27, 3, 27, 447, 8, 27, 1, 27,
+		1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27, 456, 8, 27, 1, 27, 1,
+		27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27,
+		5, 27, 470, 8, 27, 10, 27, 12, 27, 473, 9, 27, 1, 28, 1, 28, 1, 28, 5,
+		28, 478, 8, 28, 10, 28, 12, 28, 481, 9, 28, 1, 29, 1, 29, 1, 29, 5, 29,
+		486, 8, 29, 10, 29, 12, 29, 489, 9, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1,
+		31, 1, 31, 1, 31, 1, 31, 3, 31, 499, 8, 31, 1, 31, 0, 1, 54, 32, 0, 2,
+		4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40,
+		42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 0, 7, 1, 0, 38, 43, 2, 0, 12,
+		12, 68, 68, 2, 0, 45, 45, 54, 54, 2, 0, 46, 49, 52, 53, 2, 0, 44, 45, 50,
+		51, 1, 0, 57, 62, 1, 0, 36, 37, 545, 0, 89, 1, 0, 0, 0, 2, 91, 1, 0, 0,
+		0, 4, 99, 1, 0, 0, 0, 6, 119, 1, 0, 0, 0, 8, 121, 1, 0, 0, 0, 10, 128,
+		1, 0, 0, 0, 12, 136, 1, 0, 0, 0, 14, 139, 1, 0, 0, 0, 16, 179, 1, 0, 0,
+		0, 18, 183, 1, 0, 0, 0, 20, 188, 1, 0, 0, 0, 22, 199, 1, 0, 0, 0, 24, 218,
+		1, 0, 0, 0, 26, 241, 1, 0, 0, 0, 28, 243, 1, 0, 0, 0, 30, 256, 1, 0, 0,
+		0, 32, 280, 1, 0, 0, 0, 34, 287, 1, 0, 0, 0, 36, 298, 1, 0, 0, 0, 38, 300,
+		1, 0, 0, 0, 40, 303, 1, 0, 0, 0, 42, 313, 1, 0, 0, 0, 44, 315, 1, 0, 0,
+		0, 46, 324, 1, 0, 0, 0, 48, 328, 1, 0, 0, 0, 50, 337, 1, 0, 0, 0, 52, 345,
+		1, 0, 0, 0, 54, 419, 1, 0, 0, 0, 56, 474, 1, 0, 0, 0, 58, 482, 1, 0, 0,
+		0, 60, 490, 1, 0, 0, 0, 62, 498, 1, 0, 0, 0, 64, 65, 5, 64, 0, 0, 65, 67,
+		5, 1, 0, 0, 66, 68, 3, 2, 1, 0, 67, 66, 1, 0, 0, 0, 67, 68, 1, 0, 0, 0,
+		68, 69, 1, 0, 0, 0, 69, 90, 5, 2, 0, 0, 70, 90, 5, 64, 0, 0, 71, 90, 3,
+		4, 2, 0, 72, 90, 3, 6, 3, 0, 73, 74, 5, 18, 0, 0, 74, 76, 5, 1, 0, 0, 75,
+		77, 3, 2, 1, 0, 76, 75, 1, 0, 0, 0, 76, 77, 1, 0, 0, 0, 77, 78, 1, 0, 0,
+		0, 78, 79, 5, 2, 0, 0, 79, 90, 3, 0, 0, 0, 80, 81, 5, 3, 0, 0, 81, 82,
+		5, 4, 0, 0, 82, 90, 3, 0, 0, 0, 83, 84, 5, 22, 0, 0, 84, 85, 5, 3, 0, 0,
+		85, 86, 3, 0, 0, 0, 86, 87, 5, 4, 0, 0, 87, 88, 3, 0, 0, 0, 88, 90, 1,
+		0, 0, 0, 89, 64, 1, 0, 0, 0, 89, 70, 1, 0, 0, 0, 89, 71, 1, 0, 0, 0, 89,
+		72, 1, 0, 0, 0, 89, 73, 1, 0, 0, 0, 89, 80, 1, 0, 0, 0, 89, 83, 1, 0, 0,
+		0, 90, 1, 1, 0, 0, 0, 91, 96, 3, 0, 0, 0, 92, 93, 5, 5, 0, 0, 93, 95, 3,
+		0, 0, 0, 94, 92, 1, 0, 0, 0, 95, 98, 1, 0, 0, 0, 96, 94, 1, 0, 0, 0, 96,
+		97, 1, 0, 0, 0, 97, 3, 1, 0, 0, 0, 98, 96, 1, 0, 0, 0, 99, 100, 7, 0, 0,
+		0, 100, 5, 1, 0, 0, 0, 101, 102, 5, 25, 0, 0, 102, 104, 5, 6, 0, 0, 103,
+		105, 3, 34, 17, 0, 104, 103, 1, 0, 0, 0, 104, 105, 1, 0, 0, 0, 105, 106,
+		1, 0, 0, 0, 106, 120, 5, 7, 0, 0, 107, 108, 5, 20, 0, 0, 108, 113, 5, 6,
+		0, 0, 109, 111, 3, 38, 19, 0, 110, 112, 5, 8, 0, 0, 111, 110, 1, 0, 0,
+		0, 111, 112, 1, 0, 0, 0, 112, 114, 1, 0, 0, 0, 113, 109, 1, 0, 0, 0, 113,
+		114, 1, 0, 0, 0, 114, 116, 1, 0, 0, 0, 115, 117, 3, 40, 20, 0, 116, 115,
+		1, 0, 0, 0, 116, 117, 1, 0, 0, 0, 117, 118, 1, 0, 0, 0, 118, 120, 5, 7,
+		0, 0, 119, 101, 1, 0, 0, 0, 119, 107, 1, 0, 0, 0, 120, 7, 1, 0, 0, 0, 121,
+		122, 5, 1, 0, 0, 122, 124, 5, 27, 0, 0, 123, 125, 3, 10, 5, 0, 124, 123,
+		1, 0, 0, 0, 124, 125, 1, 0, 0, 0, 125, 126, 1, 0, 0, 0, 126, 127, 5, 2,
+		0, 0, 127, 9, 1, 0, 0, 0, 128, 133, 3, 12, 6, 0, 129, 130, 5, 5, 0, 0,
+		130, 132, 3, 12, 6, 0, 131, 129, 1, 0, 0, 0, 132, 135, 1, 0, 0, 0, 133,
+		131, 1, 0, 0, 0, 133, 134, 1, 0, 0, 0, 134, 11, 1, 0, 0, 0, 135, 133, 1,
+		0, 0, 0, 136, 137, 5, 64, 0, 0, 137, 138, 3, 0, 0, 0, 138, 13, 1, 0, 0,
+		0, 139, 140, 5, 23, 0, 0, 140, 141, 5, 21, 0, 0, 141, 145, 5, 8, 0, 0,
+		142, 143, 5, 29, 0, 0, 143, 144, 5, 68, 0, 0, 144, 146, 5, 8, 0, 0, 145,
+		142, 1, 0, 0, 0, 145, 146, 1, 0, 0, 0, 146, 148, 1, 0, 0, 0, 147, 149,
+		3, 16, 8, 0, 148, 147, 1, 0, 0, 0, 148, 149, 1, 0, 0, 0, 149, 150, 1, 0,
+		0, 0, 150, 151, 5, 18, 0, 0, 151, 152, 5, 21, 0, 0, 152, 153, 5, 1, 0,
+		0, 153, 154, 5, 2, 0, 0, 154, 167, 5, 6, 0, 0, 155, 156, 5, 9, 0, 0, 156,
+		157, 5, 10, 0, 0, 157, 168, 3, 54, 27, 0, 158, 159, 5, 30, 0, 0, 159, 160,
+		5, 11, 0, 0, 160, 161, 5, 31, 0, 0, 161, 162, 5, 1, 0, 0, 162, 163, 5,
+		12, 0, 0, 163, 164, 5, 5, 0, 0, 164, 165, 3, 54, 27, 0, 165, 166, 5, 2,
+		0, 0, 166, 168, 1, 0, 0, 0, 167, 155, 1, 0, 0, 0, 167, 158, 1, 0, 0, 0,
+		168, 169, 1, 0, 0, 0, 169, 170, 5, 7, 0, 0, 170, 171, 5, 0, 0, 1, 171,
+		15, 1, 0, 0, 0, 172, 176, 3, 18, 9, 0, 173, 176, 3, 20, 10, 0, 174, 176,
+		3, 22, 11, 0, 175, 172, 1, 0, 0, 0, 175, 173, 1, 0, 0, 0, 175, 174, 1,
+		0, 0, 0, 176, 177, 1, 0, 0, 0, 177, 178, 5, 8, 0, 0, 178, 180, 1, 0, 0,
+		0, 179, 175, 1, 0, 0, 0, 180, 181, 1, 0, 0, 0, 181, 179, 1, 0, 0, 0, 181,
+		182, 1, 0, 0, 0, 182, 17, 1, 0, 0, 0, 183, 184, 5, 27, 0, 0, 184, 185,
+		5, 64, 0, 0, 185, 186, 3, 8, 4, 0, 186, 187, 3, 0, 0, 0, 187, 19, 1, 0,
+		0, 0, 188, 189, 5, 18, 0, 0, 189, 190, 5, 1, 0, 0, 190, 191, 5, 64, 0,
+		0, 191, 192, 5, 64, 0, 0, 192, 193, 3, 8, 4, 0, 193, 194, 5, 2, 0, 0, 194,
+		195, 3, 48, 24, 0, 195, 196, 5, 6, 0, 0, 196, 197, 3, 24, 12, 0, 197, 198,
+		5, 7, 0, 0, 198, 21, 1, 0, 0, 0, 199, 200, 5, 18, 0, 0, 200, 201, 3, 48,
+		24, 0, 201, 202, 5, 6, 0, 0, 202, 203, 3, 24, 12, 0, 203, 204, 5, 7, 0,
+		0, 204, 23, 1, 0, 0, 0, 205, 206, 5, 24, 0, 0, 206, 219, 3, 54, 27, 0,
+		207, 208, 5, 35, 0, 0, 208, 209, 5, 1, 0, 0, 209, 210, 3, 54, 27, 0, 210,
+		211, 5, 2, 0, 0, 211, 219, 1, 0, 0, 0, 212, 219, 3, 28, 14, 0, 213, 219,
+		3, 30, 15, 0, 214, 215, 3, 26, 13, 0, 215, 216, 5, 8, 0, 0, 216, 217, 3,
+		24, 12, 0, 217, 219, 1, 0, 0, 0, 218, 205, 1, 0, 0, 0, 218, 207, 1, 0,
+		0, 0, 218, 212, 1, 0, 0, 0, 218, 213, 1, 0, 0, 0, 218, 214, 1, 0, 0, 0,
+		219, 25, 1, 0, 0, 0, 220, 221, 5, 64, 0, 0, 221, 222, 5, 13, 0, 0, 222,
+		242, 3, 54, 27, 0, 223, 224, 5, 28, 0, 0, 224, 225, 5, 64, 0, 0, 225, 226,
+		3, 0, 0, 0, 226, 227, 5, 10, 0, 0, 227, 228, 3, 54, 27, 0, 228, 242, 1,
+		0, 0, 0, 229, 230, 5, 64, 0, 0, 230, 231, 5, 5, 0, 0, 231, 232, 5, 64,
+		0, 0, 232, 233, 5, 13, 0, 0, 233, 242, 3, 54, 27, 0, 234, 235, 5, 64, 0,
+		0, 235, 236, 5, 3, 0, 0, 236, 237, 3, 54, 27, 0, 237, 238, 5, 4, 0, 0,
+		238, 239, 5, 10, 0, 0, 239, 240, 3, 54, 27, 0, 240, 242, 1, 0, 0, 0, 241,
+		220, 1, 0, 0, 0, 241, 223, 1, 0, 0, 0, 241, 229, 1, 0, 0, 0, 241, 234,
+		1, 0, 0, 0, 242, 27, 1, 0, 0, 0, 243, 244, 5, 19, 0, 0, 244, 245, 3, 54,
+		27, 0, 245, 246, 5, 6, 0, 0, 246, 247, 3, 24, 12, 0, 247, 248, 5, 7, 0,
+		0, 248, 254, 5, 17, 0, 0, 249, 250, 5, 6, 0, 0, 250, 251, 3, 24, 12, 0,
+		251, 252, 5, 7, 0, 0, 252, 255, 1, 0, 0, 0, 253, 255, 3, 28, 14, 0, 254,
+		249, 1, 0, 0, 0, 254, 253, 1, 0, 0, 0, 255, 29, 1, 0, 0, 0, 256, 259, 5,
+		26, 0, 0, 257, 258, 5, 64, 0, 0, 258, 260, 5, 13, 0, 0, 259, 257, 1, 0,
+		0, 0, 259, 260, 1, 0, 0, 0, 260, 261, 1, 0, 0, 0, 261, 262, 3, 54, 27,
+		0, 262, 263, 5, 11, 0, 0, 263, 264, 5, 1, 0, 0, 264, 265, 5, 27, 0, 0,
+		265, 266, 5, 2, 0, 0, 266, 270, 5, 6, 0, 0, 267, 269, 3, 32, 16, 0, 268,
+		267, 1, 0, 0, 0, 269, 272, 1, 0, 0, 0, 270, 268, 1, 0, 0, 0, 270, 271,
+		1, 0, 0, 0, 271, 276, 1, 0, 0, 0, 272, 270, 1, 0, 0, 0, 273, 274, 5, 16,
+		0, 0, 274, 275, 5, 14, 0, 0, 275, 277, 3, 24, 12, 0, 276, 273, 1, 0, 0,
+		0, 276, 277, 1, 0, 0, 0, 277, 278, 1, 0, 0, 0, 278, 279, 5, 7, 0, 0, 279,
+		31, 1, 0, 0, 0, 280, 281, 5, 15, 0, 0, 281, 282, 3, 0, 0, 0, 282, 283,
+		5, 14, 0, 0, 283, 285, 3, 24, 12, 0, 284, 286, 5, 8, 0, 0, 285, 284, 1,
+		0, 0, 0, 285, 286, 1, 0, 0, 0, 286, 33, 1, 0, 0, 0, 287, 292, 3, 36, 18,
+		0, 288, 289, 5, 8, 0, 0, 289, 291, 3, 36, 18, 0, 290, 288, 1, 0, 0, 0,
+		291, 294, 1, 0, 0, 0, 292, 290, 1, 0, 0, 0, 292, 293, 1, 0, 0, 0, 293,
+		35, 1, 0, 0, 0, 294, 292, 1, 0, 0, 0, 295, 296, 5, 64, 0, 0, 296, 299,
+		3, 0, 0, 0, 297, 299, 3, 0, 0, 0, 298, 295, 1, 0, 0, 0, 298, 297, 1, 0,
+		0, 0, 299, 37, 1, 0, 0, 0, 300, 301, 5, 27, 0, 0, 301, 302, 3, 2, 1, 0,
+		302, 39, 1, 0, 0, 0, 303, 308, 3, 42, 21, 0, 304, 305, 5, 8, 0, 0, 305,
+		307, 3, 42, 21, 0, 306, 304, 1, 0, 0, 0, 307, 310, 1, 0, 0, 0, 308, 306,
+		1, 0, 0, 0, 308, 309, 1, 0, 0, 0, 309, 41, 1, 0, 0, 0, 310, 308, 1, 0,
+		0, 0, 311, 314, 3, 48, 24, 0, 312, 314, 3, 44, 22, 0, 313, 311, 1, 0, 0,
+		0, 313, 312, 1, 0, 0, 0, 314, 43, 1, 0, 0, 0, 315, 320, 3, 46, 23, 0, 316,
+		317, 5, 50, 0, 0, 317, 319, 3, 46, 23, 0, 318, 316, 1, 0, 0, 0, 319, 322,
+		1, 0, 0, 0, 320, 318, 1, 0, 0, 0, 320, 321, 1, 0, 0, 0, 321, 45, 1, 0,
+		0, 0, 322, 320, 1, 0, 0, 0, 323, 325, 5, 63, 0, 0, 324, 323, 1, 0, 0, 0,
+		324, 325, 1, 0, 0, 0, 325, 326, 1, 0, 0, 0, 326, 327, 3, 0, 0, 0, 327,
+		47, 1, 0, 0, 0, 328, 329, 5, 64, 0, 0, 329, 330, 3, 8, 4, 0, 330, 332,
+		5, 1, 0, 0, 331, 333, 3, 50, 25, 0, 332, 331, 1, 0, 0, 0, 332, 333, 1,
+		0, 0, 0, 333, 334, 1, 0, 0, 0, 334, 335, 5, 2, 0, 0, 335, 336, 3, 0, 0,
+		0, 336, 49, 1, 0, 0, 0, 337, 342, 3, 52, 26, 0, 338, 339, 5, 5, 0, 0, 339,
+		341, 3, 52, 26, 0, 340, 338, 1, 0, 0, 0, 341, 344, 1, 0, 0, 0, 342, 340,
+		1, 0, 0, 0, 342, 343, 1, 0, 0, 0, 343, 51, 1, 0, 0, 0, 344, 342, 1, 0,
+		0, 0, 345, 346, 5, 64, 0, 0, 346, 347, 3, 0, 0, 0, 347, 53, 1, 0, 0, 0,
+		348, 349, 6, 27, -1, 0, 349, 350, 3, 0, 0, 0, 350, 352, 5, 6, 0, 0, 351,
+		353, 3, 56, 28, 0, 352, 351, 1, 0, 0, 0, 352, 353, 1, 0, 0, 0, 353, 354,
+		1, 0, 0, 0, 354, 355, 5, 7, 0, 0, 355, 420, 1, 0, 0, 0, 356, 357, 3, 0,
+		0, 0, 357, 358, 5, 6, 0, 0, 358, 359, 3, 58, 29, 0, 359, 360, 5, 7, 0,
+		0, 360, 420, 1, 0, 0, 0, 361, 362, 5, 30, 0, 0, 362, 363, 5, 11, 0, 0,
+		363, 364, 5, 32, 0, 0, 364, 365, 5, 1, 0, 0, 365, 370, 7, 1, 0, 0, 366,
+		369, 5, 5, 0, 0, 367, 369, 3, 54, 27, 0, 368, 366, 1, 0, 0, 0, 368, 367,
+		1, 0, 0, 0, 369, 372, 1, 0, 0, 0, 370, 368, 1, 0, 0, 0, 370, 371, 1, 0,
+		0, 0, 371, 373, 1, 0, 0, 0, 372, 370, 1, 0, 0, 0, 373, 420, 5, 2, 0, 0,
+		374, 375, 5, 33, 0, 0, 375, 376, 5, 1, 0, 0, 376, 377, 3, 54, 27, 0, 377,
+		378, 5, 2, 0, 0, 378, 420, 1, 0, 0, 0, 379, 380, 5, 34, 0, 0, 380, 381,
+		5, 1, 0, 0, 381, 382, 3, 56, 28, 0, 382, 383, 5, 2, 0, 0, 383, 420, 1,
+		0, 0, 0, 384, 385, 3, 0, 0, 0, 385, 386, 5, 1, 0, 0, 386, 387, 3, 54, 27,
+		0, 387, 388, 5, 2, 0, 0, 388, 420, 1, 0, 0, 0, 389, 390, 5, 64, 0, 0, 390,
+		392, 5, 1, 0, 0, 391, 393, 3, 2, 1, 0, 392, 391, 1, 0, 0, 0, 392, 393,
+		1, 0, 0, 0, 393, 394, 1, 0, 0, 0, 394, 395, 5, 2, 0, 0, 395, 397, 5, 1,
+		0, 0, 396, 398, 3, 56, 28, 0, 397, 396, 1, 0, 0, 0, 397, 398, 1, 0, 0,
+		0, 398, 399, 1, 0, 0, 0, 399, 420, 5, 2, 0, 0, 400, 420, 5, 64, 0, 0, 401,
+		402, 5, 18, 0, 0, 402, 404, 5, 1, 0, 0, 403, 405, 3, 50, 25, 0, 404, 403,
+		1, 0, 0, 0, 404, 405, 1, 0, 0, 0, 405, 406, 1, 0, 0, 0, 406, 407, 5, 2,
+		0, 0, 407, 408, 3, 0, 0, 0, 408, 409, 5, 6, 0, 0, 409, 410, 3, 24, 12,
+		0, 410, 411, 5, 7, 0, 0, 411, 420, 1, 0, 0, 0, 412, 413, 7, 2, 0, 0, 413,
+		420, 3, 54, 27, 8, 414, 415, 5, 1, 0, 0, 415, 416, 3, 54, 27, 0, 416, 417,
+		5, 2, 0, 0, 417, 420, 1, 0, 0, 0, 418, 420, 3, 62, 31, 0, 419, 348, 1,
+		0, 0, 0, 419, 356, 1, 0, 0, 0, 419, 361, 1, 0, 0, 0, 419, 374, 1, 0, 0,
+		0, 419, 379, 1, 0, 0, 0, 419, 384, 1, 0, 0, 0, 419, 389, 1, 0, 0, 0, 419,
+		400, 1, 0, 0, 0, 419, 401, 1, 0, 0, 0, 419, 412, 1, 0, 0, 0, 419, 414,
+		1, 0, 0, 0, 419, 418, 1, 0, 0, 0, 420, 471, 1, 0, 0, 0, 421, 422, 10, 7,
+		0, 0, 422, 423, 7, 3, 0, 0, 423, 470, 3, 54, 27, 8, 424, 425, 10, 6, 0,
+		0, 425, 426, 7, 4, 0, 0, 426, 470, 3, 54, 27, 7, 427, 428, 10, 5, 0, 0,
+		428, 429, 7, 5, 0, 0, 429, 470, 3, 54, 27, 6, 430, 431, 10, 4, 0, 0, 431,
+		432, 5, 55, 0, 0, 432, 470, 3, 54, 27, 5, 433, 434, 10, 3, 0, 0, 434, 435,
+		5, 56, 0, 0, 435, 470, 3, 54, 27, 4, 436, 437, 10, 20, 0, 0, 437, 438,
+		5, 11, 0, 0, 438, 439, 5, 64, 0, 0, 439, 441, 5, 1, 0, 0, 440, 442, 3,
+		2, 1, 0, 441, 440, 1, 0, 0, 0, 441, 442, 1, 0, 0, 0, 442, 443, 1, 0, 0,
+		0, 443, 444, 5, 2, 0, 0, 444, 446, 5, 1, 0, 0, 445, 447, 3, 56, 28, 0,
+		446, 445, 1, 0, 0, 0, 446, 447, 1, 0, 0, 0, 447, 448, 1, 0, 0, 0, 448,
+		470, 5, 2, 0, 0, 449, 450, 10, 19, 0, 0, 450, 451, 5, 11, 0, 0, 451, 470,
+		5, 64, 0, 0, 452, 453, 10, 18, 0, 0, 453, 455, 5, 1, 0, 0, 454, 456, 3,
+		56, 28, 0, 455, 454, 1, 0, 0, 0, 455, 456, 1, 0, 0, 0, 456, 457, 1, 0,
+		0, 0, 457, 470, 5, 2, 0, 0, 458, 459, 10, 17, 0, 0, 459, 460, 5, 3, 0,
+		0, 460, 461, 3, 54, 27, 0, 461, 462, 5, 4, 0, 0, 462, 470, 1, 0, 0, 0,
+		463, 464, 10, 16, 0, 0, 464, 465, 5, 11, 0, 0, 465, 466, 5, 1, 0, 0, 466,
+		467, 3, 0, 0, 0, 467, 468, 5, 2, 0, 0, 468, 470, 1, 0, 0, 0, 469, 421,
+		1, 0, 0, 0, 469, 424, 1, 0, 0, 0, 469, 427, 1, 0, 0, 0, 469, 430, 1, 0,
+		0, 0, 469, 433, 1, 0, 0, 0, 469, 436, 1, 0, 0, 0, 469, 449, 1, 0, 0, 0,
+		469, 452, 1, 0, 0, 0, 469, 458, 1, 0, 0, 0, 469, 463, 1, 0, 0, 0, 470,
+		473, 1, 0, 0, 0, 471, 469, 1, 0, 0, 0, 471, 472, 1, 0, 0, 0, 472, 55, 1,
+		0, 0, 0, 473, 471, 1, 0, 0, 0, 474, 479, 3, 54, 27, 0, 475, 476, 5, 5,
+		0, 0, 476, 478, 3, 54, 27, 0, 477, 475, 1, 0, 0, 0, 478, 481, 1, 0, 0,
+		0, 479, 477, 1, 0, 0, 0, 479, 480, 1, 0, 0, 0, 480, 57, 1, 0, 0, 0, 481,
+		479, 1, 0, 0, 0, 482, 487, 3, 60, 30, 0, 483, 484, 5, 5, 0, 0, 484, 486,
+		3, 60, 30, 0, 485, 483, 1, 0, 0, 0, 486, 489, 1, 0, 0, 0, 487, 485, 1,
+		0, 0, 0, 487, 488, 1, 0, 0, 0, 488, 59, 1, 0, 0, 0, 489, 487, 1, 0, 0,
+		0, 490, 491, 3, 54, 27, 0, 491, 492, 5, 14, 0, 0, 492, 493, 3, 54, 27,
+		0, 493, 61, 1, 0, 0, 0, 494, 499, 7, 6, 0, 0, 495, 499, 5, 69, 0, 0, 496,
+		499, 5, 70, 0, 0, 497, 499, 5, 68, 0, 0, 498, 494, 1, 0, 0, 0, 498, 495,
+		1, 0, 0, 0, 498, 496, 1, 0, 0, 0, 498, 497, 1, 0, 0, 0, 499, 63, 1, 0,
+		0, 0, 46, 67, 76, 89, 96, 104, 111, 113, 116, 119, 124, 133, 145, 148,
+		167, 175, 181, 218, 241, 254, 259, 270, 276, 285, 292, 298, 308, 313, 320,
+		324, 332, 342, 352, 368, 370, 392, 397, 404, 419, 441, 446, 455, 469, 471,
+		479, 487, 498,
+	}
+	deserializer := antlr.NewATNDeserializer(nil)
+	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
+	atn := staticData.atn
+	staticData.decisionToDFA = make([]*antlr.DFA, len(atn.DecisionToState))
+	decisionToDFA := staticData.decisionToDFA
+	for index, state := range atn.DecisionToState {
+		decisionToDFA[index] = antlr.NewDFA(state, index)
+	}
+}
+
+// FGGParserInit initializes any static state used to implement FGGParser. By default the
+// static state used to implement the parser is lazily initialized during the first call to
+// NewFGGParser(). You can call this function if you wish to initialize the static state ahead
+// of time.
+func FGGParserInit() {
+	staticData := &fggParserStaticData
+	staticData.once.Do(fggParserInit)
+}
+
+// NewFGGParser produces a new parser instance for the optional input antlr.TokenStream.
 func NewFGGParser(input antlr.TokenStream) *FGGParser {
+	FGGParserInit()
 	this := new(FGGParser)
-
 	this.BaseParser = antlr.NewBaseParser(input)
-
-	this.Interpreter = antlr.NewParserATNSimulator(this, deserializedATN, decisionToDFA, antlr.NewPredictionContextCache())
-	this.RuleNames = ruleNames
-	this.LiteralNames = literalNames
-	this.SymbolicNames = symbolicNames
+	staticData := &fggParserStaticData
+	this.Interpreter = antlr.NewParserATNSimulator(this, staticData.atn, staticData.decisionToDFA, staticData.predictionContextCache)
+	this.RuleNames = staticData.ruleNames
+	this.LiteralNames = staticData.literalNames
+	this.SymbolicNames = staticData.symbolicNames
 	this.GrammarFileName = "FGG.g4"
 
 	return this
@@ -183,45 +334,102 @@ const (
 	FGGParserT__7         = 8
 	FGGParserT__8         = 9
 	FGGParserT__9         = 10
-	FGGParserFUNC         = 11
-	FGGParserINTERFACE    = 12
-	FGGParserMAIN         = 13
-	FGGParserPACKAGE      = 14
-	FGGParserRETURN       = 15
-	FGGParserSTRUCT       = 16
-	FGGParserTYPE         = 17
-	FGGParserIMPORT       = 18
-	FGGParserFMT          = 19
-	FGGParserPRINTF       = 20
-	FGGParserSPRINTF      = 21
-	FGGParserNAME         = 22
-	FGGParserWHITESPACE   = 23
-	FGGParserCOMMENT      = 24
-	FGGParserLINE_COMMENT = 25
-	FGGParserSTRING       = 26
+	FGGParserT__10        = 11
+	FGGParserT__11        = 12
+	FGGParserT__12        = 13
+	FGGParserT__13        = 14
+	FGGParserCASE         = 15
+	FGGParserDEFAULT      = 16
+	FGGParserELSE         = 17
+	FGGParserFUNC         = 18
+	FGGParserIF           = 19
+	FGGParserINTERFACE    = 20
+	FGGParserMAIN         = 21
+	FGGParserMAP          = 22
+	FGGParserPACKAGE      = 23
+	FGGParserRETURN       = 24
+	FGGParserSTRUCT       = 25
+	FGGParserSWITCH       = 26
+	FGGParserTYPE         = 27
+	FGGParserVAR          = 28
+	FGGParserIMPORT       = 29
+	FGGParserFMT          = 30
+	FGGParserPRINTF       = 31
+	FGGParserSPRINTF      = 32
+	FGGParserLEN          = 33
+	FGGParserAPPEND       = 34
+	FGGParserPANIC        = 35
+	FGGParserTRUE         = 36
+	FGGParserFALSE        = 37
+	FGGParserBOOL         = 38
+	FGGParserINT32        = 39
+	FGGParserINT64        = 40
+	FGGParserFLOAT32      = 41
+	FGGParserFLOAT64      = 42
+	FGGParserSTRING       = 43
+	FGGParserPLUS         = 44
+	FGGParserMINUS        = 45
+	FGGParserTIMES        = 46
+	FGGParserDIV          = 47
+	FGGParserMOD          = 48
+	FGGParserBITAND       = 49
+	FGGParserBITOR        = 50
+	FGGParserBITXOR       = 51
+	FGGParserSHL          = 52
+	FGGParserSHR          = 53
+	FGGParserNOT          = 54
+	FGGParserAND          = 55
+	FGGParserOR           = 56
+	FGGParserEQ           = 57
+	FGGParserNE           = 58
+	FGGParserGT           = 59
+	FGGParserLT           = 60
+	FGGParserGE           = 61
+	FGGParserLE           = 62
+	FGGParserTILDE        = 63
+	FGGParserNAME         = 64
+	FGGParserWHITESPACE   = 65
+	FGGParserCOMMENT      = 66
+	FGGParserLINE_COMMENT = 67
+	FGGParserSTRING_LIT   = 68
+	FGGParserINT_LIT      = 69
+	FGGParserFLOAT_LIT    = 70
 )
 
 // FGGParser rules.
 const (
 	FGGParserRULE_typ         = 0
 	FGGParserRULE_typs        = 1
-	FGGParserRULE_typeFormals = 2
-	FGGParserRULE_typeFDecls  = 3
-	FGGParserRULE_typeFDecl   = 4
-	FGGParserRULE_program     = 5
-	FGGParserRULE_decls       = 6
-	FGGParserRULE_typeDecl    = 7
-	FGGParserRULE_methDecl    = 8
-	FGGParserRULE_typeLit     = 9
-	FGGParserRULE_fieldDecls  = 10
-	FGGParserRULE_fieldDecl   = 11
-	FGGParserRULE_specs       = 12
-	FGGParserRULE_spec        = 13
-	FGGParserRULE_sig         = 14
-	FGGParserRULE_params      = 15
-	FGGParserRULE_paramDecl   = 16
-	FGGParserRULE_expr        = 17
-	FGGParserRULE_exprs       = 18
+	FGGParserRULE_primName    = 2
+	FGGParserRULE_typeLit     = 3
+	FGGParserRULE_typeFormals = 4
+	FGGParserRULE_typeFDecls  = 5
+	FGGParserRULE_typeFDecl   = 6
+	FGGParserRULE_program     = 7
+	FGGParserRULE_decls       = 8
+	FGGParserRULE_typeDecl    = 9
+	FGGParserRULE_methDecl    = 10
+	FGGParserRULE_funcDecl    = 11
+	FGGParserRULE_body        = 12
+	FGGParserRULE_binding     = 13
+	FGGParserRULE_ifElse      = 14
+	FGGParserRULE_typeSwitch  = 15
+	FGGParserRULE_typeCase    = 16
+	FGGParserRULE_fieldDecls  = 17
+	FGGParserRULE_fieldDecl   = 18
+	FGGParserRULE_typeList    = 19
+	FGGParserRULE_specs       = 20
+	FGGParserRULE_spec        = 21
+	FGGParserRULE_union       = 22
+	FGGParserRULE_term        = 23
+	FGGParserRULE_sig         = 24
+	FGGParserRULE_params      = 25
+	FGGParserRULE_paramDecl   = 26
+	FGGParserRULE_expr        = 27
+	FGGParserRULE_exprs       = 28
+	FGGParserRULE_entries     = 29
+	FGGParserRULE_entry       = 30
+	FGGParserRULE_primLit     = 31
 )
 
 // ITypContext is an interface to support dynamic dispatch.
@@ -276,6 +484,7 @@ func (s *TypContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) st
 
 type TypeNameContext struct {
 	*TypContext
+	name antlr.Token
 }
 
 func NewTypeNameContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TypeNameContext {
@@ -288,6 +497,10 @@ func NewTypeNameContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TypeN
 	return p
 }
 
+func (s *TypeNameContext) GetName() antlr.Token { return s.name }
+
+func (s *TypeNameContext) SetName(v antlr.Token) { s.name = v }
+
 func (s *TypeNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -297,7 +510,13 @@ func (s *TypeNameContext) NAME() antlr.TerminalNode {
 }
 
 func (s *TypeNameContext) Typs() ITypsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypsContext)(nil)).Elem(), 0)
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
@@ -318,8 +537,106 @@ func (s *TypeNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	}
 }
 
+type TypeLit_Context struct {
+	*TypContext
+}
+
+func NewTypeLit_Context(parser antlr.Parser, ctx antlr.ParserRuleContext) *TypeLit_Context {
+	var p = new(TypeLit_Context)
+
+	p.TypContext = NewEmptyTypContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypContext))
+
+	return p
+}
+
+func (s *TypeLit_Context) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeLit_Context) TypeLit() ITypeLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeLitContext)
+}
+
+func (s *TypeLit_Context) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterTypeLit_(s)
+	}
+}
+
+func (s *TypeLit_Context) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitTypeLit_(s)
+	}
+}
+
+type TSliceContext struct {
+	*TypContext
+	elem ITypContext
+}
+
+func NewTSliceContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TSliceContext {
+	var p = new(TSliceContext)
+
+	p.TypContext = NewEmptyTypContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypContext))
+
+	return p
+}
+
+func (s *TSliceContext) GetElem() ITypContext { return s.elem }
+
+func (s *TSliceContext) SetElem(v ITypContext) { s.elem = v }
+
+func (s *TSliceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TSliceContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *TSliceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterTSlice(s)
+	}
+}
+
+func (s *TSliceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitTSlice(s)
+	}
+}
+
 type TypeParamContext struct {
 	*TypContext
+	name antlr.Token
 }
 
 func NewTypeParamContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TypeParamContext {
@@ -332,6 +649,10 @@ func NewTypeParamContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Type
 	return p
 }
 
+func (s *TypeParamContext) GetName() antlr.Token { return s.name }
+
+func (s *TypeParamContext) SetName(v antlr.Token) { s.name = v }
+
 func (s *TypeParamContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -352,123 +673,188 @@ func (s *TypeParamContext) ExitRule(listener antlr.ParseTreeListener) {
 	}
 }
 
-func (p *FGGParser) Typ() (localctx ITypContext) {
-	localctx = NewTypContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 0, FGGParserRULE_typ)
-	var _la int
+type TFuncContext struct {
+	*TypContext
+}
 
-	defer func() {
-		p.ExitRule()
-	}()
+func NewTFuncContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TFuncContext {
+	var p = new(TFuncContext)
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
+	p.TypContext = NewEmptyTypContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypContext))
 
-	p.SetState(45)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext()) {
-	case 1:
-		localctx = NewTypeParamContext(p, localctx)
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(38)
-			p.Match(FGGParserNAME)
-		}
+	return p
+}
 
-	case 2:
-		localctx = NewTypeNameContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(39)
-			p.Match(FGGParserNAME)
-		}
-		{
-			p.SetState(40)
-			p.Match(FGGParserT__0)
-		}
-		p.SetState(42)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+func (s *TFuncContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-		if _la == FGGParserNAME {
-			{
-				p.SetState(41)
-				p.Typs()
-			}
+func (s *TFuncContext) FUNC() antlr.TerminalNode {
+	return s.GetToken(FGGParserFUNC, 0)
+}
 
+func (s *TFuncContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
-		{
-			p.SetState(44)
-			p.Match(FGGParserT__1)
-		}
+	}
 
+	if t == nil {
+		return nil
 	}
 
-	return localctx
+	return t.(ITypContext)
 }
 
-// ITypsContext is an interface to support dynamic dispatch.
-type ITypsContext interface {
-	antlr.ParserRuleContext
+func (s *TFuncContext) Typs() ITypsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+	if t == nil {
+		return nil
+	}
 
-	// IsTypsContext differentiates from other interfaces.
-	IsTypsContext()
+	return t.(ITypsContext)
 }
 
-type TypsContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *TFuncContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterTFunc(s)
+	}
 }
 
-func NewEmptyTypsContext() *TypsContext {
-	var p = new(TypsContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_typs
+func (s *TFuncContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitTFunc(s)
+	}
+}
+
+type TPrimitiveContext struct {
+	*TypContext
+	name IPrimNameContext
+}
+
+func NewTPrimitiveContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TPrimitiveContext {
+	var p = new(TPrimitiveContext)
+
+	p.TypContext = NewEmptyTypContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypContext))
+
 	return p
 }
 
-func (*TypsContext) IsTypsContext() {}
+func (s *TPrimitiveContext) GetName() IPrimNameContext { return s.name }
 
-func NewTypsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypsContext {
-	var p = new(TypsContext)
+func (s *TPrimitiveContext) SetName(v IPrimNameContext) { s.name = v }
 
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+func (s *TPrimitiveContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TPrimitiveContext) PrimName() IPrimNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrimNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrimNameContext)
+}
+
+func (s *TPrimitiveContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterTPrimitive(s)
+	}
+}
+
+func (s *TPrimitiveContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitTPrimitive(s)
+	}
+}
+
+type TMapContext struct {
+	*TypContext
+	key  ITypContext
+	elem ITypContext
+}
 
+func NewTMapContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TMapContext {
+	var p = new(TMapContext)
+
+	p.TypContext = NewEmptyTypContext()
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_typs
+	p.CopyFrom(ctx.(*TypContext))
 
 	return p
 }
 
-func (s *TypsContext) GetParser() antlr.Parser { return s.parser }
+func (s *TMapContext) GetKey() ITypContext { return s.key }
 
-func (s *TypsContext) AllTyp() []ITypContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITypContext)(nil)).Elem())
-	var tst = make([]ITypContext, len(ts))
+func (s *TMapContext) GetElem() ITypContext { return s.elem }
+
+func (s *TMapContext) SetKey(v ITypContext) { s.key = v }
+
+func (s *TMapContext) SetElem(v ITypContext) { s.elem = v }
+
+func (s *TMapContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TMapContext) MAP() antlr.TerminalNode {
+	return s.GetToken(FGGParserMAP, 0)
+}
+
+func (s *TMapContext) AllTyp() []ITypContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypContext); ok {
+			len++
+		}
+	}
 
-	for i, t := range ts {
-		if t != nil {
+	tst := make([]ITypContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypContext); ok {
 			tst[i] = t.(ITypContext)
+			i++
 		}
 	}
 
 	return tst
 }
 
-func (s *TypsContext) Typ(i int) ITypContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypContext)(nil)).Elem(), i)
+func (s *TMapContext) Typ(i int) ITypContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
 	if t == nil {
 		return nil
@@ -477,29 +863,24 @@ func (s *TypsContext) Typ(i int) ITypContext {
 	return t.(ITypContext)
 }
 
-func (s *TypsContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *TypsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-func (s *TypsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TMapContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterTyps(s)
+		listenerT.EnterTMap(s)
 	}
 }
 
-func (s *TypsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TMapContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitTyps(s)
+		listenerT.ExitTMap(s)
 	}
 }
 
-func (p *FGGParser) Typs() (localctx ITypsContext) {
-	localctx = NewTypsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 2, FGGParserRULE_typs)
+func (p *FGGParser) Typ() (localctx ITypContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 0, FGGParserRULE_typ)
 	var _la int
 
 	defer func() {
@@ -518,238 +899,259 @@ func (p *FGGParser) Typs() (localctx ITypsContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(47)
-		p.Typ()
-	}
-	p.SetState(52)
+	p.SetState(89)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == FGGParserT__2 {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewTypeNameContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(48)
-			p.Match(FGGParserT__2)
+			p.SetState(64)
+
+			var _m = p.Match(FGGParserNAME)
+
+			localctx.(*TypeNameContext).name = _m
 		}
 		{
-			p.SetState(49)
-			p.Typ()
+			p.SetState(65)
+			p.Match(FGGParserT__0)
 		}
-
-		p.SetState(54)
+		p.SetState(67)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
-	}
 
-	return localctx
-}
-
-// ITypeFormalsContext is an interface to support dynamic dispatch.
-type ITypeFormalsContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// IsTypeFormalsContext differentiates from other interfaces.
-	IsTypeFormalsContext()
-}
-
-type TypeFormalsContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyTypeFormalsContext() *TypeFormalsContext {
-	var p = new(TypeFormalsContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_typeFormals
-	return p
-}
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGGParserT__2)|(1<<FGGParserFUNC)|(1<<FGGParserINTERFACE)|(1<<FGGParserMAP)|(1<<FGGParserSTRUCT))) != 0) || (((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(FGGParserBOOL-38))|(1<<(FGGParserINT32-38))|(1<<(FGGParserINT64-38))|(1<<(FGGParserFLOAT32-38))|(1<<(FGGParserFLOAT64-38))|(1<<(FGGParserSTRING-38))|(1<<(FGGParserNAME-38)))) != 0) {
+			{
+				p.SetState(66)
+				p.Typs()
+			}
 
-func (*TypeFormalsContext) IsTypeFormalsContext() {}
+		}
+		{
+			p.SetState(69)
+			p.Match(FGGParserT__1)
+		}
 
-func NewTypeFormalsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeFormalsContext {
-	var p = new(TypeFormalsContext)
+	case 2:
+		localctx = NewTypeParamContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(70)
 
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+			var _m = p.Match(FGGParserNAME)
 
-	p.parser = parser
-	p.RuleIndex = FGGParserRULE_typeFormals
+			localctx.(*TypeParamContext).name = _m
+		}
 
-	return p
-}
+	case 3:
+		localctx = NewTPrimitiveContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(71)
 
-func (s *TypeFormalsContext) GetParser() antlr.Parser { return s.parser }
+			var _x = p.PrimName()
 
-func (s *TypeFormalsContext) TYPE() antlr.TerminalNode {
-	return s.GetToken(FGGParserTYPE, 0)
-}
+			localctx.(*TPrimitiveContext).name = _x
+		}
 
-func (s *TypeFormalsContext) TypeFDecls() ITypeFDeclsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeFDeclsContext)(nil)).Elem(), 0)
+	case 4:
+		localctx = NewTypeLit_Context(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(72)
+			p.TypeLit()
+		}
 
-	if t == nil {
-		return nil
-	}
+	case 5:
+		localctx = NewTFuncContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(73)
+			p.Match(FGGParserFUNC)
+		}
+		{
+			p.SetState(74)
+			p.Match(FGGParserT__0)
+		}
+		p.SetState(76)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-	return t.(ITypeFDeclsContext)
-}
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGGParserT__2)|(1<<FGGParserFUNC)|(1<<FGGParserINTERFACE)|(1<<FGGParserMAP)|(1<<FGGParserSTRUCT))) != 0) || (((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(FGGParserBOOL-38))|(1<<(FGGParserINT32-38))|(1<<(FGGParserINT64-38))|(1<<(FGGParserFLOAT32-38))|(1<<(FGGParserFLOAT64-38))|(1<<(FGGParserSTRING-38))|(1<<(FGGParserNAME-38)))) != 0) {
+			{
+				p.SetState(75)
+				p.Typs()
+			}
 
-func (s *TypeFormalsContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+		}
+		{
+			p.SetState(78)
+			p.Match(FGGParserT__1)
+		}
+		{
+			p.SetState(79)
+			p.Typ()
+		}
 
-func (s *TypeFormalsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+	case 6:
+		localctx = NewTSliceContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(80)
+			p.Match(FGGParserT__2)
+		}
+		{
+			p.SetState(81)
+			p.Match(FGGParserT__3)
+		}
+		{
+			p.SetState(82)
 
-func (s *TypeFormalsContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterTypeFormals(s)
-	}
-}
+			var _x = p.Typ()
 
-func (s *TypeFormalsContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitTypeFormals(s)
-	}
-}
+			localctx.(*TSliceContext).elem = _x
+		}
 
-func (p *FGGParser) TypeFormals() (localctx ITypeFormalsContext) {
-	localctx = NewTypeFormalsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 4, FGGParserRULE_typeFormals)
-	var _la int
+	case 7:
+		localctx = NewTMapContext(p, localctx)
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(83)
+			p.Match(FGGParserMAP)
+		}
+		{
+			p.SetState(84)
+			p.Match(FGGParserT__2)
+		}
+		{
+			p.SetState(85)
 
-	defer func() {
-		p.ExitRule()
-	}()
+			var _x = p.Typ()
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
+			localctx.(*TMapContext).key = _x
 		}
-	}()
+		{
+			p.SetState(86)
+			p.Match(FGGParserT__3)
+		}
+		{
+			p.SetState(87)
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(55)
-		p.Match(FGGParserT__0)
-	}
-	{
-		p.SetState(56)
-		p.Match(FGGParserTYPE)
-	}
-	p.SetState(58)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+			var _x = p.Typ()
 
-	if _la == FGGParserNAME {
-		{
-			p.SetState(57)
-			p.TypeFDecls()
+			localctx.(*TMapContext).elem = _x
 		}
 
 	}
-	{
-		p.SetState(60)
-		p.Match(FGGParserT__1)
-	}
 
 	return localctx
 }
 
-// ITypeFDeclsContext is an interface to support dynamic dispatch.
-type ITypeFDeclsContext interface {
+// ITypsContext is an interface to support dynamic dispatch.
+type ITypsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeFDeclsContext differentiates from other interfaces.
-	IsTypeFDeclsContext()
+	// IsTypsContext differentiates from other interfaces.
+	IsTypsContext()
 }
 
-type TypeFDeclsContext struct {
+type TypsContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeFDeclsContext() *TypeFDeclsContext {
-	var p = new(TypeFDeclsContext)
+func NewEmptyTypsContext() *TypsContext {
+	var p = new(TypsContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_typeFDecls
+	p.RuleIndex = FGGParserRULE_typs
 	return p
 }
 
-func (*TypeFDeclsContext) IsTypeFDeclsContext() {}
+func (*TypsContext) IsTypsContext() {}
 
-func NewTypeFDeclsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeFDeclsContext {
-	var p = new(TypeFDeclsContext)
+func NewTypsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypsContext {
+	var p = new(TypsContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_typeFDecls
+	p.RuleIndex = FGGParserRULE_typs
 
 	return p
 }
 
-func (s *TypeFDeclsContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeFDeclsContext) AllTypeFDecl() []ITypeFDeclContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITypeFDeclContext)(nil)).Elem())
-	var tst = make([]ITypeFDeclContext, len(ts))
+func (s *TypsContext) AllTyp() []ITypContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypContext); ok {
+			len++
+		}
+	}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(ITypeFDeclContext)
+	tst := make([]ITypContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypContext); ok {
+			tst[i] = t.(ITypContext)
+			i++
 		}
 	}
 
 	return tst
 }
 
-func (s *TypeFDeclsContext) TypeFDecl(i int) ITypeFDeclContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeFDeclContext)(nil)).Elem(), i)
+func (s *TypsContext) Typ(i int) ITypContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITypeFDeclContext)
+	return t.(ITypContext)
 }
 
-func (s *TypeFDeclsContext) GetRuleContext() antlr.RuleContext {
+func (s *TypsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeFDeclsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeFDeclsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterTypeFDecls(s)
+		listenerT.EnterTyps(s)
 	}
 }
 
-func (s *TypeFDeclsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitTypeFDecls(s)
+		listenerT.ExitTyps(s)
 	}
 }
 
-func (p *FGGParser) TypeFDecls() (localctx ITypeFDeclsContext) {
-	localctx = NewTypeFDeclsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 6, FGGParserRULE_typeFDecls)
+func (p *FGGParser) Typs() (localctx ITypsContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 2, FGGParserRULE_typs)
 	var _la int
 
 	defer func() {
@@ -770,24 +1172,24 @@ func (p *FGGParser) TypeFDecls() (localctx ITypeFDeclsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(62)
-		p.TypeFDecl()
+		p.SetState(91)
+		p.Typ()
 	}
-	p.SetState(67)
+	p.SetState(96)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == FGGParserT__2 {
+	for _la == FGGParserT__4 {
 		{
-			p.SetState(63)
-			p.Match(FGGParserT__2)
+			p.SetState(92)
+			p.Match(FGGParserT__4)
 		}
 		{
-			p.SetState(64)
-			p.TypeFDecl()
+			p.SetState(93)
+			p.Typ()
 		}
 
-		p.SetState(69)
+		p.SetState(98)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -795,81 +1197,95 @@ func (p *FGGParser) TypeFDecls() (localctx ITypeFDeclsContext) {
 	return localctx
 }
 
-// ITypeFDeclContext is an interface to support dynamic dispatch.
-type ITypeFDeclContext interface {
+// IPrimNameContext is an interface to support dynamic dispatch.
+type IPrimNameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeFDeclContext differentiates from other interfaces.
-	IsTypeFDeclContext()
+	// IsPrimNameContext differentiates from other interfaces.
+	IsPrimNameContext()
 }
 
-type TypeFDeclContext struct {
+type PrimNameContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeFDeclContext() *TypeFDeclContext {
-	var p = new(TypeFDeclContext)
+func NewEmptyPrimNameContext() *PrimNameContext {
+	var p = new(PrimNameContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_typeFDecl
+	p.RuleIndex = FGGParserRULE_primName
 	return p
 }
 
-func (*TypeFDeclContext) IsTypeFDeclContext() {}
+func (*PrimNameContext) IsPrimNameContext() {}
 
-func NewTypeFDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeFDeclContext {
-	var p = new(TypeFDeclContext)
+func NewPrimNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimNameContext {
+	var p = new(PrimNameContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_typeFDecl
+	p.RuleIndex = FGGParserRULE_primName
 
 	return p
 }
 
-func (s *TypeFDeclContext) GetParser() antlr.Parser { return s.parser }
+func (s *PrimNameContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeFDeclContext) NAME() antlr.TerminalNode {
-	return s.GetToken(FGGParserNAME, 0)
+func (s *PrimNameContext) BOOL() antlr.TerminalNode {
+	return s.GetToken(FGGParserBOOL, 0)
 }
 
-func (s *TypeFDeclContext) Typ() ITypContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypContext)(nil)).Elem(), 0)
+func (s *PrimNameContext) INT32() antlr.TerminalNode {
+	return s.GetToken(FGGParserINT32, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *PrimNameContext) INT64() antlr.TerminalNode {
+	return s.GetToken(FGGParserINT64, 0)
+}
 
-	return t.(ITypContext)
+func (s *PrimNameContext) FLOAT32() antlr.TerminalNode {
+	return s.GetToken(FGGParserFLOAT32, 0)
 }
 
-func (s *TypeFDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *PrimNameContext) FLOAT64() antlr.TerminalNode {
+	return s.GetToken(FGGParserFLOAT64, 0)
+}
+
+func (s *PrimNameContext) STRING() antlr.TerminalNode {
+	return s.GetToken(FGGParserSTRING, 0)
+}
+
+func (s *PrimNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeFDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PrimNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeFDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrimNameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterTypeFDecl(s)
+		listenerT.EnterPrimName(s)
 	}
 }
 
-func (s *TypeFDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrimNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitTypeFDecl(s)
+		listenerT.ExitPrimName(s)
 	}
 }
 
-func (p *FGGParser) TypeFDecl() (localctx ITypeFDeclContext) {
-	localctx = NewTypeFDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, FGGParserRULE_typeFDecl)
+func (p *FGGParser) PrimName() (localctx IPrimNameContext) {
+	this := p
+	_ = this
+
+	localctx = NewPrimNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 4, FGGParserRULE_primName)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -889,134 +1305,192 @@ func (p *FGGParser) TypeFDecl() (localctx ITypeFDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(70)
-		p.Match(FGGParserNAME)
-	}
-	{
-		p.SetState(71)
-		p.Typ()
+		p.SetState(99)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(FGGParserBOOL-38))|(1<<(FGGParserINT32-38))|(1<<(FGGParserINT64-38))|(1<<(FGGParserFLOAT32-38))|(1<<(FGGParserFLOAT64-38))|(1<<(FGGParserSTRING-38)))) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
 	}
 
 	return localctx
 }
 
-// IProgramContext is an interface to support dynamic dispatch.
-type IProgramContext interface {
+// ITypeLitContext is an interface to support dynamic dispatch.
+type ITypeLitContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsProgramContext differentiates from other interfaces.
-	IsProgramContext()
+	// IsTypeLitContext differentiates from other interfaces.
+	IsTypeLitContext()
 }
 
-type ProgramContext struct {
+type TypeLitContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyProgramContext() *ProgramContext {
-	var p = new(ProgramContext)
+func NewEmptyTypeLitContext() *TypeLitContext {
+	var p = new(TypeLitContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_program
+	p.RuleIndex = FGGParserRULE_typeLit
 	return p
 }
 
-func (*ProgramContext) IsProgramContext() {}
+func (*TypeLitContext) IsTypeLitContext() {}
 
-func NewProgramContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ProgramContext {
-	var p = new(ProgramContext)
+func NewTypeLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeLitContext {
+	var p = new(TypeLitContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_program
+	p.RuleIndex = FGGParserRULE_typeLit
 
 	return p
 }
 
-func (s *ProgramContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeLitContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ProgramContext) PACKAGE() antlr.TerminalNode {
-	return s.GetToken(FGGParserPACKAGE, 0)
+func (s *TypeLitContext) CopyFrom(ctx *TypeLitContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
 }
 
-func (s *ProgramContext) AllMAIN() []antlr.TerminalNode {
-	return s.GetTokens(FGGParserMAIN)
+func (s *TypeLitContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *ProgramContext) MAIN(i int) antlr.TerminalNode {
-	return s.GetToken(FGGParserMAIN, i)
+func (s *TypeLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ProgramContext) FUNC() antlr.TerminalNode {
-	return s.GetToken(FGGParserFUNC, 0)
+type StructTypeLitContext struct {
+	*TypeLitContext
 }
 
-func (s *ProgramContext) EOF() antlr.TerminalNode {
-	return s.GetToken(FGGParserEOF, 0)
+func NewStructTypeLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StructTypeLitContext {
+	var p = new(StructTypeLitContext)
+
+	p.TypeLitContext = NewEmptyTypeLitContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypeLitContext))
+
+	return p
 }
 
-func (s *ProgramContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *StructTypeLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StructTypeLitContext) STRUCT() antlr.TerminalNode {
+	return s.GetToken(FGGParserSTRUCT, 0)
+}
+
+func (s *StructTypeLitContext) FieldDecls() IFieldDeclsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldDeclsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(IFieldDeclsContext)
 }
 
-func (s *ProgramContext) FMT() antlr.TerminalNode {
-	return s.GetToken(FGGParserFMT, 0)
+func (s *StructTypeLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterStructTypeLit(s)
+	}
 }
 
-func (s *ProgramContext) PRINTF() antlr.TerminalNode {
-	return s.GetToken(FGGParserPRINTF, 0)
+func (s *StructTypeLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitStructTypeLit(s)
+	}
 }
 
-func (s *ProgramContext) IMPORT() antlr.TerminalNode {
-	return s.GetToken(FGGParserIMPORT, 0)
+type InterfaceTypeLitContext struct {
+	*TypeLitContext
 }
 
-func (s *ProgramContext) STRING() antlr.TerminalNode {
-	return s.GetToken(FGGParserSTRING, 0)
+func NewInterfaceTypeLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InterfaceTypeLitContext {
+	var p = new(InterfaceTypeLitContext)
+
+	p.TypeLitContext = NewEmptyTypeLitContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypeLitContext))
+
+	return p
 }
 
-func (s *ProgramContext) Decls() IDeclsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDeclsContext)(nil)).Elem(), 0)
+func (s *InterfaceTypeLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *InterfaceTypeLitContext) INTERFACE() antlr.TerminalNode {
+	return s.GetToken(FGGParserINTERFACE, 0)
+}
+
+func (s *InterfaceTypeLitContext) TypeList() ITypeListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IDeclsContext)
+	return t.(ITypeListContext)
 }
 
-func (s *ProgramContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *InterfaceTypeLitContext) Specs() ISpecsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISpecsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *ProgramContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISpecsContext)
 }
 
-func (s *ProgramContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *InterfaceTypeLitContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterProgram(s)
+		listenerT.EnterInterfaceTypeLit(s)
 	}
 }
 
-func (s *ProgramContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *InterfaceTypeLitContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitProgram(s)
+		listenerT.ExitInterfaceTypeLit(s)
 	}
 }
 
-func (p *FGGParser) Program() (localctx IProgramContext) {
-	localctx = NewProgramContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, FGGParserRULE_program)
+func (p *FGGParser) TypeLit() (localctx ITypeLitContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeLitContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 6, FGGParserRULE_typeLit)
 	var _la int
 
 	defer func() {
@@ -1035,242 +1509,178 @@ func (p *FGGParser) Program() (localctx IProgramContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(73)
-		p.Match(FGGParserPACKAGE)
-	}
-	{
-		p.SetState(74)
-		p.Match(FGGParserMAIN)
-	}
-	{
-		p.SetState(75)
-		p.Match(FGGParserT__3)
-	}
-	p.SetState(79)
+	p.SetState(119)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == FGGParserIMPORT {
-		{
-			p.SetState(76)
-			p.Match(FGGParserIMPORT)
-		}
-		{
-			p.SetState(77)
-			p.Match(FGGParserSTRING)
-		}
+	switch p.GetTokenStream().LA(1) {
+	case FGGParserSTRUCT:
+		localctx = NewStructTypeLitContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(78)
-			p.Match(FGGParserT__3)
+			p.SetState(101)
+			p.Match(FGGParserSTRUCT)
 		}
-
-	}
-	p.SetState(82)
-	p.GetErrorHandler().Sync(p)
-
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(81)
-			p.Decls()
+			p.SetState(102)
+			p.Match(FGGParserT__5)
 		}
+		p.SetState(104)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-	}
-	{
-		p.SetState(84)
-		p.Match(FGGParserFUNC)
-	}
-	{
-		p.SetState(85)
-		p.Match(FGGParserMAIN)
-	}
-	{
-		p.SetState(86)
-		p.Match(FGGParserT__0)
-	}
-	{
-		p.SetState(87)
-		p.Match(FGGParserT__1)
-	}
-	{
-		p.SetState(88)
-		p.Match(FGGParserT__4)
-	}
-	p.SetState(101)
-	p.GetErrorHandler().Sync(p)
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGGParserT__2)|(1<<FGGParserFUNC)|(1<<FGGParserINTERFACE)|(1<<FGGParserMAP)|(1<<FGGParserSTRUCT))) != 0) || (((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(FGGParserBOOL-38))|(1<<(FGGParserINT32-38))|(1<<(FGGParserINT64-38))|(1<<(FGGParserFLOAT32-38))|(1<<(FGGParserFLOAT64-38))|(1<<(FGGParserSTRING-38))|(1<<(FGGParserNAME-38)))) != 0) {
+			{
+				p.SetState(103)
+				p.FieldDecls()
+			}
 
-	switch p.GetTokenStream().LA(1) {
-	case FGGParserT__5:
-		{
-			p.SetState(89)
-			p.Match(FGGParserT__5)
 		}
 		{
-			p.SetState(90)
+			p.SetState(106)
 			p.Match(FGGParserT__6)
 		}
-		{
-			p.SetState(91)
-			p.expr(0)
-		}
 
-	case FGGParserFMT:
-		{
-			p.SetState(92)
-			p.Match(FGGParserFMT)
-		}
-		{
-			p.SetState(93)
-			p.Match(FGGParserT__7)
-		}
-		{
-			p.SetState(94)
-			p.Match(FGGParserPRINTF)
-		}
+	case FGGParserINTERFACE:
+		localctx = NewInterfaceTypeLitContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(95)
-			p.Match(FGGParserT__0)
+			p.SetState(107)
+			p.Match(FGGParserINTERFACE)
 		}
 		{
-			p.SetState(96)
-			p.Match(FGGParserT__8)
+			p.SetState(108)
+			p.Match(FGGParserT__5)
 		}
-		{
-			p.SetState(97)
-			p.Match(FGGParserT__2)
+		p.SetState(113)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == FGGParserTYPE {
+			{
+				p.SetState(109)
+				p.TypeList()
+			}
+			p.SetState(111)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == FGGParserT__7 {
+				{
+					p.SetState(110)
+					p.Match(FGGParserT__7)
+				}
+
+			}
+
 		}
-		{
-			p.SetState(98)
-			p.expr(0)
+		p.SetState(116)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGGParserT__2)|(1<<FGGParserFUNC)|(1<<FGGParserINTERFACE)|(1<<FGGParserMAP)|(1<<FGGParserSTRUCT))) != 0) || (((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(FGGParserBOOL-38))|(1<<(FGGParserINT32-38))|(1<<(FGGParserINT64-38))|(1<<(FGGParserFLOAT32-38))|(1<<(FGGParserFLOAT64-38))|(1<<(FGGParserSTRING-38))|(1<<(FGGParserTILDE-38))|(1<<(FGGParserNAME-38)))) != 0) {
+			{
+				p.SetState(115)
+				p.Specs()
+			}
+
 		}
 		{
-			p.SetState(99)
-			p.Match(FGGParserT__1)
+			p.SetState(118)
+			p.Match(FGGParserT__6)
 		}
 
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	{
-		p.SetState(103)
-		p.Match(FGGParserT__9)
-	}
-	{
-		p.SetState(104)
-		p.Match(FGGParserEOF)
-	}
 
 	return localctx
 }
 
-// IDeclsContext is an interface to support dynamic dispatch.
-type IDeclsContext interface {
+// ITypeFormalsContext is an interface to support dynamic dispatch.
+type ITypeFormalsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDeclsContext differentiates from other interfaces.
-	IsDeclsContext()
+	// IsTypeFormalsContext differentiates from other interfaces.
+	IsTypeFormalsContext()
 }
 
-type DeclsContext struct {
+type TypeFormalsContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDeclsContext() *DeclsContext {
-	var p = new(DeclsContext)
+func NewEmptyTypeFormalsContext() *TypeFormalsContext {
+	var p = new(TypeFormalsContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_decls
+	p.RuleIndex = FGGParserRULE_typeFormals
 	return p
 }
 
-func (*DeclsContext) IsDeclsContext() {}
+func (*TypeFormalsContext) IsTypeFormalsContext() {}
 
-func NewDeclsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeclsContext {
-	var p = new(DeclsContext)
+func NewTypeFormalsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeFormalsContext {
+	var p = new(TypeFormalsContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_decls
+	p.RuleIndex = FGGParserRULE_typeFormals
 
 	return p
 }
 
-func (s *DeclsContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *DeclsContext) AllTypeDecl() []ITypeDeclContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITypeDeclContext)(nil)).Elem())
-	var tst = make([]ITypeDeclContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(ITypeDeclContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *DeclsContext) TypeDecl(i int) ITypeDeclContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeDeclContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
+func (s *TypeFormalsContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(ITypeDeclContext)
+func (s *TypeFormalsContext) TYPE() antlr.TerminalNode {
+	return s.GetToken(FGGParserTYPE, 0)
 }
 
-func (s *DeclsContext) AllMethDecl() []IMethDeclContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMethDeclContext)(nil)).Elem())
-	var tst = make([]IMethDeclContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IMethDeclContext)
+func (s *TypeFormalsContext) TypeFDecls() ITypeFDeclsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeFDeclsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	return tst
-}
-
-func (s *DeclsContext) MethDecl(i int) IMethDeclContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMethDeclContext)(nil)).Elem(), i)
-
 	if t == nil {
 		return nil
 	}
 
-	return t.(IMethDeclContext)
+	return t.(ITypeFDeclsContext)
 }
 
-func (s *DeclsContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeFormalsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DeclsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeFormalsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DeclsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeFormalsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterDecls(s)
+		listenerT.EnterTypeFormals(s)
 	}
 }
 
-func (s *DeclsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeFormalsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitDecls(s)
+		listenerT.ExitTypeFormals(s)
 	}
 }
 
-func (p *FGGParser) Decls() (localctx IDeclsContext) {
-	localctx = NewDeclsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, FGGParserRULE_decls)
+func (p *FGGParser) TypeFormals() (localctx ITypeFormalsContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeFormalsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 8, FGGParserRULE_typeFormals)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -1288,140 +1698,140 @@ func (p *FGGParser) Decls() (localctx IDeclsContext) {
 		}
 	}()
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(112)
+	{
+		p.SetState(121)
+		p.Match(FGGParserT__0)
+	}
+	{
+		p.SetState(122)
+		p.Match(FGGParserTYPE)
+	}
+	p.SetState(124)
 	p.GetErrorHandler().Sync(p)
-	_alt = 1
-	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		switch _alt {
-		case 1:
-			p.SetState(108)
-			p.GetErrorHandler().Sync(p)
-
-			switch p.GetTokenStream().LA(1) {
-			case FGGParserTYPE:
-				{
-					p.SetState(106)
-					p.TypeDecl()
-				}
-
-			case FGGParserFUNC:
-				{
-					p.SetState(107)
-					p.MethDecl()
-				}
-
-			default:
-				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			}
-			{
-				p.SetState(110)
-				p.Match(FGGParserT__3)
-			}
+	_la = p.GetTokenStream().LA(1)
 
-		default:
-			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	if _la == FGGParserNAME {
+		{
+			p.SetState(123)
+			p.TypeFDecls()
 		}
 
-		p.SetState(114)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext())
+	}
+	{
+		p.SetState(126)
+		p.Match(FGGParserT__1)
 	}
 
 	return localctx
 }
 
-// ITypeDeclContext is an interface to support dynamic dispatch.
-type ITypeDeclContext interface {
+// ITypeFDeclsContext is an interface to support dynamic dispatch.
+type ITypeFDeclsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeDeclContext differentiates from other interfaces.
-	IsTypeDeclContext()
+	// IsTypeFDeclsContext differentiates from other interfaces.
+	IsTypeFDeclsContext()
 }
 
-type TypeDeclContext struct {
+type TypeFDeclsContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeDeclContext() *TypeDeclContext {
-	var p = new(TypeDeclContext)
+func NewEmptyTypeFDeclsContext() *TypeFDeclsContext {
+	var p = new(TypeFDeclsContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_typeDecl
+	p.RuleIndex = FGGParserRULE_typeFDecls
 	return p
 }
 
-func (*TypeDeclContext) IsTypeDeclContext() {}
+func (*TypeFDeclsContext) IsTypeFDeclsContext() {}
 
-func NewTypeDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeDeclContext {
-	var p = new(TypeDeclContext)
+func NewTypeFDeclsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeFDeclsContext {
+	var p = new(TypeFDeclsContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_typeDecl
+	p.RuleIndex = FGGParserRULE_typeFDecls
 
 	return p
 }
 
-func (s *TypeDeclContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TypeDeclContext) TYPE() antlr.TerminalNode {
-	return s.GetToken(FGGParserTYPE, 0)
-}
-
-func (s *TypeDeclContext) NAME() antlr.TerminalNode {
-	return s.GetToken(FGGParserNAME, 0)
-}
+func (s *TypeFDeclsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeDeclContext) TypeFormals() ITypeFormalsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeFormalsContext)(nil)).Elem(), 0)
+func (s *TypeFDeclsContext) AllTypeFDecl() []ITypeFDeclContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypeFDeclContext); ok {
+			len++
+		}
+	}
 
-	if t == nil {
-		return nil
+	tst := make([]ITypeFDeclContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeFDeclContext); ok {
+			tst[i] = t.(ITypeFDeclContext)
+			i++
+		}
 	}
 
-	return t.(ITypeFormalsContext)
+	return tst
 }
 
-func (s *TypeDeclContext) TypeLit() ITypeLitContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeLitContext)(nil)).Elem(), 0)
+func (s *TypeFDeclsContext) TypeFDecl(i int) ITypeFDeclContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeFDeclContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITypeLitContext)
+	return t.(ITypeFDeclContext)
 }
 
-func (s *TypeDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeFDeclsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeFDeclsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeFDeclsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterTypeDecl(s)
+		listenerT.EnterTypeFDecls(s)
 	}
 }
 
-func (s *TypeDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeFDeclsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitTypeDecl(s)
+		listenerT.ExitTypeFDecls(s)
 	}
 }
 
-func (p *FGGParser) TypeDecl() (localctx ITypeDeclContext) {
-	localctx = NewTypeDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, FGGParserRULE_typeDecl)
+func (p *FGGParser) TypeFDecls() (localctx ITypeFDeclsContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeFDeclsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 10, FGGParserRULE_typeFDecls)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -1441,154 +1851,115 @@ func (p *FGGParser) TypeDecl() (localctx ITypeDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(116)
-		p.Match(FGGParserTYPE)
-	}
-	{
-		p.SetState(117)
-		p.Match(FGGParserNAME)
-	}
-	{
-		p.SetState(118)
-		p.TypeFormals()
+		p.SetState(128)
+		p.TypeFDecl()
 	}
-	{
-		p.SetState(119)
-		p.TypeLit()
+	p.SetState(133)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FGGParserT__4 {
+		{
+			p.SetState(129)
+			p.Match(FGGParserT__4)
+		}
+		{
+			p.SetState(130)
+			p.TypeFDecl()
+		}
+
+		p.SetState(135)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// IMethDeclContext is an interface to support dynamic dispatch.
-type IMethDeclContext interface {
+// ITypeFDeclContext is an interface to support dynamic dispatch.
+type ITypeFDeclContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetRecv returns the recv token.
-	GetRecv() antlr.Token
-
-	// GetTypn returns the typn token.
-	GetTypn() antlr.Token
-
-	// SetRecv sets the recv token.
-	SetRecv(antlr.Token)
-
-	// SetTypn sets the typn token.
-	SetTypn(antlr.Token)
-
-	// IsMethDeclContext differentiates from other interfaces.
-	IsMethDeclContext()
+	// IsTypeFDeclContext differentiates from other interfaces.
+	IsTypeFDeclContext()
 }
 
-type MethDeclContext struct {
+type TypeFDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
-	recv   antlr.Token
-	typn   antlr.Token
 }
 
-func NewEmptyMethDeclContext() *MethDeclContext {
-	var p = new(MethDeclContext)
+func NewEmptyTypeFDeclContext() *TypeFDeclContext {
+	var p = new(TypeFDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_methDecl
+	p.RuleIndex = FGGParserRULE_typeFDecl
 	return p
 }
 
-func (*MethDeclContext) IsMethDeclContext() {}
+func (*TypeFDeclContext) IsTypeFDeclContext() {}
 
-func NewMethDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MethDeclContext {
-	var p = new(MethDeclContext)
+func NewTypeFDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeFDeclContext {
+	var p = new(TypeFDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_methDecl
+	p.RuleIndex = FGGParserRULE_typeFDecl
 
 	return p
 }
 
-func (s *MethDeclContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *MethDeclContext) GetRecv() antlr.Token { return s.recv }
-
-func (s *MethDeclContext) GetTypn() antlr.Token { return s.typn }
-
-func (s *MethDeclContext) SetRecv(v antlr.Token) { s.recv = v }
-
-func (s *MethDeclContext) SetTypn(v antlr.Token) { s.typn = v }
+func (s *TypeFDeclContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MethDeclContext) FUNC() antlr.TerminalNode {
-	return s.GetToken(FGGParserFUNC, 0)
+func (s *TypeFDeclContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGGParserNAME, 0)
 }
 
-func (s *MethDeclContext) TypeFormals() ITypeFormalsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeFormalsContext)(nil)).Elem(), 0)
+func (s *TypeFDeclContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITypeFormalsContext)
+	return t.(ITypContext)
 }
 
-func (s *MethDeclContext) Sig() ISigContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISigContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISigContext)
+func (s *TypeFDeclContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *MethDeclContext) RETURN() antlr.TerminalNode {
-	return s.GetToken(FGGParserRETURN, 0)
+func (s *TypeFDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MethDeclContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExprContext)
-}
-
-func (s *MethDeclContext) AllNAME() []antlr.TerminalNode {
-	return s.GetTokens(FGGParserNAME)
-}
-
-func (s *MethDeclContext) NAME(i int) antlr.TerminalNode {
-	return s.GetToken(FGGParserNAME, i)
-}
-
-func (s *MethDeclContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *MethDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-func (s *MethDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeFDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterMethDecl(s)
+		listenerT.EnterTypeFDecl(s)
 	}
 }
 
-func (s *MethDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeFDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitMethDecl(s)
+		listenerT.ExitTypeFDecl(s)
 	}
 }
 
-func (p *FGGParser) MethDecl() (localctx IMethDeclContext) {
-	localctx = NewMethDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, FGGParserRULE_methDecl)
+func (p *FGGParser) TypeFDecl() (localctx ITypeFDeclContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeFDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 12, FGGParserRULE_typeFDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -1608,200 +1979,149 @@ func (p *FGGParser) MethDecl() (localctx IMethDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(121)
-		p.Match(FGGParserFUNC)
-	}
-	{
-		p.SetState(122)
-		p.Match(FGGParserT__0)
-	}
-	{
-		p.SetState(123)
-
-		var _m = p.Match(FGGParserNAME)
-
-		localctx.(*MethDeclContext).recv = _m
-	}
-	{
-		p.SetState(124)
-
-		var _m = p.Match(FGGParserNAME)
-
-		localctx.(*MethDeclContext).typn = _m
-	}
-	{
-		p.SetState(125)
-		p.TypeFormals()
-	}
-	{
-		p.SetState(126)
-		p.Match(FGGParserT__1)
-	}
-	{
-		p.SetState(127)
-		p.Sig()
-	}
-	{
-		p.SetState(128)
-		p.Match(FGGParserT__4)
-	}
-	{
-		p.SetState(129)
-		p.Match(FGGParserRETURN)
-	}
-	{
-		p.SetState(130)
-		p.expr(0)
+		p.SetState(136)
+		p.Match(FGGParserNAME)
 	}
 	{
-		p.SetState(131)
-		p.Match(FGGParserT__9)
+		p.SetState(137)
+		p.Typ()
 	}
 
 	return localctx
 }
 
-// ITypeLitContext is an interface to support dynamic dispatch.
-type ITypeLitContext interface {
+// IProgramContext is an interface to support dynamic dispatch.
+type IProgramContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeLitContext differentiates from other interfaces.
-	IsTypeLitContext()
+	// IsProgramContext differentiates from other interfaces.
+	IsProgramContext()
 }
 
-type TypeLitContext struct {
+type ProgramContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeLitContext() *TypeLitContext {
-	var p = new(TypeLitContext)
+func NewEmptyProgramContext() *ProgramContext {
+	var p = new(ProgramContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_typeLit
+	p.RuleIndex = FGGParserRULE_program
 	return p
 }
 
-func (*TypeLitContext) IsTypeLitContext() {}
+func (*ProgramContext) IsProgramContext() {}
 
-func NewTypeLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeLitContext {
-	var p = new(TypeLitContext)
+func NewProgramContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ProgramContext {
+	var p = new(ProgramContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_typeLit
+	p.RuleIndex = FGGParserRULE_program
 
 	return p
 }
 
-func (s *TypeLitContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TypeLitContext) CopyFrom(ctx *TypeLitContext) {
-	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
-}
-
-func (s *TypeLitContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *ProgramContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *ProgramContext) PACKAGE() antlr.TerminalNode {
+	return s.GetToken(FGGParserPACKAGE, 0)
 }
 
-type StructTypeLitContext struct {
-	*TypeLitContext
+func (s *ProgramContext) AllMAIN() []antlr.TerminalNode {
+	return s.GetTokens(FGGParserMAIN)
 }
 
-func NewStructTypeLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StructTypeLitContext {
-	var p = new(StructTypeLitContext)
-
-	p.TypeLitContext = NewEmptyTypeLitContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*TypeLitContext))
-
-	return p
+func (s *ProgramContext) MAIN(i int) antlr.TerminalNode {
+	return s.GetToken(FGGParserMAIN, i)
 }
 
-func (s *StructTypeLitContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *ProgramContext) FUNC() antlr.TerminalNode {
+	return s.GetToken(FGGParserFUNC, 0)
 }
 
-func (s *StructTypeLitContext) STRUCT() antlr.TerminalNode {
-	return s.GetToken(FGGParserSTRUCT, 0)
+func (s *ProgramContext) EOF() antlr.TerminalNode {
+	return s.GetToken(FGGParserEOF, 0)
 }
 
-func (s *StructTypeLitContext) FieldDecls() IFieldDeclsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFieldDeclsContext)(nil)).Elem(), 0)
+func (s *ProgramContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IFieldDeclsContext)
-}
-
-func (s *StructTypeLitContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterStructTypeLit(s)
-	}
-}
-
-func (s *StructTypeLitContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitStructTypeLit(s)
-	}
+	return t.(IExprContext)
 }
 
-type InterfaceTypeLitContext struct {
-	*TypeLitContext
+func (s *ProgramContext) FMT() antlr.TerminalNode {
+	return s.GetToken(FGGParserFMT, 0)
 }
 
-func NewInterfaceTypeLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InterfaceTypeLitContext {
-	var p = new(InterfaceTypeLitContext)
-
-	p.TypeLitContext = NewEmptyTypeLitContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*TypeLitContext))
-
-	return p
+func (s *ProgramContext) PRINTF() antlr.TerminalNode {
+	return s.GetToken(FGGParserPRINTF, 0)
 }
 
-func (s *InterfaceTypeLitContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *ProgramContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(FGGParserIMPORT, 0)
 }
 
-func (s *InterfaceTypeLitContext) INTERFACE() antlr.TerminalNode {
-	return s.GetToken(FGGParserINTERFACE, 0)
+func (s *ProgramContext) STRING_LIT() antlr.TerminalNode {
+	return s.GetToken(FGGParserSTRING_LIT, 0)
 }
 
-func (s *InterfaceTypeLitContext) Specs() ISpecsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISpecsContext)(nil)).Elem(), 0)
+func (s *ProgramContext) Decls() IDeclsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDeclsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISpecsContext)
+	return t.(IDeclsContext)
 }
 
-func (s *InterfaceTypeLitContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ProgramContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ProgramContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ProgramContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterInterfaceTypeLit(s)
+		listenerT.EnterProgram(s)
 	}
 }
 
-func (s *InterfaceTypeLitContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ProgramContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitInterfaceTypeLit(s)
+		listenerT.ExitProgram(s)
 	}
 }
 
-func (p *FGGParser) TypeLit() (localctx ITypeLitContext) {
-	localctx = NewTypeLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, FGGParserRULE_typeLit)
+func (p *FGGParser) Program() (localctx IProgramContext) {
+	this := p
+	_ = this
+
+	localctx = NewProgramContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, FGGParserRULE_program)
 	var _la int
 
 	defer func() {
@@ -1820,156 +2140,322 @@ func (p *FGGParser) TypeLit() (localctx ITypeLitContext) {
 		}
 	}()
 
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(139)
+		p.Match(FGGParserPACKAGE)
+	}
+	{
+		p.SetState(140)
+		p.Match(FGGParserMAIN)
+	}
+	{
+		p.SetState(141)
+		p.Match(FGGParserT__7)
+	}
 	p.SetState(145)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case FGGParserSTRUCT:
-		localctx = NewStructTypeLitContext(p, localctx)
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(133)
-			p.Match(FGGParserSTRUCT)
-		}
-		{
-			p.SetState(134)
-			p.Match(FGGParserT__4)
-		}
-		p.SetState(136)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == FGGParserNAME {
-			{
-				p.SetState(135)
-				p.FieldDecls()
-			}
-
-		}
+	if _la == FGGParserIMPORT {
 		{
-			p.SetState(138)
-			p.Match(FGGParserT__9)
+			p.SetState(142)
+			p.Match(FGGParserIMPORT)
 		}
-
-	case FGGParserINTERFACE:
-		localctx = NewInterfaceTypeLitContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(139)
-			p.Match(FGGParserINTERFACE)
+			p.SetState(143)
+			p.Match(FGGParserSTRING_LIT)
 		}
 		{
-			p.SetState(140)
-			p.Match(FGGParserT__4)
+			p.SetState(144)
+			p.Match(FGGParserT__7)
 		}
-		p.SetState(142)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
 
-		if _la == FGGParserNAME {
-			{
-				p.SetState(141)
-				p.Specs()
-			}
+	}
+	p.SetState(148)
+	p.GetErrorHandler().Sync(p)
 
-		}
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 12, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(144)
-			p.Match(FGGParserT__9)
+			p.SetState(147)
+			p.Decls()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(150)
+		p.Match(FGGParserFUNC)
+	}
+	{
+		p.SetState(151)
+		p.Match(FGGParserMAIN)
+	}
+	{
+		p.SetState(152)
+		p.Match(FGGParserT__0)
+	}
+	{
+		p.SetState(153)
+		p.Match(FGGParserT__1)
+	}
+	{
+		p.SetState(154)
+		p.Match(FGGParserT__5)
+	}
+	p.SetState(167)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case FGGParserT__8:
+		{
+			p.SetState(155)
+			p.Match(FGGParserT__8)
+		}
+		{
+			p.SetState(156)
+			p.Match(FGGParserT__9)
+		}
+		{
+			p.SetState(157)
+			p.expr(0)
+		}
+
+	case FGGParserFMT:
+		{
+			p.SetState(158)
+			p.Match(FGGParserFMT)
+		}
+		{
+			p.SetState(159)
+			p.Match(FGGParserT__10)
+		}
+		{
+			p.SetState(160)
+			p.Match(FGGParserPRINTF)
+		}
+		{
+			p.SetState(161)
+			p.Match(FGGParserT__0)
+		}
+		{
+			p.SetState(162)
+			p.Match(FGGParserT__11)
+		}
+		{
+			p.SetState(163)
+			p.Match(FGGParserT__4)
+		}
+		{
+			p.SetState(164)
+			p.expr(0)
+		}
+		{
+			p.SetState(165)
+			p.Match(FGGParserT__1)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(169)
+		p.Match(FGGParserT__6)
+	}
+	{
+		p.SetState(170)
+		p.Match(FGGParserEOF)
 	}
 
 	return localctx
 }
 
-// IFieldDeclsContext is an interface to support dynamic dispatch.
-type IFieldDeclsContext interface {
+// IDeclsContext is an interface to support dynamic dispatch.
+type IDeclsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFieldDeclsContext differentiates from other interfaces.
-	IsFieldDeclsContext()
+	// IsDeclsContext differentiates from other interfaces.
+	IsDeclsContext()
 }
 
-type FieldDeclsContext struct {
+type DeclsContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFieldDeclsContext() *FieldDeclsContext {
-	var p = new(FieldDeclsContext)
+func NewEmptyDeclsContext() *DeclsContext {
+	var p = new(DeclsContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_fieldDecls
+	p.RuleIndex = FGGParserRULE_decls
 	return p
 }
 
-func (*FieldDeclsContext) IsFieldDeclsContext() {}
+func (*DeclsContext) IsDeclsContext() {}
 
-func NewFieldDeclsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldDeclsContext {
-	var p = new(FieldDeclsContext)
+func NewDeclsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeclsContext {
+	var p = new(DeclsContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_fieldDecls
+	p.RuleIndex = FGGParserRULE_decls
 
 	return p
 }
 
-func (s *FieldDeclsContext) GetParser() antlr.Parser { return s.parser }
+func (s *DeclsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FieldDeclsContext) AllFieldDecl() []IFieldDeclContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IFieldDeclContext)(nil)).Elem())
-	var tst = make([]IFieldDeclContext, len(ts))
+func (s *DeclsContext) AllTypeDecl() []ITypeDeclContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypeDeclContext); ok {
+			len++
+		}
+	}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IFieldDeclContext)
+	tst := make([]ITypeDeclContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeDeclContext); ok {
+			tst[i] = t.(ITypeDeclContext)
+			i++
 		}
 	}
 
 	return tst
 }
 
-func (s *FieldDeclsContext) FieldDecl(i int) IFieldDeclContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFieldDeclContext)(nil)).Elem(), i)
+func (s *DeclsContext) TypeDecl(i int) ITypeDeclContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeDeclContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IFieldDeclContext)
+	return t.(ITypeDeclContext)
 }
 
-func (s *FieldDeclsContext) GetRuleContext() antlr.RuleContext {
+func (s *DeclsContext) AllMethDecl() []IMethDeclContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMethDeclContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMethDeclContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMethDeclContext); ok {
+			tst[i] = t.(IMethDeclContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *DeclsContext) MethDecl(i int) IMethDeclContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMethDeclContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMethDeclContext)
+}
+
+func (s *DeclsContext) AllFuncDecl() []IFuncDeclContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFuncDeclContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFuncDeclContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFuncDeclContext); ok {
+			tst[i] = t.(IFuncDeclContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *DeclsContext) FuncDecl(i int) IFuncDeclContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFuncDeclContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFuncDeclContext)
+}
+
+func (s *DeclsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FieldDeclsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DeclsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FieldDeclsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DeclsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterFieldDecls(s)
+		listenerT.EnterDecls(s)
 	}
 }
 
-func (s *FieldDeclsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DeclsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitFieldDecls(s)
+		listenerT.ExitDecls(s)
 	}
 }
 
-func (p *FGGParser) FieldDecls() (localctx IFieldDeclsContext) {
-	localctx = NewFieldDeclsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, FGGParserRULE_fieldDecls)
-	var _la int
+func (p *FGGParser) Decls() (localctx IDeclsContext) {
+	this := p
+	_ = this
+
+	localctx = NewDeclsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, FGGParserRULE_decls)
 
 	defer func() {
 		p.ExitRule()
@@ -1987,84 +2473,131 @@ func (p *FGGParser) FieldDecls() (localctx IFieldDeclsContext) {
 		}
 	}()
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(147)
-		p.FieldDecl()
-	}
-	p.SetState(152)
+	p.SetState(179)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+	_alt = 1
+	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		switch _alt {
+		case 1:
+			p.SetState(175)
+			p.GetErrorHandler().Sync(p)
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 14, p.GetParserRuleContext()) {
+			case 1:
+				{
+					p.SetState(172)
+					p.TypeDecl()
+				}
 
-	for _la == FGGParserT__3 {
-		{
-			p.SetState(148)
-			p.Match(FGGParserT__3)
-		}
-		{
-			p.SetState(149)
-			p.FieldDecl()
+			case 2:
+				{
+					p.SetState(173)
+					p.MethDecl()
+				}
+
+			case 3:
+				{
+					p.SetState(174)
+					p.FuncDecl()
+				}
+
+			}
+			{
+				p.SetState(177)
+				p.Match(FGGParserT__7)
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(154)
+		p.SetState(181)
 		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 15, p.GetParserRuleContext())
 	}
 
 	return localctx
 }
 
-// IFieldDeclContext is an interface to support dynamic dispatch.
-type IFieldDeclContext interface {
+// ITypeDeclContext is an interface to support dynamic dispatch.
+type ITypeDeclContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetField returns the field token.
-	GetField() antlr.Token
+	// GetId returns the id token.
+	GetId() antlr.Token
 
-	// SetField sets the field token.
-	SetField(antlr.Token)
+	// SetId sets the id token.
+	SetId(antlr.Token)
 
-	// IsFieldDeclContext differentiates from other interfaces.
-	IsFieldDeclContext()
+	// IsTypeDeclContext differentiates from other interfaces.
+	IsTypeDeclContext()
 }
 
-type FieldDeclContext struct {
+type TypeDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
-	field  antlr.Token
+	id     antlr.Token
 }
 
-func NewEmptyFieldDeclContext() *FieldDeclContext {
-	var p = new(FieldDeclContext)
+func NewEmptyTypeDeclContext() *TypeDeclContext {
+	var p = new(TypeDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_fieldDecl
+	p.RuleIndex = FGGParserRULE_typeDecl
 	return p
 }
 
-func (*FieldDeclContext) IsFieldDeclContext() {}
+func (*TypeDeclContext) IsTypeDeclContext() {}
 
-func NewFieldDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldDeclContext {
-	var p = new(FieldDeclContext)
+func NewTypeDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeDeclContext {
+	var p = new(TypeDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_fieldDecl
+	p.RuleIndex = FGGParserRULE_typeDecl
 
 	return p
 }
 
-func (s *FieldDeclContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeDeclContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FieldDeclContext) GetField() antlr.Token { return s.field }
+func (s *TypeDeclContext) GetId() antlr.Token { return s.id }
 
-func (s *FieldDeclContext) SetField(v antlr.Token) { s.field = v }
+func (s *TypeDeclContext) SetId(v antlr.Token) { s.id = v }
 
-func (s *FieldDeclContext) Typ() ITypContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypContext)(nil)).Elem(), 0)
+func (s *TypeDeclContext) TYPE() antlr.TerminalNode {
+	return s.GetToken(FGGParserTYPE, 0)
+}
+
+func (s *TypeDeclContext) TypeFormals() ITypeFormalsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeFormalsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeFormalsContext)
+}
+
+func (s *TypeDeclContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
@@ -2073,33 +2606,36 @@ func (s *FieldDeclContext) Typ() ITypContext {
 	return t.(ITypContext)
 }
 
-func (s *FieldDeclContext) NAME() antlr.TerminalNode {
+func (s *TypeDeclContext) NAME() antlr.TerminalNode {
 	return s.GetToken(FGGParserNAME, 0)
 }
 
-func (s *FieldDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeDeclContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FieldDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FieldDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterFieldDecl(s)
+		listenerT.EnterTypeDecl(s)
 	}
 }
 
-func (s *FieldDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitFieldDecl(s)
+		listenerT.ExitTypeDecl(s)
 	}
 }
 
-func (p *FGGParser) FieldDecl() (localctx IFieldDeclContext) {
-	localctx = NewFieldDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, FGGParserRULE_fieldDecl)
+func (p *FGGParser) TypeDecl() (localctx ITypeDeclContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, FGGParserRULE_typeDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -2119,105 +2655,174 @@ func (p *FGGParser) FieldDecl() (localctx IFieldDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(155)
+		p.SetState(183)
+		p.Match(FGGParserTYPE)
+	}
+	{
+		p.SetState(184)
 
 		var _m = p.Match(FGGParserNAME)
 
-		localctx.(*FieldDeclContext).field = _m
+		localctx.(*TypeDeclContext).id = _m
+	}
+	{
+		p.SetState(185)
+		p.TypeFormals()
 	}
 	{
-		p.SetState(156)
+		p.SetState(186)
 		p.Typ()
 	}
 
 	return localctx
 }
 
-// ISpecsContext is an interface to support dynamic dispatch.
-type ISpecsContext interface {
+// IMethDeclContext is an interface to support dynamic dispatch.
+type IMethDeclContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSpecsContext differentiates from other interfaces.
-	IsSpecsContext()
+	// GetRecv returns the recv token.
+	GetRecv() antlr.Token
+
+	// GetTypn returns the typn token.
+	GetTypn() antlr.Token
+
+	// SetRecv sets the recv token.
+	SetRecv(antlr.Token)
+
+	// SetTypn sets the typn token.
+	SetTypn(antlr.Token)
+
+	// IsMethDeclContext differentiates from other interfaces.
+	IsMethDeclContext()
 }
 
-type SpecsContext struct {
+type MethDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
+	recv   antlr.Token
+	typn   antlr.Token
 }
 
-func NewEmptySpecsContext() *SpecsContext {
-	var p = new(SpecsContext)
+func NewEmptyMethDeclContext() *MethDeclContext {
+	var p = new(MethDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_specs
+	p.RuleIndex = FGGParserRULE_methDecl
 	return p
 }
 
-func (*SpecsContext) IsSpecsContext() {}
+func (*MethDeclContext) IsMethDeclContext() {}
 
-func NewSpecsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpecsContext {
-	var p = new(SpecsContext)
+func NewMethDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MethDeclContext {
+	var p = new(MethDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_specs
+	p.RuleIndex = FGGParserRULE_methDecl
 
 	return p
 }
 
-func (s *SpecsContext) GetParser() antlr.Parser { return s.parser }
+func (s *MethDeclContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SpecsContext) AllSpec() []ISpecContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISpecContext)(nil)).Elem())
-	var tst = make([]ISpecContext, len(ts))
+func (s *MethDeclContext) GetRecv() antlr.Token { return s.recv }
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(ISpecContext)
+func (s *MethDeclContext) GetTypn() antlr.Token { return s.typn }
+
+func (s *MethDeclContext) SetRecv(v antlr.Token) { s.recv = v }
+
+func (s *MethDeclContext) SetTypn(v antlr.Token) { s.typn = v }
+
+func (s *MethDeclContext) FUNC() antlr.TerminalNode {
+	return s.GetToken(FGGParserFUNC, 0)
+}
+
+func (s *MethDeclContext) TypeFormals() ITypeFormalsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeFormalsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	return tst
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeFormalsContext)
 }
 
-func (s *SpecsContext) Spec(i int) ISpecContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISpecContext)(nil)).Elem(), i)
+func (s *MethDeclContext) Sig() ISigContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISigContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISpecContext)
+	return t.(ISigContext)
 }
 
-func (s *SpecsContext) GetRuleContext() antlr.RuleContext {
+func (s *MethDeclContext) Body() IBodyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBodyContext)
+}
+
+func (s *MethDeclContext) AllNAME() []antlr.TerminalNode {
+	return s.GetTokens(FGGParserNAME)
+}
+
+func (s *MethDeclContext) NAME(i int) antlr.TerminalNode {
+	return s.GetToken(FGGParserNAME, i)
+}
+
+func (s *MethDeclContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SpecsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MethDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SpecsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MethDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterSpecs(s)
+		listenerT.EnterMethDecl(s)
 	}
 }
 
-func (s *SpecsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MethDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitSpecs(s)
+		listenerT.ExitMethDecl(s)
 	}
 }
 
-func (p *FGGParser) Specs() (localctx ISpecsContext) {
-	localctx = NewSpecsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, FGGParserRULE_specs)
-	var _la int
+func (p *FGGParser) MethDecl() (localctx IMethDeclContext) {
+	this := p
+	_ = this
+
+	localctx = NewMethDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, FGGParserRULE_methDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -2237,164 +2842,155 @@ func (p *FGGParser) Specs() (localctx ISpecsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(158)
-		p.Spec()
+		p.SetState(188)
+		p.Match(FGGParserFUNC)
 	}
-	p.SetState(163)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+	{
+		p.SetState(189)
+		p.Match(FGGParserT__0)
+	}
+	{
+		p.SetState(190)
 
-	for _la == FGGParserT__3 {
-		{
-			p.SetState(159)
-			p.Match(FGGParserT__3)
-		}
-		{
-			p.SetState(160)
-			p.Spec()
-		}
+		var _m = p.Match(FGGParserNAME)
 
-		p.SetState(165)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		localctx.(*MethDeclContext).recv = _m
+	}
+	{
+		p.SetState(191)
+
+		var _m = p.Match(FGGParserNAME)
+
+		localctx.(*MethDeclContext).typn = _m
+	}
+	{
+		p.SetState(192)
+		p.TypeFormals()
+	}
+	{
+		p.SetState(193)
+		p.Match(FGGParserT__1)
+	}
+	{
+		p.SetState(194)
+		p.Sig()
+	}
+	{
+		p.SetState(195)
+		p.Match(FGGParserT__5)
+	}
+	{
+		p.SetState(196)
+		p.Body()
+	}
+	{
+		p.SetState(197)
+		p.Match(FGGParserT__6)
 	}
 
 	return localctx
 }
 
-// ISpecContext is an interface to support dynamic dispatch.
-type ISpecContext interface {
+// IFuncDeclContext is an interface to support dynamic dispatch.
+type IFuncDeclContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSpecContext differentiates from other interfaces.
-	IsSpecContext()
+	// IsFuncDeclContext differentiates from other interfaces.
+	IsFuncDeclContext()
 }
 
-type SpecContext struct {
+type FuncDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySpecContext() *SpecContext {
-	var p = new(SpecContext)
+func NewEmptyFuncDeclContext() *FuncDeclContext {
+	var p = new(FuncDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_spec
+	p.RuleIndex = FGGParserRULE_funcDecl
 	return p
 }
 
-func (*SpecContext) IsSpecContext() {}
+func (*FuncDeclContext) IsFuncDeclContext() {}
 
-func NewSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpecContext {
-	var p = new(SpecContext)
+func NewFuncDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FuncDeclContext {
+	var p = new(FuncDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_spec
+	p.RuleIndex = FGGParserRULE_funcDecl
 
 	return p
 }
 
-func (s *SpecContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SpecContext) CopyFrom(ctx *SpecContext) {
-	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
-}
-
-func (s *SpecContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *SpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-type InterfaceSpecContext struct {
-	*SpecContext
-}
-
-func NewInterfaceSpecContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InterfaceSpecContext {
-	var p = new(InterfaceSpecContext)
-
-	p.SpecContext = NewEmptySpecContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*SpecContext))
-
-	return p
-}
+func (s *FuncDeclContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *InterfaceSpecContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *FuncDeclContext) FUNC() antlr.TerminalNode {
+	return s.GetToken(FGGParserFUNC, 0)
 }
 
-func (s *InterfaceSpecContext) Typ() ITypContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypContext)(nil)).Elem(), 0)
+func (s *FuncDeclContext) Sig() ISigContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISigContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITypContext)
+	return t.(ISigContext)
 }
 
-func (s *InterfaceSpecContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterInterfaceSpec(s)
+func (s *FuncDeclContext) Body() IBodyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
 	}
-}
 
-func (s *InterfaceSpecContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitInterfaceSpec(s)
+	if t == nil {
+		return nil
 	}
-}
 
-type SigSpecContext struct {
-	*SpecContext
+	return t.(IBodyContext)
 }
 
-func NewSigSpecContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SigSpecContext {
-	var p = new(SigSpecContext)
-
-	p.SpecContext = NewEmptySpecContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*SpecContext))
-
-	return p
-}
-
-func (s *SigSpecContext) GetRuleContext() antlr.RuleContext {
+func (s *FuncDeclContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SigSpecContext) Sig() ISigContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISigContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISigContext)
+func (s *FuncDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SigSpecContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FuncDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterSigSpec(s)
+		listenerT.EnterFuncDecl(s)
 	}
 }
 
-func (s *SigSpecContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FuncDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitSigSpec(s)
+		listenerT.ExitFuncDecl(s)
 	}
 }
 
-func (p *FGGParser) Spec() (localctx ISpecContext) {
-	localctx = NewSpecContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, FGGParserRULE_spec)
+func (p *FGGParser) FuncDecl() (localctx IFuncDeclContext) {
+	this := p
+	_ = this
+
+	localctx = NewFuncDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, FGGParserRULE_funcDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -2412,137 +3008,183 @@ func (p *FGGParser) Spec() (localctx ISpecContext) {
 		}
 	}()
 
-	p.SetState(168)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 15, p.GetParserRuleContext()) {
-	case 1:
-		localctx = NewSigSpecContext(p, localctx)
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(166)
-			p.Sig()
-		}
-
-	case 2:
-		localctx = NewInterfaceSpecContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(167)
-			p.Typ()
-		}
-
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(199)
+		p.Match(FGGParserFUNC)
+	}
+	{
+		p.SetState(200)
+		p.Sig()
+	}
+	{
+		p.SetState(201)
+		p.Match(FGGParserT__5)
+	}
+	{
+		p.SetState(202)
+		p.Body()
+	}
+	{
+		p.SetState(203)
+		p.Match(FGGParserT__6)
 	}
 
 	return localctx
 }
 
-// ISigContext is an interface to support dynamic dispatch.
-type ISigContext interface {
+// IBodyContext is an interface to support dynamic dispatch.
+type IBodyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetMeth returns the meth token.
-	GetMeth() antlr.Token
+	// IsBodyContext differentiates from other interfaces.
+	IsBodyContext()
+}
 
-	// SetMeth sets the meth token.
-	SetMeth(antlr.Token)
-
-	// IsSigContext differentiates from other interfaces.
-	IsSigContext()
-}
-
-type SigContext struct {
+type BodyContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
-	meth   antlr.Token
 }
 
-func NewEmptySigContext() *SigContext {
-	var p = new(SigContext)
+func NewEmptyBodyContext() *BodyContext {
+	var p = new(BodyContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_sig
+	p.RuleIndex = FGGParserRULE_body
 	return p
 }
 
-func (*SigContext) IsSigContext() {}
+func (*BodyContext) IsBodyContext() {}
 
-func NewSigContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SigContext {
-	var p = new(SigContext)
+func NewBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BodyContext {
+	var p = new(BodyContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_sig
+	p.RuleIndex = FGGParserRULE_body
 
 	return p
 }
 
-func (s *SigContext) GetParser() antlr.Parser { return s.parser }
+func (s *BodyContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SigContext) GetMeth() antlr.Token { return s.meth }
+func (s *BodyContext) RETURN() antlr.TerminalNode {
+	return s.GetToken(FGGParserRETURN, 0)
+}
 
-func (s *SigContext) SetMeth(v antlr.Token) { s.meth = v }
+func (s *BodyContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *SigContext) TypeFormals() ITypeFormalsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeFormalsContext)(nil)).Elem(), 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *BodyContext) PANIC() antlr.TerminalNode {
+	return s.GetToken(FGGParserPANIC, 0)
+}
+
+func (s *BodyContext) IfElse() IIfElseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIfElseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITypeFormalsContext)
+	return t.(IIfElseContext)
 }
 
-func (s *SigContext) Typ() ITypContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypContext)(nil)).Elem(), 0)
+func (s *BodyContext) TypeSwitch() ITypeSwitchContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeSwitchContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITypContext)
+	return t.(ITypeSwitchContext)
 }
 
-func (s *SigContext) NAME() antlr.TerminalNode {
-	return s.GetToken(FGGParserNAME, 0)
+func (s *BodyContext) Binding() IBindingContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBindingContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBindingContext)
 }
 
-func (s *SigContext) Params() IParamsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParamsContext)(nil)).Elem(), 0)
+func (s *BodyContext) Body() IBodyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IParamsContext)
+	return t.(IBodyContext)
 }
 
-func (s *SigContext) GetRuleContext() antlr.RuleContext {
+func (s *BodyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SigContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *BodyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SigContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BodyContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterSig(s)
+		listenerT.EnterBody(s)
 	}
 }
 
-func (s *SigContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BodyContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitSig(s)
+		listenerT.ExitBody(s)
 	}
 }
 
-func (p *FGGParser) Sig() (localctx ISigContext) {
-	localctx = NewSigContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, FGGParserRULE_sig)
-	var _la int
+func (p *FGGParser) Body() (localctx IBodyContext) {
+	this := p
+	_ = this
+
+	localctx = NewBodyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, FGGParserRULE_body)
 
 	defer func() {
 		p.ExitRule()
@@ -2560,130 +3202,209 @@ func (p *FGGParser) Sig() (localctx ISigContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(170)
+	p.SetState(218)
+	p.GetErrorHandler().Sync(p)
 
-		var _m = p.Match(FGGParserNAME)
+	switch p.GetTokenStream().LA(1) {
+	case FGGParserRETURN:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(205)
+			p.Match(FGGParserRETURN)
+		}
+		{
+			p.SetState(206)
+			p.expr(0)
+		}
 
-		localctx.(*SigContext).meth = _m
-	}
-	{
-		p.SetState(171)
-		p.TypeFormals()
-	}
-	{
-		p.SetState(172)
-		p.Match(FGGParserT__0)
-	}
-	p.SetState(174)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+	case FGGParserPANIC:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(207)
+			p.Match(FGGParserPANIC)
+		}
+		{
+			p.SetState(208)
+			p.Match(FGGParserT__0)
+		}
+		{
+			p.SetState(209)
+			p.expr(0)
+		}
+		{
+			p.SetState(210)
+			p.Match(FGGParserT__1)
+		}
 
-	if _la == FGGParserNAME {
+	case FGGParserIF:
+		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(173)
-			p.Params()
+			p.SetState(212)
+			p.IfElse()
 		}
 
-	}
-	{
-		p.SetState(176)
-		p.Match(FGGParserT__1)
-	}
-	{
-		p.SetState(177)
-		p.Typ()
+	case FGGParserSWITCH:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(213)
+			p.TypeSwitch()
+		}
+
+	case FGGParserVAR, FGGParserNAME:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(214)
+			p.Binding()
+		}
+		{
+			p.SetState(215)
+			p.Match(FGGParserT__7)
+		}
+		{
+			p.SetState(216)
+			p.Body()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IParamsContext is an interface to support dynamic dispatch.
-type IParamsContext interface {
+// IBindingContext is an interface to support dynamic dispatch.
+type IBindingContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsParamsContext differentiates from other interfaces.
-	IsParamsContext()
+	// IsBindingContext differentiates from other interfaces.
+	IsBindingContext()
 }
 
-type ParamsContext struct {
+type BindingContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyParamsContext() *ParamsContext {
-	var p = new(ParamsContext)
+func NewEmptyBindingContext() *BindingContext {
+	var p = new(BindingContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_params
+	p.RuleIndex = FGGParserRULE_binding
 	return p
 }
 
-func (*ParamsContext) IsParamsContext() {}
+func (*BindingContext) IsBindingContext() {}
 
-func NewParamsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParamsContext {
-	var p = new(ParamsContext)
+func NewBindingContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BindingContext {
+	var p = new(BindingContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_params
+	p.RuleIndex = FGGParserRULE_binding
 
 	return p
 }
 
-func (s *ParamsContext) GetParser() antlr.Parser { return s.parser }
+func (s *BindingContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ParamsContext) AllParamDecl() []IParamDeclContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IParamDeclContext)(nil)).Elem())
-	var tst = make([]IParamDeclContext, len(ts))
+func (s *BindingContext) AllNAME() []antlr.TerminalNode {
+	return s.GetTokens(FGGParserNAME)
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IParamDeclContext)
+func (s *BindingContext) NAME(i int) antlr.TerminalNode {
+	return s.GetToken(FGGParserNAME, i)
+}
+
+func (s *BindingContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
 		}
 	}
 
 	return tst
 }
 
-func (s *ParamsContext) ParamDecl(i int) IParamDeclContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParamDeclContext)(nil)).Elem(), i)
+func (s *BindingContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IParamDeclContext)
+	return t.(IExprContext)
 }
 
-func (s *ParamsContext) GetRuleContext() antlr.RuleContext {
+func (s *BindingContext) VAR() antlr.TerminalNode {
+	return s.GetToken(FGGParserVAR, 0)
+}
+
+func (s *BindingContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *BindingContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ParamsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *BindingContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ParamsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BindingContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterParams(s)
+		listenerT.EnterBinding(s)
 	}
 }
 
-func (s *ParamsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BindingContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitParams(s)
+		listenerT.ExitBinding(s)
 	}
 }
 
-func (p *FGGParser) Params() (localctx IParamsContext) {
-	localctx = NewParamsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, FGGParserRULE_params)
-	var _la int
+func (p *FGGParser) Binding() (localctx IBindingContext) {
+	this := p
+	_ = this
+
+	localctx = NewBindingContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, FGGParserRULE_binding)
 
 	defer func() {
 		p.ExitRule()
@@ -2701,119 +3422,247 @@ func (p *FGGParser) Params() (localctx IParamsContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(179)
-		p.ParamDecl()
-	}
-	p.SetState(184)
+	p.SetState(241)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(220)
+			p.Match(FGGParserNAME)
+		}
+		{
+			p.SetState(221)
+			p.Match(FGGParserT__12)
+		}
+		{
+			p.SetState(222)
+			p.expr(0)
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(223)
+			p.Match(FGGParserVAR)
+		}
+		{
+			p.SetState(224)
+			p.Match(FGGParserNAME)
+		}
+		{
+			p.SetState(225)
+			p.Typ()
+		}
+		{
+			p.SetState(226)
+			p.Match(FGGParserT__9)
+		}
+		{
+			p.SetState(227)
+			p.expr(0)
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(229)
+			p.Match(FGGParserNAME)
+		}
+		{
+			p.SetState(230)
+			p.Match(FGGParserT__4)
+		}
+		{
+			p.SetState(231)
+			p.Match(FGGParserNAME)
+		}
+		{
+			p.SetState(232)
+			p.Match(FGGParserT__12)
+		}
+		{
+			p.SetState(233)
+			p.expr(0)
+		}
 
-	for _la == FGGParserT__2 {
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(234)
+			p.Match(FGGParserNAME)
+		}
 		{
-			p.SetState(180)
+			p.SetState(235)
 			p.Match(FGGParserT__2)
 		}
 		{
-			p.SetState(181)
-			p.ParamDecl()
+			p.SetState(236)
+			p.expr(0)
+		}
+		{
+			p.SetState(237)
+			p.Match(FGGParserT__3)
+		}
+		{
+			p.SetState(238)
+			p.Match(FGGParserT__9)
+		}
+		{
+			p.SetState(239)
+			p.expr(0)
 		}
 
-		p.SetState(186)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// IParamDeclContext is an interface to support dynamic dispatch.
-type IParamDeclContext interface {
+// IIfElseContext is an interface to support dynamic dispatch.
+type IIfElseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetVari returns the vari token.
-	GetVari() antlr.Token
-
-	// SetVari sets the vari token.
-	SetVari(antlr.Token)
-
-	// IsParamDeclContext differentiates from other interfaces.
-	IsParamDeclContext()
+	// IsIfElseContext differentiates from other interfaces.
+	IsIfElseContext()
 }
 
-type ParamDeclContext struct {
+type IfElseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
-	vari   antlr.Token
 }
 
-func NewEmptyParamDeclContext() *ParamDeclContext {
-	var p = new(ParamDeclContext)
+func NewEmptyIfElseContext() *IfElseContext {
+	var p = new(IfElseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_paramDecl
+	p.RuleIndex = FGGParserRULE_ifElse
 	return p
 }
 
-func (*ParamDeclContext) IsParamDeclContext() {}
+func (*IfElseContext) IsIfElseContext() {}
 
-func NewParamDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParamDeclContext {
-	var p = new(ParamDeclContext)
+func NewIfElseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IfElseContext {
+	var p = new(IfElseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_paramDecl
+	p.RuleIndex = FGGParserRULE_ifElse
 
 	return p
 }
 
-func (s *ParamDeclContext) GetParser() antlr.Parser { return s.parser }
+func (s *IfElseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ParamDeclContext) GetVari() antlr.Token { return s.vari }
+func (s *IfElseContext) IF() antlr.TerminalNode {
+	return s.GetToken(FGGParserIF, 0)
+}
 
-func (s *ParamDeclContext) SetVari(v antlr.Token) { s.vari = v }
+func (s *IfElseContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *ParamDeclContext) Typ() ITypContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypContext)(nil)).Elem(), 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *IfElseContext) AllBody() []IBodyContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IBodyContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IBodyContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IBodyContext); ok {
+			tst[i] = t.(IBodyContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *IfElseContext) Body(i int) IBodyContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITypContext)
+	return t.(IBodyContext)
 }
 
-func (s *ParamDeclContext) NAME() antlr.TerminalNode {
-	return s.GetToken(FGGParserNAME, 0)
+func (s *IfElseContext) ELSE() antlr.TerminalNode {
+	return s.GetToken(FGGParserELSE, 0)
 }
 
-func (s *ParamDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *IfElseContext) IfElse() IIfElseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIfElseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIfElseContext)
+}
+
+func (s *IfElseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ParamDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IfElseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ParamDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IfElseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterParamDecl(s)
+		listenerT.EnterIfElse(s)
 	}
 }
 
-func (s *ParamDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IfElseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitParamDecl(s)
+		listenerT.ExitIfElse(s)
 	}
 }
 
-func (p *FGGParser) ParamDecl() (localctx IParamDeclContext) {
-	localctx = NewParamDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, FGGParserRULE_paramDecl)
+func (p *FGGParser) IfElse() (localctx IIfElseContext) {
+	this := p
+	_ = this
+
+	localctx = NewIfElseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, FGGParserRULE_ifElse)
 
 	defer func() {
 		p.ExitRule()
@@ -2833,408 +3682,574 @@ func (p *FGGParser) ParamDecl() (localctx IParamDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(187)
-
-		var _m = p.Match(FGGParserNAME)
-
-		localctx.(*ParamDeclContext).vari = _m
+		p.SetState(243)
+		p.Match(FGGParserIF)
 	}
 	{
-		p.SetState(188)
-		p.Typ()
+		p.SetState(244)
+		p.expr(0)
+	}
+	{
+		p.SetState(245)
+		p.Match(FGGParserT__5)
+	}
+	{
+		p.SetState(246)
+		p.Body()
+	}
+	{
+		p.SetState(247)
+		p.Match(FGGParserT__6)
+	}
+	{
+		p.SetState(248)
+		p.Match(FGGParserELSE)
+	}
+	p.SetState(254)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case FGGParserT__5:
+		{
+			p.SetState(249)
+			p.Match(FGGParserT__5)
+		}
+		{
+			p.SetState(250)
+			p.Body()
+		}
+		{
+			p.SetState(251)
+			p.Match(FGGParserT__6)
+		}
+
+	case FGGParserIF:
+		{
+			p.SetState(253)
+			p.IfElse()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IExprContext is an interface to support dynamic dispatch.
-type IExprContext interface {
+// ITypeSwitchContext is an interface to support dynamic dispatch.
+type ITypeSwitchContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExprContext differentiates from other interfaces.
-	IsExprContext()
+	// IsTypeSwitchContext differentiates from other interfaces.
+	IsTypeSwitchContext()
 }
 
-type ExprContext struct {
+type TypeSwitchContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExprContext() *ExprContext {
-	var p = new(ExprContext)
+func NewEmptyTypeSwitchContext() *TypeSwitchContext {
+	var p = new(TypeSwitchContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_expr
+	p.RuleIndex = FGGParserRULE_typeSwitch
 	return p
 }
 
-func (*ExprContext) IsExprContext() {}
+func (*TypeSwitchContext) IsTypeSwitchContext() {}
 
-func NewExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprContext {
-	var p = new(ExprContext)
+func NewTypeSwitchContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSwitchContext {
+	var p = new(TypeSwitchContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_expr
+	p.RuleIndex = FGGParserRULE_typeSwitch
 
 	return p
 }
 
-func (s *ExprContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ExprContext) CopyFrom(ctx *ExprContext) {
-	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
-}
-
-func (s *ExprContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *ExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+func (s *TypeSwitchContext) GetParser() antlr.Parser { return s.parser }
 
-type CallContext struct {
-	*ExprContext
-	recv  IExprContext
-	targs ITypsContext
-	args  IExprsContext
+func (s *TypeSwitchContext) SWITCH() antlr.TerminalNode {
+	return s.GetToken(FGGParserSWITCH, 0)
 }
 
-func NewCallContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CallContext {
-	var p = new(CallContext)
+func (s *TypeSwitchContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(IExprContext)
 }
 
-func (s *CallContext) GetRecv() IExprContext { return s.recv }
-
-func (s *CallContext) GetTargs() ITypsContext { return s.targs }
-
-func (s *CallContext) GetArgs() IExprsContext { return s.args }
-
-func (s *CallContext) SetRecv(v IExprContext) { s.recv = v }
-
-func (s *CallContext) SetTargs(v ITypsContext) { s.targs = v }
-
-func (s *CallContext) SetArgs(v IExprsContext) { s.args = v }
-
-func (s *CallContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *TypeSwitchContext) TYPE() antlr.TerminalNode {
+	return s.GetToken(FGGParserTYPE, 0)
 }
 
-func (s *CallContext) NAME() antlr.TerminalNode {
+func (s *TypeSwitchContext) NAME() antlr.TerminalNode {
 	return s.GetToken(FGGParserNAME, 0)
 }
 
-func (s *CallContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
+func (s *TypeSwitchContext) AllTypeCase() []ITypeCaseContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypeCaseContext); ok {
+			len++
+		}
 	}
 
-	return t.(IExprContext)
-}
-
-func (s *CallContext) Typs() ITypsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypsContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
+	tst := make([]ITypeCaseContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeCaseContext); ok {
+			tst[i] = t.(ITypeCaseContext)
+			i++
+		}
 	}
 
-	return t.(ITypsContext)
+	return tst
 }
 
-func (s *CallContext) Exprs() IExprsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprsContext)(nil)).Elem(), 0)
+func (s *TypeSwitchContext) TypeCase(i int) ITypeCaseContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeCaseContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprsContext)
+	return t.(ITypeCaseContext)
 }
 
-func (s *CallContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterCall(s)
-	}
+func (s *TypeSwitchContext) DEFAULT() antlr.TerminalNode {
+	return s.GetToken(FGGParserDEFAULT, 0)
 }
 
-func (s *CallContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitCall(s)
+func (s *TypeSwitchContext) Body() IBodyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
 	}
-}
 
-type VariableContext struct {
-	*ExprContext
-}
-
-func NewVariableContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *VariableContext {
-	var p = new(VariableContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(IBodyContext)
 }
 
-func (s *VariableContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeSwitchContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *VariableContext) NAME() antlr.TerminalNode {
-	return s.GetToken(FGGParserNAME, 0)
+func (s *TypeSwitchContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *VariableContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeSwitchContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterVariable(s)
+		listenerT.EnterTypeSwitch(s)
 	}
 }
 
-func (s *VariableContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeSwitchContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitVariable(s)
+		listenerT.ExitTypeSwitch(s)
 	}
 }
 
-type AssertContext struct {
-	*ExprContext
-}
-
-func NewAssertContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AssertContext {
-	var p = new(AssertContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+func (p *FGGParser) TypeSwitch() (localctx ITypeSwitchContext) {
+	this := p
+	_ = this
 
-	return p
-}
+	localctx = NewTypeSwitchContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, FGGParserRULE_typeSwitch)
+	var _la int
 
-func (s *AssertContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	defer func() {
+		p.ExitRule()
+	}()
 
-func (s *AssertContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-	if t == nil {
-		return nil
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(256)
+		p.Match(FGGParserSWITCH)
 	}
+	p.SetState(259)
+	p.GetErrorHandler().Sync(p)
 
-	return t.(IExprContext)
-}
-
-func (s *AssertContext) Typ() ITypContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypContext)(nil)).Elem(), 0)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(257)
+			p.Match(FGGParserNAME)
+		}
+		{
+			p.SetState(258)
+			p.Match(FGGParserT__12)
+		}
 
-	if t == nil {
-		return nil
 	}
-
-	return t.(ITypContext)
-}
-
-func (s *AssertContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterAssert(s)
+	{
+		p.SetState(261)
+		p.expr(0)
 	}
-}
-
-func (s *AssertContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitAssert(s)
+	{
+		p.SetState(262)
+		p.Match(FGGParserT__10)
 	}
-}
-
-type SprintfContext struct {
-	*ExprContext
-}
+	{
+		p.SetState(263)
+		p.Match(FGGParserT__0)
+	}
+	{
+		p.SetState(264)
+		p.Match(FGGParserTYPE)
+	}
+	{
+		p.SetState(265)
+		p.Match(FGGParserT__1)
+	}
+	{
+		p.SetState(266)
+		p.Match(FGGParserT__5)
+	}
+	p.SetState(270)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-func NewSprintfContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SprintfContext {
-	var p = new(SprintfContext)
+	for _la == FGGParserCASE {
+		{
+			p.SetState(267)
+			p.TypeCase()
+		}
 
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+		p.SetState(272)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(276)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	return p
+	if _la == FGGParserDEFAULT {
+		{
+			p.SetState(273)
+			p.Match(FGGParserDEFAULT)
+		}
+		{
+			p.SetState(274)
+			p.Match(FGGParserT__13)
+		}
+		{
+			p.SetState(275)
+			p.Body()
+		}
+
+	}
+	{
+		p.SetState(278)
+		p.Match(FGGParserT__6)
+	}
+
+	return localctx
 }
 
-func (s *SprintfContext) GetRuleContext() antlr.RuleContext {
-	return s
+// ITypeCaseContext is an interface to support dynamic dispatch.
+type ITypeCaseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTypeCaseContext differentiates from other interfaces.
+	IsTypeCaseContext()
 }
 
-func (s *SprintfContext) FMT() antlr.TerminalNode {
-	return s.GetToken(FGGParserFMT, 0)
+type TypeCaseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *SprintfContext) SPRINTF() antlr.TerminalNode {
-	return s.GetToken(FGGParserSPRINTF, 0)
+func NewEmptyTypeCaseContext() *TypeCaseContext {
+	var p = new(TypeCaseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_typeCase
+	return p
 }
 
-func (s *SprintfContext) STRING() antlr.TerminalNode {
-	return s.GetToken(FGGParserSTRING, 0)
+func (*TypeCaseContext) IsTypeCaseContext() {}
+
+func NewTypeCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeCaseContext {
+	var p = new(TypeCaseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_typeCase
+
+	return p
 }
 
-func (s *SprintfContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
+func (s *TypeCaseContext) GetParser() antlr.Parser { return s.parser }
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExprContext)
+func (s *TypeCaseContext) CASE() antlr.TerminalNode {
+	return s.GetToken(FGGParserCASE, 0)
+}
+
+func (s *TypeCaseContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	return tst
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
 }
 
-func (s *SprintfContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+func (s *TypeCaseContext) Body() IBodyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(IBodyContext)
 }
 
-func (s *SprintfContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeCaseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeCaseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterSprintf(s)
+		listenerT.EnterTypeCase(s)
 	}
 }
 
-func (s *SprintfContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeCaseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitSprintf(s)
+		listenerT.ExitTypeCase(s)
 	}
 }
 
-type SelectContext struct {
-	*ExprContext
-}
+func (p *FGGParser) TypeCase() (localctx ITypeCaseContext) {
+	this := p
+	_ = this
 
-func NewSelectContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SelectContext {
-	var p = new(SelectContext)
+	localctx = NewTypeCaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, FGGParserRULE_typeCase)
+	var _la int
 
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	defer func() {
+		p.ExitRule()
+	}()
 
-	return p
-}
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-func (s *SelectContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(280)
+		p.Match(FGGParserCASE)
+	}
+	{
+		p.SetState(281)
+		p.Typ()
+	}
+	{
+		p.SetState(282)
+		p.Match(FGGParserT__13)
+	}
+	{
+		p.SetState(283)
+		p.Body()
+	}
+	p.SetState(285)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-func (s *SelectContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+	if _la == FGGParserT__7 {
+		{
+			p.SetState(284)
+			p.Match(FGGParserT__7)
+		}
 
-	if t == nil {
-		return nil
 	}
 
-	return t.(IExprContext)
+	return localctx
 }
 
-func (s *SelectContext) NAME() antlr.TerminalNode {
-	return s.GetToken(FGGParserNAME, 0)
-}
+// IFieldDeclsContext is an interface to support dynamic dispatch.
+type IFieldDeclsContext interface {
+	antlr.ParserRuleContext
 
-func (s *SelectContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterSelect(s)
-	}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFieldDeclsContext differentiates from other interfaces.
+	IsFieldDeclsContext()
 }
 
-func (s *SelectContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitSelect(s)
-	}
+type FieldDeclsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-type StructLitContext struct {
-	*ExprContext
+func NewEmptyFieldDeclsContext() *FieldDeclsContext {
+	var p = new(FieldDeclsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_fieldDecls
+	return p
 }
 
-func NewStructLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StructLitContext {
-	var p = new(StructLitContext)
+func (*FieldDeclsContext) IsFieldDeclsContext() {}
+
+func NewFieldDeclsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldDeclsContext {
+	var p = new(FieldDeclsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
+	p.RuleIndex = FGGParserRULE_fieldDecls
 
 	return p
 }
 
-func (s *StructLitContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *FieldDeclsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StructLitContext) Typ() ITypContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypContext)(nil)).Elem(), 0)
+func (s *FieldDeclsContext) AllFieldDecl() []IFieldDeclContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFieldDeclContext); ok {
+			len++
+		}
+	}
 
-	if t == nil {
-		return nil
+	tst := make([]IFieldDeclContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFieldDeclContext); ok {
+			tst[i] = t.(IFieldDeclContext)
+			i++
+		}
 	}
 
-	return t.(ITypContext)
+	return tst
 }
 
-func (s *StructLitContext) Exprs() IExprsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprsContext)(nil)).Elem(), 0)
+func (s *FieldDeclsContext) FieldDecl(i int) IFieldDeclContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldDeclContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprsContext)
+	return t.(IFieldDeclContext)
 }
 
-func (s *StructLitContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FieldDeclsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FieldDeclsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FieldDeclsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterStructLit(s)
+		listenerT.EnterFieldDecls(s)
 	}
 }
 
-func (s *StructLitContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FieldDeclsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitStructLit(s)
+		listenerT.ExitFieldDecls(s)
 	}
 }
 
-func (p *FGGParser) Expr() (localctx IExprContext) {
-	return p.expr(0)
-}
+func (p *FGGParser) FieldDecls() (localctx IFieldDeclsContext) {
+	this := p
+	_ = this
 
-func (p *FGGParser) expr(_p int) (localctx IExprContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
-	_parentState := p.GetState()
-	localctx = NewExprContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IExprContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 34
-	p.EnterRecursionRule(localctx, 34, FGGParserRULE_expr, _p)
+	localctx = NewFieldDeclsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, FGGParserRULE_fieldDecls)
 	var _la int
 
 	defer func() {
-		p.UnrollRecursionContexts(_parentctx)
+		p.ExitRule()
 	}()
 
 	defer func() {
@@ -3249,330 +4264,3990 @@ func (p *FGGParser) expr(_p int) (localctx IExprContext) {
 		}
 	}()
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(212)
+	{
+		p.SetState(287)
+		p.FieldDecl()
+	}
+	p.SetState(292)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext()) {
-	case 1:
-		localctx = NewVariableContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-
-		{
-			p.SetState(191)
-			p.Match(FGGParserNAME)
-		}
+	_la = p.GetTokenStream().LA(1)
 
-	case 2:
-		localctx = NewStructLitContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
+	for _la == FGGParserT__7 {
 		{
-			p.SetState(192)
-			p.Typ()
+			p.SetState(288)
+			p.Match(FGGParserT__7)
 		}
 		{
-			p.SetState(193)
-			p.Match(FGGParserT__4)
+			p.SetState(289)
+			p.FieldDecl()
 		}
-		p.SetState(195)
+
+		p.SetState(294)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
+	}
 
-		if _la == FGGParserFMT || _la == FGGParserNAME {
-			{
-				p.SetState(194)
-				p.Exprs()
-			}
+	return localctx
+}
 
+// IFieldDeclContext is an interface to support dynamic dispatch.
+type IFieldDeclContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetField returns the field token.
+	GetField() antlr.Token
+
+	// SetField sets the field token.
+	SetField(antlr.Token)
+
+	// GetEmbedded returns the embedded rule contexts.
+	GetEmbedded() ITypContext
+
+	// SetEmbedded sets the embedded rule contexts.
+	SetEmbedded(ITypContext)
+
+	// IsFieldDeclContext differentiates from other interfaces.
+	IsFieldDeclContext()
+}
+
+type FieldDeclContext struct {
+	*antlr.BaseParserRuleContext
+	parser   antlr.Parser
+	field    antlr.Token
+	embedded ITypContext
+}
+
+func NewEmptyFieldDeclContext() *FieldDeclContext {
+	var p = new(FieldDeclContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_fieldDecl
+	return p
+}
+
+func (*FieldDeclContext) IsFieldDeclContext() {}
+
+func NewFieldDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldDeclContext {
+	var p = new(FieldDeclContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_fieldDecl
+
+	return p
+}
+
+func (s *FieldDeclContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FieldDeclContext) GetField() antlr.Token { return s.field }
+
+func (s *FieldDeclContext) SetField(v antlr.Token) { s.field = v }
+
+func (s *FieldDeclContext) GetEmbedded() ITypContext { return s.embedded }
+
+func (s *FieldDeclContext) SetEmbedded(v ITypContext) { s.embedded = v }
+
+func (s *FieldDeclContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *FieldDeclContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGGParserNAME, 0)
+}
+
+func (s *FieldDeclContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FieldDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FieldDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterFieldDecl(s)
+	}
+}
+
+func (s *FieldDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitFieldDecl(s)
+	}
+}
+
+func (p *FGGParser) FieldDecl() (localctx IFieldDeclContext) {
+	this := p
+	_ = this
+
+	localctx = NewFieldDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, FGGParserRULE_fieldDecl)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(298)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 24, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(295)
+
+			var _m = p.Match(FGGParserNAME)
+
+			localctx.(*FieldDeclContext).field = _m
+		}
+		{
+			p.SetState(296)
+			p.Typ()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(297)
+
+			var _x = p.Typ()
+
+			localctx.(*FieldDeclContext).embedded = _x
+		}
+
+	}
+
+	return localctx
+}
+
+// ITypeListContext is an interface to support dynamic dispatch.
+type ITypeListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTypeListContext differentiates from other interfaces.
+	IsTypeListContext()
+}
+
+type TypeListContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTypeListContext() *TypeListContext {
+	var p = new(TypeListContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_typeList
+	return p
+}
+
+func (*TypeListContext) IsTypeListContext() {}
+
+func NewTypeListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeListContext {
+	var p = new(TypeListContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_typeList
+
+	return p
+}
+
+func (s *TypeListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeListContext) TYPE() antlr.TerminalNode {
+	return s.GetToken(FGGParserTYPE, 0)
+}
+
+func (s *TypeListContext) Typs() ITypsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypsContext)
+}
+
+func (s *TypeListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeListContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterTypeList(s)
+	}
+}
+
+func (s *TypeListContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitTypeList(s)
+	}
+}
+
+func (p *FGGParser) TypeList() (localctx ITypeListContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, FGGParserRULE_typeList)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(300)
+		p.Match(FGGParserTYPE)
+	}
+	{
+		p.SetState(301)
+		p.Typs()
+	}
+
+	return localctx
+}
+
+// ISpecsContext is an interface to support dynamic dispatch.
+type ISpecsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSpecsContext differentiates from other interfaces.
+	IsSpecsContext()
+}
+
+type SpecsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySpecsContext() *SpecsContext {
+	var p = new(SpecsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_specs
+	return p
+}
+
+func (*SpecsContext) IsSpecsContext() {}
+
+func NewSpecsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpecsContext {
+	var p = new(SpecsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_specs
+
+	return p
+}
+
+func (s *SpecsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SpecsContext) AllSpec() []ISpecContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISpecContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISpecContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISpecContext); ok {
+			tst[i] = t.(ISpecContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SpecsContext) Spec(i int) ISpecContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISpecContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISpecContext)
+}
+
+func (s *SpecsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SpecsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SpecsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterSpecs(s)
+	}
+}
+
+func (s *SpecsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitSpecs(s)
+	}
+}
+
+func (p *FGGParser) Specs() (localctx ISpecsContext) {
+	this := p
+	_ = this
+
+	localctx = NewSpecsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, FGGParserRULE_specs)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(303)
+		p.Spec()
+	}
+	p.SetState(308)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FGGParserT__7 {
+		{
+			p.SetState(304)
+			p.Match(FGGParserT__7)
+		}
+		{
+			p.SetState(305)
+			p.Spec()
+		}
+
+		p.SetState(310)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// ISpecContext is an interface to support dynamic dispatch.
+type ISpecContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSpecContext differentiates from other interfaces.
+	IsSpecContext()
+}
+
+type SpecContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySpecContext() *SpecContext {
+	var p = new(SpecContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_spec
+	return p
+}
+
+func (*SpecContext) IsSpecContext() {}
+
+func NewSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpecContext {
+	var p = new(SpecContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_spec
+
+	return p
+}
+
+func (s *SpecContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SpecContext) Sig() ISigContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISigContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISigContext)
+}
+
+func (s *SpecContext) Union() IUnionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUnionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUnionContext)
+}
+
+func (s *SpecContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SpecContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterSpec(s)
+	}
+}
+
+func (s *SpecContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitSpec(s)
+	}
+}
+
+func (p *FGGParser) Spec() (localctx ISpecContext) {
+	this := p
+	_ = this
+
+	localctx = NewSpecContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, FGGParserRULE_spec)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(313)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(311)
+			p.Sig()
+		}
+
+	case 2:
+		{
+			p.SetState(312)
+			p.Union()
+		}
+
+	}
+
+	return localctx
+}
+
+// IUnionContext is an interface to support dynamic dispatch.
+type IUnionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsUnionContext differentiates from other interfaces.
+	IsUnionContext()
+}
+
+type UnionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUnionContext() *UnionContext {
+	var p = new(UnionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_union
+	return p
+}
+
+func (*UnionContext) IsUnionContext() {}
+
+func NewUnionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnionContext {
+	var p = new(UnionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_union
+
+	return p
+}
+
+func (s *UnionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UnionContext) AllTerm() []ITermContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITermContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITermContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITermContext); ok {
+			tst[i] = t.(ITermContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *UnionContext) Term(i int) ITermContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITermContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITermContext)
+}
+
+func (s *UnionContext) AllBITOR() []antlr.TerminalNode {
+	return s.GetTokens(FGGParserBITOR)
+}
+
+func (s *UnionContext) BITOR(i int) antlr.TerminalNode {
+	return s.GetToken(FGGParserBITOR, i)
+}
+
+func (s *UnionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UnionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *UnionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterUnion(s)
+	}
+}
+
+func (s *UnionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitUnion(s)
+	}
+}
+
+func (p *FGGParser) Union() (localctx IUnionContext) {
+	this := p
+	_ = this
+
+	localctx = NewUnionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, FGGParserRULE_union)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(315)
+		p.Term()
+	}
+	p.SetState(320)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FGGParserBITOR {
+		{
+			p.SetState(316)
+			p.Match(FGGParserBITOR)
+		}
+		{
+			p.SetState(317)
+			p.Term()
+		}
+
+		p.SetState(322)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// ITermContext is an interface to support dynamic dispatch.
+type ITermContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetTilde returns the tilde token.
+	GetTilde() antlr.Token
+
+	// SetTilde sets the tilde token.
+	SetTilde(antlr.Token)
+
+	// IsTermContext differentiates from other interfaces.
+	IsTermContext()
+}
+
+type TermContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+	tilde  antlr.Token
+}
+
+func NewEmptyTermContext() *TermContext {
+	var p = new(TermContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_term
+	return p
+}
+
+func (*TermContext) IsTermContext() {}
+
+func NewTermContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TermContext {
+	var p = new(TermContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_term
+
+	return p
+}
+
+func (s *TermContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TermContext) GetTilde() antlr.Token { return s.tilde }
+
+func (s *TermContext) SetTilde(v antlr.Token) { s.tilde = v }
+
+func (s *TermContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *TermContext) TILDE() antlr.TerminalNode {
+	return s.GetToken(FGGParserTILDE, 0)
+}
+
+func (s *TermContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TermContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TermContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterTerm(s)
+	}
+}
+
+func (s *TermContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitTerm(s)
+	}
+}
+
+func (p *FGGParser) Term() (localctx ITermContext) {
+	this := p
+	_ = this
+
+	localctx = NewTermContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, FGGParserRULE_term)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(324)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FGGParserTILDE {
+		{
+			p.SetState(323)
+
+			var _m = p.Match(FGGParserTILDE)
+
+			localctx.(*TermContext).tilde = _m
+		}
+
+	}
+	{
+		p.SetState(326)
+		p.Typ()
+	}
+
+	return localctx
+}
+
+// ISigContext is an interface to support dynamic dispatch.
+type ISigContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetMeth returns the meth token.
+	GetMeth() antlr.Token
+
+	// SetMeth sets the meth token.
+	SetMeth(antlr.Token)
+
+	// IsSigContext differentiates from other interfaces.
+	IsSigContext()
+}
+
+type SigContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+	meth   antlr.Token
+}
+
+func NewEmptySigContext() *SigContext {
+	var p = new(SigContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_sig
+	return p
+}
+
+func (*SigContext) IsSigContext() {}
+
+func NewSigContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SigContext {
+	var p = new(SigContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_sig
+
+	return p
+}
+
+func (s *SigContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SigContext) GetMeth() antlr.Token { return s.meth }
+
+func (s *SigContext) SetMeth(v antlr.Token) { s.meth = v }
+
+func (s *SigContext) TypeFormals() ITypeFormalsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeFormalsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeFormalsContext)
+}
+
+func (s *SigContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *SigContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGGParserNAME, 0)
+}
+
+func (s *SigContext) Params() IParamsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IParamsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParamsContext)
+}
+
+func (s *SigContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SigContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SigContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterSig(s)
+	}
+}
+
+func (s *SigContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitSig(s)
+	}
+}
+
+func (p *FGGParser) Sig() (localctx ISigContext) {
+	this := p
+	_ = this
+
+	localctx = NewSigContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, FGGParserRULE_sig)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(328)
+
+		var _m = p.Match(FGGParserNAME)
+
+		localctx.(*SigContext).meth = _m
+	}
+	{
+		p.SetState(329)
+		p.TypeFormals()
+	}
+	{
+		p.SetState(330)
+		p.Match(FGGParserT__0)
+	}
+	p.SetState(332)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FGGParserNAME {
+		{
+			p.SetState(331)
+			p.Params()
+		}
+
+	}
+	{
+		p.SetState(334)
+		p.Match(FGGParserT__1)
+	}
+	{
+		p.SetState(335)
+		p.Typ()
+	}
+
+	return localctx
+}
+
+// IParamsContext is an interface to support dynamic dispatch.
+type IParamsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsParamsContext differentiates from other interfaces.
+	IsParamsContext()
+}
+
+type ParamsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyParamsContext() *ParamsContext {
+	var p = new(ParamsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_params
+	return p
+}
+
+func (*ParamsContext) IsParamsContext() {}
+
+func NewParamsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParamsContext {
+	var p = new(ParamsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_params
+
+	return p
+}
+
+func (s *ParamsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ParamsContext) AllParamDecl() []IParamDeclContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IParamDeclContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IParamDeclContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IParamDeclContext); ok {
+			tst[i] = t.(IParamDeclContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ParamsContext) ParamDecl(i int) IParamDeclContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IParamDeclContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParamDeclContext)
+}
+
+func (s *ParamsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParamsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ParamsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterParams(s)
+	}
+}
+
+func (s *ParamsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitParams(s)
+	}
+}
+
+func (p *FGGParser) Params() (localctx IParamsContext) {
+	this := p
+	_ = this
+
+	localctx = NewParamsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, FGGParserRULE_params)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(337)
+		p.ParamDecl()
+	}
+	p.SetState(342)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FGGParserT__4 {
+		{
+			p.SetState(338)
+			p.Match(FGGParserT__4)
+		}
+		{
+			p.SetState(339)
+			p.ParamDecl()
+		}
+
+		p.SetState(344)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IParamDeclContext is an interface to support dynamic dispatch.
+type IParamDeclContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetVari returns the vari token.
+	GetVari() antlr.Token
+
+	// SetVari sets the vari token.
+	SetVari(antlr.Token)
+
+	// IsParamDeclContext differentiates from other interfaces.
+	IsParamDeclContext()
+}
+
+type ParamDeclContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+	vari   antlr.Token
+}
+
+func NewEmptyParamDeclContext() *ParamDeclContext {
+	var p = new(ParamDeclContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_paramDecl
+	return p
+}
+
+func (*ParamDeclContext) IsParamDeclContext() {}
+
+func NewParamDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParamDeclContext {
+	var p = new(ParamDeclContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_paramDecl
+
+	return p
+}
+
+func (s *ParamDeclContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ParamDeclContext) GetVari() antlr.Token { return s.vari }
+
+func (s *ParamDeclContext) SetVari(v antlr.Token) { s.vari = v }
+
+func (s *ParamDeclContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *ParamDeclContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGGParserNAME, 0)
+}
+
+func (s *ParamDeclContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParamDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ParamDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterParamDecl(s)
+	}
+}
+
+func (s *ParamDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitParamDecl(s)
+	}
+}
+
+func (p *FGGParser) ParamDecl() (localctx IParamDeclContext) {
+	this := p
+	_ = this
+
+	localctx = NewParamDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, FGGParserRULE_paramDecl)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(345)
+
+		var _m = p.Match(FGGParserNAME)
+
+		localctx.(*ParamDeclContext).vari = _m
+	}
+	{
+		p.SetState(346)
+		p.Typ()
+	}
+
+	return localctx
+}
+
+// IExprContext is an interface to support dynamic dispatch.
+type IExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExprContext differentiates from other interfaces.
+	IsExprContext()
+}
+
+type ExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExprContext() *ExprContext {
+	var p = new(ExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_expr
+	return p
+}
+
+func (*ExprContext) IsExprContext() {}
+
+func NewExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprContext {
+	var p = new(ExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_expr
+
+	return p
+}
+
+func (s *ExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExprContext) CopyFrom(ctx *ExprContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *ExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type PrimaryLitContext struct {
+	*ExprContext
+}
+
+func NewPrimaryLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PrimaryLitContext {
+	var p = new(PrimaryLitContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *PrimaryLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrimaryLitContext) PrimLit() IPrimLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrimLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrimLitContext)
+}
+
+func (s *PrimaryLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterPrimaryLit(s)
+	}
+}
+
+func (s *PrimaryLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitPrimaryLit(s)
+	}
+}
+
+type CallContext struct {
+	*ExprContext
+	recv  IExprContext
+	targs ITypsContext
+	args  IExprsContext
+}
+
+func NewCallContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CallContext {
+	var p = new(CallContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *CallContext) GetRecv() IExprContext { return s.recv }
+
+func (s *CallContext) GetTargs() ITypsContext { return s.targs }
+
+func (s *CallContext) GetArgs() IExprsContext { return s.args }
+
+func (s *CallContext) SetRecv(v IExprContext) { s.recv = v }
+
+func (s *CallContext) SetTargs(v ITypsContext) { s.targs = v }
+
+func (s *CallContext) SetArgs(v IExprsContext) { s.args = v }
+
+func (s *CallContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CallContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGGParserNAME, 0)
+}
+
+func (s *CallContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *CallContext) Typs() ITypsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypsContext)
+}
+
+func (s *CallContext) Exprs() IExprsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprsContext)
+}
+
+func (s *CallContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterCall(s)
+	}
+}
+
+func (s *CallContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitCall(s)
+	}
+}
+
+type ConvertContext struct {
+	*ExprContext
+}
+
+func NewConvertContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ConvertContext {
+	var p = new(ConvertContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *ConvertContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ConvertContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *ConvertContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ConvertContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterConvert(s)
+	}
+}
+
+func (s *ConvertContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitConvert(s)
+	}
+}
+
+type VariableContext struct {
+	*ExprContext
+}
+
+func NewVariableContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *VariableContext {
+	var p = new(VariableContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *VariableContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *VariableContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGGParserNAME, 0)
+}
+
+func (s *VariableContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterVariable(s)
+	}
+}
+
+func (s *VariableContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitVariable(s)
+	}
+}
+
+type UnaryOpContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewUnaryOpContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *UnaryOpContext {
+	var p = new(UnaryOpContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *UnaryOpContext) GetOp() antlr.Token { return s.op }
+
+func (s *UnaryOpContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *UnaryOpContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UnaryOpContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *UnaryOpContext) NOT() antlr.TerminalNode {
+	return s.GetToken(FGGParserNOT, 0)
+}
+
+func (s *UnaryOpContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(FGGParserMINUS, 0)
+}
+
+func (s *UnaryOpContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterUnaryOp(s)
+	}
+}
+
+func (s *UnaryOpContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitUnaryOp(s)
+	}
+}
+
+type FuncLitContext struct {
+	*ExprContext
+}
+
+func NewFuncLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FuncLitContext {
+	var p = new(FuncLitContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *FuncLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FuncLitContext) FUNC() antlr.TerminalNode {
+	return s.GetToken(FGGParserFUNC, 0)
+}
+
+func (s *FuncLitContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *FuncLitContext) Body() IBodyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBodyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBodyContext)
+}
+
+func (s *FuncLitContext) Params() IParamsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IParamsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParamsContext)
+}
+
+func (s *FuncLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterFuncLit(s)
+	}
+}
+
+func (s *FuncLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitFuncLit(s)
+	}
+}
+
+type ApplyContext struct {
+	*ExprContext
+	args IExprsContext
+}
+
+func NewApplyContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ApplyContext {
+	var p = new(ApplyContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *ApplyContext) GetArgs() IExprsContext { return s.args }
+
+func (s *ApplyContext) SetArgs(v IExprsContext) { s.args = v }
+
+func (s *ApplyContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ApplyContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ApplyContext) Exprs() IExprsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprsContext)
+}
+
+func (s *ApplyContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterApply(s)
+	}
+}
+
+func (s *ApplyContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitApply(s)
+	}
+}
+
+type IndexContext struct {
+	*ExprContext
+	index IExprContext
+}
+
+func NewIndexContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IndexContext {
+	var p = new(IndexContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *IndexContext) GetIndex() IExprContext { return s.index }
+
+func (s *IndexContext) SetIndex(v IExprContext) { s.index = v }
+
+func (s *IndexContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IndexContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *IndexContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *IndexContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterIndex(s)
+	}
+}
+
+func (s *IndexContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitIndex(s)
+	}
+}
+
+type MapLitContext struct {
+	*ExprContext
+}
+
+func NewMapLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MapLitContext {
+	var p = new(MapLitContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *MapLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MapLitContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *MapLitContext) Entries() IEntriesContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEntriesContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEntriesContext)
+}
+
+func (s *MapLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterMapLit(s)
+	}
+}
+
+func (s *MapLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitMapLit(s)
+	}
+}
+
+type StructLitContext struct {
+	*ExprContext
+}
+
+func NewStructLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StructLitContext {
+	var p = new(StructLitContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *StructLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StructLitContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *StructLitContext) Exprs() IExprsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprsContext)
+}
+
+func (s *StructLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterStructLit(s)
+	}
+}
+
+func (s *StructLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitStructLit(s)
+	}
+}
+
+type FuncCallContext struct {
+	*ExprContext
+	targs ITypsContext
+	args  IExprsContext
+}
+
+func NewFuncCallContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FuncCallContext {
+	var p = new(FuncCallContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *FuncCallContext) GetTargs() ITypsContext { return s.targs }
+
+func (s *FuncCallContext) GetArgs() IExprsContext { return s.args }
+
+func (s *FuncCallContext) SetTargs(v ITypsContext) { s.targs = v }
+
+func (s *FuncCallContext) SetArgs(v IExprsContext) { s.args = v }
+
+func (s *FuncCallContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FuncCallContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGGParserNAME, 0)
+}
+
+func (s *FuncCallContext) Typs() ITypsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypsContext)
+}
+
+func (s *FuncCallContext) Exprs() IExprsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprsContext)
+}
+
+func (s *FuncCallContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterFuncCall(s)
+	}
+}
+
+func (s *FuncCallContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitFuncCall(s)
+	}
+}
+
+type AppendContext struct {
+	*ExprContext
+	args IExprsContext
+}
+
+func NewAppendContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AppendContext {
+	var p = new(AppendContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *AppendContext) GetArgs() IExprsContext { return s.args }
+
+func (s *AppendContext) SetArgs(v IExprsContext) { s.args = v }
+
+func (s *AppendContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AppendContext) APPEND() antlr.TerminalNode {
+	return s.GetToken(FGGParserAPPEND, 0)
+}
+
+func (s *AppendContext) Exprs() IExprsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprsContext)
+}
+
+func (s *AppendContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterAppend(s)
+	}
+}
+
+func (s *AppendContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitAppend(s)
+	}
+}
+
+type AssertContext struct {
+	*ExprContext
+}
+
+func NewAssertContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AssertContext {
+	var p = new(AssertContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *AssertContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AssertContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *AssertContext) Typ() ITypContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypContext)
+}
+
+func (s *AssertContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterAssert(s)
+	}
+}
+
+func (s *AssertContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitAssert(s)
+	}
+}
+
+type LenContext struct {
+	*ExprContext
+}
+
+func NewLenContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LenContext {
+	var p = new(LenContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *LenContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LenContext) LEN() antlr.TerminalNode {
+	return s.GetToken(FGGParserLEN, 0)
+}
+
+func (s *LenContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *LenContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterLen(s)
+	}
+}
+
+func (s *LenContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitLen(s)
+	}
+}
+
+type SprintfContext struct {
+	*ExprContext
+}
+
+func NewSprintfContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SprintfContext {
+	var p = new(SprintfContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *SprintfContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SprintfContext) FMT() antlr.TerminalNode {
+	return s.GetToken(FGGParserFMT, 0)
+}
+
+func (s *SprintfContext) SPRINTF() antlr.TerminalNode {
+	return s.GetToken(FGGParserSPRINTF, 0)
+}
+
+func (s *SprintfContext) STRING_LIT() antlr.TerminalNode {
+	return s.GetToken(FGGParserSTRING_LIT, 0)
+}
+
+func (s *SprintfContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SprintfContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *SprintfContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterSprintf(s)
+	}
+}
+
+func (s *SprintfContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitSprintf(s)
+	}
+}
+
+type SelectContext struct {
+	*ExprContext
+}
+
+func NewSelectContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SelectContext {
+	var p = new(SelectContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *SelectContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *SelectContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FGGParserNAME, 0)
+}
+
+func (s *SelectContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterSelect(s)
+	}
+}
+
+func (s *SelectContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitSelect(s)
+	}
+}
+
+type ParenContext struct {
+	*ExprContext
+}
+
+func NewParenContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParenContext {
+	var p = new(ParenContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *ParenContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParenContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ParenContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterParen(s)
+	}
+}
+
+func (s *ParenContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitParen(s)
+	}
+}
+
+type BinaryOpContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewBinaryOpContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BinaryOpContext {
+	var p = new(BinaryOpContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *BinaryOpContext) GetOp() antlr.Token { return s.op }
+
+func (s *BinaryOpContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *BinaryOpContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BinaryOpContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *BinaryOpContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *BinaryOpContext) TIMES() antlr.TerminalNode {
+	return s.GetToken(FGGParserTIMES, 0)
+}
+
+func (s *BinaryOpContext) DIV() antlr.TerminalNode {
+	return s.GetToken(FGGParserDIV, 0)
+}
+
+func (s *BinaryOpContext) MOD() antlr.TerminalNode {
+	return s.GetToken(FGGParserMOD, 0)
+}
+
+func (s *BinaryOpContext) SHL() antlr.TerminalNode {
+	return s.GetToken(FGGParserSHL, 0)
+}
+
+func (s *BinaryOpContext) SHR() antlr.TerminalNode {
+	return s.GetToken(FGGParserSHR, 0)
+}
+
+func (s *BinaryOpContext) BITAND() antlr.TerminalNode {
+	return s.GetToken(FGGParserBITAND, 0)
+}
+
+func (s *BinaryOpContext) PLUS() antlr.TerminalNode {
+	return s.GetToken(FGGParserPLUS, 0)
+}
+
+func (s *BinaryOpContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(FGGParserMINUS, 0)
+}
+
+func (s *BinaryOpContext) BITOR() antlr.TerminalNode {
+	return s.GetToken(FGGParserBITOR, 0)
+}
+
+func (s *BinaryOpContext) BITXOR() antlr.TerminalNode {
+	return s.GetToken(FGGParserBITXOR, 0)
+}
+
+func (s *BinaryOpContext) EQ() antlr.TerminalNode {
+	return s.GetToken(FGGParserEQ, 0)
+}
+
+func (s *BinaryOpContext) NE() antlr.TerminalNode {
+	return s.GetToken(FGGParserNE, 0)
+}
+
+func (s *BinaryOpContext) GT() antlr.TerminalNode {
+	return s.GetToken(FGGParserGT, 0)
+}
+
+func (s *BinaryOpContext) LT() antlr.TerminalNode {
+	return s.GetToken(FGGParserLT, 0)
+}
+
+func (s *BinaryOpContext) GE() antlr.TerminalNode {
+	return s.GetToken(FGGParserGE, 0)
+}
+
+func (s *BinaryOpContext) LE() antlr.TerminalNode {
+	return s.GetToken(FGGParserLE, 0)
+}
+
+func (s *BinaryOpContext) AND() antlr.TerminalNode {
+	return s.GetToken(FGGParserAND, 0)
+}
+
+func (s *BinaryOpContext) OR() antlr.TerminalNode {
+	return s.GetToken(FGGParserOR, 0)
+}
+
+func (s *BinaryOpContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterBinaryOp(s)
+	}
+}
+
+func (s *BinaryOpContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitBinaryOp(s)
+	}
+}
+
+func (p *FGGParser) Expr() (localctx IExprContext) {
+	return p.expr(0)
+}
+
+func (p *FGGParser) expr(_p int) (localctx IExprContext) {
+	this := p
+	_ = this
+
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+	_parentState := p.GetState()
+	localctx = NewExprContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExprContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 54
+	p.EnterRecursionRule(localctx, 54, FGGParserRULE_expr, _p)
+	var _la int
+
+	defer func() {
+		p.UnrollRecursionContexts(_parentctx)
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(419)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewStructLitContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+
+		{
+			p.SetState(349)
+			p.Typ()
+		}
+		{
+			p.SetState(350)
+			p.Match(FGGParserT__5)
+		}
+		p.SetState(352)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGGParserT__0)|(1<<FGGParserT__2)|(1<<FGGParserFUNC)|(1<<FGGParserINTERFACE)|(1<<FGGParserMAP)|(1<<FGGParserSTRUCT)|(1<<FGGParserFMT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(FGGParserLEN-33))|(1<<(FGGParserAPPEND-33))|(1<<(FGGParserTRUE-33))|(1<<(FGGParserFALSE-33))|(1<<(FGGParserBOOL-33))|(1<<(FGGParserINT32-33))|(1<<(FGGParserINT64-33))|(1<<(FGGParserFLOAT32-33))|(1<<(FGGParserFLOAT64-33))|(1<<(FGGParserSTRING-33))|(1<<(FGGParserMINUS-33))|(1<<(FGGParserNOT-33))|(1<<(FGGParserNAME-33)))) != 0) || (((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(FGGParserSTRING_LIT-68))|(1<<(FGGParserINT_LIT-68))|(1<<(FGGParserFLOAT_LIT-68)))) != 0) {
+			{
+				p.SetState(351)
+				p.Exprs()
+			}
+
+		}
+		{
+			p.SetState(354)
+			p.Match(FGGParserT__6)
+		}
+
+	case 2:
+		localctx = NewMapLitContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(356)
+			p.Typ()
+		}
+		{
+			p.SetState(357)
+			p.Match(FGGParserT__5)
+		}
+		{
+			p.SetState(358)
+			p.Entries()
+		}
+		{
+			p.SetState(359)
+			p.Match(FGGParserT__6)
+		}
+
+	case 3:
+		localctx = NewSprintfContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(361)
+			p.Match(FGGParserFMT)
+		}
+		{
+			p.SetState(362)
+			p.Match(FGGParserT__10)
+		}
+		{
+			p.SetState(363)
+			p.Match(FGGParserSPRINTF)
+		}
+		{
+			p.SetState(364)
+			p.Match(FGGParserT__0)
+		}
+		{
+			p.SetState(365)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == FGGParserT__11 || _la == FGGParserSTRING_LIT) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		p.SetState(370)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGGParserT__0)|(1<<FGGParserT__2)|(1<<FGGParserT__4)|(1<<FGGParserFUNC)|(1<<FGGParserINTERFACE)|(1<<FGGParserMAP)|(1<<FGGParserSTRUCT)|(1<<FGGParserFMT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(FGGParserLEN-33))|(1<<(FGGParserAPPEND-33))|(1<<(FGGParserTRUE-33))|(1<<(FGGParserFALSE-33))|(1<<(FGGParserBOOL-33))|(1<<(FGGParserINT32-33))|(1<<(FGGParserINT64-33))|(1<<(FGGParserFLOAT32-33))|(1<<(FGGParserFLOAT64-33))|(1<<(FGGParserSTRING-33))|(1<<(FGGParserMINUS-33))|(1<<(FGGParserNOT-33))|(1<<(FGGParserNAME-33)))) != 0) || (((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(FGGParserSTRING_LIT-68))|(1<<(FGGParserINT_LIT-68))|(1<<(FGGParserFLOAT_LIT-68)))) != 0) {
+			p.SetState(368)
+			p.GetErrorHandler().Sync(p)
+
+			switch p.GetTokenStream().LA(1) {
+			case FGGParserT__4:
+				{
+					p.SetState(366)
+					p.Match(FGGParserT__4)
+				}
+
+			case FGGParserT__0, FGGParserT__2, FGGParserFUNC, FGGParserINTERFACE, FGGParserMAP, FGGParserSTRUCT, FGGParserFMT, FGGParserLEN, FGGParserAPPEND, FGGParserTRUE, FGGParserFALSE, FGGParserBOOL, FGGParserINT32, FGGParserINT64, FGGParserFLOAT32, FGGParserFLOAT64, FGGParserSTRING, FGGParserMINUS, FGGParserNOT, FGGParserNAME, FGGParserSTRING_LIT, FGGParserINT_LIT, FGGParserFLOAT_LIT:
+				{
+					p.SetState(367)
+					p.expr(0)
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+			p.SetState(372)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(373)
+			p.Match(FGGParserT__1)
+		}
+
+	case 4:
+		localctx = NewLenContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(374)
+			p.Match(FGGParserLEN)
+		}
+		{
+			p.SetState(375)
+			p.Match(FGGParserT__0)
+		}
+		{
+			p.SetState(376)
+			p.expr(0)
+		}
+		{
+			p.SetState(377)
+			p.Match(FGGParserT__1)
+		}
+
+	case 5:
+		localctx = NewAppendContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(379)
+			p.Match(FGGParserAPPEND)
+		}
+		{
+			p.SetState(380)
+			p.Match(FGGParserT__0)
+		}
+		{
+			p.SetState(381)
+
+			var _x = p.Exprs()
+
+			localctx.(*AppendContext).args = _x
+		}
+		{
+			p.SetState(382)
+			p.Match(FGGParserT__1)
+		}
+
+	case 6:
+		localctx = NewConvertContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(384)
+			p.Typ()
+		}
+		{
+			p.SetState(385)
+			p.Match(FGGParserT__0)
+		}
+		{
+			p.SetState(386)
+			p.expr(0)
+		}
+		{
+			p.SetState(387)
+			p.Match(FGGParserT__1)
+		}
+
+	case 7:
+		localctx = NewFuncCallContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(389)
+			p.Match(FGGParserNAME)
+		}
+		{
+			p.SetState(390)
+			p.Match(FGGParserT__0)
+		}
+		p.SetState(392)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGGParserT__2)|(1<<FGGParserFUNC)|(1<<FGGParserINTERFACE)|(1<<FGGParserMAP)|(1<<FGGParserSTRUCT))) != 0) || (((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(FGGParserBOOL-38))|(1<<(FGGParserINT32-38))|(1<<(FGGParserINT64-38))|(1<<(FGGParserFLOAT32-38))|(1<<(FGGParserFLOAT64-38))|(1<<(FGGParserSTRING-38))|(1<<(FGGParserNAME-38)))) != 0) {
+			{
+				p.SetState(391)
+
+				var _x = p.Typs()
+
+				localctx.(*FuncCallContext).targs = _x
+			}
+
+		}
+		{
+			p.SetState(394)
+			p.Match(FGGParserT__1)
+		}
+		{
+			p.SetState(395)
+			p.Match(FGGParserT__0)
+		}
+		p.SetState(397)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGGParserT__0)|(1<<FGGParserT__2)|(1<<FGGParserFUNC)|(1<<FGGParserINTERFACE)|(1<<FGGParserMAP)|(1<<FGGParserSTRUCT)|(1<<FGGParserFMT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(FGGParserLEN-33))|(1<<(FGGParserAPPEND-33))|(1<<(FGGParserTRUE-33))|(1<<(FGGParserFALSE-33))|(1<<(FGGParserBOOL-33))|(1<<(FGGParserINT32-33))|(1<<(FGGParserINT64-33))|(1<<(FGGParserFLOAT32-33))|(1<<(FGGParserFLOAT64-33))|(1<<(FGGParserSTRING-33))|(1<<(FGGParserMINUS-33))|(1<<(FGGParserNOT-33))|(1<<(FGGParserNAME-33)))) != 0) || (((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(FGGParserSTRING_LIT-68))|(1<<(FGGParserINT_LIT-68))|(1<<(FGGParserFLOAT_LIT-68)))) != 0) {
+			{
+				p.SetState(396)
+
+				var _x = p.Exprs()
+
+				localctx.(*FuncCallContext).args = _x
+			}
+
+		}
+		{
+			p.SetState(399)
+			p.Match(FGGParserT__1)
+		}
+
+	case 8:
+		localctx = NewVariableContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(400)
+			p.Match(FGGParserNAME)
+		}
+
+	case 9:
+		localctx = NewFuncLitContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(401)
+			p.Match(FGGParserFUNC)
+		}
+		{
+			p.SetState(402)
+			p.Match(FGGParserT__0)
+		}
+		p.SetState(404)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == FGGParserNAME {
+			{
+				p.SetState(403)
+				p.Params()
+			}
+
+		}
+		{
+			p.SetState(406)
+			p.Match(FGGParserT__1)
+		}
+		{
+			p.SetState(407)
+			p.Typ()
+		}
+		{
+			p.SetState(408)
+			p.Match(FGGParserT__5)
+		}
+		{
+			p.SetState(409)
+			p.Body()
+		}
+		{
+			p.SetState(410)
+			p.Match(FGGParserT__6)
+		}
+
+	case 10:
+		localctx = NewUnaryOpContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(412)
+
+			var _lt = p.GetTokenStream().LT(1)
+
+			localctx.(*UnaryOpContext).op = _lt
+
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == FGGParserMINUS || _la == FGGParserNOT) {
+				var _ri = p.GetErrorHandler().RecoverInline(p)
+
+				localctx.(*UnaryOpContext).op = _ri
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(413)
+			p.expr(8)
+		}
+
+	case 11:
+		localctx = NewParenContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(414)
+			p.Match(FGGParserT__0)
+		}
+		{
+			p.SetState(415)
+			p.expr(0)
+		}
+		{
+			p.SetState(416)
+			p.Match(FGGParserT__1)
+		}
+
+	case 12:
+		localctx = NewPrimaryLitContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(418)
+			p.PrimLit()
+		}
+
+	}
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(471)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			p.SetState(469)
+			p.GetErrorHandler().Sync(p)
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 41, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewBinaryOpContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGGParserRULE_expr)
+				p.SetState(421)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+				}
+				{
+					p.SetState(422)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*BinaryOpContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(((_la-46)&-(0x1f+1)) == 0 && ((1<<uint((_la-46)))&((1<<(FGGParserTIMES-46))|(1<<(FGGParserDIV-46))|(1<<(FGGParserMOD-46))|(1<<(FGGParserBITAND-46))|(1<<(FGGParserSHL-46))|(1<<(FGGParserSHR-46)))) != 0) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*BinaryOpContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(423)
+					p.expr(8)
+				}
+
+			case 2:
+				localctx = NewBinaryOpContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGGParserRULE_expr)
+				p.SetState(424)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+				}
+				{
+					p.SetState(425)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*BinaryOpContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(((_la-44)&-(0x1f+1)) == 0 && ((1<<uint((_la-44)))&((1<<(FGGParserPLUS-44))|(1<<(FGGParserMINUS-44))|(1<<(FGGParserBITOR-44))|(1<<(FGGParserBITXOR-44)))) != 0) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*BinaryOpContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(426)
+					p.expr(7)
+				}
+
+			case 3:
+				localctx = NewBinaryOpContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGGParserRULE_expr)
+				p.SetState(427)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+				}
+				{
+					p.SetState(428)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*BinaryOpContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(FGGParserEQ-57))|(1<<(FGGParserNE-57))|(1<<(FGGParserGT-57))|(1<<(FGGParserLT-57))|(1<<(FGGParserGE-57))|(1<<(FGGParserLE-57)))) != 0) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*BinaryOpContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(429)
+					p.expr(6)
+				}
+
+			case 4:
+				localctx = NewBinaryOpContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGGParserRULE_expr)
+				p.SetState(430)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+				}
+				{
+					p.SetState(431)
+
+					var _m = p.Match(FGGParserAND)
+
+					localctx.(*BinaryOpContext).op = _m
+				}
+				{
+					p.SetState(432)
+					p.expr(5)
+				}
+
+			case 5:
+				localctx = NewBinaryOpContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGGParserRULE_expr)
+				p.SetState(433)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+				}
+				{
+					p.SetState(434)
+
+					var _m = p.Match(FGGParserOR)
+
+					localctx.(*BinaryOpContext).op = _m
+				}
+				{
+					p.SetState(435)
+					p.expr(4)
+				}
+
+			case 6:
+				localctx = NewCallContext(p, NewExprContext(p, _parentctx, _parentState))
+				localctx.(*CallContext).recv = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, FGGParserRULE_expr)
+				p.SetState(436)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 20)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 20)", ""))
+				}
+				{
+					p.SetState(437)
+					p.Match(FGGParserT__10)
+				}
+				{
+					p.SetState(438)
+					p.Match(FGGParserNAME)
+				}
+				{
+					p.SetState(439)
+					p.Match(FGGParserT__0)
+				}
+				p.SetState(441)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGGParserT__2)|(1<<FGGParserFUNC)|(1<<FGGParserINTERFACE)|(1<<FGGParserMAP)|(1<<FGGParserSTRUCT))) != 0) || (((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(FGGParserBOOL-38))|(1<<(FGGParserINT32-38))|(1<<(FGGParserINT64-38))|(1<<(FGGParserFLOAT32-38))|(1<<(FGGParserFLOAT64-38))|(1<<(FGGParserSTRING-38))|(1<<(FGGParserNAME-38)))) != 0) {
+					{
+						p.SetState(440)
+
+						var _x = p.Typs()
+
+						localctx.(*CallContext).targs = _x
+					}
+
+				}
+				{
+					p.SetState(443)
+					p.Match(FGGParserT__1)
+				}
+				{
+					p.SetState(444)
+					p.Match(FGGParserT__0)
+				}
+				p.SetState(446)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGGParserT__0)|(1<<FGGParserT__2)|(1<<FGGParserFUNC)|(1<<FGGParserINTERFACE)|(1<<FGGParserMAP)|(1<<FGGParserSTRUCT)|(1<<FGGParserFMT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(FGGParserLEN-33))|(1<<(FGGParserAPPEND-33))|(1<<(FGGParserTRUE-33))|(1<<(FGGParserFALSE-33))|(1<<(FGGParserBOOL-33))|(1<<(FGGParserINT32-33))|(1<<(FGGParserINT64-33))|(1<<(FGGParserFLOAT32-33))|(1<<(FGGParserFLOAT64-33))|(1<<(FGGParserSTRING-33))|(1<<(FGGParserMINUS-33))|(1<<(FGGParserNOT-33))|(1<<(FGGParserNAME-33)))) != 0) || (((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(FGGParserSTRING_LIT-68))|(1<<(FGGParserINT_LIT-68))|(1<<(FGGParserFLOAT_LIT-68)))) != 0) {
+					{
+						p.SetState(445)
+
+						var _x = p.Exprs()
+
+						localctx.(*CallContext).args = _x
+					}
+
+				}
+				{
+					p.SetState(448)
+					p.Match(FGGParserT__1)
+				}
+
+			case 7:
+				localctx = NewSelectContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGGParserRULE_expr)
+				p.SetState(449)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
+				}
+				{
+					p.SetState(450)
+					p.Match(FGGParserT__10)
+				}
+				{
+					p.SetState(451)
+					p.Match(FGGParserNAME)
+				}
+
+			case 8:
+				localctx = NewApplyContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGGParserRULE_expr)
+				p.SetState(452)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
+				}
+				{
+					p.SetState(453)
+					p.Match(FGGParserT__0)
+				}
+				p.SetState(455)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGGParserT__0)|(1<<FGGParserT__2)|(1<<FGGParserFUNC)|(1<<FGGParserINTERFACE)|(1<<FGGParserMAP)|(1<<FGGParserSTRUCT)|(1<<FGGParserFMT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(FGGParserLEN-33))|(1<<(FGGParserAPPEND-33))|(1<<(FGGParserTRUE-33))|(1<<(FGGParserFALSE-33))|(1<<(FGGParserBOOL-33))|(1<<(FGGParserINT32-33))|(1<<(FGGParserINT64-33))|(1<<(FGGParserFLOAT32-33))|(1<<(FGGParserFLOAT64-33))|(1<<(FGGParserSTRING-33))|(1<<(FGGParserMINUS-33))|(1<<(FGGParserNOT-33))|(1<<(FGGParserNAME-33)))) != 0) || (((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(FGGParserSTRING_LIT-68))|(1<<(FGGParserINT_LIT-68))|(1<<(FGGParserFLOAT_LIT-68)))) != 0) {
+					{
+						p.SetState(454)
+
+						var _x = p.Exprs()
+
+						localctx.(*ApplyContext).args = _x
+					}
+
+				}
+				{
+					p.SetState(457)
+					p.Match(FGGParserT__1)
+				}
+
+			case 9:
+				localctx = NewIndexContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGGParserRULE_expr)
+				p.SetState(458)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
+				}
+				{
+					p.SetState(459)
+					p.Match(FGGParserT__2)
+				}
+				{
+					p.SetState(460)
+
+					var _x = p.expr(0)
+
+					localctx.(*IndexContext).index = _x
+				}
+				{
+					p.SetState(461)
+					p.Match(FGGParserT__3)
+				}
+
+			case 10:
+				localctx = NewAssertContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FGGParserRULE_expr)
+				p.SetState(463)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
+				}
+				{
+					p.SetState(464)
+					p.Match(FGGParserT__10)
+				}
+				{
+					p.SetState(465)
+					p.Match(FGGParserT__0)
+				}
+				{
+					p.SetState(466)
+					p.Typ()
+				}
+				{
+					p.SetState(467)
+					p.Match(FGGParserT__1)
+				}
+
+			}
+
+		}
+		p.SetState(473)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// IExprsContext is an interface to support dynamic dispatch.
+type IExprsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExprsContext differentiates from other interfaces.
+	IsExprsContext()
+}
+
+type ExprsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExprsContext() *ExprsContext {
+	var p = new(ExprsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_exprs
+	return p
+}
+
+func (*ExprsContext) IsExprsContext() {}
+
+func NewExprsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprsContext {
+	var p = new(ExprsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_exprs
+
+	return p
+}
+
+func (s *ExprsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExprsContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
 		}
-		{
-			p.SetState(197)
-			p.Match(FGGParserT__9)
-		}
+	}
 
-	case 3:
-		localctx = NewSprintfContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-		{
-			p.SetState(199)
-			p.Match(FGGParserFMT)
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
 		}
-		{
-			p.SetState(200)
-			p.Match(FGGParserT__7)
+	}
+
+	return tst
+}
+
+func (s *ExprsContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
-		{
-			p.SetState(201)
-			p.Match(FGGParserSPRINTF)
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ExprsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExprsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExprsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterExprs(s)
+	}
+}
+
+func (s *ExprsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitExprs(s)
+	}
+}
+
+func (p *FGGParser) Exprs() (localctx IExprsContext) {
+	this := p
+	_ = this
+
+	localctx = NewExprsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, FGGParserRULE_exprs)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
 		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(474)
+		p.expr(0)
+	}
+	p.SetState(479)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FGGParserT__4 {
 		{
-			p.SetState(202)
-			p.Match(FGGParserT__0)
+			p.SetState(475)
+			p.Match(FGGParserT__4)
 		}
 		{
-			p.SetState(203)
-			_la = p.GetTokenStream().LA(1)
-
-			if !(_la == FGGParserT__8 || _la == FGGParserSTRING) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
-			}
+			p.SetState(476)
+			p.expr(0)
 		}
-		p.SetState(208)
+
+		p.SetState(481)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
+	}
 
-		for ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FGGParserT__2)|(1<<FGGParserFMT)|(1<<FGGParserNAME))) != 0 {
-			p.SetState(206)
-			p.GetErrorHandler().Sync(p)
+	return localctx
+}
 
-			switch p.GetTokenStream().LA(1) {
-			case FGGParserT__2:
-				{
-					p.SetState(204)
-					p.Match(FGGParserT__2)
-				}
+// IEntriesContext is an interface to support dynamic dispatch.
+type IEntriesContext interface {
+	antlr.ParserRuleContext
 
-			case FGGParserFMT, FGGParserNAME:
-				{
-					p.SetState(205)
-					p.expr(0)
-				}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-			default:
-				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	// IsEntriesContext differentiates from other interfaces.
+	IsEntriesContext()
+}
+
+type EntriesContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyEntriesContext() *EntriesContext {
+	var p = new(EntriesContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_entries
+	return p
+}
+
+func (*EntriesContext) IsEntriesContext() {}
+
+func NewEntriesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EntriesContext {
+	var p = new(EntriesContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_entries
+
+	return p
+}
+
+func (s *EntriesContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *EntriesContext) AllEntry() []IEntryContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IEntryContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IEntryContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IEntryContext); ok {
+			tst[i] = t.(IEntryContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *EntriesContext) Entry(i int) IEntryContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEntryContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
 			}
+			j++
+		}
+	}
 
-			p.SetState(210)
-			p.GetErrorHandler().Sync(p)
-			_la = p.GetTokenStream().LA(1)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEntryContext)
+}
+
+func (s *EntriesContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EntriesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *EntriesContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterEntries(s)
+	}
+}
+
+func (s *EntriesContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitEntries(s)
+	}
+}
+
+func (p *FGGParser) Entries() (localctx IEntriesContext) {
+	this := p
+	_ = this
+
+	localctx = NewEntriesContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, FGGParserRULE_entries)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(482)
+		p.Entry()
+	}
+	p.SetState(487)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FGGParserT__4 {
+		{
+			p.SetState(483)
+			p.Match(FGGParserT__4)
 		}
 		{
-			p.SetState(211)
-			p.Match(FGGParserT__1)
+			p.SetState(484)
+			p.Entry()
 		}
 
+		p.SetState(489)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
-	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(238)
-	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext())
 
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			if p.GetParseListeners() != nil {
-				p.TriggerExitRuleEvent()
-			}
-			_prevctx = localctx
-			p.SetState(236)
-			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 24, p.GetParserRuleContext()) {
-			case 1:
-				localctx = NewSelectContext(p, NewExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, FGGParserRULE_expr)
-				p.SetState(214)
+	return localctx
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
-				}
-				{
-					p.SetState(215)
-					p.Match(FGGParserT__7)
-				}
-				{
-					p.SetState(216)
-					p.Match(FGGParserNAME)
-				}
+// IEntryContext is an interface to support dynamic dispatch.
+type IEntryContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetKey returns the key rule contexts.
+	GetKey() IExprContext
+
+	// GetVal returns the val rule contexts.
+	GetVal() IExprContext
+
+	// SetKey sets the key rule contexts.
+	SetKey(IExprContext)
+
+	// SetVal sets the val rule contexts.
+	SetVal(IExprContext)
+
+	// IsEntryContext differentiates from other interfaces.
+	IsEntryContext()
+}
+
+type EntryContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+	key    IExprContext
+	val    IExprContext
+}
+
+func NewEmptyEntryContext() *EntryContext {
+	var p = new(EntryContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FGGParserRULE_entry
+	return p
+}
+
+func (*EntryContext) IsEntryContext() {}
+
+func NewEntryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EntryContext {
+	var p = new(EntryContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FGGParserRULE_entry
+
+	return p
+}
+
+func (s *EntryContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *EntryContext) GetKey() IExprContext { return s.key }
+
+func (s *EntryContext) GetVal() IExprContext { return s.val }
+
+func (s *EntryContext) SetKey(v IExprContext) { s.key = v }
+
+func (s *EntryContext) SetVal(v IExprContext) { s.val = v }
+
+func (s *EntryContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *EntryContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
-			case 2:
-				localctx = NewCallContext(p, NewExprContext(p, _parentctx, _parentState))
-				localctx.(*CallContext).recv = _prevctx
+	if t == nil {
+		return nil
+	}
 
-				p.PushNewRecursionContext(localctx, _startState, FGGParserRULE_expr)
-				p.SetState(217)
+	return t.(IExprContext)
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
-				}
-				{
-					p.SetState(218)
-					p.Match(FGGParserT__7)
-				}
-				{
-					p.SetState(219)
-					p.Match(FGGParserNAME)
-				}
-				{
-					p.SetState(220)
-					p.Match(FGGParserT__0)
-				}
-				p.SetState(222)
-				p.GetErrorHandler().Sync(p)
-				_la = p.GetTokenStream().LA(1)
+func (s *EntryContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-				if _la == FGGParserNAME {
-					{
-						p.SetState(221)
+func (s *EntryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-						var _x = p.Typs()
+func (s *EntryContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterEntry(s)
+	}
+}
 
-						localctx.(*CallContext).targs = _x
-					}
+func (s *EntryContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitEntry(s)
+	}
+}
 
-				}
-				{
-					p.SetState(224)
-					p.Match(FGGParserT__1)
-				}
-				{
-					p.SetState(225)
-					p.Match(FGGParserT__0)
-				}
-				p.SetState(227)
-				p.GetErrorHandler().Sync(p)
-				_la = p.GetTokenStream().LA(1)
+func (p *FGGParser) Entry() (localctx IEntryContext) {
+	this := p
+	_ = this
 
-				if _la == FGGParserFMT || _la == FGGParserNAME {
-					{
-						p.SetState(226)
+	localctx = NewEntryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, FGGParserRULE_entry)
 
-						var _x = p.Exprs()
+	defer func() {
+		p.ExitRule()
+	}()
 
-						localctx.(*CallContext).args = _x
-					}
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-				}
-				{
-					p.SetState(229)
-					p.Match(FGGParserT__1)
-				}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(490)
 
-			case 3:
-				localctx = NewAssertContext(p, NewExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, FGGParserRULE_expr)
-				p.SetState(230)
+		var _x = p.expr(0)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
-				}
-				{
-					p.SetState(231)
-					p.Match(FGGParserT__7)
-				}
-				{
-					p.SetState(232)
-					p.Match(FGGParserT__0)
-				}
-				{
-					p.SetState(233)
-					p.Typ()
-				}
-				{
-					p.SetState(234)
-					p.Match(FGGParserT__1)
-				}
+		localctx.(*EntryContext).key = _x
+	}
+	{
+		p.SetState(491)
+		p.Match(FGGParserT__13)
+	}
+	{
+		p.SetState(492)
 
-			}
+		var _x = p.expr(0)
 
-		}
-		p.SetState(240)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext())
+		localctx.(*EntryContext).val = _x
 	}
 
 	return localctx
 }
 
-// IExprsContext is an interface to support dynamic dispatch.
-type IExprsContext interface {
+// IPrimLitContext is an interface to support dynamic dispatch.
+type IPrimLitContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExprsContext differentiates from other interfaces.
-	IsExprsContext()
+	// IsPrimLitContext differentiates from other interfaces.
+	IsPrimLitContext()
 }
 
-type ExprsContext struct {
+type PrimLitContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExprsContext() *ExprsContext {
-	var p = new(ExprsContext)
+func NewEmptyPrimLitContext() *PrimLitContext {
+	var p = new(PrimLitContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FGGParserRULE_exprs
+	p.RuleIndex = FGGParserRULE_primLit
 	return p
 }
 
-func (*ExprsContext) IsExprsContext() {}
+func (*PrimLitContext) IsPrimLitContext() {}
 
-func NewExprsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprsContext {
-	var p = new(ExprsContext)
+func NewPrimLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimLitContext {
+	var p = new(PrimLitContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FGGParserRULE_exprs
+	p.RuleIndex = FGGParserRULE_primLit
 
 	return p
 }
 
-func (s *ExprsContext) GetParser() antlr.Parser { return s.parser }
+func (s *PrimLitContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExprsContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
+func (s *PrimLitContext) CopyFrom(ctx *PrimLitContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExprContext)
-		}
+func (s *PrimLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrimLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type FloatLitContext struct {
+	*PrimLitContext
+	lit antlr.Token
+}
+
+func NewFloatLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FloatLitContext {
+	var p = new(FloatLitContext)
+
+	p.PrimLitContext = NewEmptyPrimLitContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*PrimLitContext))
+
+	return p
+}
+
+func (s *FloatLitContext) GetLit() antlr.Token { return s.lit }
+
+func (s *FloatLitContext) SetLit(v antlr.Token) { s.lit = v }
+
+func (s *FloatLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FloatLitContext) FLOAT_LIT() antlr.TerminalNode {
+	return s.GetToken(FGGParserFLOAT_LIT, 0)
+}
+
+func (s *FloatLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterFloatLit(s)
 	}
+}
 
-	return tst
+func (s *FloatLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitFloatLit(s)
+	}
 }
 
-func (s *ExprsContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+type IntLitContext struct {
+	*PrimLitContext
+	lit antlr.Token
+}
 
-	if t == nil {
-		return nil
+func NewIntLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IntLitContext {
+	var p = new(IntLitContext)
+
+	p.PrimLitContext = NewEmptyPrimLitContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*PrimLitContext))
+
+	return p
+}
+
+func (s *IntLitContext) GetLit() antlr.Token { return s.lit }
+
+func (s *IntLitContext) SetLit(v antlr.Token) { s.lit = v }
+
+func (s *IntLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IntLitContext) INT_LIT() antlr.TerminalNode {
+	return s.GetToken(FGGParserINT_LIT, 0)
+}
+
+func (s *IntLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterIntLit(s)
 	}
+}
 
-	return t.(IExprContext)
+func (s *IntLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitIntLit(s)
+	}
 }
 
-func (s *ExprsContext) GetRuleContext() antlr.RuleContext {
+type BoolLitContext struct {
+	*PrimLitContext
+	lit antlr.Token
+}
+
+func NewBoolLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BoolLitContext {
+	var p = new(BoolLitContext)
+
+	p.PrimLitContext = NewEmptyPrimLitContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*PrimLitContext))
+
+	return p
+}
+
+func (s *BoolLitContext) GetLit() antlr.Token { return s.lit }
+
+func (s *BoolLitContext) SetLit(v antlr.Token) { s.lit = v }
+
+func (s *BoolLitContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExprsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *BoolLitContext) TRUE() antlr.TerminalNode {
+	return s.GetToken(FGGParserTRUE, 0)
 }
 
-func (s *ExprsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BoolLitContext) FALSE() antlr.TerminalNode {
+	return s.GetToken(FGGParserFALSE, 0)
+}
+
+func (s *BoolLitContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.EnterExprs(s)
+		listenerT.EnterBoolLit(s)
 	}
 }
 
-func (s *ExprsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BoolLitContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FGGListener); ok {
-		listenerT.ExitExprs(s)
+		listenerT.ExitBoolLit(s)
 	}
 }
 
-func (p *FGGParser) Exprs() (localctx IExprsContext) {
-	localctx = NewExprsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, FGGParserRULE_exprs)
+type StringLitContext struct {
+	*PrimLitContext
+	lit antlr.Token
+}
+
+func NewStringLitContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StringLitContext {
+	var p = new(StringLitContext)
+
+	p.PrimLitContext = NewEmptyPrimLitContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*PrimLitContext))
+
+	return p
+}
+
+func (s *StringLitContext) GetLit() antlr.Token { return s.lit }
+
+func (s *StringLitContext) SetLit(v antlr.Token) { s.lit = v }
+
+func (s *StringLitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StringLitContext) STRING_LIT() antlr.TerminalNode {
+	return s.GetToken(FGGParserSTRING_LIT, 0)
+}
+
+func (s *StringLitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.EnterStringLit(s)
+	}
+}
+
+func (s *StringLitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FGGListener); ok {
+		listenerT.ExitStringLit(s)
+	}
+}
+
+func (p *FGGParser) PrimLit() (localctx IPrimLitContext) {
+	this := p
+	_ = this
+
+	localctx = NewPrimLitContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, FGGParserRULE_primLit)
 	var _la int
 
 	defer func() {
@@ -3591,28 +8266,67 @@ func (p *FGGParser) Exprs() (localctx IExprsContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(241)
-		p.expr(0)
-	}
-	p.SetState(246)
+	p.SetState(498)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == FGGParserT__2 {
+	switch p.GetTokenStream().LA(1) {
+	case FGGParserTRUE, FGGParserFALSE:
+		localctx = NewBoolLitContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(242)
-			p.Match(FGGParserT__2)
+			p.SetState(494)
+
+			var _lt = p.GetTokenStream().LT(1)
+
+			localctx.(*BoolLitContext).lit = _lt
+
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == FGGParserTRUE || _la == FGGParserFALSE) {
+				var _ri = p.GetErrorHandler().RecoverInline(p)
+
+				localctx.(*BoolLitContext).lit = _ri
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	case FGGParserINT_LIT:
+		localctx = NewIntLitContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(495)
+
+			var _m = p.Match(FGGParserINT_LIT)
+
+			localctx.(*IntLitContext).lit = _m
 		}
+
+	case FGGParserFLOAT_LIT:
+		localctx = NewFloatLitContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(243)
-			p.expr(0)
+			p.SetState(496)
+
+			var _m = p.Match(FGGParserFLOAT_LIT)
+
+			localctx.(*FloatLitContext).lit = _m
 		}
 
-		p.SetState(248)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	case FGGParserSTRING_LIT:
+		localctx = NewStringLitContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(497)
+
+			var _m = p.Match(FGGParserSTRING_LIT)
+
+			localctx.(*StringLitContext).lit = _m
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -3620,7 +8334,7 @@ func (p *FGGParser) Exprs() (localctx IExprsContext) {
 
 func (p *FGGParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 17:
+	case 27:
 		var t *ExprContext = nil
 		if localctx != nil {
 			t = localctx.(*ExprContext)
@@ -3633,15 +8347,39 @@ func (p *FGGParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int
 }
 
 func (p *FGGParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	this := p
+	_ = this
+
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 4)
+		return p.Precpred(p.GetParserRuleContext(), 7)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 3)
+		return p.Precpred(p.GetParserRuleContext(), 6)
 
 	case 2:
-		return p.Precpred(p.GetParserRuleContext(), 2)
+		return p.Precpred(p.GetParserRuleContext(), 5)
+
+	case 3:
+		return p.Precpred(p.GetParserRuleContext(), 4)
+
+	case 4:
+		return p.Precpred(p.GetParserRuleContext(), 3)
+
+	case 5:
+		return p.Precpred(p.GetParserRuleContext(), 20)
+
+	case 6:
+		return p.Precpred(p.GetParserRuleContext(), 19)
+
+	case 7:
+		return p.Precpred(p.GetParserRuleContext(), 18)
+
+	case 8:
+		return p.Precpred(p.GetParserRuleContext(), 17)
+
+	case 9:
+		return p.Precpred(p.GetParserRuleContext(), 16)
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))